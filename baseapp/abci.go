@@ -13,6 +13,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -20,6 +21,7 @@ import (
 
 	"github.com/line/lbm-sdk/codec"
 	snapshottypes "github.com/line/lbm-sdk/snapshots/types"
+	"github.com/line/lbm-sdk/store/rootmulti"
 	"github.com/line/lbm-sdk/telemetry"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
@@ -178,8 +180,11 @@ func (app *BaseApp) BeginBlock(req ocabci.RequestBeginBlock) (res abci.ResponseB
 			WithHeaderHash(req.Hash)
 	}
 
+	ctx, span := startSpan(app.deliverState.ctx, spanBeginBlock, attribute.Int64("height", req.Header.Height))
+	defer span.End()
+
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		res = app.beginBlocker(ctx, req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 	// set the signed validators for addition to context in deliverTx
@@ -203,8 +208,11 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	}
 
+	ctx, span := startSpan(app.deliverState.ctx, spanEndBlock, attribute.Int64("height", req.Height))
+	defer span.End()
+
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		res = app.endBlocker(ctx, req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
@@ -343,6 +351,9 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.deliverState.ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	_, span := startSpan(app.deliverState.ctx, spanCommit, attribute.Int64("height", header.Height))
+	defer span.End()
+
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
@@ -866,6 +877,12 @@ func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.R
 	}
 
 	resp := queryable.Query(req)
+
+	// "/store/<storeName>/diff" carries the heights in the request data, so the
+	// response height is the one the diff has been taken to
+	if len(path) == 3 && "/"+path[2] == rootmulti.StateDiffPath {
+		return resp
+	}
 	resp.Height = req.Height
 
 	return resp
@@ -11,6 +11,7 @@ import (
 
 	ocabci "github.com/line/ostracon/abci/types"
 
+	storetypes "github.com/line/lbm-sdk/store/types"
 	sdk "github.com/line/lbm-sdk/types"
 )
 
@@ -172,6 +173,38 @@ func TestBaseAppCreateQueryContext(t *testing.T) {
 	}
 }
 
+func TestBaseAppQueryStateDiff(t *testing.T) {
+	t.Parallel()
+
+	logger := defaultLogger()
+	db := dbm.NewMemDB()
+	name := t.Name()
+	app := NewBaseApp(name, logger, db, nil)
+	capKey := sdk.NewKVStoreKey("main")
+	app.MountStores(capKey)
+	require.NoError(t, app.LoadLatestVersion())
+
+	key, value := []byte("hello"), []byte("goodbye")
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		if height == 2 {
+			app.deliverState.ctx.KVStore(capKey).Set(key, value)
+		}
+		app.Commit()
+	}
+
+	reqBz, err := (&storetypes.StateDiffRequest{FromVersion: 1, ToVersion: 2}).Marshal()
+	require.NoError(t, err)
+
+	res := app.Query(abci.RequestQuery{Path: "/store/main/diff", Data: reqBz})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(2), res.Height)
+
+	var diff storetypes.StateDiff
+	require.NoError(t, diff.Unmarshal(res.Value))
+	require.Equal(t, []storetypes.StateChange{{Key: key, NewValue: value}}, diff.Added)
+}
+
 // Test and ensure that consensus params has been updated.
 // See:
 // - https://github.com/line/lbm-sdk/pull/673
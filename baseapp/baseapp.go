@@ -8,6 +8,8 @@ import (
 	"sync"
 
 	"github.com/gogo/protobuf/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -22,6 +24,7 @@ import (
 	"github.com/line/lbm-sdk/snapshots"
 	"github.com/line/lbm-sdk/store"
 	"github.com/line/lbm-sdk/store/rootmulti"
+	"github.com/line/lbm-sdk/telemetry"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/auth/legacy/legacytx"
@@ -306,6 +309,12 @@ func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
 	return app.cms
 }
 
+// InterBlockCache returns the inter-block cache of the multi-store, or nil if
+// it is disabled.
+func (app *BaseApp) InterBlockCache() sdk.MultiStorePersistentCache {
+	return app.interBlockCache
+}
+
 // SnapshotManager returns the snapshot manager.
 // application use this to register extra extension snapshotters.
 func (app *BaseApp) SnapshotManager() *snapshots.Manager {
@@ -687,6 +696,14 @@ func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.G
 	ctx := app.getRunContextForTx(txBytes, simulate)
 	ms := ctx.MultiStore()
 
+	spanName := spanDeliverTx
+	if simulate {
+		spanName = spanSimulate
+	}
+	var span trace.Span
+	ctx, span = startSpan(ctx, spanName, attribute.Int64("height", ctx.BlockHeight()))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// only run the tx if there is block gas remaining
 	if !simulate && ctx.BlockGasMeter().IsOutOfGas() {
 		return gInfo, nil, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
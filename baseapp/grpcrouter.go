@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/encoding/proto"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/line/lbm-sdk/client/grpc/reflection"
 	codectypes "github.com/line/lbm-sdk/codec/types"
+	"github.com/line/lbm-sdk/telemetry"
 	sdk "github.com/line/lbm-sdk/types"
 )
 
@@ -79,7 +81,10 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 			)
 		}
 
-		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (_ abci.ResponseQuery, err error) {
+			ctx, span := startSpan(ctx, fqName, attribute.Int64("height", req.Height))
+			defer func() { telemetry.EndSpan(span, err) }()
+
 			// call the method handler from the service description with the handler object,
 			// a wrapped sdk.Context with proto-unmarshaled data from the ABCI request data
 			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
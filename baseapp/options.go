@@ -21,6 +21,26 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetStorePruning sets the pruning options of the individual stores by their
+// names on the multistore associated with the app, overriding SetPruning.
+func SetStorePruning(storeOpts map[string]sdk.StorePruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		for name, opts := range storeOpts {
+			bapp.cms.SetStorePruning(name, opts)
+		}
+	}
+}
+
+// SetPruningArchive sets the db the pruned states of the stores enabling the
+// archive are offloaded into. A nil db disables the archive.
+func SetPruningArchive(db dbm.DB) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if db != nil {
+			bapp.cms.SetPruningArchive(db)
+		}
+	}
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -0,0 +1,37 @@
+package baseapp
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/line/lbm-sdk/telemetry"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// Span names of the ABCI methods traced by the BaseApp.
+const (
+	spanBeginBlock = "abci.BeginBlock"
+	spanDeliverTx  = "abci.DeliverTx"
+	spanSimulate   = "abci.Simulate"
+	spanEndBlock   = "abci.EndBlock"
+	spanCommit     = "abci.Commit"
+)
+
+// startSpan starts a span as a child of the span held by ctx, if any, and
+// returns a Context holding the new span. A non-recording span is returned if
+// tracing is disabled.
+func startSpan(ctx sdk.Context, name string, attrs ...attribute.KeyValue) (sdk.Context, trace.Span) {
+	if !telemetry.IsTracingEnabled() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	goCtx := ctx.Context()
+	if goCtx == nil {
+		goCtx = context.Background()
+	}
+
+	goCtx, span := telemetry.StartSpan(goCtx, name, attrs...)
+	return ctx.WithContext(goCtx), span
+}
@@ -0,0 +1,102 @@
+package baseapp_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	ocabci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/telemetry"
+	"github.com/line/lbm-sdk/testutil/testdata"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+type outerDecorator struct{}
+
+func (outerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+type innerDecorator struct{}
+
+func (innerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+func TestTracing(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "traces.json")
+	tracing, err := telemetry.NewTracing(telemetry.Config{
+		Tracing: telemetry.TracingConfig{
+			Enabled:    true,
+			Exporter:   telemetry.TracingExporterFile,
+			File:       file,
+			SampleRate: 1,
+		},
+	})
+	require.NoError(t, err)
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	app := baseapp.NewBaseApp("test", log.NewOCLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	app.SetAnteHandler(sdk.ChainAnteDecorators(outerDecorator{}, innerDecorator{}))
+	testdata.RegisterMsgServer(app.MsgServiceRouter(), testdata.MsgServerImpl{})
+	require.NoError(t, app.LoadLatestVersion())
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}))
+	txBytes, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	require.NoError(t, tracing.Shutdown(context.Background()))
+
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+
+	type span struct {
+		Name        string
+		SpanContext struct{ SpanID string }
+		Parent      struct{ SpanID string }
+	}
+	spans := map[string]span{}
+	dec := json.NewDecoder(bufio.NewReader(f))
+	for dec.More() {
+		var s span
+		require.NoError(t, dec.Decode(&s))
+		spans[s.Name] = s
+	}
+
+	for _, name := range []string{"abci.BeginBlock", "abci.EndBlock", "abci.Commit"} {
+		require.Contains(t, spans, name)
+	}
+
+	deliverTx := spans["abci.DeliverTx"]
+	outer := spans["baseapp_test.outerDecorator"]
+	inner := spans["baseapp_test.innerDecorator"]
+	msg := spans[sdk.MsgTypeURL(&testdata.MsgCreateDog{})]
+
+	require.NotEmpty(t, deliverTx.SpanContext.SpanID)
+	require.Equal(t, deliverTx.SpanContext.SpanID, outer.Parent.SpanID)
+	require.Equal(t, outer.SpanContext.SpanID, inner.Parent.SpanID)
+	// the Msg handler span is a sibling of the ante decorators ones
+	require.Equal(t, deliverTx.SpanContext.SpanID, msg.Parent.SpanID)
+}
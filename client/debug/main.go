@@ -7,13 +7,24 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/store/rootmulti"
+	storetypes "github.com/line/lbm-sdk/store/types"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/version"
 )
 
+// flags for the state-diff command
+const (
+	FlagStart = "start"
+	FlagEnd   = "end"
+	FlagLimit = "limit"
+)
+
 // Cmd creates a main CLI command
 func Cmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,6 +36,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(PubkeyCmd())
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(StateDiffCmd())
 
 	return cmd
 }
@@ -127,3 +139,78 @@ $ %s debug raw-bytes [72 101 108 108 111 44 32 112 108 97 121 103 114 111 117 11
 		},
 	}
 }
+
+func StateDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff [store-name] [from-height] [to-height]",
+		Short: "Query the keys of a store changed between two committed heights",
+		Long: fmt.Sprintf(`Query the keys of a store added, updated and deleted between two committed
+heights. The key range is given in hex by --start (inclusive) and --end (exclusive).
+If there are more changes than --limit, or more keys in the range than a node
+walks in a single query, the diff is truncated and next_key is set to continue
+from with --start.
+
+Example:
+$ %s debug state-diff bank 100 200 --start 02
+			`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from-height: %w", err)
+			}
+			toHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to-height: %w", err)
+			}
+
+			req := storetypes.StateDiffRequest{
+				FromVersion: fromHeight,
+				ToVersion:   toHeight,
+			}
+			startStr, _ := cmd.Flags().GetString(FlagStart)
+			if req.Start, err = hex.DecodeString(startStr); err != nil {
+				return fmt.Errorf("invalid start: %w", err)
+			}
+			endStr, _ := cmd.Flags().GetString(FlagEnd)
+			if req.End, err = hex.DecodeString(endStr); err != nil {
+				return fmt.Errorf("invalid end: %w", err)
+			}
+			if req.Limit, err = cmd.Flags().GetUint64(FlagLimit); err != nil {
+				return err
+			}
+
+			bz, err := req.Marshal()
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path: fmt.Sprintf("/store/%s%s", args[0], rootmulti.StateDiffPath),
+				Data: bz,
+			})
+			if err != nil {
+				return err
+			}
+
+			var diff storetypes.StateDiff
+			if err := diff.Unmarshal(res.Value); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&diff)
+		},
+	}
+
+	cmd.Flags().String(FlagStart, "", "The start of the key range in hex (inclusive)")
+	cmd.Flags().String(FlagEnd, "", "The end of the key range in hex (exclusive)")
+	cmd.Flags().Uint64(FlagLimit, 0, "The maximum number of changes to return; 0 means the default limit of the node")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
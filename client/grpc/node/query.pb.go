@@ -6,8 +6,10 @@ package node
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/line/lbm-sdk/store/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -110,9 +112,95 @@ func (m *ConfigResponse) GetMinimumGasPrice() string {
 	return ""
 }
 
+// InterBlockCacheStatsRequest defines the request structure for the
+// InterBlockCacheStats gRPC query.
+type InterBlockCacheStatsRequest struct {
+}
+
+func (m *InterBlockCacheStatsRequest) Reset()         { *m = InterBlockCacheStatsRequest{} }
+func (m *InterBlockCacheStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*InterBlockCacheStatsRequest) ProtoMessage()    {}
+func (*InterBlockCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{2}
+}
+func (m *InterBlockCacheStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterBlockCacheStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterBlockCacheStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterBlockCacheStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterBlockCacheStatsRequest.Merge(m, src)
+}
+func (m *InterBlockCacheStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterBlockCacheStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterBlockCacheStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterBlockCacheStatsRequest proto.InternalMessageInfo
+
+// InterBlockCacheStatsResponse defines the response structure for the
+// InterBlockCacheStats gRPC query.
+type InterBlockCacheStatsResponse struct {
+	Stats types.InterBlockCacheStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *InterBlockCacheStatsResponse) Reset()         { *m = InterBlockCacheStatsResponse{} }
+func (m *InterBlockCacheStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*InterBlockCacheStatsResponse) ProtoMessage()    {}
+func (*InterBlockCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{3}
+}
+func (m *InterBlockCacheStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterBlockCacheStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterBlockCacheStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterBlockCacheStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterBlockCacheStatsResponse.Merge(m, src)
+}
+func (m *InterBlockCacheStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterBlockCacheStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterBlockCacheStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterBlockCacheStatsResponse proto.InternalMessageInfo
+
+func (m *InterBlockCacheStatsResponse) GetStats() types.InterBlockCacheStats {
+	if m != nil {
+		return m.Stats
+	}
+	return types.InterBlockCacheStats{}
+}
+
 func init() {
 	proto.RegisterType((*ConfigRequest)(nil), "cosmos.base.node.v1beta1.ConfigRequest")
 	proto.RegisterType((*ConfigResponse)(nil), "cosmos.base.node.v1beta1.ConfigResponse")
+	proto.RegisterType((*InterBlockCacheStatsRequest)(nil), "cosmos.base.node.v1beta1.InterBlockCacheStatsRequest")
+	proto.RegisterType((*InterBlockCacheStatsResponse)(nil), "cosmos.base.node.v1beta1.InterBlockCacheStatsResponse")
 }
 
 func init() {
@@ -120,25 +208,33 @@ func init() {
 }
 
 var fileDescriptor_8324226a07064341 = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x31, 0x4b, 0x3b, 0x41,
-	0x14, 0xc4, 0xb3, 0xff, 0x22, 0x7f, 0x5c, 0xd0, 0xe0, 0x55, 0x21, 0xc8, 0x12, 0x0e, 0xc1, 0x43,
-	0x70, 0x97, 0x68, 0x6b, 0x15, 0x0b, 0x5b, 0x89, 0x9d, 0x4d, 0xd8, 0xdb, 0x3c, 0xd7, 0xc5, 0xdb,
-	0x7d, 0x97, 0xdb, 0xbd, 0x80, 0xad, 0x60, 0xaf, 0xf8, 0xa5, 0x2c, 0x03, 0x36, 0x96, 0x72, 0xe7,
-	0x07, 0x91, 0xbb, 0x8b, 0x85, 0x45, 0xb0, 0x9d, 0xf7, 0x9b, 0x79, 0xc3, 0xd0, 0x43, 0x85, 0xde,
-	0xa2, 0x17, 0xa9, 0xf4, 0x20, 0x1c, 0x2e, 0x40, 0xac, 0x26, 0x29, 0x04, 0x39, 0x11, 0xcb, 0x12,
-	0x8a, 0x07, 0x9e, 0x17, 0x18, 0x30, 0x1a, 0x76, 0x14, 0x6f, 0x28, 0xde, 0x50, 0x7c, 0x43, 0x8d,
-	0x0e, 0x34, 0xa2, 0xce, 0x40, 0xc8, 0xdc, 0x08, 0xe9, 0x1c, 0x06, 0x19, 0x0c, 0x3a, 0xdf, 0xf9,
-	0xe2, 0x01, 0xdd, 0xbd, 0x40, 0x77, 0x6b, 0xf4, 0x0c, 0x96, 0x25, 0xf8, 0x10, 0x9f, 0xd3, 0xbd,
-	0x1f, 0xc1, 0xe7, 0xe8, 0x3c, 0x44, 0xc7, 0x74, 0xdf, 0x1a, 0x67, 0x6c, 0x69, 0xe7, 0x5a, 0xfa,
-	0x79, 0x5e, 0x18, 0x05, 0x43, 0x32, 0x26, 0xc9, 0xce, 0x6c, 0xb0, 0x39, 0x5c, 0x4a, 0x7f, 0xd5,
-	0xc8, 0xa7, 0x2f, 0x84, 0xfe, 0xbf, 0x86, 0x62, 0x65, 0x14, 0x44, 0x4f, 0x84, 0xf6, 0xbb, 0xa8,
-	0xe8, 0x88, 0x6f, 0xab, 0xc7, 0x7f, 0x7d, 0x1f, 0x25, 0x7f, 0x83, 0x5d, 0xab, 0x38, 0x79, 0x7c,
-	0xff, 0x7a, 0xfd, 0x17, 0x47, 0x63, 0xb1, 0x75, 0x1f, 0xd5, 0x3a, 0xa6, 0xd3, 0xb7, 0x8a, 0x91,
-	0x75, 0xc5, 0xc8, 0x67, 0xc5, 0xc8, 0x73, 0xcd, 0x7a, 0xeb, 0x9a, 0xf5, 0x3e, 0x6a, 0xd6, 0xbb,
-	0x49, 0xb4, 0x09, 0x77, 0x65, 0xca, 0x15, 0x5a, 0x91, 0x19, 0x07, 0x22, 0x4b, 0xed, 0x89, 0x5f,
-	0xdc, 0x0b, 0x95, 0x19, 0x70, 0x41, 0xe8, 0x22, 0x57, 0x6d, 0x64, 0xda, 0x6f, 0xd7, 0x3a, 0xfb,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x9a, 0xf1, 0x9d, 0x8d, 0x01, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x8b, 0xd3, 0x40,
+	0x18, 0xc5, 0x93, 0x45, 0x57, 0x1c, 0xd1, 0xc5, 0xb0, 0x87, 0x25, 0xae, 0xb1, 0x04, 0xc1, 0x20,
+	0x38, 0x43, 0x5a, 0x14, 0x04, 0x4f, 0xe9, 0x41, 0xc4, 0x8b, 0xb4, 0x37, 0x2f, 0x61, 0x32, 0x1d,
+	0xa7, 0x43, 0x93, 0xf9, 0xd2, 0xcc, 0xa4, 0xe0, 0x55, 0xf0, 0x2e, 0xf8, 0x0f, 0x79, 0xec, 0xb1,
+	0xa0, 0x07, 0x4f, 0x22, 0xad, 0x7f, 0x88, 0x4c, 0x92, 0x62, 0xc5, 0x46, 0xf1, 0x16, 0xbe, 0xef,
+	0xcd, 0xfb, 0xbd, 0x79, 0x19, 0x74, 0x9f, 0x81, 0x2e, 0x40, 0x93, 0x8c, 0x6a, 0x4e, 0x14, 0xcc,
+	0x38, 0x59, 0xc5, 0x19, 0x37, 0x34, 0x26, 0xcb, 0x9a, 0x57, 0x6f, 0x71, 0x59, 0x81, 0x01, 0xef,
+	0xa2, 0x55, 0x61, 0xab, 0xc2, 0x56, 0x85, 0x3b, 0x95, 0x7f, 0x2e, 0x40, 0x40, 0x23, 0x22, 0xf6,
+	0xab, 0xd5, 0xfb, 0x97, 0x02, 0x40, 0xe4, 0x9c, 0xd0, 0x52, 0x12, 0xaa, 0x14, 0x18, 0x6a, 0x24,
+	0x28, 0xdd, 0x6d, 0xe3, 0x43, 0xa6, 0x36, 0x50, 0xfd, 0x82, 0x4a, 0x65, 0x78, 0x95, 0x66, 0x39,
+	0xb0, 0x45, 0xca, 0x28, 0x9b, 0xf3, 0xf6, 0x48, 0x78, 0x86, 0x6e, 0x8e, 0x41, 0xbd, 0x91, 0x62,
+	0xc2, 0x97, 0x35, 0xd7, 0x26, 0x7c, 0x86, 0x6e, 0xed, 0x07, 0xba, 0x04, 0xa5, 0xb9, 0xf7, 0x10,
+	0xdd, 0x2e, 0xa4, 0x92, 0x45, 0x5d, 0xa4, 0x82, 0xea, 0xb4, 0xac, 0x24, 0xe3, 0x17, 0xee, 0xc0,
+	0x8d, 0xae, 0x4f, 0xce, 0xba, 0xc5, 0x73, 0xaa, 0x5f, 0xd9, 0x71, 0x78, 0x17, 0xdd, 0x79, 0x61,
+	0x49, 0x89, 0x05, 0x8d, 0x2d, 0x67, 0x6a, 0xa8, 0xd1, 0x7b, 0xf3, 0x05, 0xba, 0x3c, 0xbe, 0xee,
+	0x50, 0x2f, 0xd1, 0x55, 0x6d, 0x07, 0x8d, 0xfd, 0x8d, 0x21, 0xc1, 0x87, 0xf5, 0x34, 0x17, 0xda,
+	0xf7, 0x83, 0x8f, 0xf9, 0x24, 0x57, 0xd6, 0xdf, 0xee, 0x39, 0x93, 0xd6, 0x63, 0xf8, 0xe5, 0x04,
+	0x5d, 0x9b, 0xf2, 0x6a, 0x25, 0x19, 0xf7, 0xde, 0xbb, 0xe8, 0xb4, 0xbd, 0x96, 0xf7, 0x00, 0xf7,
+	0x75, 0x8e, 0x7f, 0x6b, 0xc2, 0x8f, 0xfe, 0x2d, 0x6c, 0x63, 0x87, 0xd1, 0xbb, 0xcf, 0x3f, 0x3e,
+	0x9e, 0x84, 0xde, 0x80, 0xf4, 0xfe, 0x74, 0xd6, 0xc2, 0x3f, 0xb9, 0xe8, 0xfc, 0x58, 0x72, 0xef,
+	0x71, 0x3f, 0xec, 0x2f, 0x85, 0xfa, 0x4f, 0xfe, 0xf7, 0x58, 0x97, 0xf8, 0x69, 0x93, 0x78, 0xe4,
+	0xc5, 0xfd, 0x89, 0xff, 0x78, 0x31, 0x69, 0x53, 0x6b, 0x92, 0xac, 0xb7, 0x81, 0xbb, 0xd9, 0x06,
+	0xee, 0xf7, 0x6d, 0xe0, 0x7e, 0xd8, 0x05, 0xce, 0x66, 0x17, 0x38, 0x5f, 0x77, 0x81, 0xf3, 0x3a,
+	0x12, 0xd2, 0xcc, 0xeb, 0x0c, 0x33, 0x28, 0x48, 0x2e, 0x15, 0x27, 0x79, 0x56, 0x3c, 0xd2, 0xb3,
+	0x05, 0x61, 0xb9, 0xe4, 0xca, 0x10, 0x51, 0x95, 0xac, 0x61, 0x64, 0xa7, 0xcd, 0xe3, 0x1b, 0xfd,
+	0x1c, 0x00, 0x3d, 0xb4, 0x12, 0x8b, 0x25, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +251,8 @@ const _ = grpc.SupportPackageIsVersion4
 type ServiceClient interface {
 	// Config queries for the operator configuration.
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+	// InterBlockCacheStats queries for the statistics of the inter-block cache.
+	InterBlockCacheStats(ctx context.Context, in *InterBlockCacheStatsRequest, opts ...grpc.CallOption) (*InterBlockCacheStatsResponse, error)
 }
 
 type serviceClient struct {
@@ -174,10 +272,21 @@ func (c *serviceClient) Config(ctx context.Context, in *ConfigRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) InterBlockCacheStats(ctx context.Context, in *InterBlockCacheStatsRequest, opts ...grpc.CallOption) (*InterBlockCacheStatsResponse, error) {
+	out := new(InterBlockCacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/InterBlockCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Config queries for the operator configuration.
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
+	// InterBlockCacheStats queries for the statistics of the inter-block cache.
+	InterBlockCacheStats(context.Context, *InterBlockCacheStatsRequest) (*InterBlockCacheStatsResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -187,6 +296,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) Config(ctx context.Context, req *ConfigRequest) (*ConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (*UnimplementedServiceServer) InterBlockCacheStats(ctx context.Context, req *InterBlockCacheStatsRequest) (*InterBlockCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterBlockCacheStats not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -210,6 +322,24 @@ func _Service_Config_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_InterBlockCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterBlockCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).InterBlockCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/InterBlockCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).InterBlockCacheStats(ctx, req.(*InterBlockCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.node.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -218,6 +348,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Service_Config_Handler,
 		},
+		{
+			MethodName: "InterBlockCacheStats",
+			Handler:    _Service_InterBlockCacheStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
@@ -276,6 +410,62 @@ func (m *ConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InterBlockCacheStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterBlockCacheStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterBlockCacheStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InterBlockCacheStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterBlockCacheStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterBlockCacheStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -309,6 +499,26 @@ func (m *ConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *InterBlockCacheStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InterBlockCacheStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -447,6 +657,139 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InterBlockCacheStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterBlockCacheStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterBlockCacheStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterBlockCacheStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterBlockCacheStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterBlockCacheStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
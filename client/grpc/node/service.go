@@ -5,6 +5,8 @@ import (
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/line/lbm-sdk/client"
 	sdk "github.com/line/lbm-sdk/types"
@@ -15,6 +17,12 @@ func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server) {
 	RegisterServiceServer(server, NewQueryServer(clientCtx))
 }
 
+// RegisterNodeServiceWithCache registers the node gRPC service on the provided
+// gRPC router, serving the statistics of the given inter-block cache.
+func RegisterNodeServiceWithCache(clientCtx client.Context, server gogogrpc.Server, cache sdk.MultiStorePersistentCache) {
+	RegisterServiceServer(server, NewQueryServerWithCache(clientCtx, cache))
+}
+
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
 // on the given mux object.
 func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
@@ -24,7 +32,8 @@ func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.Serv
 var _ ServiceServer = queryServer{}
 
 type queryServer struct {
-	clientCtx client.Context
+	clientCtx       client.Context
+	interBlockCache sdk.MultiStorePersistentCache
 }
 
 func NewQueryServer(clientCtx client.Context) ServiceServer {
@@ -33,6 +42,13 @@ func NewQueryServer(clientCtx client.Context) ServiceServer {
 	}
 }
 
+func NewQueryServerWithCache(clientCtx client.Context, cache sdk.MultiStorePersistentCache) ServiceServer {
+	return queryServer{
+		clientCtx:       clientCtx,
+		interBlockCache: cache,
+	}
+}
+
 func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -40,3 +56,13 @@ func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigRespo
 		MinimumGasPrice: sdkCtx.MinGasPrices().String(),
 	}, nil
 }
+
+func (s queryServer) InterBlockCacheStats(_ context.Context, _ *InterBlockCacheStatsRequest) (*InterBlockCacheStatsResponse, error) {
+	if s.interBlockCache == nil {
+		return nil, status.Error(codes.Unavailable, "inter-block cache is disabled")
+	}
+
+	return &InterBlockCacheStatsResponse{
+		Stats: s.interBlockCache.Stats(),
+	}, nil
+}
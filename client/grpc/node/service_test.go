@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/store/cache"
+	"github.com/line/lbm-sdk/store/transient"
 	sdk "github.com/line/lbm-sdk/types"
 )
 
@@ -22,3 +24,19 @@ func TestServiceServer_Config(t *testing.T) {
 	require.NotNil(t, resp)
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
 }
+
+func TestServiceServer_InterBlockCacheStats(t *testing.T) {
+	goCtx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+
+	_, err := NewQueryServer(client.Context{}).InterBlockCacheStats(goCtx, &InterBlockCacheStatsRequest{})
+	require.Error(t, err)
+
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, cache.NopMetricsProvider())
+	mngr.GetStoreCache(sdk.NewKVStoreKey("test"), transient.NewStore()).Get([]byte("key"))
+
+	resp, err := NewQueryServerWithCache(client.Context{}, mngr).InterBlockCacheStats(goCtx, &InterBlockCacheStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, mngr.Stats(), resp.Stats)
+	require.Len(t, resp.Stats.Stores, 1)
+	require.EqualValues(t, 1, resp.Stats.Stores[0].Misses)
+}
@@ -0,0 +1,115 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/input"
+	"github.com/line/lbm-sdk/crypto/keyring"
+)
+
+const flagConflict = "conflict"
+
+// BackupKeysCommand backs up all the keys of the key store.
+func BackupKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "backup [file]",
+		Short: "Back up all keys",
+		Long: `Back up all the keys of the local keyring, including local, ledger, offline and
+multisig ones, in a single ASCII-armored archive encrypted with a passphrase.
+The archive is written to [file] if given, or printed otherwise. It can be
+restored into a keyring of any backend with the restore command.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the backup:", buf)
+			if err != nil {
+				return err
+			}
+			confirmPassword, err := input.GetPassword("Repeat the passphrase:", buf)
+			if err != nil {
+				return err
+			}
+			if encryptPassword != confirmPassword {
+				return errors.New("passphrases don't match")
+			}
+
+			armored, err := clientCtx.Keyring.Backup(encryptPassword)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				cmd.Println(armored)
+				return nil
+			}
+			return os.WriteFile(args[0], []byte(armored+"\n"), 0o600)
+		},
+	}
+}
+
+// RestoreKeysCommand restores the keys of a backup into the key store.
+func RestoreKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <file>",
+		Short: "Restore keys from a backup",
+		Long: `Restore all the keys in a backup generated by the backup command into the local
+keyring. A key conflicting with an existing key by name or address is handled
+according to --conflict:
+
+    fail        Abort the restore before restoring any key (default)
+    skip        Keep the existing key and skip the key in the backup
+    overwrite   Delete the existing keys and restore the key in the backup`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			conflictName, _ := cmd.Flags().GetString(flagConflict)
+			conflict, err := keyring.ParseRestoreConflict(conflictName)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt the backup:", buf)
+			if err != nil {
+				return err
+			}
+
+			result, err := clientCtx.Keyring.Restore(string(bz), passphrase, conflict)
+			if err != nil {
+				return err
+			}
+
+			for _, name := range result.Restored {
+				cmd.Printf("restored %s\n", name)
+			}
+			for _, name := range result.Skipped {
+				cmd.Printf("skipped %s\n", name)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagConflict, string(keyring.RestoreConflictFail), "How to handle the keys conflicting with the existing ones (fail|skip|overwrite)")
+
+	return cmd
+}
@@ -0,0 +1,84 @@
+package keys
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keyring"
+	"github.com/line/lbm-sdk/testutil"
+	"github.com/line/lbm-sdk/testutil/testdata"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+func Test_runBackupRestoreCmd(t *testing.T) {
+	backupFile := filepath.Join(t.TempDir(), "backup.txt")
+
+	runCmd := func(cmd *cobra.Command, kbHome, userInput string, args ...string) (string, error) {
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		))
+		mockIn, mockOut := testutil.ApplyMockIO(cmd)
+		mockIn.Reset(userInput)
+		mockInBuf := bufio.NewReader(mockIn)
+
+		kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockInBuf)
+		require.NoError(t, err)
+		clientCtx := client.Context{}.
+			WithKeyringDir(kbHome).
+			WithKeyring(kb).
+			WithInput(mockInBuf)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		err = cmd.ExecuteContext(ctx)
+		return mockOut.String(), err
+	}
+
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+	require.NoError(t, err)
+	_, err = kb.NewAccount("keyname1", testdata.TestMnemonic, "", sdk.GetConfig().GetFullBIP44Path(), hd.Secp256k1)
+	require.NoError(t, err)
+
+	// mismatching passphrases
+	_, err = runCmd(BackupKeysCommand(), kbHome, "12345678\n87654321\n", backupFile)
+	require.Error(t, err)
+
+	_, err = runCmd(BackupKeysCommand(), kbHome, "12345678\n12345678\n", backupFile)
+	require.NoError(t, err)
+
+	restoredHome := t.TempDir()
+	_, err = runCmd(RestoreKeysCommand(), restoredHome, "wrongpass\n", backupFile)
+	require.Error(t, err)
+	_, err = runCmd(RestoreKeysCommand(), restoredHome, "12345678\n", backupFile, "--conflict=unknown")
+	require.Error(t, err)
+
+	out, err := runCmd(RestoreKeysCommand(), restoredHome, "12345678\n", backupFile)
+	require.NoError(t, err)
+	require.Equal(t, "restored keyname1\n", out)
+
+	// the restored key conflicts with itself
+	_, err = runCmd(RestoreKeysCommand(), restoredHome, "12345678\n", backupFile)
+	require.Error(t, err)
+	out, err = runCmd(RestoreKeysCommand(), restoredHome, "12345678\n", backupFile, "--conflict=skip")
+	require.NoError(t, err)
+	require.Equal(t, "skipped keyname1\n", out)
+
+	restoredKb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, restoredHome, nil)
+	require.NoError(t, err)
+	info, err := restoredKb.Key("keyname1")
+	require.NoError(t, err)
+	original, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, original.GetAddress(), info.GetAddress())
+}
@@ -42,6 +42,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		AddKeyCommand(),
 		ExportKeyCommand(),
 		ImportKeyCommand(),
+		BackupKeysCommand(),
+		RestoreKeysCommand(),
 		ListKeysCmd(),
 		ShowKeysCmd(),
 		DeleteKeyCommand(),
@@ -0,0 +1,288 @@
+package tx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/line/lbm-sdk/client"
+	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/crypto/types/multisig"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/types/tx/signing"
+	authsigning "github.com/line/lbm-sdk/x/auth/signing"
+)
+
+// SigningSession collects the signatures of the members of a multisig key on
+// a tx offline, which is finalized into the tx signed by the multisig key once
+// the threshold of the signatures is reached. The signatures are made in
+// SIGN_MODE_LEGACY_AMINO_JSON as the multisig implementation requires.
+type SigningSession struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	PubKey        *kmultisig.LegacyAminoPubKey
+	// Tx is the unsigned tx.
+	Tx authsigning.Tx
+	// Signatures are the signatures of the members, in the order of addition.
+	Signatures []signing.SignatureV2
+}
+
+// SigningSessionStatus is the status of the signatures of a signing session.
+type SigningSessionStatus struct {
+	Threshold uint32                `json:"threshold" yaml:"threshold"`
+	Members   []SigningMemberStatus `json:"members" yaml:"members"`
+}
+
+// SigningMemberStatus is whether a member of a multisig key has signed.
+type SigningMemberStatus struct {
+	Address string `json:"address" yaml:"address"`
+	Signed  bool   `json:"signed" yaml:"signed"`
+}
+
+// Signed returns the number of the members which have signed.
+func (s SigningSessionStatus) Signed() uint32 {
+	signed := uint32(0)
+	for _, member := range s.Members {
+		if member.Signed {
+			signed++
+		}
+	}
+	return signed
+}
+
+// Ready returns whether the session has reached its threshold.
+func (s SigningSessionStatus) Ready() bool {
+	return s.Signed() >= s.Threshold
+}
+
+func (s SigningSessionStatus) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "signed: %d/%d (threshold %d)\n", s.Signed(), len(s.Members), s.Threshold)
+	for _, member := range s.Members {
+		mark := " "
+		if member.Signed {
+			mark = "x"
+		}
+		fmt.Fprintf(&buf, "[%s] %s\n", mark, member.Address)
+	}
+	return buf.String()
+}
+
+// NewSigningSession creates a signing session of the multisig key on the
+// unsigned tx, which must be signed by the multisig key.
+func NewSigningSession(tx sdk.Tx, pubKey *kmultisig.LegacyAminoPubKey, chainID string, accNum, seq uint64) (*SigningSession, error) {
+	if chainID == "" {
+		return nil, sdkerrors.ErrInvalidChainID.Wrap("chain id cannot be empty")
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, sdkerrors.ErrTxDecode.Wrapf("could not cast %T to %T", tx, (authsigning.Tx)(nil))
+	}
+
+	found := false
+	for _, signer := range sigTx.GetSigners() {
+		if signer.Equals(sdk.AccAddress(pubKey.Address())) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrapf("multisig %s is not a signer of the tx", sdk.AccAddress(pubKey.Address()))
+	}
+
+	return &SigningSession{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      seq,
+		PubKey:        pubKey,
+		Tx:            sigTx,
+	}, nil
+}
+
+// AddSignature validates the signature of a member against the sign bytes of
+// the session, and adds it to the session.
+func (s *SigningSession) AddSignature(txCfg client.TxConfig, sig signing.SignatureV2) error {
+	if !s.isMember(sig.PubKey) {
+		return sdkerrors.ErrInvalidPubKey.Wrapf("%s is not a member of the multisig", sdk.AccAddress(sig.PubKey.Address()))
+	}
+	for _, prev := range s.Signatures {
+		if prev.PubKey.Equals(sig.PubKey) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s has already signed", sdk.AccAddress(sig.PubKey.Address()))
+		}
+	}
+
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("expected single signature data, got %T", sig.Data)
+	}
+	if data.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return sdkerrors.ErrNotSupported.Wrapf("sign mode %s is not supported by multisig", data.SignMode)
+	}
+	if sig.Sequence != s.Sequence {
+		return sdkerrors.ErrWrongSequence.Wrapf("signature of sequence %d, expected %d", sig.Sequence, s.Sequence)
+	}
+
+	if err := authsigning.VerifySignature(sig.PubKey, s.signerData(), data, txCfg.SignModeHandler(), s.Tx); err != nil {
+		return sdkerrors.ErrUnauthorized.Wrapf("couldn't verify signature for address %s", sdk.AccAddress(sig.PubKey.Address()))
+	}
+
+	s.Signatures = append(s.Signatures, sig)
+	return nil
+}
+
+// Status returns the status of the signatures of the session.
+func (s SigningSession) Status() SigningSessionStatus {
+	status := SigningSessionStatus{Threshold: s.PubKey.Threshold}
+	for _, pubKey := range s.PubKey.GetPubKeys() {
+		signed := false
+		for _, sig := range s.Signatures {
+			if sig.PubKey.Equals(pubKey) {
+				signed = true
+				break
+			}
+		}
+		status.Members = append(status.Members, SigningMemberStatus{
+			Address: sdk.AccAddress(pubKey.Address()).String(),
+			Signed:  signed,
+		})
+	}
+	return status
+}
+
+// CheckDrift returns an error if the account number or the sequence of the
+// multisig account has changed since the session was created, in which case
+// the signatures will not be valid on chain.
+func (s SigningSession) CheckDrift(accNum, seq uint64) error {
+	if accNum != s.AccountNumber {
+		return sdkerrors.ErrInvalidRequest.Wrapf("account number drifted: session has %d but the account has %d", s.AccountNumber, accNum)
+	}
+	if seq != s.Sequence {
+		return sdkerrors.ErrWrongSequence.Wrapf("sequence drifted: session has %d but the account has %d", s.Sequence, seq)
+	}
+	return nil
+}
+
+// Finalize combines the signatures into the multisig signature, returning the
+// signed tx. The session must have reached its threshold.
+func (s SigningSession) Finalize(txCfg client.TxConfig) (authsigning.Tx, error) {
+	status := s.Status()
+	if !status.Ready() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("not enough signatures: %d of threshold %d", status.Signed(), status.Threshold)
+	}
+
+	multisigSig := multisig.NewMultisig(len(s.PubKey.PubKeys))
+	for _, sig := range s.Signatures {
+		if err := multisig.AddSignatureV2(multisigSig, sig, s.PubKey.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	txBuilder, err := txCfg.WrapTxBuilder(s.Tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   s.PubKey,
+		Data:     multisigSig,
+		Sequence: s.Sequence,
+	}); err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx(), nil
+}
+
+func (s SigningSession) isMember(pubKey cryptotypes.PubKey) bool {
+	for _, member := range s.PubKey.GetPubKeys() {
+		if member.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s SigningSession) signerData() authsigning.SignerData {
+	return authsigning.SignerData{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+	}
+}
+
+// signingSessionJSON is the file format of a signing session, where the tx,
+// the multisig key and the signatures are in their usual JSON encodings.
+type signingSessionJSON struct {
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number,string"`
+	Sequence      uint64          `json:"sequence,string"`
+	PubKey        json.RawMessage `json:"pub_key"`
+	Tx            json.RawMessage `json:"tx"`
+	Signatures    json.RawMessage `json:"signatures"`
+}
+
+// MarshalSigningSession encodes the signing session into JSON.
+func MarshalSigningSession(clientCtx client.Context, s *SigningSession) ([]byte, error) {
+	pubKey, err := clientCtx.Codec.MarshalInterfaceJSON(s.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := clientCtx.TxConfig.TxJSONEncoder()(s.Tx)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := clientCtx.TxConfig.MarshalSignatureJSON(s.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(signingSessionJSON{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		PubKey:        pubKey,
+		Tx:            tx,
+		Signatures:    sigs,
+	}, "", "  ")
+}
+
+// UnmarshalSigningSession decodes a signing session from JSON, validating its
+// signatures against its sign bytes.
+func UnmarshalSigningSession(clientCtx client.Context, bz []byte) (*SigningSession, error) {
+	var raw signingSessionJSON
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, sdkerrors.ErrJSONUnmarshal.Wrap(err.Error())
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(raw.PubKey, &pubKey); err != nil {
+		return nil, err
+	}
+	multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrapf("expected multisig key, got %T", pubKey)
+	}
+	tx, err := clientCtx.TxConfig.TxJSONDecoder()(raw.Tx)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(raw.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := NewSigningSession(tx, multisigPub, raw.ChainID, raw.AccountNumber, raw.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range sigs {
+		if err := session.AddSignature(clientCtx.TxConfig, sig); err != nil {
+			return nil, err
+		}
+	}
+
+	return session, nil
+}
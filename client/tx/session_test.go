@@ -0,0 +1,108 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/tx"
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keyring"
+	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	signingtypes "github.com/line/lbm-sdk/types/tx/signing"
+	"github.com/line/lbm-sdk/x/auth/signing"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+func TestSigningSession(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Marshaler).
+		WithTxConfig(encCfg.TxConfig)
+
+	kr, err := keyring.New(t.Name(), "test", t.TempDir(), nil)
+	require.NoError(t, err)
+	path := hd.CreateHDPath(118, 0, 0).String()
+	names := []string{"member1", "member2", "member3", "stranger"}
+	var pubKeys []cryptotypes.PubKey
+	for _, name := range names {
+		info, _, err := kr.NewMnemonic(name, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, info.GetPubKey())
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys[:3])
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	txf := tx.Factory{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithKeybase(kr).
+		WithAccountNumber(7).
+		WithSequence(3).
+		WithFees("50stake").
+		WithChainID("test-chain").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	txb, err := tx.BuildUnsignedTx(txf, banktypes.NewMsgSend(multisigAddr, sdk.AccAddress("to"), nil))
+	require.NoError(t, err)
+
+	sign := func(txf tx.Factory, name string) signingtypes.SignatureV2 {
+		signTxb, err := tx.BuildUnsignedTx(txf, txb.GetTx().GetMsgs()...)
+		require.NoError(t, err)
+		require.NoError(t, tx.Sign(txf, name, signTxb, true))
+		sigs, err := signTxb.GetTx().GetSignaturesV2()
+		require.NoError(t, err)
+		return sigs[0]
+	}
+
+	_, err = tx.NewSigningSession(txb.GetTx(), multisigPub, "", 7, 3)
+	require.Error(t, err)
+	_, err = tx.NewSigningSession(txb.GetTx(), kmultisig.NewLegacyAminoPubKey(1, pubKeys[1:]), "test-chain", 7, 3)
+	require.Error(t, err)
+
+	session, err := tx.NewSigningSession(txb.GetTx(), multisigPub, "test-chain", 7, 3)
+	require.NoError(t, err)
+	require.False(t, session.Status().Ready())
+	_, err = session.Finalize(encCfg.TxConfig)
+	require.Error(t, err)
+
+	// invalid signatures are rejected
+	require.Error(t, session.AddSignature(encCfg.TxConfig, sign(txf, "stranger")))
+	require.Error(t, session.AddSignature(encCfg.TxConfig, sign(txf.WithSequence(4), "member1")))
+	require.Error(t, session.AddSignature(encCfg.TxConfig, sign(txf.WithAccountNumber(8), "member1")))
+	require.Error(t, session.AddSignature(encCfg.TxConfig, sign(txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT), "member1")))
+
+	require.NoError(t, session.AddSignature(encCfg.TxConfig, sign(txf, "member1")))
+	require.Error(t, session.AddSignature(encCfg.TxConfig, sign(txf, "member1")))
+	require.Equal(t, uint32(1), session.Status().Signed())
+
+	// the session survives the encoding with its signatures
+	bz, err := tx.MarshalSigningSession(clientCtx, session)
+	require.NoError(t, err)
+	session, err = tx.UnmarshalSigningSession(clientCtx, bz)
+	require.NoError(t, err)
+	require.Len(t, session.Signatures, 1)
+
+	require.NoError(t, session.AddSignature(encCfg.TxConfig, sign(txf, "member3")))
+	status := session.Status()
+	require.True(t, status.Ready())
+	require.Equal(t, []tx.SigningMemberStatus{
+		{Address: sdk.AccAddress(pubKeys[0].Address()).String(), Signed: true},
+		{Address: sdk.AccAddress(pubKeys[1].Address()).String(), Signed: false},
+		{Address: sdk.AccAddress(pubKeys[2].Address()).String(), Signed: true},
+	}, status.Members)
+
+	require.NoError(t, session.CheckDrift(7, 3))
+	require.Error(t, session.CheckDrift(7, 4))
+	require.Error(t, session.CheckDrift(8, 3))
+
+	signedTx, err := session.Finalize(encCfg.TxConfig)
+	require.NoError(t, err)
+	sigs, err := signedTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	signerData := signing.SignerData{ChainID: "test-chain", AccountNumber: 7, Sequence: 3}
+	require.NoError(t, signing.VerifySignature(multisigPub, signerData, sigs[0].Data, encCfg.TxConfig.SignModeHandler(), signedTx))
+}
@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
@@ -18,11 +19,13 @@ const (
 	blockTypePrivKey = "OSTRACON PRIVATE KEY"
 	blockTypeKeyInfo = "OSTRACON KEY INFO"
 	blockTypePubKey  = "OSTRACON PUBLIC KEY"
+	blockTypeBackup  = "OSTRACON KEYRING BACKUP"
 
 	defaultAlgo = "secp256k1"
 
-	headerVersion = "version"
-	headerType    = "type"
+	headerVersion  = "version"
+	headerType     = "type"
+	headerChecksum = "checksum"
 )
 
 // BcryptSecurityParameter is security parameter var, and it can be changed within the lcd test.
@@ -144,6 +147,12 @@ func EncryptArmorPrivKey(privKey cryptotypes.PrivKey, passphrase string, algo st
 // generated salt and the xsalsa20 cipher. returns the salt and the
 // encrypted priv key.
 func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes []byte, encBytes []byte) {
+	return encryptBytes(legacy.Cdc.MustMarshal(privKey), passphrase)
+}
+
+// encrypt the given bytes with the passphrase using a randomly generated
+// salt and the xsalsa20 cipher. returns the salt and the encrypted bytes.
+func encryptBytes(bz []byte, passphrase string) (saltBytes []byte, encBytes []byte) {
 	saltBytes = crypto.CRandBytes(16)
 	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
 	if err != nil {
@@ -151,9 +160,8 @@ func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes [
 	}
 
 	key = crypto.Sha256(key) // get 32 bytes
-	privKeyBytes := legacy.Cdc.MustMarshal(privKey)
 
-	return saltBytes, xsalsa20symmetric.EncryptSymmetric(privKeyBytes, key)
+	return saltBytes, xsalsa20symmetric.EncryptSymmetric(bz, key)
 }
 
 // UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error
@@ -190,19 +198,76 @@ func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKey cryptoty
 }
 
 func decryptPrivKey(saltBytes []byte, encBytes []byte, passphrase string) (privKey cryptotypes.PrivKey, err error) {
+	privKeyBytes, err := decryptBytes(saltBytes, encBytes, passphrase)
+	if err != nil {
+		return privKey, err
+	}
+
+	return legacy.PrivKeyFromBytes(privKeyBytes)
+}
+
+func decryptBytes(saltBytes []byte, encBytes []byte, passphrase string) ([]byte, error) {
 	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
 	if err != nil {
-		return privKey, sdkerrors.Wrap(err, "error generating bcrypt key from passphrase")
+		return nil, sdkerrors.Wrap(err, "error generating bcrypt key from passphrase")
 	}
 
 	key = crypto.Sha256(key) // Get 32 bytes
 
-	privKeyBytes, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
+	bz, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
 	if err != nil && err.Error() == "Ciphertext decryption failed" {
-		return privKey, sdkerrors.ErrWrongPassword
+		return nil, sdkerrors.ErrWrongPassword
 	} else if err != nil {
-		return privKey, err
+		return nil, err
 	}
 
-	return legacy.PrivKeyFromBytes(privKeyBytes)
+	return bz, nil
+}
+
+// EncryptArmorKeyringBackup encrypts the backup of a keyring with the
+// passphrase and armors it, with the checksum of the encrypted backup in the
+// header, so that a corrupted backup is told apart from a wrong passphrase.
+func EncryptArmorKeyringBackup(bz []byte, passphrase string) string {
+	saltBytes, encBytes := encryptBytes(bz, passphrase)
+	header := map[string]string{
+		"kdf":          "bcrypt",
+		"salt":         fmt.Sprintf("%X", saltBytes),
+		headerVersion:  "0.0.1",
+		headerChecksum: fmt.Sprintf("%X", crypto.Sha256(encBytes)),
+	}
+
+	return armor.EncodeArmor(blockTypeBackup, header, encBytes)
+}
+
+// UnarmorDecryptKeyringBackup returns the backup of a keyring decrypted with
+// the passphrase, verifying the checksum of the encrypted backup first.
+func UnarmorDecryptKeyringBackup(armorStr string, passphrase string) ([]byte, error) {
+	encBytes, header, err := unarmorBytes(armorStr, blockTypeBackup)
+	if err != nil {
+		return nil, err
+	}
+
+	if header[headerVersion] != "0.0.1" {
+		return nil, fmt.Errorf("unrecognized version: %v", header[headerVersion])
+	}
+
+	if header["kdf"] != "bcrypt" {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", header["kdf"])
+	}
+
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil || len(saltBytes) == 0 {
+		return nil, fmt.Errorf("invalid salt bytes: %q", header["salt"])
+	}
+
+	checksum, err := hex.DecodeString(header[headerChecksum])
+	if err != nil || len(checksum) == 0 {
+		return nil, fmt.Errorf("invalid checksum: %q", header[headerChecksum])
+	}
+
+	if !bytes.Equal(crypto.Sha256(encBytes), checksum) {
+		return nil, fmt.Errorf("checksum mismatch")
+	}
+
+	return decryptBytes(saltBytes, encBytes, passphrase)
 }
@@ -68,6 +68,33 @@ func TestArmorUnarmorPrivKey(t *testing.T) {
 	require.Equal(t, "unrecognized KDF type: wrong", err.Error())
 }
 
+func TestArmorUnarmorKeyringBackup(t *testing.T) {
+	backup := []byte("backup")
+	armored := crypto.EncryptArmorKeyringBackup(backup, "passphrase")
+	_, err := crypto.UnarmorDecryptKeyringBackup(armored, "wrongpassphrase")
+	require.Error(t, err)
+	decrypted, err := crypto.UnarmorDecryptKeyringBackup(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, backup, decrypted)
+
+	// wrong block type
+	_, err = crypto.UnarmorDecryptKeyringBackup(crypto.ArmorInfoBytes(backup), "passphrase")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unrecognized armor type")
+
+	// the checksum covers the encrypted backup only
+	_, header, encBytes, err := armor.DecodeArmor(armored)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%X", ostcrypto.Sha256(encBytes)), header["checksum"])
+	require.NotEqual(t, fmt.Sprintf("%X", ostcrypto.Sha256(backup)), header["checksum"])
+
+	// tampered checksum
+	header["checksum"] = fmt.Sprintf("%X", ostcrypto.Sha256([]byte("other")))
+	_, err = crypto.UnarmorDecryptKeyringBackup(armor.EncodeArmor("OSTRACON KEYRING BACKUP", header, encBytes), "passphrase")
+	require.Error(t, err)
+	require.Equal(t, "checksum mismatch", err.Error())
+}
+
 func TestArmorUnarmorPubKey(t *testing.T) {
 	// Select the encryption and storage for your cryptostore
 	cstore := keyring.NewInMemory()
@@ -3,6 +3,7 @@ package hd
 import (
 	bip39 "github.com/cosmos/go-bip39"
 
+	"github.com/line/lbm-sdk/crypto/keys/bls12381"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	"github.com/line/lbm-sdk/crypto/types"
 )
@@ -20,10 +21,16 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Bls12381Type represents the BLS signature system on the BLS12-381 curve.
+	Bls12381Type = PubKeyType("bls12381")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// Bls12381 uses the BLS signatures on the BLS12-381 curve.
+	Bls12381 = bls12381Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -68,3 +75,22 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type bls12381Algo struct{}
+
+func (s bls12381Algo) Name() PubKeyType {
+	return Bls12381Type
+}
+
+// Derive derives and returns the secret for the given seed and HD path, which
+// is derived in the same way as the secp256k1 private key.
+func (s bls12381Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates a BLS12-381 private key from the given secret.
+func (s bls12381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		return bls12381.GenPrivKeyFromSecret(bz)
+	}
+}
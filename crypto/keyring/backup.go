@@ -0,0 +1,202 @@
+package keyring
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/keyring"
+
+	"github.com/line/lbm-sdk/crypto"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// RestoreConflict defines how a key in a backup is restored when the keyring
+// already has a key of the same name or address.
+type RestoreConflict string
+
+const (
+	// RestoreConflictFail fails the restore before restoring any key.
+	RestoreConflictFail RestoreConflict = "fail"
+	// RestoreConflictSkip keeps the existing key and skips the key in the backup.
+	RestoreConflictSkip RestoreConflict = "skip"
+	// RestoreConflictOverwrite replaces the existing keys with the key in the backup.
+	RestoreConflictOverwrite RestoreConflict = "overwrite"
+)
+
+// ParseRestoreConflict returns the RestoreConflict of the given name.
+func ParseRestoreConflict(name string) (RestoreConflict, error) {
+	switch conflict := RestoreConflict(name); conflict {
+	case RestoreConflictFail, RestoreConflictSkip, RestoreConflictOverwrite:
+		return conflict, nil
+	default:
+		return "", fmt.Errorf("unknown conflict handling %q, expected one of %s, %s or %s",
+			name, RestoreConflictFail, RestoreConflictSkip, RestoreConflictOverwrite)
+	}
+}
+
+// RestoreResult is the names of the keys restored from a backup and skipped
+// for the conflicts.
+type RestoreResult struct {
+	Restored []string `json:"restored" yaml:"restored"`
+	Skipped  []string `json:"skipped" yaml:"skipped"`
+}
+
+// keyringBackup is the content of a backup, which is encrypted as a whole.
+type keyringBackup struct {
+	// Infos are the serialized Info records, which include the private keys of
+	// the local keys.
+	Infos [][]byte `json:"infos"`
+}
+
+// Backup implements Exporter.
+func (ks keystore) Backup(passphrase string) (string, error) {
+	infos, err := ks.List()
+	if err != nil {
+		return "", err
+	}
+
+	var backup keyringBackup
+	for _, info := range infos {
+		// List returns the unreadable keys as offline ones without the public keys
+		if info.GetPubKey() == nil {
+			return "", sdkerrors.ErrKeyNotFound.Wrapf("cannot read key %s", info.GetName())
+		}
+		backup.Infos = append(backup.Infos, marshalInfo(info))
+	}
+
+	bz, err := json.Marshal(backup)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.EncryptArmorKeyringBackup(bz, passphrase), nil
+}
+
+// Restore implements Importer.
+func (ks keystore) Restore(armor, passphrase string, conflict RestoreConflict) (RestoreResult, error) {
+	var result RestoreResult
+	if _, err := ParseRestoreConflict(string(conflict)); err != nil {
+		return result, err
+	}
+
+	bz, err := crypto.UnarmorDecryptKeyringBackup(armor, passphrase)
+	if err != nil {
+		return result, sdkerrors.Wrap(err, "failed to decrypt the backup")
+	}
+
+	var backup keyringBackup
+	if err := json.Unmarshal(bz, &backup); err != nil {
+		return result, sdkerrors.ErrJSONUnmarshal.Wrap(err.Error())
+	}
+
+	infos := make([]Info, len(backup.Infos))
+	conflicts := make([][]Info, len(backup.Infos))
+	names := map[string]bool{}
+	for i, infoBz := range backup.Infos {
+		info, err := unmarshalInfo(infoBz)
+		if err != nil {
+			return result, sdkerrors.Wrapf(err, "invalid key at %d in the backup", i)
+		}
+		if names[info.GetName()] {
+			return result, fmt.Errorf("duplicate key %s in the backup", info.GetName())
+		}
+		names[info.GetName()] = true
+		infos[i] = info
+
+		conflicts[i], err = ks.conflictingKeys(info)
+		if err != nil {
+			return result, err
+		}
+		if len(conflicts[i]) != 0 && conflict == RestoreConflictFail {
+			return result, fmt.Errorf("key %s conflicts with the existing keys %v", info.GetName(), infoNames(conflicts[i]))
+		}
+	}
+
+	for i, info := range infos {
+		if len(conflicts[i]) == 0 {
+			if err := ks.writeInfo(info); err != nil {
+				return result, sdkerrors.Wrapf(err, "failed to restore key %s", info.GetName())
+			}
+			result.Restored = append(result.Restored, info.GetName())
+			continue
+		}
+
+		if conflict == RestoreConflictSkip {
+			result.Skipped = append(result.Skipped, info.GetName())
+			continue
+		}
+
+		// the key is written before the conflicting keys are removed, so a
+		// failure in between never loses both of them
+		if err := ks.setInfo(info); err != nil {
+			return result, sdkerrors.Wrapf(err, "failed to restore key %s", info.GetName())
+		}
+		for _, existing := range conflicts[i] {
+			if err := ks.removeOverwritten(existing, info); err != nil {
+				return result, sdkerrors.Wrapf(err, "failed to remove key %s overwritten by the restored one", existing.GetName())
+			}
+		}
+		result.Restored = append(result.Restored, info.GetName())
+	}
+
+	return result, nil
+}
+
+// removeOverwritten removes the entries of the existing key which have been
+// neither overwritten by the info nor taken over by the other restored keys.
+func (ks keystore) removeOverwritten(existing, info Info) error {
+	if !existing.GetAddress().Equals(info.GetAddress()) {
+		addrKey := addrHexKeyAsString(existing.GetAddress())
+		item, err := ks.db.Get(addrKey)
+		if err == nil && string(item.Data) == string(infoKeyBz(existing.GetName())) {
+			err = ks.db.Remove(addrKey)
+		}
+		if err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+			return err
+		}
+	}
+
+	if existing.GetName() != info.GetName() {
+		stored, err := ks.Key(existing.GetName())
+		if err == nil && stored.GetAddress().Equals(existing.GetAddress()) {
+			err = ks.db.Remove(infoKey(existing.GetName()))
+		}
+		if err != nil && !errors.Is(err, sdkerrors.ErrKeyNotFound) && !errors.Is(err, keyring.ErrKeyNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func infoNames(infos []Info) []string {
+	names := make([]string, len(infos))
+	for i, info := range infos {
+		names[i] = info.GetName()
+	}
+	return names
+}
+
+// conflictingKeys returns the existing keys of the same name or address as
+// the info.
+func (ks keystore) conflictingKeys(info Info) ([]Info, error) {
+	var infos []Info
+	existing, err := ks.Key(info.GetName())
+	if err == nil {
+		infos = append(infos, existing)
+	} else if !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	existing, err = ks.KeyByAddress(info.GetAddress())
+	if err == nil {
+		if existing.GetName() != info.GetName() {
+			infos = append(infos, existing)
+		}
+	} else if !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	return infos, nil
+}
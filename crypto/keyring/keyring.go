@@ -113,6 +113,11 @@ type Importer interface {
 
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
+
+	// Restore imports all the keys in an ASCII armored passphrase-encrypted
+	// backup, handling the keys conflicting with the existing ones by name or
+	// address as given.
+	Restore(armor, passphrase string, conflict RestoreConflict) (RestoreResult, error)
 }
 
 // LegacyInfoImporter is implemented by key stores that support import of Info types.
@@ -132,6 +137,10 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
+
+	// Backup returns all the keys, including local, ledger, offline and
+	// multisig ones, in a single ASCII armored passphrase-encrypted backup.
+	Backup(passphrase string) (armor string, err error)
 }
 
 // UnsafeExporter is implemented by key stores that support unsafe export
@@ -205,7 +214,7 @@ func infoKeyBz(name string) []byte { return []byte(infoKey(name)) }
 func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Bls12381},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -776,9 +785,6 @@ func (ks keystore) writeLocalKey(name string, priv types.PrivKey, algo hd.PubKey
 }
 
 func (ks keystore) writeInfo(info Info) error {
-	key := infoKeyBz(info.GetName())
-	serializedInfo := marshalInfo(info)
-
 	exists, err := ks.existsInDb(info)
 	if err != nil {
 		return err
@@ -787,7 +793,16 @@ func (ks keystore) writeInfo(info Info) error {
 		return errors.New("public key already exists in keybase")
 	}
 
-	err = ks.db.Set(keyring.Item{
+	return ks.setInfo(info)
+}
+
+// setInfo writes the info and its address index, overwriting the existing
+// entries of the same keys.
+func (ks keystore) setInfo(info Info) error {
+	key := infoKeyBz(info.GetName())
+	serializedInfo := marshalInfo(info)
+
+	err := ks.db.Set(keyring.Item{
 		Key:  string(key),
 		Data: serializedInfo,
 	})
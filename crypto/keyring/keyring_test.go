@@ -12,6 +12,7 @@ import (
 
 	"github.com/line/lbm-sdk/crypto"
 	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keys/bls12381"
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	"github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
@@ -1141,6 +1142,38 @@ func TestAltKeyring_ConstructorSupportedAlgos(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAltKeyring_Bls12381(t *testing.T) {
+	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	info, mnemonic, err := keyring.NewMnemonic("bls", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Bls12381)
+	require.NoError(t, err)
+	require.Equal(t, hd.Bls12381Type, info.GetAlgo())
+	require.IsType(t, &bls12381.PubKey{}, info.GetPubKey())
+
+	msg := []byte("message")
+	sig, pubKey, err := keyring.Sign("bls", msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// the key is recovered from the mnemonic
+	keyring2, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+	recovered, err := keyring2.NewAccount("recovered", mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Bls12381)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), recovered.GetPubKey())
+
+	// the private key survives the export
+	armor, err := keyring.ExportPrivKeyArmor("bls", "passphrase")
+	require.NoError(t, err)
+	require.NoError(t, keyring2.Delete("recovered"))
+	require.NoError(t, keyring2.ImportPrivKey("imported", armor, "passphrase"))
+	sig, pubKey, err = keyring2.Sign("imported", msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.True(t, info.GetPubKey().Equals(pubKey))
+}
+
 func TestBackendConfigConstructors(t *testing.T) {
 	backend := newKWalletBackendKeyringConfig("test", "", nil)
 	require.Equal(t, []keyring.BackendType{keyring.KWalletBackend}, backend.AllowedBackends)
@@ -1161,4 +1194,83 @@ func requireEqualInfo(t *testing.T, key Info, mnemonic Info) {
 	require.Equal(t, key.GetType(), mnemonic.GetType())
 }
 
+func TestBackupRestore(t *testing.T) {
+	kb := NewInMemory()
+	local, _, err := kb.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	offline, err := kb.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+	multi, err := kb.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(1, []types.PubKey{local.GetPubKey(), offline.GetPubKey()}))
+	require.NoError(t, err)
+	ledger := newLedgerInfo("ledger", secp256k1.GenPrivKey().PubKey(), *hd.NewFundraiserParams(0, sdk.CoinType, 0), hd.Secp256k1Type)
+	require.NoError(t, kb.(LegacyInfoImporter).ImportInfo(ledger))
+
+	armor, err := kb.Backup("passphrase")
+	require.NoError(t, err)
+
+	// wrong passphrase and unknown conflict handling
+	_, err = NewInMemory().Restore(armor, "wrong", RestoreConflictFail)
+	require.Error(t, err)
+	_, err = NewInMemory().Restore(armor, "passphrase", RestoreConflict("unknown"))
+	require.Error(t, err)
+
+	restoredKb := NewInMemory()
+	result, err := restoredKb.Restore(armor, "passphrase", RestoreConflictFail)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ledger", "local", "multi", "offline"}, result.Restored)
+	for _, info := range []Info{local, offline, multi, ledger} {
+		restored, err := restoredKb.Key(info.GetName())
+		require.NoError(t, err)
+		require.Equal(t, info.GetType(), restored.GetType())
+		require.Equal(t, info.GetAddress(), restored.GetAddress())
+		require.True(t, info.GetPubKey().Equals(restored.GetPubKey()))
+	}
+	// the private key of the local key is restored
+	msg := []byte("message")
+	sig, pub, err := restoredKb.Sign("local", msg)
+	require.NoError(t, err)
+	require.True(t, local.GetPubKey().VerifySignature(msg, sig))
+	require.True(t, local.GetPubKey().Equals(pub))
+
+	// conflicts by name and address
+	conflictKb := NewInMemory()
+	conflicting, err := conflictKb.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+	_, err = conflictKb.SavePubKey("renamed", local.GetPubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	_, err = conflictKb.Restore(armor, "passphrase", RestoreConflictFail)
+	require.Error(t, err)
+	_, err = conflictKb.Key("ledger")
+	require.Error(t, err, "nothing is restored on the failure")
+
+	result, err = conflictKb.Restore(armor, "passphrase", RestoreConflictSkip)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ledger", "multi"}, result.Restored)
+	require.Equal(t, []string{"local", "offline"}, result.Skipped)
+	existing, err := conflictKb.Key("offline")
+	require.NoError(t, err)
+	require.False(t, offline.GetPubKey().Equals(existing.GetPubKey()))
+
+	result, err = conflictKb.Restore(armor, "passphrase", RestoreConflictOverwrite)
+	require.NoError(t, err)
+	require.Len(t, result.Restored, 4)
+	_, err = conflictKb.Key("renamed")
+	require.Error(t, err)
+	existing, err = conflictKb.Key("offline")
+	require.NoError(t, err)
+	require.True(t, offline.GetPubKey().Equals(existing.GetPubKey()))
+	_, err = conflictKb.Key("local")
+	require.NoError(t, err)
+	// the overwritten keys leave no stale address index behind
+	_, err = conflictKb.KeyByAddress(conflicting.GetAddress())
+	require.Error(t, err)
+	existing, err = conflictKb.KeyByAddress(local.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, "local", existing.GetName())
+	infos, err := conflictKb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 4)
+}
+
 func accAddr(info Info) sdk.AccAddress { return info.GetAddress() }
@@ -0,0 +1,215 @@
+package bls12381
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/gogo/protobuf/proto"
+	bls "github.com/kilic/bls12-381"
+
+	"github.com/line/ostracon/crypto"
+
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/types/address"
+)
+
+var _ cryptotypes.PubKey = &AggregatePubKey{}
+
+const (
+	aggregateKeyType    = "bls12381-aggregate"
+	AggregatePubKeyName = "lbm/PubKeyBls12381Aggregate"
+)
+
+// NewAggregatePubKey returns a new AggregatePubKey.
+// Panics if len(pubKeys) < k or 0 >= k, or pubKeys has duplicates.
+func NewAggregatePubKey(threshold int, pubKeys []*PubKey) *AggregatePubKey {
+	if threshold <= 0 {
+		panic("threshold k of n multisignature: k <= 0")
+	}
+	if len(pubKeys) < threshold {
+		panic("threshold k of n multisignature: len(pubKeys) < k")
+	}
+	m := &AggregatePubKey{Threshold: uint32(threshold), PubKeys: pubKeys}
+	if _, err := m.points(); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Address implements cryptotypes.PubKey Address method
+func (m *AggregatePubKey) Address() crypto.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Bytes returns the proto encoded version of the AggregatePubKey
+func (m *AggregatePubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifySignature implements cryptotypes.PubKey VerifySignature method.
+// The signature is an AggregateSignature, which must be signed by at least the
+// threshold number of the members.
+func (m *AggregatePubKey) VerifySignature(msg, sig []byte) bool {
+	var aggSig AggregateSignature
+	if err := aggSig.Unmarshal(sig); err != nil {
+		return false
+	}
+	signers := aggSig.Signers
+	if signers == nil || signers.Count() != len(m.PubKeys) {
+		return false
+	}
+	if signers.NumTrueBitsBefore(signers.Count()) < int(m.Threshold) {
+		return false
+	}
+
+	points, err := m.points()
+	if err != nil {
+		return false
+	}
+	coeffs := m.coefficients()
+
+	g1 := bls.NewG1()
+	pk := g1.Zero()
+	for i, point := range points {
+		if !signers.GetIndex(i) {
+			continue
+		}
+		g1.Add(pk, pk, g1.MulScalarBig(g1.New(), point, coeffs[i]))
+	}
+
+	return verify(pk, msg, aggSig.Signature)
+}
+
+// AggregateSignatures aggregates the signatures of the signers, which must be
+// members of the key, into the signature of the key.
+func (m *AggregatePubKey) AggregateSignatures(signers []cryptotypes.PubKey, sigs [][]byte) ([]byte, error) {
+	if len(signers) != len(sigs) {
+		return nil, fmt.Errorf("got %d signatures for %d signers", len(sigs), len(signers))
+	}
+
+	bitArray := cryptotypes.NewCompactBitArray(len(m.PubKeys))
+	coeffs := m.coefficients()
+	g2 := bls.NewG2()
+	aggregate := g2.Zero()
+	for i, signer := range signers {
+		index := m.indexOf(signer)
+		if index < 0 {
+			return nil, fmt.Errorf("%s is not a member", signer)
+		}
+		if bitArray.GetIndex(index) {
+			return nil, fmt.Errorf("duplicate signature of %s", signer)
+		}
+		bitArray.SetIndex(index, true)
+
+		sig, err := g2.FromCompressed(sigs[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature of %s: %w", signer, err)
+		}
+		g2.Add(aggregate, aggregate, g2.MulScalarBig(g2.New(), sig, coeffs[index]))
+	}
+
+	aggSig := AggregateSignature{
+		Signers:   bitArray,
+		Signature: g2.ToCompressed(aggregate),
+	}
+	return aggSig.Marshal()
+}
+
+// GetPubKeys returns the member keys of the AggregatePubKey.
+func (m *AggregatePubKey) GetPubKeys() []cryptotypes.PubKey {
+	pubKeys := make([]cryptotypes.PubKey, len(m.PubKeys))
+	for i, pk := range m.PubKeys {
+		pubKeys[i] = pk
+	}
+	return pubKeys
+}
+
+// GetThreshold implements the PubKey.GetThreshold method.
+func (m *AggregatePubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// Equals returns true if m and other both have the same number of keys, and
+// all constituent keys are the same, and in the same order.
+func (m *AggregatePubKey) Equals(key cryptotypes.PubKey) bool {
+	otherKey, ok := key.(*AggregatePubKey)
+	if !ok {
+		return false
+	}
+	if m.Threshold != otherKey.Threshold || len(m.PubKeys) != len(otherKey.PubKeys) {
+		return false
+	}
+
+	for i := range m.PubKeys {
+		if !m.PubKeys[i].Equals(otherKey.PubKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Type returns the type of the key.
+func (m *AggregatePubKey) Type() string {
+	return aggregateKeyType
+}
+
+func (m *AggregatePubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12381Aggregate{%d/%d %v}", m.Threshold, len(m.PubKeys), m.PubKeys)
+}
+
+// points decodes the member keys into the points of G1.
+func (m *AggregatePubKey) points() ([]*bls.PointG1, error) {
+	points := make([]*bls.PointG1, len(m.PubKeys))
+	seen := map[string]bool{}
+	for i, pk := range m.PubKeys {
+		if pk == nil {
+			return nil, fmt.Errorf("nil pubkey at %d", i)
+		}
+		if seen[string(pk.Key)] {
+			return nil, fmt.Errorf("duplicate pubkey %s", pk)
+		}
+		seen[string(pk.Key)] = true
+
+		point, err := pk.point()
+		if err != nil {
+			return nil, err
+		}
+		points[i] = point
+	}
+
+	return points, nil
+}
+
+// coefficients returns the coefficients of the members, which are
+// t_i = H(pk_i, {pk_1, ..., pk_n}) truncated to 128 bits.
+func (m *AggregatePubKey) coefficients() []*big.Int {
+	all := sha256.New()
+	for _, pk := range m.PubKeys {
+		all.Write(pk.Key)
+	}
+	allHash := all.Sum(nil)
+
+	coeffs := make([]*big.Int, len(m.PubKeys))
+	for i, pk := range m.PubKeys {
+		h := sha256.New()
+		h.Write(pk.Key)
+		h.Write(allHash)
+		coeffs[i] = new(big.Int).SetBytes(h.Sum(nil)[:16])
+	}
+
+	return coeffs
+}
+
+func (m *AggregatePubKey) indexOf(pubKey cryptotypes.PubKey) int {
+	for i, pk := range m.PubKeys {
+		if pk.Equals(pubKey) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,107 @@
+package bls12381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto/keys/bls12381"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+)
+
+func generateAggregateKeys(n int) ([]*bls12381.PrivKey, []*bls12381.PubKey) {
+	privKeys := make([]*bls12381.PrivKey, n)
+	pubKeys := make([]*bls12381.PubKey, n)
+	for i := 0; i < n; i++ {
+		privKeys[i] = bls12381.GenPrivKey()
+		pubKeys[i] = privKeys[i].PubKey().(*bls12381.PubKey)
+	}
+	return privKeys, pubKeys
+}
+
+func aggregateSign(t testing.TB, pubKey *bls12381.AggregatePubKey, privKeys []*bls12381.PrivKey, msg []byte) ([]byte, error) {
+	signers := make([]cryptotypes.PubKey, len(privKeys))
+	sigs := make([][]byte, len(privKeys))
+	for i, privKey := range privKeys {
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		signers[i] = privKey.PubKey()
+		sigs[i] = sig
+	}
+	return pubKey.AggregateSignatures(signers, sigs)
+}
+
+func TestNewAggregatePubKey(t *testing.T) {
+	_, pubKeys := generateAggregateKeys(3)
+
+	require.Panics(t, func() { bls12381.NewAggregatePubKey(0, pubKeys) })
+	require.Panics(t, func() { bls12381.NewAggregatePubKey(4, pubKeys) })
+	require.Panics(t, func() { bls12381.NewAggregatePubKey(2, append(pubKeys, pubKeys[0])) })
+
+	pubKey := bls12381.NewAggregatePubKey(2, pubKeys)
+	require.Equal(t, uint(2), pubKey.GetThreshold())
+	require.Len(t, pubKey.GetPubKeys(), 3)
+	require.True(t, pubKey.Equals(bls12381.NewAggregatePubKey(2, pubKeys)))
+	require.False(t, pubKey.Equals(bls12381.NewAggregatePubKey(1, pubKeys)))
+	require.False(t, pubKey.Equals(bls12381.NewAggregatePubKey(2, []*bls12381.PubKey{pubKeys[1], pubKeys[0], pubKeys[2]})))
+	require.Equal(t, pubKey.Address(), bls12381.NewAggregatePubKey(2, pubKeys).Address())
+	require.NotEqual(t, pubKey.Address(), bls12381.NewAggregatePubKey(1, pubKeys).Address())
+}
+
+func TestAggregateSignature(t *testing.T) {
+	privKeys, pubKeys := generateAggregateKeys(5)
+	pubKey := bls12381.NewAggregatePubKey(3, pubKeys)
+	msg := []byte("message")
+
+	// below the threshold
+	sig, err := aggregateSign(t, pubKey, privKeys[:2], msg)
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+
+	// the signature is of a constant size
+	sig, err = aggregateSign(t, pubKey, privKeys[1:4], msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature([]byte("another message"), sig))
+	allSig, err := aggregateSign(t, pubKey, privKeys, msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, allSig))
+	require.Len(t, allSig, len(sig))
+
+	// another key of the same members
+	require.False(t, bls12381.NewAggregatePubKey(3, []*bls12381.PubKey{pubKeys[4], pubKeys[3], pubKeys[2], pubKeys[1], pubKeys[0]}).VerifySignature(msg, sig))
+
+	// mismatching signers
+	var aggSig bls12381.AggregateSignature
+	require.NoError(t, aggSig.Unmarshal(sig))
+	aggSig.Signers.SetIndex(0, true)
+	aggSig.Signers.SetIndex(1, false)
+	tampered, err := aggSig.Marshal()
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, tampered))
+
+	// invalid signatures
+	stranger := bls12381.GenPrivKey()
+	_, err = aggregateSign(t, pubKey, append(privKeys[:3], stranger), msg)
+	require.Error(t, err)
+	_, err = aggregateSign(t, pubKey, append(privKeys[:3], privKeys[0]), msg)
+	require.Error(t, err)
+	strangerSig, err := stranger.Sign(msg)
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, strangerSig))
+	require.False(t, pubKey.VerifySignature(msg, nil))
+}
+
+func BenchmarkAggregateVerification(b *testing.B) {
+	privKeys, pubKeys := generateAggregateKeys(100)
+	pubKey := bls12381.NewAggregatePubKey(100, pubKeys)
+	msg := []byte("Hello, world!")
+	sig, err := aggregateSign(b, pubKey, privKeys, msg)
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pubKey.VerifySignature(msg, sig)
+	}
+}
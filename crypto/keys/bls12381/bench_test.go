@@ -0,0 +1,30 @@
+package bls12381
+
+import (
+	"io"
+	"testing"
+
+	"github.com/line/lbm-sdk/crypto/keys/internal/benchmarking"
+	"github.com/line/lbm-sdk/crypto/types"
+)
+
+func BenchmarkKeyGeneration(b *testing.B) {
+	b.ReportAllocs()
+	benchmarkKeygenWrapper := func(reader io.Reader) types.PrivKey {
+		priv := genPrivKey(reader)
+		return &PrivKey{Key: priv}
+	}
+	benchmarking.BenchmarkKeyGeneration(b, benchmarkKeygenWrapper)
+}
+
+func BenchmarkSigning(b *testing.B) {
+	b.ReportAllocs()
+	priv := GenPrivKey()
+	benchmarking.BenchmarkSigning(b, priv)
+}
+
+func BenchmarkVerification(b *testing.B) {
+	b.ReportAllocs()
+	priv := GenPrivKey()
+	benchmarking.BenchmarkVerification(b, priv)
+}
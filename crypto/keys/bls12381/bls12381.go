@@ -0,0 +1,271 @@
+package bls12381
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/gogo/protobuf/proto"
+	bls "github.com/kilic/bls12-381"
+
+	"github.com/line/ostracon/crypto"
+
+	"github.com/line/lbm-sdk/codec"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/types/address"
+	"github.com/line/lbm-sdk/types/errors"
+)
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+const (
+	// PrivKeySize is the size of the big-endian encoding of a scalar.
+	PrivKeySize = 32
+	// PubKeySize is the size of a compressed G1 point.
+	PubKeySize = 48
+	// SignatureSize is the size of a compressed G2 point.
+	SignatureSize = 96
+
+	keyType     = "bls12381"
+	PrivKeyName = "lbm/PrivKeyBls12381"
+	PubKeyName  = "lbm/PubKeyBls12381"
+)
+
+// dst is the domain separation tag of the basic scheme hashing the messages
+// to G2.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
+// curveOrder is the order r of G1 and G2.
+var curveOrder = bls.NewG1().Q()
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point of G1 to get the pubkey.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	g1 := bls.NewG1()
+	pk := g1.MulScalarBig(g1.New(), g1.One(), privKey.scalar())
+	return &PubKey{Key: g1.ToCompressed(pk)}
+}
+
+// Sign signs the message, which is hashed to a point of G2, with the privKey.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	if len(privKey.Key) != PrivKeySize {
+		return nil, fmt.Errorf("invalid privkey size")
+	}
+	g2 := bls.NewG2()
+	h, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	sig := g2.MulScalarBig(g2.New(), h, privKey.scalar())
+	return g2.ToCompressed(sig), nil
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+func (privKey *PrivKey) scalar() *big.Int {
+	return new(big.Int).SetBytes(privKey.Key)
+}
+
+// GenPrivKey generates a new BLS12-381 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	return &PrivKey{Key: genPrivKey(crypto.CReader())}
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) []byte {
+	var privKeyBytes [PrivKeySize]byte
+	d := new(big.Int)
+	for {
+		privKeyBytes = [PrivKeySize]byte{}
+		_, err := io.ReadFull(rand, privKeyBytes[:])
+		if err != nil {
+			panic(err)
+		}
+
+		d.SetBytes(privKeyBytes[:])
+		// break if we found a valid scalar (i.e. > 0 and < r)
+		if 0 < d.Sign() && d.Cmp(curveOrder) < 0 {
+			break
+		}
+	}
+
+	return privKeyBytes[:]
+}
+
+var one = big.NewInt(1)
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+//
+// It makes sure the private key is a valid scalar by setting:
+//
+// c = sha256(secret)
+// k = (c mod (r − 1)) + 1, where r = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) *PrivKey {
+	secHash := sha256.Sum256(secret)
+	fe := new(big.Int).SetBytes(secHash[:])
+	n := new(big.Int).Sub(curveOrder, one)
+	fe.Mod(fe, n)
+	fe.Add(fe, one)
+
+	privKey := make([]byte, PrivKeySize)
+	fe.FillBytes(privKey)
+
+	return &PrivKey{Key: privKey}
+}
+
+//-------------------------------------
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// Address returns the address of the pubkey as defined in ADR-028.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+
+	return address.Hash(proto.MessageName(pubKey), pubKey.Key)
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// VerifySignature verifies the signature of the message, checking
+// e(pubKey, H(msg)) == e(g1, sig).
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	pk, err := pubKey.point()
+	if err != nil {
+		return false
+	}
+
+	return verify(pk, msg, sig)
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12381{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errors.Wrap(errors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// point decodes the pubkey into a point of G1, rejecting the identity.
+func (pubKey *PubKey) point() (*bls.PointG1, error) {
+	g1 := bls.NewG1()
+	pk, err := g1.FromCompressed(pubKey.Key)
+	if err != nil {
+		return nil, errors.ErrInvalidPubKey.Wrap(err.Error())
+	}
+	if g1.IsZero(pk) {
+		return nil, errors.ErrInvalidPubKey.Wrap("identity pubkey")
+	}
+
+	return pk, nil
+}
+
+// verify verifies the signature of the message by the pubkey point.
+func verify(pk *bls.PointG1, msg, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+	g2 := bls.NewG2()
+	s, err := g2.FromCompressed(sig)
+	if err != nil || g2.IsZero(s) {
+		return false
+	}
+	h, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		return false
+	}
+
+	engine := bls.NewEngine()
+	engine.AddPair(pk, h)
+	engine.AddPairInv(engine.G1.One(), s)
+	return engine.Check()
+}
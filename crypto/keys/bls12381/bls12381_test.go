@@ -0,0 +1,148 @@
+package bls12381_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/crypto"
+
+	"github.com/line/lbm-sdk/codec"
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	cryptocodec "github.com/line/lbm-sdk/crypto/codec"
+	"github.com/line/lbm-sdk/crypto/keys/bls12381"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+)
+
+// curveOrder is the order r of the groups of BLS12-381.
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+func TestSignAndValidate(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), bls12381.PubKeySize)
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, bls12381.SignatureSize)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// mutate the message
+	msg[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+	msg[7] ^= byte(0x01)
+
+	// another key
+	require.False(t, bls12381.GenPrivKey().PubKey().VerifySignature(msg, sig))
+
+	// malformed signatures
+	require.False(t, pubKey.VerifySignature(msg, sig[1:]))
+	sig[10] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+
+	// the identity signature of the identity pubkey
+	identity := make([]byte, bls12381.SignatureSize)
+	identity[0] = 0xc0
+	identityPubKey := &bls12381.PubKey{Key: make([]byte, bls12381.PubKeySize)}
+	identityPubKey.Key[0] = 0xc0
+	require.False(t, pubKey.VerifySignature(msg, identity))
+	require.False(t, identityPubKey.VerifySignature(msg, identity))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secrets := [][]byte{
+		[]byte("a secret"),
+		[]byte("another secret"),
+		crypto.CRandBytes(32),
+		{},
+	}
+	for _, secret := range secrets {
+		privKey := bls12381.GenPrivKeyFromSecret(secret)
+		require.Len(t, privKey.Key, bls12381.PrivKeySize)
+		d := new(big.Int).SetBytes(privKey.Key)
+		require.True(t, d.Sign() > 0 && d.Cmp(curveOrder) < 0)
+		require.True(t, privKey.Equals(bls12381.GenPrivKeyFromSecret(secret)))
+	}
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	require.True(t, pubKey.Equals(&bls12381.PubKey{Key: pubKey.Bytes()}))
+	require.False(t, pubKey.Equals(bls12381.GenPrivKey().PubKey()))
+	require.False(t, pubKey.Equals(secp256k1.GenPrivKey().PubKey()))
+	require.Equal(t, pubKey.Address(), privKey.PubKey().Address())
+	require.NotEqual(t, pubKey.Address(), bls12381.GenPrivKey().PubKey().Address())
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	cryptocodec.RegisterCrypto(aminoCdc)
+
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(*bls12381.PubKey)
+	aggPubKey := bls12381.NewAggregatePubKey(1, []*bls12381.PubKey{pubKey, bls12381.GenPrivKey().PubKey().(*bls12381.PubKey)})
+
+	testCases := []struct {
+		desc string
+		msg  interface{}
+		typ  interface{}
+	}{
+		{"bls12381 private key", privKey, &bls12381.PrivKey{}},
+		{"bls12381 public key", pubKey, &bls12381.PubKey{}},
+		{"bls12381 aggregate public key", aggPubKey, &bls12381.AggregatePubKey{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			bz, err := aminoCdc.Marshal(tc.msg)
+			require.NoError(t, err)
+			require.NoError(t, aminoCdc.Unmarshal(bz, tc.typ))
+			require.Equal(t, tc.msg, tc.typ)
+
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.NoError(t, aminoCdc.UnmarshalJSON(bz, tc.typ))
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+
+	// as the interfaces
+	var iface cryptotypes.PubKey
+	bz, err := aminoCdc.Marshal(cryptotypes.PubKey(aggPubKey))
+	require.NoError(t, err)
+	require.NoError(t, aminoCdc.Unmarshal(bz, &iface))
+	require.True(t, aggPubKey.Equals(iface))
+
+	var privIface cryptotypes.PrivKey
+	bz, err = aminoCdc.Marshal(cryptotypes.PrivKey(privKey))
+	require.NoError(t, err)
+	require.NoError(t, aminoCdc.Unmarshal(bz, &privIface))
+	require.True(t, privKey.Equals(privIface))
+}
+
+func TestMarshalProto(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	pubKey := bls12381.GenPrivKey().PubKey().(*bls12381.PubKey)
+	aggPubKey := bls12381.NewAggregatePubKey(1, []*bls12381.PubKey{pubKey, bls12381.GenPrivKey().PubKey().(*bls12381.PubKey)})
+
+	for _, pk := range []cryptotypes.PubKey{pubKey, aggPubKey} {
+		bz, err := cdc.MarshalInterface(pk)
+		require.NoError(t, err)
+		var got cryptotypes.PubKey
+		require.NoError(t, cdc.UnmarshalInterface(bz, &got))
+		require.True(t, pk.Equals(got))
+
+		bz, err = cdc.MarshalInterfaceJSON(pk)
+		require.NoError(t, err)
+		require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &got))
+		require.True(t, pk.Equals(got))
+	}
+}
@@ -0,0 +1,11 @@
+package bls12381
+
+import (
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+)
+
+// RegisterInterfaces adds the bls12381 PubKey and AggregatePubKey to pubkey registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{}, &AggregatePubKey{})
+}
@@ -0,0 +1,13 @@
+// Package bls12381 implements BLS signatures on the BLS12-381 curve compatible
+// with the SDK PubKey and PrivKey interfaces.
+//
+// The public keys are in G1 and the signatures are in G2, both in the
+// compressed form, and the messages are hashed to G2 under the ciphersuite
+// BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_ of the basic scheme.
+//
+// AggregatePubKey is a threshold multisig key of BLS12-381 members, of which
+// the signature is the aggregate of the signatures of the signers and so is
+// of a constant size regardless of the number of the signers. The members are
+// weighted with the coefficients of Boneh, Drijvers and Neven, which protects
+// the aggregate against rogue key attacks.
+package bls12381
@@ -4,6 +4,7 @@ import (
 	"github.com/line/ostracon/crypto/sr25519"
 
 	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/crypto/keys/bls12381"
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
@@ -28,6 +29,8 @@ func init() {
 		sr25519.PubKeyName, nil)
 	AminoCdc.RegisterConcrete(&secp256k1.PubKey{},
 		secp256k1.PubKeyName, nil)
+	AminoCdc.RegisterConcrete(&bls12381.PubKey{},
+		bls12381.PubKeyName, nil)
 	AminoCdc.RegisterConcrete(&LegacyAminoPubKey{},
 		PubKeyAminoRoute, nil)
 }
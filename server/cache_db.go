@@ -0,0 +1,195 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/cachekv"
+	"github.com/line/lbm-sdk/store/dbadapter"
+)
+
+var (
+	errKeyEmpty   = errors.New("key cannot be empty")
+	errValueNil   = errors.New("value cannot be nil")
+	errBatchClose = errors.New("batch has been written or closed")
+)
+
+// cacheDB is a dbm.DB which keeps all the writes in memory on top of the
+// underlying db, which is never written.
+type cacheDB struct {
+	store *cachekv.Store
+}
+
+var _ dbm.DB = (*cacheDB)(nil)
+
+func newCacheDB(db dbm.DB) *cacheDB {
+	return &cacheDB{
+		store: cachekv.NewStore(dbadapter.Store{DB: db}),
+	}
+}
+
+// Get implements dbm.DB.
+func (db *cacheDB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errKeyEmpty
+	}
+	return db.store.Get(key), nil
+}
+
+// Has implements dbm.DB.
+func (db *cacheDB) Has(key []byte) (bool, error) {
+	if len(key) == 0 {
+		return false, errKeyEmpty
+	}
+	return db.store.Has(key), nil
+}
+
+// Set implements dbm.DB.
+func (db *cacheDB) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if value == nil {
+		return errValueNil
+	}
+	db.store.Set(key, value)
+	return nil
+}
+
+// SetSync implements dbm.DB.
+func (db *cacheDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+// Delete implements dbm.DB.
+func (db *cacheDB) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	db.store.Delete(key)
+	return nil
+}
+
+// DeleteSync implements dbm.DB.
+func (db *cacheDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+// Iterator implements dbm.DB.
+func (db *cacheDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errKeyEmpty
+	}
+	return db.store.Iterator(start, end), nil
+}
+
+// ReverseIterator implements dbm.DB.
+func (db *cacheDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errKeyEmpty
+	}
+	return db.store.ReverseIterator(start, end), nil
+}
+
+// Close implements dbm.DB. The underlying db is not closed.
+func (db *cacheDB) Close() error {
+	return nil
+}
+
+// NewBatch implements dbm.DB.
+func (db *cacheDB) NewBatch() dbm.Batch {
+	return &cacheDBBatch{db: db}
+}
+
+// Print implements dbm.DB.
+func (db *cacheDB) Print() error {
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", itr.Key(), itr.Value())
+	}
+	return nil
+}
+
+// Stats implements dbm.DB.
+func (db *cacheDB) Stats() map[string]string {
+	return map[string]string{
+		"database.type": "cacheDB",
+	}
+}
+
+type cacheDBOperation struct {
+	key    []byte
+	value  []byte // nil on deletion
+	delete bool
+}
+
+// cacheDBBatch is a dbm.Batch of cacheDB.
+type cacheDBBatch struct {
+	db  *cacheDB
+	ops []cacheDBOperation
+}
+
+var _ dbm.Batch = (*cacheDBBatch)(nil)
+
+// Set implements dbm.Batch.
+func (b *cacheDBBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if value == nil {
+		return errValueNil
+	}
+	if b.db == nil {
+		return errBatchClose
+	}
+	b.ops = append(b.ops, cacheDBOperation{key: key, value: value})
+	return nil
+}
+
+// Delete implements dbm.Batch.
+func (b *cacheDBBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if b.db == nil {
+		return errBatchClose
+	}
+	b.ops = append(b.ops, cacheDBOperation{key: key, delete: true})
+	return nil
+}
+
+// Write implements dbm.Batch.
+func (b *cacheDBBatch) Write() error {
+	if b.db == nil {
+		return errBatchClose
+	}
+
+	for _, op := range b.ops {
+		if op.delete {
+			b.db.store.Delete(op.key)
+		} else {
+			b.db.store.Set(op.key, op.value)
+		}
+	}
+
+	return b.Close()
+}
+
+// WriteSync implements dbm.Batch.
+func (b *cacheDBBatch) WriteSync() error {
+	return b.Write()
+}
+
+// Close implements dbm.Batch.
+func (b *cacheDBBatch) Close() error {
+	b.db = nil
+	b.ops = nil
+	return nil
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestCacheDB(t *testing.T) {
+	parent := dbm.NewMemDB()
+	require.NoError(t, parent.Set([]byte("a"), []byte("1")))
+	require.NoError(t, parent.Set([]byte("b"), []byte("2")))
+	require.NoError(t, parent.Set([]byte("c"), []byte("3")))
+
+	db := newCacheDB(parent)
+	require.NoError(t, db.Set([]byte("b"), []byte("20")))
+	require.NoError(t, db.Delete([]byte("c")))
+	require.NoError(t, db.Set([]byte("d"), []byte("4")))
+
+	// reads merge the writes
+	value, err := db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("20"), value)
+	has, err := db.Has([]byte("c"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	collect := func(itr dbm.Iterator, err error) []string {
+		require.NoError(t, err)
+		defer itr.Close()
+		var kvs []string
+		for ; itr.Valid(); itr.Next() {
+			kvs = append(kvs, string(itr.Key())+"="+string(itr.Value()))
+		}
+		return kvs
+	}
+	require.Equal(t, []string{"a=1", "b=20", "d=4"}, collect(db.Iterator(nil, nil)))
+	require.Equal(t, []string{"d=4", "b=20", "a=1"}, collect(db.ReverseIterator(nil, nil)))
+
+	// batches
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("e"), []byte("5")))
+	require.NoError(t, batch.Delete([]byte("a")))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+	require.Error(t, batch.Set([]byte("f"), []byte("6")))
+	require.Equal(t, []string{"b=20", "d=4", "e=5"}, collect(db.Iterator(nil, nil)))
+
+	// invalid arguments
+	require.Error(t, db.Set(nil, []byte("1")))
+	require.Error(t, db.Set([]byte("a"), nil))
+
+	// the parent is untouched
+	require.NoError(t, db.Close())
+	require.Equal(t, []string{"a=1", "b=2", "c=3"}, collect(parent.Iterator(nil, nil)))
+}
@@ -79,6 +79,10 @@ type BaseConfig struct {
 	// Interblock cache size; bytes size unit
 	InterBlockCacheSize int `mapstructure:"inter-block-cache-size"`
 
+	// InterBlockCacheWarmUpKeys is the number of the recently accessed keys of
+	// each store logged to warm the inter-block cache up at startup; 0 disables.
+	InterBlockCacheWarmUpKeys int `mapstructure:"inter-block-cache-warm-up-keys"`
+
 	// IAVL cache size; bytes size unit
 	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
 
@@ -182,6 +186,19 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// StorePruningConfig defines the pruning strategy of an individual store,
+// overriding the one of the base configuration.
+type StorePruningConfig struct {
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+	PruningKeepEvery  string `mapstructure:"pruning-keep-every"`
+	PruningInterval   string `mapstructure:"pruning-interval"`
+
+	// Archive defines if the pruned states of the store are offloaded into the
+	// archive db, which the historical queries fall back to.
+	Archive bool `mapstructure:"archive"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -193,6 +210,14 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+
+	// InterBlockCacheStoreSizes defines the bytes sizes of the inter-block
+	// caches dedicated to the individual stores by their names.
+	InterBlockCacheStoreSizes map[string]int `mapstructure:"inter-block-cache-store-sizes"`
+
+	// StorePruning defines the pruning strategies of the individual stores by
+	// their names.
+	StorePruning map[string]StorePruningConfig `mapstructure:"store-pruning"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -242,6 +267,13 @@ func DefaultConfig() *Config {
 		Telemetry: telemetry.Config{
 			Enabled:      false,
 			GlobalLabels: [][]string{},
+			Tracing: telemetry.TracingConfig{
+				Enabled:      false,
+				Exporter:     telemetry.TracingExporterOTLP,
+				OTLPEndpoint: "http://localhost:4318",
+				File:         "traces.json",
+				SampleRate:   1,
+			},
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -273,6 +305,8 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		InterBlockCacheStoreSizes: make(map[string]int),
+		StorePruning:              make(map[string]StorePruningConfig),
 	}
 }
 
@@ -294,21 +328,33 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		}
 	}
 
+	interBlockCacheStoreSizes := make(map[string]int)
+	if err := v.UnmarshalKey("inter-block-cache-store-sizes", &interBlockCacheStoreSizes); err != nil {
+		return Config{}, fmt.Errorf("failed to parse inter-block-cache-store-sizes config: %w", err)
+	}
+
+	storePruning := make(map[string]StorePruningConfig)
+	if err := v.UnmarshalKey("store-pruning", &storePruning); err != nil {
+		return Config{}, fmt.Errorf("failed to parse store-pruning config: %w", err)
+	}
+
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        v.GetString("minimum-gas-prices"),
-			InterBlockCache:     v.GetBool("inter-block-cache"),
-			Pruning:             v.GetString("pruning"),
-			PruningKeepRecent:   v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:    v.GetString("pruning-keep-every"),
-			PruningInterval:     v.GetString("pruning-interval"),
-			HaltHeight:          v.GetUint64("halt-height"),
-			HaltTime:            v.GetUint64("halt-time"),
-			IndexEvents:         v.GetStringSlice("index-events"),
-			MinRetainBlocks:     v.GetUint64("min-retain-blocks"),
-			IAVLDisableFastNode: v.GetBool("iavl-disable-fastnode"),
-			IAVLCacheSize:       v.GetUint64("iavl-cache-size"),
-			ChanCheckTxSize:     v.GetUint("chan-check-tx-size"),
+			MinGasPrices:              v.GetString("minimum-gas-prices"),
+			InterBlockCache:           v.GetBool("inter-block-cache"),
+			InterBlockCacheSize:       v.GetInt("inter-block-cache-size"),
+			InterBlockCacheWarmUpKeys: v.GetInt("inter-block-cache-warm-up-keys"),
+			Pruning:                   v.GetString("pruning"),
+			PruningKeepRecent:         v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:          v.GetString("pruning-keep-every"),
+			PruningInterval:           v.GetString("pruning-interval"),
+			HaltHeight:                v.GetUint64("halt-height"),
+			HaltTime:                  v.GetUint64("halt-time"),
+			IndexEvents:               v.GetStringSlice("index-events"),
+			MinRetainBlocks:           v.GetUint64("min-retain-blocks"),
+			IAVLDisableFastNode:       v.GetBool("iavl-disable-fastnode"),
+			IAVLCacheSize:             v.GetUint64("iavl-cache-size"),
+			ChanCheckTxSize:           v.GetUint("chan-check-tx-size"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -318,6 +364,13 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EnableServiceLabel:      v.GetBool("telemetry.enable-service-label"),
 			PrometheusRetentionTime: v.GetInt64("telemetry.prometheus-retention-time"),
 			GlobalLabels:            globalLabels,
+			Tracing: telemetry.TracingConfig{
+				Enabled:      v.GetBool("telemetry.tracing.enabled"),
+				Exporter:     v.GetString("telemetry.tracing.exporter"),
+				OTLPEndpoint: v.GetString("telemetry.tracing.otlp-endpoint"),
+				File:         v.GetString("telemetry.tracing.file"),
+				SampleRate:   v.GetFloat64("telemetry.tracing.sample-rate"),
+			},
 		},
 		API: APIConfig{
 			Enable:             v.GetBool("api.enable"),
@@ -351,6 +404,8 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		InterBlockCacheStoreSizes: interBlockCacheStoreSizes,
+		StorePruning:              storePruning,
 	}, nil
 }
 
@@ -364,6 +419,16 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", storetypes.PruningOptionEverything,
 		)
 	}
+	for name, storePruning := range c.StorePruning {
+		if storePruning.Pruning == storetypes.PruningOptionEverything && c.StateSync.SnapshotInterval > 0 {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"cannot enable state sync snapshots with '%s' pruning setting of store %s", storetypes.PruningOptionEverything, name,
+			)
+		}
+	}
+	if err := c.Telemetry.Tracing.Validate(); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
 
 	return nil
 }
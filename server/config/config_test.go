@@ -1,11 +1,14 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	storetypes "github.com/line/lbm-sdk/store/types"
+	"github.com/line/lbm-sdk/telemetry"
 	sdk "github.com/line/lbm-sdk/types"
 )
 
@@ -30,4 +33,65 @@ func TestValidateBasic(t *testing.T) {
 	cfg.StateSync.SnapshotInterval = 5
 	err = cfg.ValidateBasic()
 	require.Error(t, err)
+
+	cfg.Pruning = storetypes.PruningOptionDefault
+	cfg.StorePruning["collection"] = StorePruningConfig{Pruning: storetypes.PruningOptionEverything}
+	err = cfg.ValidateBasic()
+	require.Error(t, err)
+}
+
+func TestStoreConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.StorePruning["collection"] = StorePruningConfig{
+		Pruning:           storetypes.PruningOptionCustom,
+		PruningKeepRecent: "100",
+		PruningKeepEvery:  "0",
+		PruningInterval:   "10",
+		Archive:           true,
+	}
+	cfg.StorePruning["foundation"] = StorePruningConfig{
+		Pruning:           storetypes.PruningOptionNothing,
+		PruningKeepRecent: "0",
+		PruningKeepEvery:  "0",
+		PruningInterval:   "0",
+	}
+
+	cfg.InterBlockCacheStoreSizes["collection"] = 33554432
+	cfg.InterBlockCacheWarmUpKeys = 1000
+
+	configFile := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(configFile, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed, err := GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg.StorePruning, parsed.StorePruning)
+	require.Equal(t, cfg.InterBlockCacheStoreSizes, parsed.InterBlockCacheStoreSizes)
+	require.Equal(t, cfg.InterBlockCacheWarmUpKeys, parsed.InterBlockCacheWarmUpKeys)
+}
+
+func TestTracingConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
+	cfg.Telemetry.Tracing.Enabled = true
+	cfg.Telemetry.Tracing.Exporter = telemetry.TracingExporterFile
+	cfg.Telemetry.Tracing.SampleRate = 0.25
+	require.NoError(t, cfg.ValidateBasic())
+
+	configFile := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(configFile, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed, err := GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg.Telemetry.Tracing, parsed.Telemetry.Tracing)
+
+	cfg.Telemetry.Tracing.Exporter = "jaeger"
+	require.Error(t, cfg.ValidateBasic())
 }
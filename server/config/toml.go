@@ -66,6 +66,11 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # InterBlockCacheSize is the maximum bytes size of the inter-block cache.
 inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
 
+# InterBlockCacheWarmUpKeys is the number of the recently accessed keys of each
+# store logged in data/inter-block-cache, which are loaded into the inter-block
+# cache at startup to warm it up (0 to disable).
+inter-block-cache-warm-up-keys = {{ .BaseConfig.InterBlockCacheWarmUpKeys }}
+
 # IAVLCacheSize is the maximum units size of iavl node cache (1 unit is 128 bytes)
 # This iavl cache size is just one store cache size, and the store exists for each modules.
 # So be careful that all iavl cache size are difference from this iavl cache size value.
@@ -125,6 +130,27 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+[telemetry.tracing]
+
+# Enabled enables OpenTelemetry tracing of the ABCI methods, ante decorators,
+# Msg service handlers and gRPC queries.
+enabled = {{ .Telemetry.Tracing.Enabled }}
+
+# Exporter defines where the spans are exported to: "otlp" sends them to an
+# OpenTelemetry collector over OTLP/HTTP, "stdout" and "file" write them as
+# JSON for offline use.
+exporter = "{{ .Telemetry.Tracing.Exporter }}"
+
+# OTLPEndpoint defines the OTLP/HTTP collector endpoint of the "otlp" exporter.
+# The "/v1/traces" path is appended if the endpoint has no path.
+otlp-endpoint = "{{ .Telemetry.Tracing.OTLPEndpoint }}"
+
+# File defines the path of the file the "file" exporter appends spans to.
+file = "{{ .Telemetry.Tracing.File }}"
+
+# SampleRate defines the fraction of traces which are sampled, between 0 and 1.
+sample-rate = {{ .Telemetry.Tracing.SampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -224,6 +250,48 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                  Inter-block Cache Store Configuration                  ###
+###############################################################################
+
+# The stores listed here by their names have the inter-block caches of their
+# own maximum bytes sizes, instead of sharing the one of inter-block-cache-size,
+# e.g. to keep a frequently accessed store from evicting the others.
+[inter-block-cache-store-sizes]
+{{- range $name, $size := .InterBlockCacheStoreSizes }}
+{{ $name }} = {{ $size }}
+{{- end }}
+
+###############################################################################
+###                       Store Pruning Configuration                       ###
+###############################################################################
+
+# Store pruning overrides the pruning strategy above for the individual stores
+# by their names, e.g. to keep the recent states of a store while keeping every
+# state of another. The strategies and their options are the same as above.
+# The states of a store with archive enabled are archived into the archive db
+# (data/archive.db) in the background, and pruned from the store only once they
+# have been archived. The historical queries fall back to the archive.
+#
+# [store-pruning.collection]
+# pruning = "custom"
+# pruning-keep-recent = "100"
+# pruning-keep-every = "0"
+# pruning-interval = "10"
+# archive = true
+#
+# [store-pruning.foundation]
+# pruning = "nothing"
+{{- range $name, $pruning := .StorePruning }}
+
+[store-pruning.{{ $name }}]
+pruning = "{{ $pruning.Pruning }}"
+pruning-keep-recent = "{{ $pruning.PruningKeepRecent }}"
+pruning-keep-every = "{{ $pruning.PruningKeepEvery }}"
+pruning-interval = "{{ $pruning.PruningInterval }}"
+archive = {{ $pruning.Archive }}
+{{- end }}
 `
 
 var configTemplate *template.Template
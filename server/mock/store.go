@@ -143,6 +143,14 @@ func (ms multiStore) RollbackToVersion(version int64) error {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetStorePruning(name string, opts sdk.StorePruningOptions) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetPruningArchive(db dbm.DB) {
+	panic("not implemented")
+}
+
 var _ sdk.KVStore = kvStore{}
 
 type kvStore struct {
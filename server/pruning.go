@@ -38,3 +38,45 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (storetypes.PruningOpt
 		return store.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
 }
+
+// GetStorePruningOptionsFromFlags parses the pruning strategies of the
+// individual stores configured under FlagStorePruning, keyed by the store
+// names. Each strategy is parsed the same way as GetPruningOptionsFromFlags.
+func GetStorePruningOptionsFromFlags(appOpts types.AppOptions) (map[string]storetypes.StorePruningOptions, error) {
+	raw := appOpts.Get(FlagStorePruning)
+	if raw == nil {
+		return map[string]storetypes.StorePruningOptions{}, nil
+	}
+
+	storePruning, err := cast.ToStringMapE(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid store pruning options: %w", err)
+	}
+
+	storeOpts := make(map[string]storetypes.StorePruningOptions, len(storePruning))
+	for name, storeRaw := range storePruning {
+		storeAppOpts, err := cast.ToStringMapE(storeRaw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning options of store %s: %w", name, err)
+		}
+
+		opts, err := GetPruningOptionsFromFlags(mapAppOptions(storeAppOpts))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning options of store %s: %w", name, err)
+		}
+
+		storeOpts[name] = storetypes.StorePruningOptions{
+			PruningOptions: opts,
+			Archive:        cast.ToBool(storeAppOpts[FlagStorePruningArchive]),
+		}
+	}
+
+	return storeOpts, nil
+}
+
+// mapAppOptions is the AppOptions of a map.
+type mapAppOptions map[string]interface{}
+
+func (m mapAppOptions) Get(key string) interface{} {
+	return m[key]
+}
@@ -71,3 +71,36 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStorePruningOptionsFromFlags(t *testing.T) {
+	v := viper.New()
+	v.Set(FlagStorePruning, map[string]interface{}{
+		"collection": map[string]interface{}{
+			FlagPruning:             types.PruningOptionCustom,
+			FlagPruningKeepRecent:   "100",
+			FlagPruningKeepEvery:    "0",
+			FlagPruningInterval:     "10",
+			FlagStorePruningArchive: true,
+		},
+		"foundation": map[string]interface{}{
+			FlagPruning: types.PruningOptionNothing,
+		},
+	})
+
+	opts, err := GetStorePruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, map[string]types.StorePruningOptions{
+		"collection": {PruningOptions: types.NewPruningOptions(100, 0, 10), Archive: true},
+		"foundation": {PruningOptions: types.PruneNothing},
+	}, opts)
+
+	v.Set(FlagStorePruning, map[string]interface{}{
+		"collection": map[string]interface{}{FlagPruning: "unknown"},
+	})
+	_, err = GetStorePruningOptionsFromFlags(v)
+	require.Error(t, err)
+
+	opts, err = GetStorePruningOptionsFromFlags(viper.New())
+	require.NoError(t, err)
+	require.Empty(t, opts)
+}
@@ -80,6 +80,12 @@ func NewClient(cfg *Config) (*Client, error) {
 		bank.EventTypeCoinSpent,
 		bank.EventTypeCoinReceived,
 		bank.EventTypeCoinBurn,
+		EventTypeTokenSent,
+		EventTypeTokenMinted,
+		EventTypeTokenBurned,
+		EventTypeCollectionSent,
+		EventTypeCollectionMintedFT,
+		EventTypeCollectionBurned,
 	)
 
 	return &Client{
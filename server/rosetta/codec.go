@@ -6,6 +6,8 @@ import (
 	cryptocodec "github.com/line/lbm-sdk/crypto/codec"
 	authcodec "github.com/line/lbm-sdk/x/auth/types"
 	bankcodec "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/token"
 )
 
 // MakeCodec generates the codec required to interact
@@ -17,6 +19,8 @@ func MakeCodec() (*codec.ProtoCodec, codectypes.InterfaceRegistry) {
 	authcodec.RegisterInterfaces(ir)
 	bankcodec.RegisterInterfaces(ir)
 	cryptocodec.RegisterInterfaces(ir)
+	token.RegisterInterfaces(ir)
+	collection.RegisterInterfaces(ir)
 
 	return cdc, ir
 }
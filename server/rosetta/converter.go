@@ -27,6 +27,8 @@ import (
 	authsigning "github.com/line/lbm-sdk/x/auth/signing"
 	auth "github.com/line/lbm-sdk/x/auth/types"
 	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/token"
 )
 
 // Converter is a utility that can be used to convert
@@ -338,6 +340,9 @@ func sdkEventToBalanceOperations(status string, event abci.Event) (operations []
 	switch event.Type {
 	default:
 		return nil, false
+	case EventTypeTokenSent, EventTypeTokenMinted, EventTypeTokenBurned,
+		EventTypeCollectionSent, EventTypeCollectionMintedFT, EventTypeCollectionBurned:
+		return tokenEventToBalanceOperations(status, event), true
 	case banktypes.EventTypeCoinSpent:
 		spender := sdk.MustAccAddressFromBech32((string)(event.Attributes[0].Value))
 		coins, err := sdk.ParseCoinsNormalized((string)(event.Attributes[1].Value))
@@ -401,6 +406,92 @@ func sdkEventToBalanceOperations(status string, event abci.Event) (operations []
 	return operations, true
 }
 
+// tokenEventToBalanceOperations converts a sent, minted or burned event of
+// x/token or x/collection to the balance operations for each token changed. A
+// send subtracts from the sender and adds to the recipient, a mint adds to the
+// recipient, and a burn subtracts from the holder and adds to
+// BurnerAddressIdentifier. The non-fungible tokens of x/collection are not
+// modeled as currencies, so they are skipped.
+func tokenEventToBalanceOperations(status string, event abci.Event) []*rosettatypes.Operation {
+	typedEvent, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		panic(err)
+	}
+
+	var (
+		from, to   string
+		amounts    []sdk.Int
+		currencies []*rosettatypes.Currency
+	)
+	addTokenAmount := func(contractID string, amount sdk.Int) {
+		amounts = append(amounts, amount)
+		currencies = append(currencies, TokenCurrency(contractID))
+	}
+	addCollectionAmounts := func(contractID string, coins []collection.Coin) {
+		for _, coin := range coins {
+			if err := collection.ValidateFTID(coin.TokenId); err != nil {
+				continue
+			}
+			amounts = append(amounts, coin.Amount)
+			currencies = append(currencies, CollectionFTCurrency(contractID, collection.SplitTokenID(coin.TokenId)))
+		}
+	}
+
+	switch e := typedEvent.(type) {
+	case *token.EventSent:
+		from, to = e.From, e.To
+		addTokenAmount(e.ContractId, e.Amount)
+	case *token.EventMinted:
+		to = e.To
+		addTokenAmount(e.ContractId, e.Amount)
+	case *token.EventBurned:
+		from, to = e.From, BurnerAddressIdentifier
+		addTokenAmount(e.ContractId, e.Amount)
+	case *collection.EventSent:
+		from, to = e.From, e.To
+		addCollectionAmounts(e.ContractId, e.Amount)
+	case *collection.EventMintedFT:
+		to = e.To
+		addCollectionAmounts(e.ContractId, e.Amount)
+	case *collection.EventBurned:
+		from, to = e.From, BurnerAddressIdentifier
+		addCollectionAmounts(e.ContractId, e.Amount)
+	default:
+		panic(fmt.Errorf("unexpected event of type %s", event.Type))
+	}
+	if from != "" {
+		sdk.MustAccAddressFromBech32(from)
+	}
+	if to != BurnerAddressIdentifier {
+		sdk.MustAccAddressFromBech32(to)
+	}
+
+	operations := make([]*rosettatypes.Operation, 0, 2*len(amounts))
+	for i, amount := range amounts {
+		if from != "" {
+			operations = append(operations, &rosettatypes.Operation{
+				Type:    event.Type,
+				Status:  &status,
+				Account: &rosettatypes.AccountIdentifier{Address: from},
+				Amount: &rosettatypes.Amount{
+					Value:    "-" + amount.String(),
+					Currency: currencies[i],
+				},
+			})
+		}
+		operations = append(operations, &rosettatypes.Operation{
+			Type:    event.Type,
+			Status:  &status,
+			Account: &rosettatypes.AccountIdentifier{Address: to},
+			Amount: &rosettatypes.Amount{
+				Value:    amount.String(),
+				Currency: currencies[i],
+			},
+		})
+	}
+	return operations
+}
+
 // Amounts converts []sdk.Coin to rosetta amounts
 func (c converter) Amounts(ownedCoins []sdk.Coin, availableCoins sdk.Coins) []*rosettatypes.Amount {
 	amounts := make([]*rosettatypes.Amount, len(availableCoins))
@@ -22,6 +22,8 @@ import (
 	sdk "github.com/line/lbm-sdk/types"
 	authsigning "github.com/line/lbm-sdk/x/auth/signing"
 	bank "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/token"
 )
 
 type ConverterTestSuite struct {
@@ -383,6 +385,167 @@ func (s *ConverterTestSuite) TestBalanceOps() {
 	})
 }
 
+func (s *ConverterTestSuite) TestTokenOpsToTx() {
+	addr1 := sdk.AccAddress("address1").String()
+	addr2 := sdk.AccAddress("address2").String()
+
+	msgs := []sdk.Msg{
+		&token.MsgSend{
+			ContractId: "9be17165",
+			From:       addr1,
+			To:         addr2,
+			Amount:     sdk.NewInt(10),
+		},
+		&collection.MsgSendFT{
+			ContractId: "deadbeef",
+			From:       addr2,
+			To:         addr1,
+			Amount:     collection.NewCoins(collection.NewFTCoin("00bab10c", sdk.NewInt(20))),
+		},
+	}
+
+	var ops []*rosettatypes.Operation
+	for _, msg := range msgs {
+		msgOps, err := s.c.ToRosetta().Ops("", msg)
+		s.Require().NoError(err)
+		ops = append(ops, msgOps...)
+	}
+	s.Require().Equal("/lbm.token.v1.MsgSend", ops[0].Type)
+	s.Require().Equal("/lbm.collection.v1.MsgSendFT", ops[1].Type)
+
+	tx, err := s.c.ToSDK().UnsignedTx(ops)
+	s.Require().NoError(err)
+	s.Require().Equal(msgs, tx.GetMsgs())
+}
+
+func (s *ConverterTestSuite) TestTokenBalanceOps() {
+	addr1 := sdk.AccAddress("address1").String()
+	addr2 := sdk.AccAddress("address2").String()
+
+	tokenSent, err := sdk.TypedEventToEvent(&token.EventSent{
+		ContractId: "9be17165",
+		Operator:   addr1,
+		From:       addr1,
+		To:         addr2,
+		Amount:     sdk.NewInt(10),
+	})
+	s.Require().NoError(err)
+
+	collectionSent, err := sdk.TypedEventToEvent(&collection.EventSent{
+		ContractId: "deadbeef",
+		Operator:   addr2,
+		From:       addr2,
+		To:         addr1,
+		Amount: []collection.Coin{
+			collection.NewFTCoin("00bab10c", sdk.NewInt(20)),
+			collection.NewNFTCoin("deadbeef", 1),
+		},
+	})
+	s.Require().NoError(err)
+
+	ops := s.c.ToRosetta().BalanceOps("", []abci.Event{(abci.Event)(tokenSent), (abci.Event)(collectionSent)})
+	// the nft is not a currency
+	s.Require().Len(ops, 4)
+
+	expected := []struct {
+		typ      string
+		address  string
+		value    string
+		currency *rosettatypes.Currency
+	}{
+		{rosetta.EventTypeTokenSent, addr1, "-10", rosetta.TokenCurrency("9be17165")},
+		{rosetta.EventTypeTokenSent, addr2, "10", rosetta.TokenCurrency("9be17165")},
+		{rosetta.EventTypeCollectionSent, addr2, "-20", rosetta.CollectionFTCurrency("deadbeef", "00bab10c")},
+		{rosetta.EventTypeCollectionSent, addr1, "20", rosetta.CollectionFTCurrency("deadbeef", "00bab10c")},
+	}
+	for i, op := range ops {
+		s.Require().Equal(expected[i].typ, op.Type)
+		s.Require().Equal(expected[i].address, op.Account.Address)
+		s.Require().Equal(expected[i].value, op.Amount.Value)
+		s.Require().Equal(expected[i].currency, op.Amount.Currency)
+	}
+	s.Require().Equal("token/9be17165", ops[0].Amount.Currency.Symbol)
+	s.Require().Equal("collection/deadbeef/00bab10c", ops[2].Amount.Currency.Symbol)
+
+	s.Require().Panics(func() {
+		specBroken := abci.Event{
+			Type: rosetta.EventTypeTokenSent,
+		}
+		_ = s.c.ToRosetta().BalanceOps("", []abci.Event{specBroken})
+	})
+}
+
+func (s *ConverterTestSuite) TestTokenMintBurnBalanceOps() {
+	addr1 := sdk.AccAddress("address1").String()
+	addr2 := sdk.AccAddress("address2").String()
+
+	tokenMinted, err := sdk.TypedEventToEvent(&token.EventMinted{
+		ContractId: "9be17165",
+		Operator:   addr1,
+		To:         addr2,
+		Amount:     sdk.NewInt(10),
+	})
+	s.Require().NoError(err)
+
+	// burnt by the operator
+	tokenBurned, err := sdk.TypedEventToEvent(&token.EventBurned{
+		ContractId: "9be17165",
+		Operator:   addr1,
+		From:       addr2,
+		Amount:     sdk.NewInt(3),
+	})
+	s.Require().NoError(err)
+
+	collectionMinted, err := sdk.TypedEventToEvent(&collection.EventMintedFT{
+		ContractId: "deadbeef",
+		Operator:   addr1,
+		To:         addr2,
+		Amount:     collection.NewCoins(collection.NewFTCoin("00bab10c", sdk.NewInt(20))),
+	})
+	s.Require().NoError(err)
+
+	collectionBurned, err := sdk.TypedEventToEvent(&collection.EventBurned{
+		ContractId: "deadbeef",
+		Operator:   addr2,
+		From:       addr2,
+		Amount: []collection.Coin{
+			collection.NewFTCoin("00bab10c", sdk.NewInt(5)),
+			collection.NewNFTCoin("deadbeef", 1),
+		},
+	})
+	s.Require().NoError(err)
+
+	events := []abci.Event{
+		(abci.Event)(tokenMinted),
+		(abci.Event)(tokenBurned),
+		(abci.Event)(collectionMinted),
+		(abci.Event)(collectionBurned),
+	}
+	ops := s.c.ToRosetta().BalanceOps("", events)
+	// the nft is not a currency
+	s.Require().Len(ops, 6)
+
+	expected := []struct {
+		typ      string
+		address  string
+		value    string
+		currency *rosettatypes.Currency
+	}{
+		{rosetta.EventTypeTokenMinted, addr2, "10", rosetta.TokenCurrency("9be17165")},
+		{rosetta.EventTypeTokenBurned, addr2, "-3", rosetta.TokenCurrency("9be17165")},
+		{rosetta.EventTypeTokenBurned, rosetta.BurnerAddressIdentifier, "3", rosetta.TokenCurrency("9be17165")},
+		{rosetta.EventTypeCollectionMintedFT, addr2, "20", rosetta.CollectionFTCurrency("deadbeef", "00bab10c")},
+		{rosetta.EventTypeCollectionBurned, addr2, "-5", rosetta.CollectionFTCurrency("deadbeef", "00bab10c")},
+		{rosetta.EventTypeCollectionBurned, rosetta.BurnerAddressIdentifier, "5", rosetta.CollectionFTCurrency("deadbeef", "00bab10c")},
+	}
+	for i, op := range ops {
+		s.Require().Equal(expected[i].typ, op.Type)
+		s.Require().Equal(expected[i].address, op.Account.Address)
+		s.Require().Equal(expected[i].value, op.Amount.Value)
+		s.Require().Equal(expected[i].currency, op.Amount.Currency)
+	}
+}
+
 func TestConverterTestSuite(t *testing.T) {
 	suite.Run(t, new(ConverterTestSuite))
 }
@@ -2,6 +2,12 @@ package rosetta
 
 import (
 	"crypto/sha256"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/token"
 )
 
 // statuses
@@ -31,6 +37,48 @@ const (
 	BurnerAddressIdentifier = "burner"
 )
 
+// The balance changes of x/token and x/collection are represented by their
+// sent, minted and burned events, whose tokens are modeled as the currencies
+// below. The burns, including the ones by the operators, are mocked as sends to
+// BurnerAddressIdentifier, as the burns of x/bank.
+var (
+	EventTypeTokenSent          = proto.MessageName(&token.EventSent{})
+	EventTypeTokenMinted        = proto.MessageName(&token.EventMinted{})
+	EventTypeTokenBurned        = proto.MessageName(&token.EventBurned{})
+	EventTypeCollectionSent     = proto.MessageName(&collection.EventSent{})
+	EventTypeCollectionMintedFT = proto.MessageName(&collection.EventMintedFT{})
+	EventTypeCollectionBurned   = proto.MessageName(&collection.EventBurned{})
+)
+
+// currency metadata keys
+const (
+	CurrencyMetadataContractID = "contract_id"
+	CurrencyMetadataClassID    = "class_id"
+)
+
+// TokenCurrency returns the currency of the tokens of a x/token contract,
+// whose symbol is token/<contract id>.
+func TokenCurrency(contractID string) *rosettatypes.Currency {
+	return &rosettatypes.Currency{
+		Symbol: "token/" + contractID,
+		Metadata: map[string]interface{}{
+			CurrencyMetadataContractID: contractID,
+		},
+	}
+}
+
+// CollectionFTCurrency returns the currency of the fungible tokens of a class
+// in a x/collection contract, whose symbol is collection/<contract id>/<class id>.
+func CollectionFTCurrency(contractID, classID string) *rosettatypes.Currency {
+	return &rosettatypes.Currency{
+		Symbol: "collection/" + contractID + "/" + classID,
+		Metadata: map[string]interface{}{
+			CurrencyMetadataContractID: contractID,
+			CurrencyMetadataClassID:    classID,
+		},
+	}
+}
+
 // TransactionType is used to distinguish if a rosetta provided hash
 // represents endblock, beginblock or deliver tx
 type TransactionType int
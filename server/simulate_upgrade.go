@@ -0,0 +1,79 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/server/types"
+)
+
+// SimulateUpgradeCmd creates a command to dry-run an upgrade against the
+// application state on disk.
+func SimulateUpgradeCmd(simulator types.AppUpgradeSimulator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-upgrade [name]",
+		Short: "Dry-run an upgrade against the application state on disk",
+		Long: `Load the application state at the given height, which is the latest height by
+default, and apply the store upgrades and the upgrade handler registered under
+the name as if the upgrade were scheduled at the next height. The invariants are
+asserted afterwards. All the writes are kept in memory, and the database on disk
+is never modified. The node must not be running.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			name := args[0]
+
+			simulation, err := simulator(serverCtx.Logger, newCacheDB(db), nil, height, name, serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("failed to simulate upgrade %s: %w", name, err)
+			}
+
+			cmd.Printf("upgrade %s applied at height %d\n", name, simulation.Height)
+			cmd.Printf("app hash: %X\n", simulation.AppHash)
+
+			cmd.Println("module versions:")
+			for _, version := range simulation.ModuleVersions {
+				marker := ""
+				if version.From != version.To {
+					marker = " (migrated)"
+				}
+				cmd.Printf("  %s: %d -> %d%s\n", version.Module, version.From, version.To, marker)
+			}
+
+			cmd.Println("timings:")
+			for _, timing := range simulation.Timings {
+				cmd.Printf("  %s: %s\n", timing.Step, timing.Duration)
+			}
+
+			if len(simulation.BrokenInvariants) != 0 {
+				for _, res := range simulation.BrokenInvariants {
+					cmd.Println(res)
+				}
+				return fmt.Errorf("%d invariant(s) broken after upgrade %s", len(simulation.BrokenInvariants), name)
+			}
+			cmd.Println("all invariants hold")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Height of the state to apply the upgrade on; the latest height if not given")
+
+	return cmd
+}
@@ -3,9 +3,12 @@ package server
 // DONTCOVER
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime/pprof"
 	"time"
 
@@ -38,21 +41,23 @@ import (
 
 // Ostracon full-node start flags
 const (
-	flagWithOstracon        = "with-ostracon"
-	flagAddress             = "address"
-	flagTransport           = "transport"
-	flagTraceStore          = "trace-store"
-	flagCPUProfile          = "cpu-profile"
-	FlagMinGasPrices        = "minimum-gas-prices"
-	FlagHaltHeight          = "halt-height"
-	FlagHaltTime            = "halt-time"
-	FlagInterBlockCache     = "inter-block-cache"
-	FlagInterBlockCacheSize = "inter-block-cache-size"
-	FlagUnsafeSkipUpgrades  = "unsafe-skip-upgrades"
-	FlagTrace               = "trace"
-	FlagInvCheckPeriod      = "inv-check-period"
-	FlagPrometheus          = "prometheus"
-	FlagChanCheckTxSize     = "chan-check-tx-size"
+	flagWithOstracon              = "with-ostracon"
+	flagAddress                   = "address"
+	flagTransport                 = "transport"
+	flagTraceStore                = "trace-store"
+	flagCPUProfile                = "cpu-profile"
+	FlagMinGasPrices              = "minimum-gas-prices"
+	FlagHaltHeight                = "halt-height"
+	FlagHaltTime                  = "halt-time"
+	FlagInterBlockCache           = "inter-block-cache"
+	FlagInterBlockCacheSize       = "inter-block-cache-size"
+	FlagInterBlockCacheWarmUpKeys = "inter-block-cache-warm-up-keys"
+	FlagInterBlockCacheStoreSizes = "inter-block-cache-store-sizes" // configured in app.toml only
+	FlagUnsafeSkipUpgrades        = "unsafe-skip-upgrades"
+	FlagTrace                     = "trace"
+	FlagInvCheckPeriod            = "inv-check-period"
+	FlagPrometheus                = "prometheus"
+	FlagChanCheckTxSize           = "chan-check-tx-size"
 
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
@@ -63,6 +68,10 @@ const (
 	FlagIAVLCacheSize     = "iavl-cache-size"
 	FlagIAVLFastNode      = "iavl-disable-fastnode"
 
+	// store pruning-related options, configured in app.toml only
+	FlagStorePruning        = "store-pruning"
+	FlagStorePruningArchive = "archive"
+
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
@@ -158,6 +167,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().Int(FlagInterBlockCacheSize, cache.DefaultCommitKVStoreCacheSize, "The maximum bytes size of the inter-block cache")
+	cmd.Flags().Int(FlagInterBlockCacheWarmUpKeys, 0, "The number of the recently accessed keys of each store logged to warm the inter-block cache up at startup (0 to disable)")
 	cmd.Flags().Int(FlagIAVLCacheSize, iavl.DefaultIAVLCacheSize, "The maximum units size of the iavl node cache (1 unit is 128 bytes).")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
@@ -206,6 +216,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	}
 
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
+	defer closeInterBlockCache(ctx, app)
 
 	config, err := config.GetConfig(ctx.Viper)
 	if err != nil {
@@ -217,6 +228,14 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		return err
 	}
 
+	tracing, err := startTracing(config, home)
+	if err != nil {
+		return err
+	}
+	if tracing != nil {
+		defer stopTracing(ctx, tracing)
+	}
+
 	svr, err := server.NewServer(addr, transport, app)
 	if err != nil {
 		return fmt.Errorf("error creating listener: %v", err)
@@ -285,6 +304,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	}
 
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
+	defer closeInterBlockCache(ctx, app)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
@@ -345,6 +365,11 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return err
 	}
 
+	tracing, err := startTracing(config, home)
+	if err != nil {
+		return err
+	}
+
 	var apiSrv *api.Server
 	if config.API.Enable {
 		genDoc, err := genDocProvider()
@@ -453,6 +478,10 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			cpuProfileCleanup()
 		}
 
+		if tracing != nil {
+			stopTracing(ctx, tracing)
+		}
+
 		if apiSrv != nil {
 			_ = apiSrv.Close()
 		}
@@ -471,9 +500,50 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	return WaitForQuitSignals()
 }
 
+// closeInterBlockCache closes the inter-block cache of the app if it has one,
+// which writes the access logs of the cache for the last time.
+func closeInterBlockCache(ctx *Context, app types.Application) {
+	cacheApp, ok := app.(interface {
+		InterBlockCache() storetypes.MultiStorePersistentCache
+	})
+	if !ok {
+		return
+	}
+
+	if closer, ok := cacheApp.InterBlockCache().(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			ctx.Logger.Error("failed to close the inter-block cache", "err", err)
+		}
+	}
+}
+
 func startTelemetry(cfg config.Config) (*telemetry.Metrics, error) {
 	if !cfg.Telemetry.Enabled {
 		return nil, nil
 	}
 	return telemetry.New(cfg.Telemetry)
 }
+
+// startTracing installs the tracer provider configured in app.toml. A relative
+// path of the file exporter is resolved against the node home directory.
+func startTracing(cfg config.Config, home string) (*telemetry.Tracing, error) {
+	if !cfg.Telemetry.Tracing.Enabled {
+		return nil, nil
+	}
+
+	if file := cfg.Telemetry.Tracing.File; file != "" && !filepath.IsAbs(file) {
+		cfg.Telemetry.Tracing.File = filepath.Join(home, file)
+	}
+
+	return telemetry.NewTracing(cfg.Telemetry)
+}
+
+// stopTracing flushes the pending spans and stops the tracer provider.
+func stopTracing(ctx *Context, tracing *telemetry.Tracing) {
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := tracing.Shutdown(shutdownCtx); err != nil {
+		ctx.Logger.Error("failed to shutdown tracing", "err", err)
+	}
+}
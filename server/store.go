@@ -0,0 +1,177 @@
+package server
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/server/types"
+	"github.com/line/lbm-sdk/store/rootmulti"
+)
+
+const (
+	FlagStart = "start"
+	FlagEnd   = "end"
+
+	// storeExportMaxItemSize is the maximum size of an item of an exported store.
+	// SDK has no key/value size limit, so we set an arbitrary limit
+	storeExportMaxItemSize = int(64e6)
+)
+
+// StoreCmd creates a command to export and import the key/value pairs of a
+// single store, for the state surgery of a module.
+func StoreCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Export and import the raw key/value pairs of a single store",
+	}
+
+	cmd.AddCommand(
+		StoreExportCmd(appCreator, defaultNodeHome),
+		StoreImportCmd(appCreator, defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// StoreExportCmd creates a command to export the key/value pairs of a store
+// into a file.
+func StoreExportCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [store-name] [file]",
+		Short: "Export the key/value pairs of a store at a height into a file",
+		Long: `Export the raw key/value pairs of a store at a height into a file, which ends
+with the checksum of the pairs. The key range is given in hex by --start
+(inclusive) and --end (exclusive). The node must not be running.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			start, end, err := getKeyRangeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			rs, db, err := openMultiStore(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 {
+				height = rs.LastCommitID().Version
+			}
+
+			file, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			bufWriter := bufio.NewWriter(file)
+			if err := rs.ExportStore(args[0], height, start, end, protoio.NewDelimitedWriter(bufWriter)); err != nil {
+				return err
+			}
+			if err := bufWriter.Flush(); err != nil {
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Exported store %s at height %d into %s\n", args[0], height, args[1])
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Height to export the store at; 0 means the latest height")
+	cmd.Flags().String(FlagStart, "", "The start of the key range in hex (inclusive)")
+	cmd.Flags().String(FlagEnd, "", "The end of the key range in hex (exclusive)")
+
+	return cmd
+}
+
+// StoreImportCmd creates a command to import the key/value pairs of a store
+// from a file.
+func StoreImportCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import the key/value pairs of a store from an exported file",
+		Long: `Stage the key/value pairs of an exported file, which replace the ones within
+the key range of the file when the node starts, and are committed with the next
+block. The file is verified against its checksum before anything is staged, and
+the committed state is left untouched, so the node resumes at the same height.
+
+All the nodes must stage the same file at the same height to reach the same app
+hash. The node must not be running.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rs, db, err := openMultiStore(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			header, err := rs.ImportStore(protoio.NewDelimitedReader(bufio.NewReader(file), storeExportMaxItemSize))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Staged store %s exported at height %d, to be committed with the block at height %d\n",
+				header.StoreKey, header.Version, rs.LastCommitID().Version+1)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// openMultiStore opens the multistore of the application in the home
+// directory. The caller must close the returned db.
+func openMultiStore(cmd *cobra.Command, appCreator types.AppCreator) (*rootmulti.Store, dbm.DB, error) {
+	ctx := GetServerContextFromCmd(cmd)
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	ctx.Config.SetRoot(homeDir)
+
+	db, err := openDB(ctx.Config.RootDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+	rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		db.Close()
+		return nil, nil, fmt.Errorf("unsupported multistore type %T", app.CommitMultiStore())
+	}
+
+	return rs, db, nil
+}
+
+func getKeyRangeFromFlags(cmd *cobra.Command) (start, end []byte, err error) {
+	startStr, _ := cmd.Flags().GetString(FlagStart)
+	if start, err = hex.DecodeString(startStr); err != nil {
+		return nil, nil, fmt.Errorf("invalid start: %w", err)
+	}
+	endStr, _ := cmd.Flags().GetString(FlagEnd)
+	if end, err = hex.DecodeString(endStr); err != nil {
+		return nil, nil, fmt.Errorf("invalid end: %w", err)
+	}
+	return start, end, nil
+}
@@ -0,0 +1,108 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	ocabci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/server"
+	"github.com/line/lbm-sdk/server/types"
+	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/store/rootmulti"
+	sdk "github.com/line/lbm-sdk/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+func TestStoreExportImportCmd(t *testing.T) {
+	home := t.TempDir()
+	encCfg := simapp.MakeTestEncodingConfig()
+	appCreator := func(logger log.Logger, db dbm.DB, _ io.Writer, _ types.AppOptions) types.Application {
+		return simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, home, 0, encCfg, simapp.EmptyAppOptions{})
+	}
+
+	openApp := func() (*simapp.SimApp, dbm.DB) {
+		db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+		require.NoError(t, err)
+		return appCreator(log.NewNopLogger(), db, nil, nil).(*simapp.SimApp), db
+	}
+	commitBlock := func(app *simapp.SimApp, height int64, deliver func(ctx sdk.Context)) {
+		header := tmproto.Header{Height: height}
+		app.BeginBlock(ocabci.RequestBeginBlock{Header: header})
+		if deliver != nil {
+			deliver(app.NewContext(false, header))
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+	key := []byte("surgery")
+
+	app, db := openApp()
+	genDoc := newDefaultGenesisDoc(encCfg.Marshaler)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   genDoc.AppState,
+	})
+	app.Commit()
+	require.NoError(t, db.Close())
+
+	serverCtx := server.NewDefaultContext()
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+	run := func(args ...string) (string, error) {
+		cmd := server.StoreCmd(appCreator, home)
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetErr(io.Discard)
+		cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	file := filepath.Join(t.TempDir(), "bank.export")
+	_, err := run("export", banktypes.StoreKey, file)
+	require.NoError(t, err)
+
+	// a key to be removed by the import
+	app, db = openApp()
+	commitBlock(app, 2, func(ctx sdk.Context) {
+		ctx.KVStore(app.GetKey(banktypes.StoreKey)).Set(key, []byte("broken"))
+	})
+	require.NoError(t, db.Close())
+
+	out, err := run("import", file)
+	require.NoError(t, err)
+	require.Contains(t, out, "to be committed with the block at height 3")
+
+	// the node resumes at the same height, with the import applied to the
+	// working state
+	app, db = openApp()
+	require.Equal(t, int64(2), app.Info(abci.RequestInfo{}).LastBlockHeight)
+	rs := app.CommitMultiStore().(*rootmulti.Store)
+	staged, err := rs.StagedImports()
+	require.NoError(t, err)
+	require.Len(t, staged, 1)
+
+	commitBlock(app, 3, func(ctx sdk.Context) {
+		require.Nil(t, ctx.KVStore(app.GetKey(banktypes.StoreKey)).Get(key))
+	})
+	require.NoError(t, db.Close())
+
+	// the import is committed with the block, and not applied again
+	app, db = openApp()
+	defer db.Close()
+	require.Equal(t, int64(3), app.Info(abci.RequestInfo{}).LastBlockHeight)
+	staged, err = app.CommitMultiStore().(*rootmulti.Store).StagedImports()
+	require.NoError(t, err)
+	require.Empty(t, staged)
+}
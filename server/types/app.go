@@ -92,4 +92,38 @@ type (
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions) (ExportedApp, error)
+
+	// UpgradeSimulation represents the results of a dry-run of an upgrade.
+	UpgradeSimulation struct {
+		// Height is the height the upgrade has been applied at.
+		Height int64
+		// AppHash is the app hash resulting from the upgrade.
+		AppHash []byte
+		// ModuleVersions are the versions of the modules before and after
+		// the upgrade.
+		ModuleVersions []ModuleVersionChange
+		// BrokenInvariants are the messages of the invariants broken after
+		// the upgrade.
+		BrokenInvariants []string
+		// Timings are the durations of the steps of the upgrade, in order.
+		Timings []UpgradeTiming
+	}
+
+	// ModuleVersionChange represents the consensus versions of a module
+	// before and after an upgrade, which are zero if the module is absent.
+	ModuleVersionChange struct {
+		Module string
+		From   uint64
+		To     uint64
+	}
+
+	// UpgradeTiming represents the duration of a step of an upgrade.
+	UpgradeTiming struct {
+		Step     string
+		Duration time.Duration
+	}
+
+	// AppUpgradeSimulator is a function that applies the upgrade of the given
+	// name on the state at the given height, without writing to the db.
+	AppUpgradeSimulator func(log.Logger, dbm.DB, io.Writer, int64, string, AppOptions) (UpgradeSimulation, error)
 )
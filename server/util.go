@@ -300,6 +300,8 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
+		StoreCmd(appCreator, defaultNodeHome),
+		NewVerifyStateCmd(appCreator, defaultNodeHome),
 	)
 }
 
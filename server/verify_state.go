@@ -0,0 +1,75 @@
+package server
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/server/types"
+)
+
+const FlagParallel = "parallel"
+
+// NewVerifyStateCmd creates a command to verify the application state on disk
+// against the committed app hashes.
+func NewVerifyStateCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-state",
+		Short: "Verify the application state on disk against the committed app hashes",
+		Long: `Recompute the hash of every IAVL store from its nodes on disk, and compare them
+and the resulting app hash with the committed commit info. The first mismatching
+node of a store is reported with its key range. The latest height is verified
+unless --height is given, which can be repeated to verify historic heights that
+have not been pruned. The node must not be running.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, db, err := openMultiStore(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			heights, _ := cmd.Flags().GetInt64Slice(FlagHeight)
+			if len(heights) == 0 {
+				heights = []int64{rs.LastCommitID().Version}
+			}
+			parallel, _ := cmd.Flags().GetInt(FlagParallel)
+
+			failed := 0
+			for _, height := range heights {
+				verification, err := rs.VerifyState(height, parallel)
+				if err != nil {
+					return fmt.Errorf("failed to verify height %d: %w", height, err)
+				}
+
+				if verification.Verified() {
+					cmd.Printf("height %d: OK, app hash %X\n", height, verification.AppHash)
+					continue
+				}
+
+				failed++
+				cmd.Printf("height %d: MISMATCH, app hash %X, committed %X\n", height, verification.AppHash, verification.CommittedAppHash)
+				if mismatch := verification.FirstMismatch(); mismatch != nil {
+					cmd.Printf("  store %s: hash %X, committed %X\n", mismatch.StoreKey, mismatch.Hash, mismatch.CommittedHash)
+					if mismatch.Mismatch != nil {
+						cmd.Printf("  %s\n", mismatch.Mismatch)
+					}
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d heights failed the verification", failed, len(heights))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64Slice(FlagHeight, nil, "Height to verify, which can be repeated; the latest height if not given")
+	cmd.Flags().Int(FlagParallel, runtime.NumCPU(), "The number of the subtrees of a store verified in parallel")
+
+	return cmd
+}
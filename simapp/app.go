@@ -340,7 +340,7 @@ func NewSimApp(
 
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter,
+		&stakingKeeper, govRouter, app.BaseApp.MsgServiceRouter(),
 	)
 
 	app.GovKeeper = *govKeeper.SetHooks(
@@ -354,6 +354,9 @@ func NewSimApp(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
 	)
 	// If evidence needs to be handled for the app, set routes in router here and seal
+	evidenceRouter := evidencetypes.NewRouter().
+		AddRoute(evidencetypes.RouteFoundationMisbehavior, evidencekeeper.NewFoundationMisbehaviorHandler(app.FoundationKeeper))
+	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
 	/****  Module Options ****/
@@ -361,6 +364,9 @@ func NewSimApp(
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
 	// we prefer to be more strict in what arguments the modules expect.
 	skipGenesisInvariants := cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
+	if cast.ToBool(appOpts.Get(crisis.FlagAsyncInvariants)) {
+		app.CrisisKeeper.EnableAsyncInvariantCheck(app.CommitMultiStore())
+	}
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -384,8 +390,8 @@ func NewSimApp(
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
-		tokenmodule.NewAppModule(appCodec, app.TokenKeeper),
-		collectionmodule.NewAppModule(appCodec, app.CollectionKeeper),
+		tokenmodule.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
+		collectionmodule.NewAppModule(appCodec, app.CollectionKeeper, app.AccountKeeper, app.BankKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 	)
 
@@ -442,6 +448,8 @@ func NewSimApp(
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
+	// NOTE: Crisis module must occur last so that the invariants are asserted
+	// against the whole genesis state.
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -452,7 +460,6 @@ func NewSimApp(
 		govtypes.ModuleName,
 		minttypes.ModuleName,
 		foundation.ModuleName,
-		crisistypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -462,6 +469,7 @@ func NewSimApp(
 		vestingtypes.ModuleName,
 		token.ModuleName,
 		collection.ModuleName,
+		crisistypes.ModuleName,
 	)
 
 	// Uncomment if you want to set a custom migration order here.
@@ -511,6 +519,8 @@ func NewSimApp(
 	app.SetAnteHandler(anteHandler)
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.registerUpgrades()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			ostos.Exit(err.Error())
@@ -677,7 +687,7 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 }
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context) {
-	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+	nodeservice.RegisterNodeServiceWithCache(clientCtx, app.GRPCQueryRouter(), app.InterBlockCache())
 }
 
 // RegisterSwaggerAPI registers swagger route with API Server
@@ -8,6 +8,7 @@ const (
 	DefaultWeightMsgWithdrawDelegationReward    int = 50
 	DefaultWeightMsgWithdrawValidatorCommission int = 50
 	DefaultWeightMsgFundCommunityPool           int = 50
+	DefaultWeightMsgSetAutoRestake              int = 50
 	DefaultWeightMsgDeposit                     int = 100
 	DefaultWeightMsgVote                        int = 67
 	DefaultWeightMsgVoteWeighted                int = 33
@@ -27,4 +28,41 @@ const (
 	DefaultWeightRevokeAllowance        int = 100
 	DefaultWeightMsgStoreCode           int = 100
 	DefaultWeightMsgInstantiateContract int = 100
+
+	// token
+	DefaultWeightMsgTokenIssue             int = 20
+	DefaultWeightMsgTokenSend              int = 100
+	DefaultWeightMsgTokenOperatorSend      int = 50
+	DefaultWeightMsgTokenAuthorizeOperator int = 30
+	DefaultWeightMsgTokenRevokeOperator    int = 10
+	DefaultWeightMsgTokenGrantPermission   int = 20
+	DefaultWeightMsgTokenRevokePermission  int = 5
+	DefaultWeightMsgTokenMint              int = 50
+	DefaultWeightMsgTokenBurn              int = 30
+	DefaultWeightMsgTokenOperatorBurn      int = 20
+	DefaultWeightMsgTokenModify            int = 10
+
+	// collection
+	DefaultWeightMsgCollectionCreateContract    int = 10
+	DefaultWeightMsgCollectionIssueFT           int = 20
+	DefaultWeightMsgCollectionIssueNFT          int = 20
+	DefaultWeightMsgCollectionMintFT            int = 50
+	DefaultWeightMsgCollectionMintNFT           int = 50
+	DefaultWeightMsgCollectionSendFT            int = 100
+	DefaultWeightMsgCollectionOperatorSendFT    int = 50
+	DefaultWeightMsgCollectionSendNFT           int = 100
+	DefaultWeightMsgCollectionOperatorSendNFT   int = 50
+	DefaultWeightMsgCollectionAuthorizeOperator int = 30
+	DefaultWeightMsgCollectionRevokeOperator    int = 10
+	DefaultWeightMsgCollectionBurnFT            int = 30
+	DefaultWeightMsgCollectionOperatorBurnFT    int = 20
+	DefaultWeightMsgCollectionBurnNFT           int = 30
+	DefaultWeightMsgCollectionOperatorBurnNFT   int = 20
+	DefaultWeightMsgCollectionModify            int = 10
+	DefaultWeightMsgCollectionGrantPermission   int = 20
+	DefaultWeightMsgCollectionRevokePermission  int = 5
+	DefaultWeightMsgCollectionAttach            int = 50
+	DefaultWeightMsgCollectionDetach            int = 30
+	DefaultWeightMsgCollectionOperatorAttach    int = 30
+	DefaultWeightMsgCollectionOperatorDetach    int = 20
 )
@@ -24,6 +24,7 @@ import (
 	authzkeeper "github.com/line/lbm-sdk/x/authz/keeper"
 	banktypes "github.com/line/lbm-sdk/x/bank/types"
 	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
+	"github.com/line/lbm-sdk/x/collection"
 	distrtypes "github.com/line/lbm-sdk/x/distribution/types"
 	evidencetypes "github.com/line/lbm-sdk/x/evidence/types"
 	govtypes "github.com/line/lbm-sdk/x/gov/types"
@@ -32,6 +33,8 @@ import (
 	"github.com/line/lbm-sdk/x/simulation"
 	slashingtypes "github.com/line/lbm-sdk/x/slashing/types"
 	stakingtypes "github.com/line/lbm-sdk/x/staking/types"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/token/class"
 )
 
 // Get flags every time the simulator is run
@@ -180,6 +183,9 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[authzkeeper.StoreKey], newApp.keys[authzkeeper.StoreKey], [][]byte{}},
+		{app.keys[class.StoreKey], newApp.keys[class.StoreKey], [][]byte{}},
+		{app.keys[token.StoreKey], newApp.keys[token.StoreKey], [][]byte{}},
+		{app.keys[collection.StoreKey], newApp.keys[collection.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -28,13 +28,14 @@ import (
 	"github.com/line/lbm-sdk/simapp"
 	"github.com/line/lbm-sdk/simapp/params"
 	"github.com/line/lbm-sdk/snapshots"
-	"github.com/line/lbm-sdk/store"
+	storecache "github.com/line/lbm-sdk/store/cache"
 	sdk "github.com/line/lbm-sdk/types"
 	authcmd "github.com/line/lbm-sdk/x/auth/client/cli"
 	"github.com/line/lbm-sdk/x/auth/types"
 	banktypes "github.com/line/lbm-sdk/x/bank/types"
 	"github.com/line/lbm-sdk/x/crisis"
 	genutilcli "github.com/line/lbm-sdk/x/genutil/client/cli"
+	upgradecli "github.com/line/lbm-sdk/x/upgrade/client/cli"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -137,6 +138,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		debug.Cmd(),
 		config.Cmd(),
 		pruning.PruningCmd(a.newApp),
+		upgradecli.NewCmdPreUpgrade(),
+		server.SimulateUpgradeCmd(a.simulateUpgrade, simapp.DefaultNodeHome),
 	)
 
 	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
@@ -195,6 +198,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetSignBatchCommand(),
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
+		authcmd.GetMultiSignSessionCommand(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
@@ -217,8 +221,15 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 
 	ibCacheMetricsProvider := baseapp.MetricsProvider(cast.ToBool(viper.GetBool(server.FlagPrometheus)))
 	if cast.ToBool(appOpts.Get(server.FlagInterBlockCache)) {
-		cache = store.NewCommitKVStoreCacheManager(
+		cacheManager := storecache.NewCommitKVStoreCacheManager(
 			cast.ToInt(appOpts.Get(server.FlagInterBlockCacheSize)), ibCacheMetricsProvider)
+		for name, size := range cast.ToStringMapInt(appOpts.Get(server.FlagInterBlockCacheStoreSizes)) {
+			cacheManager.SetStoreCacheSize(name, size)
+		}
+		cacheManager.SetAccessLog(
+			filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "inter-block-cache"),
+			cast.ToInt(appOpts.Get(server.FlagInterBlockCacheWarmUpKeys)))
+		cache = cacheManager
 	}
 
 	skipUpgradeHeights := make(map[int64]bool)
@@ -231,6 +242,23 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		panic(err)
 	}
 
+	storePruningOpts, err := server.GetStorePruningOptionsFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	// the archive db is opened only if any store offloads its pruned states
+	var archiveDB dbm.DB
+	for _, opts := range storePruningOpts {
+		if opts.Archive {
+			archiveDB, err = sdk.NewLevelDB("archive", filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data"))
+			if err != nil {
+				panic(err)
+			}
+			break
+		}
+	}
+
 	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
 	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
 	if err != nil {
@@ -248,6 +276,8 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		a.encCfg,
 		appOpts,
 		baseapp.SetPruning(pruningOpts),
+		baseapp.SetStorePruning(storePruningOpts),
+		baseapp.SetPruningArchive(archiveDB),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
@@ -288,3 +318,17 @@ func (a appCreator) appExport(
 
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
+
+// simulateUpgrade creates a new simapp, and dry-runs the upgrade on the state
+// at a given height.
+func (a appCreator) simulateUpgrade(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, name string, appOpts servertypes.AppOptions,
+) (servertypes.UpgradeSimulation, error) {
+	homePath, ok := appOpts.Get(flags.FlagHome).(string)
+	if !ok || homePath == "" {
+		return servertypes.UpgradeSimulation{}, errors.New("application home not set")
+	}
+
+	simApp := simapp.NewSimApp(logger, db, traceStore, false, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
+	return simApp.SimulateUpgrade(height, name)
+}
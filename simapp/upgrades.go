@@ -0,0 +1,200 @@
+package simapp
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	servertypes "github.com/line/lbm-sdk/server/types"
+	"github.com/line/lbm-sdk/store/iavl"
+	storetypes "github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/module"
+	bankpluskeeper "github.com/line/lbm-sdk/x/bankplus/keeper"
+	upgradetypes "github.com/line/lbm-sdk/x/upgrade/types"
+)
+
+// Upgrade defines an upgrade of the app, which is applied on the execution of
+// the upgrade plan of the same name.
+type Upgrade struct {
+	// Name is the name of the upgrade plan.
+	Name string
+	// CreateUpgradeHandler creates the handler of the upgrade.
+	CreateUpgradeHandler func(app *SimApp) upgradetypes.UpgradeHandler
+	// StoreUpgrades are the store upgrades applied on the upgrade.
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// CollectionStateFixesUpgradeName is the name of the upgrade applying the
+// state machine breaking fixes of x/collection.
+const CollectionStateFixesUpgradeName = "collection-state-fixes"
+
+// Upgrades are the upgrades of the app.
+var Upgrades = []Upgrade{
+	{
+		Name: CollectionStateFixesUpgradeName,
+		CreateUpgradeHandler: func(app *SimApp) upgradetypes.UpgradeHandler {
+			return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+			}
+		},
+	},
+}
+
+// registerUpgrades registers the handlers of the upgrades, and sets the store
+// loader of the upgrade being applied, if any.
+func (app *SimApp) registerUpgrades() {
+	if len(Upgrades) == 0 {
+		return
+	}
+
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(upgrade.Name, upgrade.CreateUpgradeHandler(app))
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
+	}
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	if upgrade := findUpgrade(upgradeInfo.Name); upgrade != nil {
+		storeUpgrades := upgrade.StoreUpgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
+}
+
+func findUpgrade(name string) *Upgrade {
+	for i := range Upgrades {
+		if Upgrades[i].Name == name {
+			return &Upgrades[i]
+		}
+	}
+	return nil
+}
+
+// SimulateUpgrade applies the store upgrades and the handler of the upgrade on
+// the state at the height, as if the upgrade plan were executed at the next
+// height, and asserts the invariants. The latest height is used if the height
+// is zero. The results are committed, so the app must be created on a
+// disposable db, and must not have been loaded.
+func (app *SimApp) SimulateUpgrade(height int64, name string) (simulation servertypes.UpgradeSimulation, err error) {
+	upgrade := findUpgrade(name)
+	if upgrade == nil {
+		return simulation, fmt.Errorf("upgrade %s not registered", name)
+	}
+
+	measure := func(step string, start time.Time) {
+		simulation.Timings = append(simulation.Timings, servertypes.UpgradeTiming{
+			Step:     step,
+			Duration: time.Since(start),
+		})
+	}
+
+	start := time.Now()
+	storeUpgrades := upgrade.StoreUpgrades
+	app.SetStoreLoader(func(ms sdk.CommitMultiStore) error {
+		if err := ms.LoadLatestVersion(); err != nil {
+			return err
+		}
+
+		latest := ms.LastCommitID().Version
+		if height == 0 {
+			height = latest
+		}
+		if height > latest {
+			return fmt.Errorf("height %d is higher than the latest height %d", height, latest)
+		}
+		if err := ms.LoadVersionAndUpgrade(height, &storeUpgrades); err != nil {
+			return err
+		}
+
+		// the later versions are overwritten on the commit of the upgrade
+		if height < latest {
+			for _, key := range app.keys {
+				store, ok := ms.GetCommitKVStore(key).(*iavl.Store)
+				if !ok || store.LastCommitID().Version == 0 {
+					continue
+				}
+				if _, err := store.LoadVersionForOverwriting(height); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err := app.LoadLatestVersion(); err != nil {
+		return simulation, err
+	}
+	measure("store upgrades", start)
+
+	plan := upgradetypes.Plan{Name: name, Height: height + 1}
+	ctx := app.NewUncachedContext(false, tmproto.Header{Height: plan.Height, Time: time.Now().UTC()})
+	app.BankKeeper.(bankpluskeeper.Keeper).InitializeBankPlus(ctx)
+
+	fromVM := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+
+	start = time.Now()
+	if err := applyUpgrade(ctx, app, plan); err != nil {
+		return simulation, err
+	}
+	measure("upgrade handler", start)
+
+	toVM := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	for module := range toVM {
+		if _, ok := fromVM[module]; !ok {
+			fromVM[module] = 0
+		}
+	}
+	for module, from := range fromVM {
+		simulation.ModuleVersions = append(simulation.ModuleVersions, servertypes.ModuleVersionChange{
+			Module: module,
+			From:   from,
+			To:     toVM[module],
+		})
+	}
+	sort.Slice(simulation.ModuleVersions, func(i, j int) bool {
+		return simulation.ModuleVersions[i].Module < simulation.ModuleVersions[j].Module
+	})
+
+	start = time.Now()
+	for _, ir := range app.CrisisKeeper.Routes() {
+		// cache, we don't want to write changes
+		cacheCtx, _ := ctx.CacheContext()
+		if res, broken := ir.Invar(cacheCtx); broken {
+			simulation.BrokenInvariants = append(simulation.BrokenInvariants, res)
+		}
+	}
+	measure("invariants", start)
+
+	start = time.Now()
+	commitID := app.CommitMultiStore().Commit()
+	measure("commit", start)
+
+	simulation.Height = plan.Height
+	simulation.AppHash = commitID.Hash
+
+	return simulation, nil
+}
+
+// applyUpgrade applies the upgrade, returning the panic of the handler as an
+// error.
+func applyUpgrade(ctx sdk.Context, app *SimApp, plan upgradetypes.Plan) (err error) {
+	if !app.UpgradeKeeper.HasHandler(plan.Name) {
+		return fmt.Errorf("no handler for upgrade %s", plan.Name)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade %s failed: %v", plan.Name, r)
+		}
+	}()
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+	return nil
+}
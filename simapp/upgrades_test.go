@@ -0,0 +1,175 @@
+package simapp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	ocabci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/log"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/module"
+	"github.com/line/lbm-sdk/x/collection"
+	upgradetypes "github.com/line/lbm-sdk/x/upgrade/types"
+)
+
+// copyDB returns a copy of db in memory.
+func copyDB(t *testing.T, db dbm.DB) dbm.DB {
+	cp := dbm.NewMemDB()
+	itr, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		require.NoError(t, cp.Set(itr.Key(), itr.Value()))
+	}
+	return cp
+}
+
+func TestSimulateUpgrade(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+
+	genesisState := NewDefaultGenesisState(encCfg.Marshaler)
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:    []abci.ValidatorUpdate{},
+		AppStateBytes: stateBytes,
+	})
+	app.Commit()
+	for height := int64(2); height <= 3; height++ {
+		app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	upgrades := Upgrades
+	defer func() { Upgrades = upgrades }()
+	Upgrades = []Upgrade{
+		{
+			Name: "bump",
+			CreateUpgradeHandler: func(app *SimApp) upgradetypes.UpgradeHandler {
+				return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+					vm, err := app.mm.RunMigrations(ctx, app.configurator, vm)
+					if err != nil {
+						return nil, err
+					}
+					vm["crisis"]++
+					return vm, nil
+				}
+			},
+		},
+		{
+			Name: "panic",
+			CreateUpgradeHandler: func(app *SimApp) upgradetypes.UpgradeHandler {
+				return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+					panic("whoops")
+				}
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		height int64
+		name   string
+		valid  bool
+	}{
+		"latest height": {
+			name:  "bump",
+			valid: true,
+		},
+		"past height": {
+			height: 2,
+			name:   "bump",
+			valid:  true,
+		},
+		"future height": {
+			height: 4,
+			name:   "bump",
+		},
+		"not registered": {
+			name: "nonexistent",
+		},
+		"handler panicked": {
+			name: "panic",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			simApp := NewSimApp(log.NewNopLogger(), copyDB(t, db), nil, false, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+
+			simulation, err := simApp.SimulateUpgrade(tc.height, tc.name)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			expectedHeight := tc.height
+			if expectedHeight == 0 {
+				expectedHeight = app.LastBlockHeight()
+			}
+			require.Equal(t, expectedHeight+1, simulation.Height)
+			require.NotEmpty(t, simulation.AppHash)
+			require.Empty(t, simulation.BrokenInvariants)
+			require.NotEmpty(t, simulation.Timings)
+
+			migrated := false
+			for _, change := range simulation.ModuleVersions {
+				if change.From != change.To {
+					require.Equal(t, "crisis", change.Module)
+					require.Equal(t, change.From+1, change.To)
+					migrated = true
+				}
+			}
+			require.True(t, migrated)
+		})
+	}
+
+	// the original db is intact
+	require.Equal(t, int64(3), app.LastBlockHeight())
+}
+
+func TestCollectionStateFixesUpgrade(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+
+	genesisState := NewDefaultGenesisState(encCfg.Marshaler)
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:    []abci.ValidatorUpdate{},
+		AppStateBytes: stateBytes,
+	})
+	app.Commit()
+
+	// x/collection of the version before the fixes
+	header := tmproto.Header{Height: 2}
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: header})
+	app.UpgradeKeeper.SetModuleVersionMap(app.NewContext(false, header), module.VersionMap{collection.ModuleName: 1})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	simApp := NewSimApp(log.NewNopLogger(), copyDB(t, db), nil, false, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+	simulation, err := simApp.SimulateUpgrade(0, CollectionStateFixesUpgradeName)
+	require.NoError(t, err)
+	require.Empty(t, simulation.BrokenInvariants)
+
+	for _, change := range simulation.ModuleVersions {
+		if change.Module == collection.ModuleName {
+			require.Equal(t, uint64(1), change.From)
+			require.Equal(t, uint64(2), change.To)
+		} else {
+			require.Equal(t, change.From, change.To)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package cache
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	accessLogWriteInterval = 1 * time.Minute
+
+	// accessLogHitSampling is the interval of the cache hits recorded in an
+	// access log, so that most of the hits are not slowed down by it. The
+	// frequently accessed keys are still recorded, and the misses are always
+	// recorded.
+	accessLogHitSampling = 16
+)
+
+// accessLog records the recently accessed keys of a store in a ring buffer. It
+// is written without locks, so a concurrent read may see a slot being
+// overwritten, which is fine as the keys are only hints.
+type accessLog struct {
+	slots []atomic.Value // []byte
+	next  uint64
+	hits  uint64
+}
+
+func newAccessLog(size int) *accessLog {
+	return &accessLog{
+		slots: make([]atomic.Value, size),
+	}
+}
+
+// add records the key, which must not be modified afterwards.
+func (l *accessLog) add(key []byte) {
+	i := atomic.AddUint64(&l.next, 1) - 1
+	l.slots[i%uint64(len(l.slots))].Store(key)
+}
+
+// hit records the key of a cache hit, sampled by accessLogHitSampling. The key
+// must not be modified afterwards.
+func (l *accessLog) hit(key []byte) {
+	if atomic.AddUint64(&l.hits, 1)%accessLogHitSampling == 0 {
+		l.add(key)
+	}
+}
+
+// recentKeys returns the distinct keys from the least recently accessed one.
+func (l *accessLog) recentKeys() [][]byte {
+	next := atomic.LoadUint64(&l.next)
+	size := uint64(len(l.slots))
+	if next < size {
+		size = next
+	}
+
+	seen := make(map[string]bool, size)
+	keys := make([][]byte, 0, size)
+	// walk from the most recent one to keep the last access of each key
+	for i := uint64(1); i <= size; i++ {
+		key, ok := l.slots[(next-i)%uint64(len(l.slots))].Load().([]byte)
+		if !ok || seen[string(key)] {
+			continue
+		}
+		seen[string(key)] = true
+		keys = append(keys, key)
+	}
+
+	for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+		keys[i], keys[j] = keys[j], keys[i]
+	}
+	return keys
+}
+
+// WriteAccessLogs writes the recently accessed keys of the stores into their
+// access logs, which are used to warm the caches up at the next startup.
+func (cmgr *CommitKVStoreCacheManager) WriteAccessLogs() error {
+	cmgr.mutex.Lock()
+	dir := cmgr.accessLogDir
+	accessLogs := make(map[string]*accessLog, len(cmgr.caches))
+	for name, ckv := range cmgr.caches {
+		if accessLog := ckv.(*CommitKVStoreCache).accessLog; accessLog != nil {
+			accessLogs[name] = accessLog
+		}
+	}
+	cmgr.mutex.Unlock()
+
+	if dir == "" {
+		return nil
+	}
+
+	// the files are written without holding the lock of the caches, but one
+	// writer at a time
+	cmgr.accessLogWriteMtx.Lock()
+	defer cmgr.accessLogWriteMtx.Unlock()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for name, accessLog := range accessLogs {
+		if err := writeAccessLog(dir, name, accessLog.recentKeys()); err != nil {
+			return fmt.Errorf("failed to write the access log of %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Close stops writing the access logs periodically, and writes them for the
+// last time.
+func (cmgr *CommitKVStoreCacheManager) Close() error {
+	cmgr.mutex.Lock()
+	stop := cmgr.stopAccessLogWriter
+	cmgr.stopAccessLogWriter = nil
+	cmgr.mutex.Unlock()
+
+	if stop == nil {
+		return nil
+	}
+	stop()
+
+	return cmgr.WriteAccessLogs()
+}
+
+// startAccessLogWriter writes the access logs periodically until the returned
+// function is called, which waits for the writer to stop.
+func startAccessLogWriter(cmgr *CommitKVStoreCacheManager) (stop func()) {
+	quit := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(accessLogWriteInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				// the access logs are only hints to warm the caches up, so the error is ignored
+				_ = cmgr.WriteAccessLogs()
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(quit)
+		<-done
+	}
+}
+
+func accessLogPath(dir, name string) string {
+	return filepath.Join(dir, name+".log")
+}
+
+// writeAccessLog writes the keys in hex, one per line, replacing the access
+// log of the store atomically.
+func writeAccessLog(dir, name string, keys [][]byte) error {
+	path := accessLogPath(dir, name)
+	file, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	for _, key := range keys {
+		if _, err := fmt.Fprintln(w, hex.EncodeToString(key)); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// readAccessLog returns the keys in the access log of the store, or nil if the
+// access log doesn't exist or is corrupted.
+func readAccessLog(dir, name string) [][]byte {
+	file, err := os.Open(accessLogPath(dir, name))
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var keys [][]byte
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		key, err := hex.DecodeString(scanner.Text())
+		if err != nil || len(key) == 0 {
+			return nil
+		}
+		keys = append(keys, key)
+	}
+	if scanner.Err() != nil {
+		return nil
+	}
+
+	return keys
+}
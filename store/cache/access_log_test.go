@@ -0,0 +1,31 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessLog(t *testing.T) {
+	l := newAccessLog(3)
+	require.Empty(t, l.recentKeys())
+
+	for _, key := range []string{"a", "b", "a", "c", "d"} {
+		l.add([]byte(key))
+	}
+	// the least recent access of a is overwritten
+	require.Equal(t, [][]byte{[]byte("a"), []byte("c"), []byte("d")}, l.recentKeys())
+
+	l.add([]byte("c"))
+	require.Equal(t, [][]byte{[]byte("d"), []byte("c")}, l.recentKeys())
+}
+
+func TestAccessLogHitSampling(t *testing.T) {
+	l := newAccessLog(accessLogHitSampling)
+
+	for i := 0; i < accessLogHitSampling; i++ {
+		l.hit([]byte(fmt.Sprintf("key_%d", i)))
+	}
+	require.Equal(t, [][]byte{[]byte(fmt.Sprintf("key_%d", accessLogHitSampling-1))}, l.recentKeys())
+}
@@ -1,7 +1,9 @@
 package cache
 
 import (
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/VictoriaMetrics/fastcache"
@@ -31,6 +33,15 @@ type (
 		cache   *fastcache.Cache
 		prefix  []byte
 		metrics *Metrics
+
+		// statistics of the store, updated atomically
+		hits    uint64
+		misses  uint64
+		writes  uint64
+		deletes uint64
+
+		// accessLog records the recently accessed keys, if not nil
+		accessLog *accessLog
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -47,6 +58,18 @@ type (
 		// Contract: The number of all cache stores cannot exceed 127(max byte)
 		prefixMap   map[string][]byte
 		prefixOrder byte
+
+		// storeCacheSizes is the sizes of the caches dedicated to the stores,
+		// which are not on the shared cache
+		storeCacheSizes map[string]int
+		storeCaches     map[string]*fastcache.Cache
+
+		// accessLogDir is the directory of the access logs of the stores, which
+		// are used to warm the caches up, if not empty
+		accessLogDir        string
+		accessLogKeys       int
+		accessLogWriteMtx   sync.Mutex
+		stopAccessLogWriter func()
 	}
 )
 
@@ -66,16 +89,46 @@ func NewCommitKVStoreCacheManager(cacheSize int, provider MetricsProvider) *Comm
 		cacheSize = DefaultCommitKVStoreCacheSize
 	}
 	cm := &CommitKVStoreCacheManager{
-		cache:       fastcache.New(cacheSize),
-		caches:      make(map[string]types.CommitKVStore),
-		metrics:     provider(),
-		prefixMap:   make(map[string][]byte),
-		prefixOrder: 0,
+		cache:           fastcache.New(cacheSize),
+		caches:          make(map[string]types.CommitKVStore),
+		metrics:         provider(),
+		prefixMap:       make(map[string][]byte),
+		prefixOrder:     0,
+		storeCacheSizes: make(map[string]int),
+		storeCaches:     make(map[string]*fastcache.Cache),
 	}
 	startCacheMetricUpdator(cm.cache, cm.metrics)
 	return cm
 }
 
+// SetStoreCacheSize sets the maximum bytes size of the cache dedicated to the
+// store of the given name, instead of the cache shared by the other stores. It
+// must be called before the store cache is created.
+func (cmgr *CommitKVStoreCacheManager) SetStoreCacheSize(name string, cacheSize int) {
+	cmgr.mutex.Lock()
+	defer cmgr.mutex.Unlock()
+
+	cmgr.storeCacheSizes[name] = cacheSize
+}
+
+// SetAccessLog enables the access logs of the stores in the directory, which
+// record up to the given number of the recently accessed keys of each store.
+// The access log of a store is used to warm its cache up when the store cache
+// is created, so it must be called before the store caches are created.
+func (cmgr *CommitKVStoreCacheManager) SetAccessLog(dir string, keys int) {
+	cmgr.mutex.Lock()
+	defer cmgr.mutex.Unlock()
+
+	if keys <= 0 {
+		return
+	}
+	cmgr.accessLogDir = dir
+	cmgr.accessLogKeys = keys
+	if cmgr.stopAccessLogWriter == nil {
+		cmgr.stopAccessLogWriter = startAccessLogWriter(cmgr)
+	}
+}
+
 func startCacheMetricUpdator(cache *fastcache.Cache, metrics *Metrics) {
 	// Execution time of `fastcache.UpdateStats()` can increase linearly as cache entries grows
 	// So we update the metrics with a separate go route.
@@ -103,7 +156,7 @@ func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store t
 			if cmgr.prefixOrder <= 0 {
 				panic("The number of cache stores exceed the maximum(127)")
 			}
-			cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.prefixMap[key.Name()], cmgr.cache, cmgr.metrics)
+			cmgr.caches[key.Name()] = cmgr.newStoreCache(key.Name(), store)
 		}
 		cmgr.mutex.Unlock()
 	}
@@ -111,6 +164,83 @@ func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store t
 	return cmgr.caches[key.Name()]
 }
 
+// newStoreCache creates the cache of the store on its dedicated cache if it has
+// a cache size, or on the shared cache otherwise. The caller must hold the lock.
+func (cmgr *CommitKVStoreCacheManager) newStoreCache(name string, store types.CommitKVStore) *CommitKVStoreCache {
+	cache := cmgr.cache
+	if cacheSize, ok := cmgr.storeCacheSizes[name]; ok {
+		if cmgr.storeCaches[name] == nil {
+			cmgr.storeCaches[name] = fastcache.New(cacheSize)
+		}
+		cache = cmgr.storeCaches[name]
+	}
+
+	ckv := NewCommitKVStoreCache(store, cmgr.prefixMap[name], cache, cmgr.metrics)
+	if cmgr.accessLogDir != "" {
+		ckv.accessLog = newAccessLog(cmgr.accessLogKeys)
+		ckv.warmUp(readAccessLog(cmgr.accessLogDir, name))
+	}
+
+	return ckv
+}
+
+// Stats returns the statistics of the caches of the stores.
+func (cmgr *CommitKVStoreCacheManager) Stats() types.InterBlockCacheStats {
+	cmgr.mutex.Lock()
+	defer cmgr.mutex.Unlock()
+
+	names := make([]string, 0, len(cmgr.caches))
+	for name := range cmgr.caches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var stats types.InterBlockCacheStats
+	var sharedWrites, sharedDeletes uint64
+	for _, name := range names {
+		ckv := cmgr.caches[name].(*CommitKVStoreCache)
+		storeStats := types.StoreCacheStats{
+			StoreKey: name,
+			Hits:     atomic.LoadUint64(&ckv.hits),
+			Misses:   atomic.LoadUint64(&ckv.misses),
+		}
+
+		writes, deletes := atomic.LoadUint64(&ckv.writes), atomic.LoadUint64(&ckv.deletes)
+		if cache, ok := cmgr.storeCaches[name]; ok {
+			storeStats.Dedicated = true
+			storeStats.Cache = cacheStats(cache, writes, deletes)
+		} else {
+			sharedWrites += writes
+			sharedDeletes += deletes
+		}
+
+		stats.Stores = append(stats.Stores, storeStats)
+	}
+	stats.Shared = cacheStats(cmgr.cache, sharedWrites, sharedDeletes)
+
+	return stats
+}
+
+// cacheStats returns the statistics of the cache, where the evictions are
+// estimated by the writes and deletes so far. The writes and deletes are
+// counted without probing the cache, so the overwrites of the cached entries
+// are counted as evictions, and the deletes of the uncached ones offset them.
+func cacheStats(cache *fastcache.Cache, writes, deletes uint64) types.CacheStats {
+	var fcStats fastcache.Stats
+	cache.UpdateStats(&fcStats)
+
+	stats := types.CacheStats{
+		Entries:      fcStats.EntriesCount,
+		BytesSize:    fcStats.BytesSize,
+		MaxBytesSize: fcStats.MaxBytesSize,
+	}
+	if live := deletes + stats.Entries; writes > live {
+		stats.Evictions = writes - live
+	}
+
+	return stats
+}
+
 // Unwrap returns the underlying CommitKVStore for a given StoreKey.
 func (cmgr *CommitKVStoreCacheManager) Unwrap(key types.StoreKey) types.CommitKVStore {
 	if ckv, ok := cmgr.caches[key.Name()]; ok {
@@ -140,37 +270,76 @@ func (ckv *CommitKVStoreCache) CacheWrap() types.CacheWrap {
 // to the underlying CommitKVStore.
 func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	types.AssertValidKey(key)
-	prefixedKey := append(ckv.prefix, key...)
+	prefixedKey := ckv.prefixed(key)
 
 	valueI := ckv.cache.Get(nil, prefixedKey)
 	if valueI != nil {
 		// cache hit
 		ckv.metrics.InterBlockCacheHits.Add(1)
+		atomic.AddUint64(&ckv.hits, 1)
+		if ckv.accessLog != nil {
+			// the prefixed key is not used anymore, so it is shared
+			ckv.accessLog.hit(prefixedKey[len(ckv.prefix):])
+		}
 		return valueI
 	}
 
 	// cache miss; write to cache
 	ckv.metrics.InterBlockCacheMisses.Add(1)
+	atomic.AddUint64(&ckv.misses, 1)
 	value := ckv.CommitKVStore.Get(key)
-	ckv.cache.Set(prefixedKey, value)
+	ckv.set(prefixedKey, value)
+	if ckv.accessLog != nil {
+		ckv.accessLog.add(prefixedKey[len(ckv.prefix):])
+	}
 	return value
 }
 
-// Set inserts a key/value pair into both the write-through cache and the
+// Set writes a key/value pair into both the write-through cache and the
 // underlying CommitKVStore.
 func (ckv *CommitKVStoreCache) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
 
-	prefixedKey := append(ckv.prefix, key...)
-	ckv.cache.Set(prefixedKey, value)
+	prefixedKey := ckv.prefixed(key)
+	ckv.set(prefixedKey, value)
 	ckv.CommitKVStore.Set(key, value)
 }
 
 // Delete removes a key/value pair from both the write-through cache and the
 // underlying CommitKVStore.
 func (ckv *CommitKVStoreCache) Delete(key []byte) {
-	prefixedKey := append(ckv.prefix, key...)
+	prefixedKey := ckv.prefixed(key)
+	atomic.AddUint64(&ckv.deletes, 1)
 	ckv.cache.Del(prefixedKey)
 	ckv.CommitKVStore.Delete(key)
 }
+
+// prefixed returns the key with the prefix of the store, in a new slice.
+func (ckv *CommitKVStoreCache) prefixed(key []byte) []byte {
+	prefixedKey := make([]byte, len(ckv.prefix)+len(key))
+	copy(prefixedKey, ckv.prefix)
+	copy(prefixedKey[len(ckv.prefix):], key)
+	return prefixedKey
+}
+
+// set writes the prefixed key to the cache, counting the writes.
+func (ckv *CommitKVStoreCache) set(prefixedKey, value []byte) {
+	atomic.AddUint64(&ckv.writes, 1)
+	ckv.cache.Set(prefixedKey, value)
+}
+
+// warmUp loads the values of the keys from the underlying CommitKVStore into
+// the cache.
+func (ckv *CommitKVStoreCache) warmUp(keys [][]byte) {
+	for _, key := range keys {
+		value := ckv.CommitKVStore.Get(key)
+		if value == nil {
+			continue
+		}
+		ckv.set(ckv.prefixed(key), value)
+		if ckv.accessLog != nil {
+			ckv.accessLog.add(key)
+		}
+	}
+}
@@ -68,3 +68,83 @@ func TestStoreCache(t *testing.T) {
 		require.Nil(t, store.Get(key))
 	}
 }
+
+func TestStoreCacheStats(t *testing.T) {
+	db := dbm.NewMemDB()
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, cache.NopMetricsProvider())
+	mngr.SetStoreCacheSize("dedicated", 1)
+
+	newStore := func(name string) types.CommitKVStore {
+		tree, err := iavl.NewMutableTree(dbm.NewPrefixDB(db, []byte(name)), 100, false)
+		require.NoError(t, err)
+		return mngr.GetStoreCache(types.NewKVStoreKey(name), iavlstore.UnsafeNewStore(tree))
+	}
+	shared := newStore("shared")
+	dedicated := newStore("dedicated")
+
+	key := []byte("key")
+	shared.Set(key, []byte("value"))
+	require.Equal(t, []byte("value"), shared.Get(key))
+	shared.Delete(key)
+	require.Nil(t, shared.Get(key))
+
+	// fill the dedicated cache of the minimum size to evict the entries
+	value := make([]byte, 1024)
+	for i := 0; i < 100000; i++ {
+		dedicated.Set([]byte(fmt.Sprintf("key_%d", i)), value)
+	}
+	for i := 0; i < 10; i++ {
+		dedicated.Get([]byte(fmt.Sprintf("key_%d", i)))
+	}
+
+	stats := mngr.Stats()
+	require.Len(t, stats.Stores, 2)
+
+	require.Equal(t, "dedicated", stats.Stores[0].StoreKey)
+	require.True(t, stats.Stores[0].Dedicated)
+	require.EqualValues(t, 10, stats.Stores[0].Hits+stats.Stores[0].Misses)
+	require.NotZero(t, stats.Stores[0].Misses)
+	require.NotZero(t, stats.Stores[0].Cache.Evictions)
+	require.NotZero(t, stats.Stores[0].Cache.Entries)
+
+	require.Equal(t, types.StoreCacheStats{StoreKey: "shared", Hits: 1, Misses: 1}, stats.Stores[1])
+	require.EqualValues(t, 1, stats.Shared.Entries)
+	require.Zero(t, stats.Shared.Evictions)
+	require.GreaterOrEqual(t, stats.Shared.MaxBytesSize, uint64(cache.DefaultCommitKVStoreCacheSize))
+}
+
+func TestStoreCacheWarmUp(t *testing.T) {
+	db := dbm.NewMemDB()
+	dir := t.TempDir()
+	sKey := types.NewKVStoreKey("test")
+	tree, err := iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, cache.NopMetricsProvider())
+	mngr.SetAccessLog(dir, 2)
+	kvStore := mngr.GetStoreCache(sKey, store)
+
+	// the keys are set bypassing the cache, so that every read is a miss
+	for i := 0; i < 3; i++ {
+		store.Set([]byte(fmt.Sprintf("key_%d", i)), []byte(fmt.Sprintf("value_%d", i)))
+	}
+	// only the recent 2 keys are logged
+	for _, i := range []int{0, 1, 2, 2} {
+		kvStore.Get([]byte(fmt.Sprintf("key_%d", i)))
+	}
+	// the access logs are written on close
+	require.NoError(t, mngr.Close())
+
+	// a new cache manager warms the cache up by the access log
+	mngr = cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, cache.NopMetricsProvider())
+	mngr.SetAccessLog(dir, 2)
+	kvStore = mngr.GetStoreCache(sKey, store)
+
+	require.Equal(t, []byte("value_2"), kvStore.Get([]byte("key_2")))
+	require.Equal(t, []byte("value_0"), kvStore.Get([]byte("key_0")))
+
+	stats := mngr.Stats()
+	require.EqualValues(t, 1, stats.Stores[0].Hits)
+	require.EqualValues(t, 1, stats.Stores[0].Misses)
+}
@@ -0,0 +1,133 @@
+package iavl
+
+import (
+	"bytes"
+
+	"github.com/cosmos/iavl"
+
+	"github.com/line/lbm-sdk/store/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	// DefaultStateDiffLimit is the maximum number of changes returned by a
+	// state diff if the request doesn't specify one.
+	DefaultStateDiffLimit = 1000
+
+	// MaxStateDiffLimit is the maximum number of changes returned by a state
+	// diff, whatever the limit of the request.
+	MaxStateDiffLimit = 10000
+
+	// MaxStateDiffScan is the maximum number of keys a state diff walks in
+	// each version, changed or not.
+	MaxStateDiffScan = 100000
+)
+
+// Diff returns the changes of the keys within the requested range between two
+// committed versions of the store, in the order of the keys. Both versions
+// are walked in lockstep, so the cost is proportional to the number of keys
+// in the range. If there are more changes than the limit, or more keys than
+// MaxStateDiffScan in the range, the diff is truncated and NextKey is set to
+// resume from.
+func (st *Store) Diff(req types.StateDiffRequest) (*types.StateDiff, error) {
+	return st.diff(req, MaxStateDiffScan)
+}
+
+func (st *Store) diff(req types.StateDiffRequest, maxScan uint64) (*types.StateDiff, error) {
+	if req.FromVersion <= 0 || req.FromVersion >= req.ToVersion {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid versions: from %d to %d", req.FromVersion, req.ToVersion)
+	}
+	if len(req.End) != 0 && bytes.Compare(req.Start, req.End) >= 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "start of the key range must be less than the end")
+	}
+
+	fromIter, err := st.versionIterator(req.FromVersion, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	defer fromIter.Close()
+
+	toIter, err := st.versionIterator(req.ToVersion, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	defer toIter.Close()
+
+	limit := req.Limit
+	switch {
+	case limit == 0:
+		limit = DefaultStateDiffLimit
+	case limit > MaxStateDiffLimit:
+		limit = MaxStateDiffLimit
+	}
+
+	diff := &types.StateDiff{
+		FromVersion: req.FromVersion,
+		ToVersion:   req.ToVersion,
+	}
+	var count, scanned uint64
+	for fromIter.Valid() || toIter.Valid() {
+		var cmp int
+		switch {
+		case !toIter.Valid():
+			cmp = -1
+		case !fromIter.Valid():
+			cmp = 1
+		default:
+			cmp = bytes.Compare(fromIter.Key(), toIter.Key())
+		}
+
+		key := toIter.Key()
+		if cmp < 0 {
+			key = fromIter.Key()
+		}
+		if scanned == maxScan {
+			diff.NextKey = key
+			break
+		}
+		scanned++
+
+		// skip the unchanged key
+		if cmp == 0 && bytes.Equal(fromIter.Value(), toIter.Value()) {
+			fromIter.Next()
+			toIter.Next()
+			continue
+		}
+
+		if count == limit {
+			diff.NextKey = key
+			break
+		}
+		count++
+
+		switch {
+		case cmp < 0:
+			diff.Deleted = append(diff.Deleted, types.StateChange{Key: key, OldValue: fromIter.Value()})
+			fromIter.Next()
+		case cmp > 0:
+			diff.Added = append(diff.Added, types.StateChange{Key: key, NewValue: toIter.Value()})
+			toIter.Next()
+		default:
+			diff.Updated = append(diff.Updated, types.StateChange{Key: key, OldValue: fromIter.Value(), NewValue: toIter.Value()})
+			fromIter.Next()
+			toIter.Next()
+		}
+	}
+
+	return diff, nil
+}
+
+// versionIterator returns an iterator over the key range of the committed
+// version of the store.
+func (st *Store) versionIterator(version int64, start, end []byte) (types.Iterator, error) {
+	if !st.VersionExists(version) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "version %d: %s", version, iavl.ErrVersionDoesNotExist)
+	}
+
+	tree, err := st.tree.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.Iterator(start, end, true)
+}
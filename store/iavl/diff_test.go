@@ -0,0 +1,142 @@
+package iavl
+
+import (
+	"testing"
+
+	"github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/types"
+)
+
+func TestIAVLDiff(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
+	require.NoError(t, err)
+	iavlStore := UnsafeNewStore(tree)
+
+	// an empty version
+	iavlStore.Commit()
+
+	iavlStore.Set([]byte("a"), []byte("1"))
+	iavlStore.Set([]byte("b"), []byte("2"))
+	iavlStore.Set([]byte("c"), []byte("3"))
+	iavlStore.Set([]byte("d"), []byte("4"))
+	iavlStore.Commit()
+
+	iavlStore.Delete([]byte("a"))
+	iavlStore.Set([]byte("b"), []byte("20"))
+	iavlStore.Set([]byte("c"), []byte("3"))
+	iavlStore.Set([]byte("e"), []byte("5"))
+	iavlStore.Commit()
+
+	testCases := map[string]struct {
+		req      types.StateDiffRequest
+		valid    bool
+		expected types.StateDiff
+	}{
+		"whole range": {
+			req:   types.StateDiffRequest{FromVersion: 2, ToVersion: 3},
+			valid: true,
+			expected: types.StateDiff{
+				FromVersion: 2,
+				ToVersion:   3,
+				Added:       []types.StateChange{{Key: []byte("e"), NewValue: []byte("5")}},
+				Updated:     []types.StateChange{{Key: []byte("b"), OldValue: []byte("2"), NewValue: []byte("20")}},
+				Deleted:     []types.StateChange{{Key: []byte("a"), OldValue: []byte("1")}},
+			},
+		},
+		"from an empty version": {
+			req:   types.StateDiffRequest{FromVersion: 1, ToVersion: 2, Start: []byte("c")},
+			valid: true,
+			expected: types.StateDiff{
+				FromVersion: 1,
+				ToVersion:   2,
+				Added: []types.StateChange{
+					{Key: []byte("c"), NewValue: []byte("3")},
+					{Key: []byte("d"), NewValue: []byte("4")},
+				},
+			},
+		},
+		"key range": {
+			req:   types.StateDiffRequest{FromVersion: 2, ToVersion: 3, Start: []byte("b"), End: []byte("e")},
+			valid: true,
+			expected: types.StateDiff{
+				FromVersion: 2,
+				ToVersion:   3,
+				Updated:     []types.StateChange{{Key: []byte("b"), OldValue: []byte("2"), NewValue: []byte("20")}},
+			},
+		},
+		"limit": {
+			req:   types.StateDiffRequest{FromVersion: 2, ToVersion: 3, Limit: 2},
+			valid: true,
+			expected: types.StateDiff{
+				FromVersion: 2,
+				ToVersion:   3,
+				Updated:     []types.StateChange{{Key: []byte("b"), OldValue: []byte("2"), NewValue: []byte("20")}},
+				Deleted:     []types.StateChange{{Key: []byte("a"), OldValue: []byte("1")}},
+				NextKey:     []byte("e"),
+			},
+		},
+		"no changes": {
+			req:   types.StateDiffRequest{FromVersion: 2, ToVersion: 3, Start: []byte("c"), End: []byte("e")},
+			valid: true,
+			expected: types.StateDiff{
+				FromVersion: 2,
+				ToVersion:   3,
+			},
+		},
+		"reversed versions": {
+			req: types.StateDiffRequest{FromVersion: 3, ToVersion: 2},
+		},
+		"version not exists": {
+			req: types.StateDiffRequest{FromVersion: 2, ToVersion: 4},
+		},
+		"invalid key range": {
+			req: types.StateDiffRequest{FromVersion: 2, ToVersion: 3, Start: []byte("c"), End: []byte("b")},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff, err := iavlStore.Diff(tc.req)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, *diff)
+		})
+	}
+}
+
+func TestIAVLDiffMaxScan(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
+	require.NoError(t, err)
+	iavlStore := UnsafeNewStore(tree)
+
+	iavlStore.Set([]byte("a"), []byte("1"))
+	iavlStore.Set([]byte("b"), []byte("2"))
+	iavlStore.Set([]byte("c"), []byte("3"))
+	iavlStore.Set([]byte("d"), []byte("4"))
+	iavlStore.Commit()
+
+	iavlStore.Set([]byte("d"), []byte("40"))
+	iavlStore.Commit()
+
+	// the unchanged keys count in the scan
+	req := types.StateDiffRequest{FromVersion: 1, ToVersion: 2}
+	diff, err := iavlStore.diff(req, 2)
+	require.NoError(t, err)
+	require.Empty(t, diff.Updated)
+	require.Equal(t, []byte("c"), diff.NextKey)
+
+	// resume from the next key
+	req.Start = diff.NextKey
+	diff, err = iavlStore.diff(req, 2)
+	require.NoError(t, err)
+	require.Equal(t, []types.StateChange{{Key: []byte("d"), OldValue: []byte("4"), NewValue: []byte("40")}}, diff.Updated)
+	require.Empty(t, diff.NextKey)
+}
@@ -1,9 +1,11 @@
 package iavl
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	ics23 "github.com/confio/ics23/go"
@@ -40,6 +42,11 @@ var (
 // Store Implements types.KVStore and CommitKVStore.
 type Store struct {
 	tree Tree
+
+	// changes records the changes since the last commit if the change sets are
+	// enabled, and changeSet holds the changes of the last commit.
+	changes   map[string][]byte
+	changeSet []kv.Pair
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
@@ -135,6 +142,17 @@ func (st *Store) Commit() types.CommitID {
 		panic(err)
 	}
 
+	if st.changes != nil {
+		st.changeSet = make([]kv.Pair, 0, len(st.changes))
+		for key, value := range st.changes {
+			st.changeSet = append(st.changeSet, kv.Pair{Key: []byte(key), Value: value})
+		}
+		sort.Slice(st.changeSet, func(i, j int) bool {
+			return bytes.Compare(st.changeSet[i].Key, st.changeSet[j].Key) < 0
+		})
+		st.changes = make(map[string][]byte)
+	}
+
 	return types.CommitID{
 		Version: version,
 		Hash:    hash,
@@ -201,6 +219,10 @@ func (st *Store) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
 	st.tree.Set(key, value)
+
+	if st.changes != nil {
+		st.changes[string(key)] = value
+	}
 }
 
 // Implements types.KVStore.
@@ -227,6 +249,29 @@ func (st *Store) Has(key []byte) (exists bool) {
 func (st *Store) Delete(key []byte) {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "delete")
 	st.tree.Remove(key)
+
+	if st.changes != nil {
+		st.changes[string(key)] = nil
+	}
+}
+
+// EnableChangeSets makes the store record the changes made to it, so that the
+// changes of each committed version can be taken by PopChangeSet. Only the
+// changes made after the call are recorded.
+func (st *Store) EnableChangeSets() {
+	if st.changes == nil {
+		st.changes = make(map[string][]byte)
+	}
+}
+
+// PopChangeSet returns the changes of the last committed version, sorted by
+// the keys, and clears them. The deleted keys have nil values. It returns
+// false if the changes of the version have not been recorded.
+func (st *Store) PopChangeSet() ([]kv.Pair, bool) {
+	changeSet := st.changeSet
+	st.changeSet = nil
+
+	return changeSet, changeSet != nil
 }
 
 // DeleteVersions deletes a series of versions from the MutableTree. An error
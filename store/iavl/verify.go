@@ -0,0 +1,263 @@
+package iavl
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	nodeKeyPrefix = 'n' // n<hash> -> node, see the node db of iavl
+	rootKeyPrefix = 'r' // r<version> -> root hash
+)
+
+// TreeMismatch describes the first node of an IAVL tree, in the order of the
+// keys, whose content doesn't result in the hash it is referenced by.
+type TreeMismatch struct {
+	// Start and End are the key range [Start, End) of the subtree of the node,
+	// where nil is unbounded.
+	Start  []byte
+	End    []byte
+	Reason string
+}
+
+func (m TreeMismatch) String() string {
+	return fmt.Sprintf("%s in key range [%X, %X)", m.Reason, m.Start, m.End)
+}
+
+// VerifyTree recomputes the hash of the IAVL tree of the version in the node
+// db from the contents of its nodes, checking each node against the hash it
+// is referenced by. It returns the recomputed root hash and the first
+// mismatching node, if any. Up to the given number of the subtrees are
+// verified in parallel.
+func VerifyTree(db dbm.DB, version int64, parallelism int) ([]byte, *TreeMismatch, error) {
+	rootKey := make([]byte, 9)
+	rootKey[0] = rootKeyPrefix
+	binary.BigEndian.PutUint64(rootKey[1:], uint64(version))
+	rootHash, err := db.Get(rootKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rootHash == nil {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "version %d does not exist", version)
+	}
+	// an empty tree has the hash of the empty input
+	if len(rootHash) == 0 {
+		return sha256.New().Sum(nil), nil, nil
+	}
+
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	v := treeVerifier{
+		db:  db,
+		sem: make(chan struct{}, parallelism-1),
+	}
+	root, mismatch, err := v.verify(rootHash, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return root.hash, mismatch, nil
+}
+
+type verifiedNode struct {
+	hash   []byte
+	size   int64
+	height int8
+}
+
+type treeVerifier struct {
+	db  dbm.DB
+	sem chan struct{}
+}
+
+// verify verifies the subtree of the node referenced by the hash, whose keys
+// must be within [lower, upper).
+func (v treeVerifier) verify(hash, lower, upper []byte) (verifiedNode, *TreeMismatch, error) {
+	bz, err := v.db.Get(append([]byte{nodeKeyPrefix}, hash...))
+	if err != nil {
+		return verifiedNode{}, nil, err
+	}
+	if bz == nil {
+		return verifiedNode{}, &TreeMismatch{Start: lower, End: upper, Reason: fmt.Sprintf("missing node %X", hash)}, nil
+	}
+
+	node, err := decodeNode(bz)
+	if err != nil {
+		return verifiedNode{}, &TreeMismatch{Start: lower, End: upper, Reason: fmt.Sprintf("invalid node %X: %s", hash, err)}, nil
+	}
+
+	if node.height == 0 {
+		start, end := node.key, append(append([]byte{}, node.key...), 0x00)
+		if (lower != nil && bytes.Compare(node.key, lower) < 0) || (upper != nil && bytes.Compare(node.key, upper) >= 0) {
+			return verifiedNode{}, &TreeMismatch{Start: start, End: end, Reason: "key out of order"}, nil
+		}
+
+		leaf := verifiedNode{hash: node.hash(), size: 1}
+		if !bytes.Equal(leaf.hash, hash) {
+			return leaf, &TreeMismatch{Start: start, End: end, Reason: fmt.Sprintf("leaf hash mismatch: expected %X, got %X", hash, leaf.hash)}, nil
+		}
+		return leaf, nil, nil
+	}
+
+	// the key of an inner node is the first key of its right subtree
+	var left, right verifiedNode
+	var leftMismatch, rightMismatch *TreeMismatch
+	var leftErr, rightErr error
+	var wg sync.WaitGroup
+	select {
+	case v.sem <- struct{}{}:
+		wg.Add(1)
+		go func() {
+			defer func() { <-v.sem }()
+			defer wg.Done()
+			left, leftMismatch, leftErr = v.verify(node.leftHash, lower, node.key)
+		}()
+	default:
+		left, leftMismatch, leftErr = v.verify(node.leftHash, lower, node.key)
+	}
+	right, rightMismatch, rightErr = v.verify(node.rightHash, node.key, upper)
+	wg.Wait()
+
+	if leftErr != nil {
+		return verifiedNode{}, nil, leftErr
+	}
+	if rightErr != nil {
+		return verifiedNode{}, nil, rightErr
+	}
+	if leftMismatch != nil {
+		return verifiedNode{}, leftMismatch, nil
+	}
+	if rightMismatch != nil {
+		return verifiedNode{}, rightMismatch, nil
+	}
+
+	inner := verifiedNode{hash: node.hash(), size: left.size + right.size, height: maxInt8(left.height, right.height) + 1}
+	switch {
+	case node.size != inner.size:
+		return inner, &TreeMismatch{Start: lower, End: upper, Reason: fmt.Sprintf("size mismatch: expected %d, got %d", node.size, inner.size)}, nil
+	case node.height != inner.height:
+		return inner, &TreeMismatch{Start: lower, End: upper, Reason: fmt.Sprintf("height mismatch: expected %d, got %d", node.height, inner.height)}, nil
+	case !bytes.Equal(inner.hash, hash):
+		return inner, &TreeMismatch{Start: lower, End: upper, Reason: fmt.Sprintf("inner hash mismatch: expected %X, got %X", hash, inner.hash)}, nil
+	}
+
+	return inner, nil, nil
+}
+
+// storedNode is a node decoded from the node db, in the same encoding as the
+// nodes of iavl.
+type storedNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+func decodeNode(bz []byte) (*storedNode, error) {
+	r := nodeReader{buf: bz}
+	height := r.varint()
+	node := &storedNode{
+		height:  int8(height),
+		size:    r.varint(),
+		version: r.varint(),
+		key:     r.bytes(),
+	}
+	if height != int64(node.height) {
+		return nil, errors.New("invalid height")
+	}
+
+	if node.height == 0 {
+		node.value = r.bytes()
+	} else {
+		node.leftHash = r.bytes()
+		node.rightHash = r.bytes()
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return node, nil
+}
+
+// hash returns the hash of the node, which is computed the same way as iavl.
+func (node *storedNode) hash() []byte {
+	var buf bytes.Buffer
+	writeVarint(&buf, int64(node.height))
+	writeVarint(&buf, node.size)
+	writeVarint(&buf, node.version)
+	if node.height == 0 {
+		valueHash := sha256.Sum256(node.value)
+		writeBytes(&buf, node.key)
+		writeBytes(&buf, valueHash[:])
+	} else {
+		writeBytes(&buf, node.leftHash)
+		writeBytes(&buf, node.rightHash)
+	}
+
+	hash := sha256.Sum256(buf.Bytes())
+	return hash[:]
+}
+
+type nodeReader struct {
+	buf []byte
+	err error
+}
+
+func (r *nodeReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	i, n := binary.Varint(r.buf)
+	if n <= 0 {
+		r.err = errors.New("invalid varint")
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return i
+}
+
+func (r *nodeReader) bytes() []byte {
+	if r.err != nil {
+		return nil
+	}
+	size, n := binary.Uvarint(r.buf)
+	if n <= 0 || uint64(len(r.buf)-n) < size {
+		r.err = errors.New("invalid bytes")
+		return nil
+	}
+	bz := r.buf[n : n+int(size)]
+	r.buf = r.buf[n+int(size):]
+	return bz
+}
+
+func writeVarint(buf *bytes.Buffer, i int64) {
+	var bz [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(bz[:], i)
+	buf.Write(bz[:n])
+}
+
+func writeBytes(buf *bytes.Buffer, bz []byte) {
+	var size [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(size[:], uint64(len(bz)))
+	buf.Write(size[:n])
+	buf.Write(bz)
+}
+
+func maxInt8(a, b int8) int8 {
+	if a > b {
+		return a
+	}
+	return b
+}
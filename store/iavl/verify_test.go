@@ -0,0 +1,77 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestVerifyTree(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
+	require.NoError(t, err)
+	iavlStore := UnsafeNewStore(tree)
+
+	// an empty version
+	emptyID := iavlStore.Commit()
+
+	for i := 0; i < 100; i++ {
+		iavlStore.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	firstID := iavlStore.Commit()
+
+	for i := 0; i < 100; i += 3 {
+		iavlStore.Delete([]byte(fmt.Sprintf("key%03d", i)))
+	}
+	iavlStore.Set([]byte("key050"), []byte("updated"))
+	secondID := iavlStore.Commit()
+
+	for _, parallelism := range []int{1, 4} {
+		for _, id := range []struct {
+			version int64
+			hash    []byte
+		}{{emptyID.Version, emptyID.Hash}, {firstID.Version, firstID.Hash}, {secondID.Version, secondID.Hash}} {
+			hash, mismatch, err := VerifyTree(db, id.version, parallelism)
+			require.NoError(t, err)
+			require.Nil(t, mismatch)
+			require.Equal(t, id.hash, hash, "version %d", id.version)
+		}
+	}
+
+	_, _, err = VerifyTree(db, 4, 1)
+	require.Error(t, err)
+
+	// corrupt the value of a leaf
+	target := []byte("key050")
+	var nodeKey, bz []byte
+	iter, err := db.Iterator([]byte{nodeKeyPrefix}, []byte{nodeKeyPrefix + 1})
+	require.NoError(t, err)
+	for ; iter.Valid(); iter.Next() {
+		node, err := decodeNode(iter.Value())
+		require.NoError(t, err)
+		if node.height == 0 && bytes.Equal(node.key, target) && bytes.Equal(node.value, []byte("updated")) {
+			nodeKey = append([]byte{}, iter.Key()...)
+			bz = append([]byte{}, iter.Value()...)
+		}
+	}
+	require.NoError(t, iter.Close())
+	require.NotNil(t, nodeKey)
+	bz[len(bz)-1] = 'D'
+	require.NoError(t, db.Set(nodeKey, bz))
+
+	hash, mismatch, err := VerifyTree(db, secondID.Version, 4)
+	require.NoError(t, err)
+	require.NotNil(t, mismatch)
+	require.Equal(t, target, mismatch.Start)
+	require.Equal(t, append(target, 0x00), mismatch.End)
+	require.NotEqual(t, secondID.Hash, hash)
+
+	// the first version doesn't share the corrupted leaf
+	_, mismatch, err = VerifyTree(db, firstID.Version, 4)
+	require.NoError(t, err)
+	require.Nil(t, mismatch)
+}
@@ -0,0 +1,386 @@
+package rootmulti
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lbm-sdk/store/iavl"
+	"github.com/line/lbm-sdk/store/types"
+	"github.com/line/lbm-sdk/types/kv"
+)
+
+const (
+	archiveTreePrefixFmt   = "s/k:%s/" // s/k:<name>/, the IAVL tree of a store
+	archiveHeightPrefixFmt = "s/h:%s/" // s/h:<name>/<height> -> the version of the IAVL tree
+
+	// archiveQueueSize is the number of the committed heights which may wait
+	// to be archived, before the commits block on the archive.
+	archiveQueueSize = 100
+
+	// archiveRootKeyPrefix is the prefix of the keys IAVL saves the roots of
+	// the versions under, i.e. 'r' followed by the big endian version.
+	archiveRootKeyPrefix = 'r'
+)
+
+// pruningArchive keeps the states of the stores at their committed heights in
+// a secondary db, so that the heights can be pruned from the stores. The states
+// of a store are saved as the versions of a separate IAVL tree, so the nodes
+// unchanged between the heights are shared, and each archived height is mapped
+// to the version of the tree holding its state.
+//
+// The heights are archived in the background, in the order of their commits,
+// by applying the change sets of the store to the tree. If the change set of a
+// height is not available, e.g. right after a restart, the state of the height
+// is synced from the store by walking the whole tree instead.
+type pruningArchive struct {
+	db     dbm.DB
+	logger log.Logger
+	jobs   chan archiveJob
+
+	mtx     sync.Mutex
+	stores  map[string]*iavl.Store
+	treeDBs map[string]*archiveTreeDB
+}
+
+// archiveJob is a committed height of a store to be archived. A job with done
+// set is a marker closing it once the jobs before it have been processed.
+type archiveJob struct {
+	name      string
+	source    *iavl.Store
+	height    int64
+	cacheSize int
+
+	changeSet    []kv.Pair
+	hasChangeSet bool
+
+	done chan struct{}
+}
+
+func newPruningArchive(db dbm.DB, logger log.Logger) *pruningArchive {
+	a := &pruningArchive{
+		db:      db,
+		logger:  logger,
+		jobs:    make(chan archiveJob, archiveQueueSize),
+		stores:  make(map[string]*iavl.Store),
+		treeDBs: make(map[string]*archiveTreeDB),
+	}
+	go a.run()
+
+	return a
+}
+
+// enqueue queues the job to be processed in the background. It blocks if the
+// queue is full.
+func (a *pruningArchive) enqueue(job archiveJob) {
+	a.jobs <- job
+}
+
+// flush waits until the jobs queued so far have been processed.
+func (a *pruningArchive) flush() {
+	done := make(chan struct{})
+	a.enqueue(archiveJob{done: done})
+	<-done
+}
+
+func (a *pruningArchive) run() {
+	for job := range a.jobs {
+		if job.done != nil {
+			close(job.done)
+			continue
+		}
+
+		if err := a.archive(job); err != nil && a.logger != nil {
+			a.logger.Error("failed to archive the state", "store", job.name, "height", job.height, "err", err)
+		}
+	}
+}
+
+// archive writes the state of the source store at the height of the job into
+// the archive. The heights between the last archived height and the height of
+// the job, which the failed or lost jobs have not archived, are archived first.
+// The heights already archived or not existing in the source are skipped.
+func (a *pruningArchive) archive(job archiveJob) error {
+	archive, treeDB, err := a.getStore(job.name, job.cacheSize)
+	if err != nil {
+		return err
+	}
+
+	last, err := a.archivedHeight(job.name)
+	if err != nil || job.height <= last {
+		return err
+	}
+
+	// nothing before the first archived height is archived
+	if last > 0 {
+		for height := last + 1; height < job.height; height++ {
+			if !job.source.VersionExists(height) {
+				continue
+			}
+
+			if err := syncArchiveStore(archive, job.source, height); err != nil {
+				return err
+			}
+			if err := a.commit(job.name, archive, treeDB, height); err != nil {
+				return err
+			}
+			last = height
+		}
+	}
+
+	switch {
+	case job.hasChangeSet && last == job.height-1:
+		for _, pair := range job.changeSet {
+			if pair.Value == nil {
+				archive.Delete(pair.Key)
+			} else {
+				archive.Set(pair.Key, pair.Value)
+			}
+		}
+	case job.source.VersionExists(job.height):
+		if err := syncArchiveStore(archive, job.source, job.height); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	return a.commit(job.name, archive, treeDB, job.height)
+}
+
+// commit commits the working state of the archive as the state of the store
+// at the height. The height is mapped to the new version in the batch saving
+// the version, so the mapping never points to a version not saved.
+func (a *pruningArchive) commit(name string, archive *iavl.Store, treeDB *archiveTreeDB, height int64) error {
+	version := archive.LastCommitID().Version + 1
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	treeDB.setPending(version, kv.Pair{Key: archiveHeightKey(name, height), Value: bz})
+
+	archive.Commit()
+
+	if treeDB.hasPending() {
+		return fmt.Errorf("height %d has not been mapped to version %d", height, version)
+	}
+
+	return nil
+}
+
+// getImmutable returns the archived state of the store at the height, or nil
+// if the height has not been archived.
+func (a *pruningArchive) getImmutable(name string, height int64, cacheSize int) (*iavl.Store, error) {
+	version, err := a.getVersion(name, height)
+	if err != nil || version == 0 {
+		return nil, err
+	}
+
+	archive, _, err := a.getStore(name, cacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return archive.GetImmutable(version)
+}
+
+// getVersion returns the version of the IAVL tree holding the state of the
+// store at the height, or 0 if the height has not been archived.
+func (a *pruningArchive) getVersion(name string, height int64) (int64, error) {
+	bz, err := a.db.Get(archiveHeightKey(name, height))
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// archivedHeight returns the last archived height of the store, or 0 if
+// nothing has been archived.
+func (a *pruningArchive) archivedHeight(name string) (int64, error) {
+	prefix := []byte(fmt.Sprintf(archiveHeightPrefixFmt, name))
+	iter, err := a.db.ReverseIterator(prefix, types.PrefixEndBytes(prefix))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, iter.Error()
+	}
+	return int64(binary.BigEndian.Uint64(iter.Key()[len(prefix):])), nil
+}
+
+// getStore returns the IAVL store of the archive for the store, and the db it
+// is backed by, loading it if it has not been loaded yet.
+func (a *pruningArchive) getStore(name string, cacheSize int) (*iavl.Store, *archiveTreeDB, error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if store, ok := a.stores[name]; ok {
+		return store, a.treeDBs[name], nil
+	}
+
+	prefix := []byte(fmt.Sprintf(archiveTreePrefixFmt, name))
+	db := &archiveTreeDB{
+		DB:     dbm.NewPrefixDB(a.db, prefix),
+		parent: a.db,
+		prefix: prefix,
+	}
+	store, err := iavl.LoadStore(db, a.logger, types.NewKVStoreKey(name), types.CommitID{}, false, cacheSize, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a.stores[name] = store.(*iavl.Store)
+	a.treeDBs[name] = db
+	return a.stores[name], db, nil
+}
+
+// syncArchiveStore changes the working state of the archive to the state of
+// the source store at the height, by walking both states in lockstep.
+func syncArchiveStore(archive, source *iavl.Store, height int64) error {
+	target, err := source.GetImmutable(height)
+	if err != nil {
+		return err
+	}
+	targetIter := target.Iterator(nil, nil)
+	defer targetIter.Close()
+
+	// nothing has been archived yet
+	if archive.LastCommitID().Version == 0 {
+		for ; targetIter.Valid(); targetIter.Next() {
+			archive.Set(targetIter.Key(), targetIter.Value())
+		}
+		return nil
+	}
+
+	latest, err := archive.GetImmutable(archive.LastCommitID().Version)
+	if err != nil {
+		return err
+	}
+	latestIter := latest.Iterator(nil, nil)
+	defer latestIter.Close()
+
+	for latestIter.Valid() || targetIter.Valid() {
+		var cmp int
+		switch {
+		case !targetIter.Valid():
+			cmp = -1
+		case !latestIter.Valid():
+			cmp = 1
+		default:
+			cmp = bytes.Compare(latestIter.Key(), targetIter.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			archive.Delete(latestIter.Key())
+			latestIter.Next()
+		case cmp > 0:
+			archive.Set(targetIter.Key(), targetIter.Value())
+			targetIter.Next()
+		default:
+			if !bytes.Equal(latestIter.Value(), targetIter.Value()) {
+				archive.Set(targetIter.Key(), targetIter.Value())
+			}
+			latestIter.Next()
+			targetIter.Next()
+		}
+	}
+
+	return nil
+}
+
+func archiveHeightKey(name string, height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append([]byte(fmt.Sprintf(archiveHeightPrefixFmt, name)), bz...)
+}
+
+// archiveTreeDB is the db of the IAVL tree of a store in the archive, under
+// its prefix of the archive db. Its batches are the batches of the archive db,
+// so that the pending height mapping is written in the batch saving the root
+// of the version it maps to.
+type archiveTreeDB struct {
+	dbm.DB
+
+	parent dbm.DB
+	prefix []byte
+
+	mtx     sync.Mutex
+	rootKey []byte
+	pending *kv.Pair
+}
+
+// setPending sets the pair to be written with the root of the version.
+func (db *archiveTreeDB) setPending(version int64, pair kv.Pair) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	db.rootKey = make([]byte, 9)
+	db.rootKey[0] = archiveRootKeyPrefix
+	binary.BigEndian.PutUint64(db.rootKey[1:], uint64(version))
+	db.pending = &pair
+}
+
+func (db *archiveTreeDB) hasPending() bool {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	return db.pending != nil
+}
+
+// takePending returns the pending pair if the key is the root key of its
+// version, clearing it.
+func (db *archiveTreeDB) takePending(key []byte) *kv.Pair {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	if db.pending == nil || !bytes.Equal(key, db.rootKey) {
+		return nil
+	}
+
+	pair := db.pending
+	db.pending = nil
+	return pair
+}
+
+func (db *archiveTreeDB) prefixed(key []byte) []byte {
+	pk := make([]byte, len(db.prefix)+len(key))
+	copy(pk, db.prefix)
+	copy(pk[len(db.prefix):], key)
+	return pk
+}
+
+// NewBatch implements DB.
+func (db *archiveTreeDB) NewBatch() dbm.Batch {
+	return &archiveTreeBatch{Batch: db.parent.NewBatch(), db: db}
+}
+
+type archiveTreeBatch struct {
+	dbm.Batch
+
+	db *archiveTreeDB
+}
+
+// Set implements Batch.
+func (b *archiveTreeBatch) Set(key, value []byte) error {
+	if err := b.Batch.Set(b.db.prefixed(key), value); err != nil {
+		return err
+	}
+
+	if pair := b.db.takePending(key); pair != nil {
+		return b.Batch.Set(pair.Key, pair.Value)
+	}
+
+	return nil
+}
+
+// Delete implements Batch.
+func (b *archiveTreeBatch) Delete(key []byte) error {
+	return b.Batch.Delete(b.db.prefixed(key))
+}
@@ -0,0 +1,168 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	iavltree "github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/iavl"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+func TestMultiStore_StorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	archiveDB := dbm.NewMemDB()
+	newStore := func() *Store {
+		ms := newMultiStoreWithMounts(db, types.PruneNothing)
+		ms.SetStorePruning(testStoreKey1.Name(), types.StorePruningOptions{
+			PruningOptions: types.NewPruningOptions(2, 0, 1),
+			Archive:        true,
+		})
+		ms.SetStorePruning(testStoreKey2.Name(), types.StorePruningOptions{
+			PruningOptions: types.NewPruningOptions(0, 0, 100),
+		})
+		ms.SetPruningArchive(archiveDB)
+		return ms
+	}
+
+	ms := newStore()
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key := []byte("key")
+	for i := int64(1); i <= 10; i++ {
+		for _, storeKey := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+			ms.GetKVStore(storeKey).Set(key, []byte(fmt.Sprint(i)))
+		}
+		ms.Commit()
+		ms.archive.flush()
+	}
+
+	// store1 keeps the recent heights only, store2 has not reached its interval
+	// yet and store3 keeps everything as the root store
+	store1 := ms.GetStoreByName(testStoreKey1.Name()).(*iavl.Store)
+	store2 := ms.GetStoreByName(testStoreKey2.Name()).(*iavl.Store)
+	store3 := ms.GetStoreByName(testStoreKey3.Name()).(*iavl.Store)
+	for v := int64(1); v <= 10; v++ {
+		require.Equal(t, v > 7, store1.VersionExists(v), "height %d", v)
+		require.True(t, store2.VersionExists(v), "height %d", v)
+		require.True(t, store3.VersionExists(v), "height %d", v)
+	}
+	require.Empty(t, ms.storePruneHeights[testStoreKey1.Name()])
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, ms.storePruneHeights[testStoreKey2.Name()])
+
+	// the pending heights of store2 are persisted
+	ph, err := getStorePruningHeights(db, testStoreKey2.Name())
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, ph)
+
+	// the pruned heights of store1 fall back to the archive
+	for v := int64(1); v <= 7; v++ {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprint(v)), cms.GetKVStore(testStoreKey1).Get(key))
+
+		res := ms.Query(abci.RequestQuery{Path: "/store1/key", Data: key, Height: v})
+		require.EqualValues(t, 0, res.Code, res.Log)
+		require.Equal(t, []byte(fmt.Sprint(v)), res.Value)
+		require.Equal(t, v, res.Height)
+	}
+
+	res := ms.Query(abci.RequestQuery{Path: "/store1/key", Data: key, Height: 3, Prove: true})
+	require.NotEqualValues(t, 0, res.Code)
+
+	// "restart" without the archive
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.SetStorePruning(testStoreKey2.Name(), types.StorePruningOptions{
+		PruningOptions: types.NewPruningOptions(0, 0, 100),
+	})
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, ms.storePruneHeights[testStoreKey2.Name()])
+
+	res = ms.Query(abci.RequestQuery{Path: "/store1/key", Data: key, Height: 3})
+	require.EqualValues(t, 0, res.Code, res.Log)
+	require.Nil(t, res.Value)
+
+	// the global heights to prune don't apply to the stores with their own strategies
+	ms.PruneStores(false, []int64{8})
+	require.True(t, ms.GetStoreByName(testStoreKey2.Name()).(*iavl.Store).VersionExists(8))
+	require.False(t, ms.GetStoreByName(testStoreKey3.Name()).(*iavl.Store).VersionExists(8))
+}
+
+func TestMultiStore_StorePruningArchiveLag(t *testing.T) {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	ms.SetStorePruning(testStoreKey1.Name(), types.StorePruningOptions{
+		PruningOptions: types.NewPruningOptions(0, 0, 1),
+		Archive:        true,
+	})
+	ms.SetPruningArchive(dbm.NewMemDB())
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the heights not archived yet are kept pending
+	ms.archive.mtx.Lock()
+	for i := int64(1); i <= 3; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprint(i)))
+		ms.Commit()
+	}
+	store1 := ms.GetStoreByName(testStoreKey1.Name()).(*iavl.Store)
+	for v := int64(1); v <= 3; v++ {
+		require.True(t, store1.VersionExists(v), "height %d", v)
+	}
+	require.Equal(t, []int64{1, 2}, ms.storePruneHeights[testStoreKey1.Name()])
+	ms.archive.mtx.Unlock()
+	ms.archive.flush()
+
+	ms.Commit()
+	require.False(t, store1.VersionExists(1))
+	require.False(t, store1.VersionExists(2))
+	require.Empty(t, ms.storePruneHeights[testStoreKey1.Name()])
+}
+
+func TestPruningArchive(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, err := iavltree.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	source := iavl.UnsafeNewStore(tree)
+	source.EnableChangeSets()
+
+	archive := newPruningArchive(dbm.NewMemDB(), nil)
+	var jobs []archiveJob
+	for i := int64(1); i <= 5; i++ {
+		source.Set([]byte(fmt.Sprint(i)), []byte(fmt.Sprint(i)))
+		source.Delete([]byte(fmt.Sprint(i - 1)))
+		source.Commit()
+
+		changeSet, ok := source.PopChangeSet()
+		require.True(t, ok)
+		jobs = append(jobs, archiveJob{name: "store", source: source, height: i, changeSet: changeSet, hasChangeSet: true})
+	}
+
+	// the jobs of 2 to 4 got lost, and the change set of 5 as well
+	jobs[4].changeSet, jobs[4].hasChangeSet = nil, false
+	archive.enqueue(jobs[0])
+	archive.enqueue(jobs[4])
+	archive.enqueue(jobs[2])
+	archive.flush()
+
+	archived, err := archive.archivedHeight("store")
+	require.NoError(t, err)
+	require.EqualValues(t, 5, archived)
+
+	for i := int64(1); i <= 5; i++ {
+		version, err := archive.getVersion("store", i)
+		require.NoError(t, err)
+		require.Equal(t, i, version)
+
+		state, err := archive.getImmutable("store", i, 100)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprint(i)), state.Get([]byte(fmt.Sprint(i))))
+		require.Nil(t, state.Get([]byte(fmt.Sprint(i-1))))
+	}
+
+	state, err := archive.getImmutable("store", 6, 100)
+	require.NoError(t, err)
+	require.Nil(t, state)
+}
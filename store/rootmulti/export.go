@@ -0,0 +1,321 @@
+package rootmulti
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+
+	iavltree "github.com/cosmos/iavl"
+	protoio "github.com/gogo/protobuf/io"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/cachekv"
+	"github.com/line/lbm-sdk/store/iavl"
+	"github.com/line/lbm-sdk/store/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/types/kv"
+)
+
+const (
+	stagedImportHeaderPrefix  = "s/import/h/"    // s/import/h/<name> -> the header of the staged import
+	stagedImportPairPrefixFmt = "s/import/p/%s/" // s/import/p/<name>/<key> -> the staged value
+)
+
+// ExportStore writes the key/value pairs of the IAVL store of the given name
+// within the key range [start, end) at the version, as a stream of
+// StoreExportItem messages. The stream ends with the number and the checksum
+// of the pairs, which are verified by ImportStore.
+func (rs *Store) ExportStore(name string, version int64, start, end []byte, protoWriter protoio.Writer) error {
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot export non-IAVL store %q", name)
+	}
+	if !store.VersionExists(version) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q has no version %d", name, version)
+	}
+
+	exporter, err := store.Export(version)
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	err = protoWriter.WriteMsg(&types.StoreExportItem{
+		Item: &types.StoreExportItem_Header{
+			Header: &types.StoreExportHeader{
+				StoreKey: name,
+				Version:  version,
+				Start:    start,
+				End:      end,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	checksum := newPairsChecksum()
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		// only the leaf nodes hold the pairs, and they are exported in the order of the keys
+		if node.Height != 0 || !inKeyRange(node.Key, start, end) {
+			continue
+		}
+
+		pair := kv.Pair{Key: node.Key, Value: node.Value}
+		if err := checksum.add(pair); err != nil {
+			return err
+		}
+		err = protoWriter.WriteMsg(&types.StoreExportItem{
+			Item: &types.StoreExportItem_Pair{Pair: &pair},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return protoWriter.WriteMsg(&types.StoreExportItem{
+		Item: &types.StoreExportItem_Footer{
+			Footer: checksum.footer(),
+		},
+	})
+}
+
+// ImportStore stages the key/value pairs of a stream written by ExportStore,
+// to replace the pairs within its key range on the next load of the latest
+// version. The staged pairs are applied to the working state of the store, so
+// they are committed with the next block, and never as a version of their own
+// which no block exists for. Nothing is staged unless the stream matches its
+// checksum. An import staged before for the same store is replaced.
+func (rs *Store) ImportStore(protoReader protoio.Reader) (*types.StoreExportHeader, error) {
+	var item types.StoreExportItem
+	if err := protoReader.ReadMsg(&item); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+	}
+	header := item.GetHeader()
+	if header == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "expected the header of the exported store")
+	}
+
+	if _, ok := rs.GetStoreByName(header.StoreKey).(*iavl.Store); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", header.StoreKey)
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	if err := deleteStagedImport(rs.db, batch, header.StoreKey); err != nil {
+		return nil, err
+	}
+	bz, err := header.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := batch.Set(stagedImportHeaderKey(header.StoreKey), bz); err != nil {
+		return nil, err
+	}
+
+	checksum := newPairsChecksum()
+	var footer *types.StoreExportFooter
+	var lastKey []byte
+	for footer == nil {
+		item = types.StoreExportItem{}
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "unexpected end of the exported store")
+		} else if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := item.Item.(type) {
+		case *types.StoreExportItem_Pair:
+			pair := item.Pair
+			if !inKeyRange(pair.Key, header.Start, header.End) {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "key %X out of the exported range", pair.Key)
+			}
+			if lastKey != nil && bytes.Compare(pair.Key, lastKey) <= 0 {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "key %X out of order", pair.Key)
+			}
+			lastKey = pair.Key
+
+			if err := checksum.add(*pair); err != nil {
+				return nil, err
+			}
+			if err := batch.Set(stagedImportPairKey(header.StoreKey, pair.Key), pair.Value); err != nil {
+				return nil, err
+			}
+		case *types.StoreExportItem_Footer:
+			footer = item.Footer
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected item %T", item)
+		}
+	}
+
+	if err := verifyExportFooter(footer, checksum.footer()); err != nil {
+		return nil, err
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// StagedImports returns the headers of the imports staged to be applied.
+func (rs *Store) StagedImports() ([]*types.StoreExportHeader, error) {
+	prefix := []byte(stagedImportHeaderPrefix)
+	iter, err := rs.db.Iterator(prefix, types.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var headers []*types.StoreExportHeader
+	for ; iter.Valid(); iter.Next() {
+		header := &types.StoreExportHeader{}
+		if err := header.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		headers = append(headers, header)
+	}
+
+	return headers, iter.Error()
+}
+
+// applyStagedImports replaces the pairs within the key ranges of the staged
+// imports in the working states of the stores. The staged imports are deleted
+// on the next commit, along with the commit of the pairs.
+func (rs *Store) applyStagedImports() error {
+	headers, err := rs.StagedImports()
+	if err != nil {
+		return err
+	}
+
+	rs.appliedImports = nil
+	for _, header := range headers {
+		key, ok := rs.keysByName[header.StoreKey]
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q of the staged import not mounted", header.StoreKey)
+		}
+		store := rs.stores[key].(types.KVStore)
+
+		cache := cachekv.NewStore(store)
+		iter := store.Iterator(header.Start, header.End)
+		for ; iter.Valid(); iter.Next() {
+			cache.Delete(iter.Key())
+		}
+		iter.Close()
+
+		prefix := []byte(fmt.Sprintf(stagedImportPairPrefixFmt, header.StoreKey))
+		pairs, err := rs.db.Iterator(prefix, types.PrefixEndBytes(prefix))
+		if err != nil {
+			return err
+		}
+		for ; pairs.Valid(); pairs.Next() {
+			cache.Set(pairs.Key()[len(prefix):], pairs.Value())
+		}
+		err = pairs.Error()
+		pairs.Close()
+		if err != nil {
+			return err
+		}
+
+		cache.Write()
+		rs.appliedImports = append(rs.appliedImports, header.StoreKey)
+
+		if rs.logger != nil {
+			rs.logger.Info("applied the staged import", "store", header.StoreKey, "exported", header.Version)
+		}
+	}
+
+	return nil
+}
+
+// deleteStagedImport deletes the import of the store staged in db, if any,
+// within the batch.
+func deleteStagedImport(db dbm.DB, batch dbm.Batch, name string) error {
+	if err := batch.Delete(stagedImportHeaderKey(name)); err != nil {
+		return err
+	}
+
+	prefix := []byte(fmt.Sprintf(stagedImportPairPrefixFmt, name))
+	iter, err := db.Iterator(prefix, types.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+
+	return iter.Error()
+}
+
+func stagedImportHeaderKey(name string) []byte {
+	return []byte(stagedImportHeaderPrefix + name)
+}
+
+func stagedImportPairKey(name string, key []byte) []byte {
+	return append([]byte(fmt.Sprintf(stagedImportPairPrefixFmt, name)), key...)
+}
+
+func verifyExportFooter(expected, actual *types.StoreExportFooter) error {
+	if expected.Count != actual.Count {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "pair count mismatch: expected %d, got %d", expected.Count, actual.Count)
+	}
+	if !bytes.Equal(expected.Checksum, actual.Checksum) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "checksum mismatch: expected %X, got %X", expected.Checksum, actual.Checksum)
+	}
+	return nil
+}
+
+// inKeyRange returns whether the key is within [start, end), where an empty
+// start or end is unbounded.
+func inKeyRange(key, start, end []byte) bool {
+	if len(start) != 0 && bytes.Compare(key, start) < 0 {
+		return false
+	}
+	if len(end) != 0 && bytes.Compare(key, end) >= 0 {
+		return false
+	}
+	return true
+}
+
+// pairsChecksum accumulates the number and the checksum of the pairs.
+type pairsChecksum struct {
+	hasher hash.Hash
+	writer protoio.Writer
+	count  uint64
+}
+
+func newPairsChecksum() *pairsChecksum {
+	hasher := sha256.New()
+	return &pairsChecksum{
+		hasher: hasher,
+		writer: protoio.NewDelimitedWriter(hasher),
+	}
+}
+
+func (c *pairsChecksum) add(pair kv.Pair) error {
+	c.count++
+	return c.writer.WriteMsg(&pair)
+}
+
+func (c *pairsChecksum) footer() *types.StoreExportFooter {
+	return &types.StoreExportFooter{
+		Count:    c.count,
+		Checksum: c.hasher.Sum(nil),
+	}
+}
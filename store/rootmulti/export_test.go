@@ -0,0 +1,113 @@
+package rootmulti
+
+import (
+	"bytes"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/types"
+)
+
+func TestMultiStoreExportImportStore(t *testing.T) {
+	source := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, source.LoadLatestVersion())
+	sourceStore := source.GetStoreByName("store1").(types.KVStore)
+	sourceStore.Set([]byte("a"), []byte("1"))
+	sourceStore.Set([]byte("b1"), []byte("2"))
+	sourceStore.Set([]byte("b2"), []byte("3"))
+	sourceStore.Set([]byte("c"), []byte("4"))
+	exported := source.Commit()
+	// changes after the exported version
+	sourceStore.Set([]byte("b3"), []byte("5"))
+	source.Commit()
+
+	export := func(start, end []byte) *bytes.Buffer {
+		buf := &bytes.Buffer{}
+		err := source.ExportStore("store1", exported.Version, start, end, protoio.NewDelimitedWriter(buf))
+		require.NoError(t, err)
+		return buf
+	}
+
+	// errors on export
+	require.Error(t, source.ExportStore("garbage", exported.Version, nil, nil, protoio.NewDelimitedWriter(&bytes.Buffer{})))
+	require.Error(t, source.ExportStore("store1", 10, nil, nil, protoio.NewDelimitedWriter(&bytes.Buffer{})))
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+	targetStore := target.GetStoreByName("store1").(types.KVStore)
+	targetStore.Set([]byte("a"), []byte("10"))
+	targetStore.Set([]byte("b0"), []byte("20"))
+	targetStore.Set([]byte("b1"), []byte("30"))
+	targetStore.Set([]byte("c"), []byte("40"))
+	last := target.Commit()
+
+	// the import of a tampered file fails without staging anything
+	tampered := export([]byte("b"), []byte("c")).Bytes()
+	tampered = bytes.Replace(tampered, []byte("b2"), []byte("bz"), 1)
+	_, err := target.ImportStore(protoio.NewDelimitedReader(bytes.NewReader(tampered), 1024))
+	require.Error(t, err)
+	staged, err := target.StagedImports()
+	require.NoError(t, err)
+	require.Empty(t, staged)
+
+	// the import of a truncated file fails without staging anything
+	truncated := export([]byte("b"), []byte("c")).Bytes()
+	truncated = truncated[:len(truncated)-10]
+	_, err = target.ImportStore(protoio.NewDelimitedReader(bytes.NewReader(truncated), 1024))
+	require.Error(t, err)
+	staged, err = target.StagedImports()
+	require.NoError(t, err)
+	require.Empty(t, staged)
+
+	// the import is staged without any commit
+	header, err := target.ImportStore(protoio.NewDelimitedReader(export([]byte("b"), []byte("c")), 1024))
+	require.NoError(t, err)
+	require.Equal(t, &types.StoreExportHeader{StoreKey: "store1", Version: exported.Version, Start: []byte("b"), End: []byte("c")}, header)
+	require.Equal(t, last, target.LastCommitID())
+	staged, err = target.StagedImports()
+	require.NoError(t, err)
+	require.Equal(t, []*types.StoreExportHeader{header}, staged)
+	require.Equal(t, []byte("30"), target.GetStoreByName("store1").(types.KVStore).Get([]byte("b1")))
+
+	// the staged pairs within the key range replace the working state on the
+	// load of the latest version, and are committed with the next version
+	require.NoError(t, target.LoadLatestVersion())
+	require.Equal(t, last, target.LastCommitID())
+	targetStore = target.GetStoreByName("store1").(types.KVStore)
+	require.Equal(t, []byte("10"), targetStore.Get([]byte("a")))
+	require.Nil(t, targetStore.Get([]byte("b0")))
+	require.Equal(t, []byte("2"), targetStore.Get([]byte("b1")))
+	require.Equal(t, []byte("3"), targetStore.Get([]byte("b2")))
+	require.Nil(t, targetStore.Get([]byte("b3")))
+	require.Equal(t, []byte("40"), targetStore.Get([]byte("c")))
+
+	targetStore.Set([]byte("d"), []byte("50"))
+	commitID := target.Commit()
+	require.Equal(t, last.Version+1, commitID.Version)
+	staged, err = target.StagedImports()
+	require.NoError(t, err)
+	require.Empty(t, staged)
+
+	// not applied again
+	targetStore.Set([]byte("b1"), []byte("60"))
+	target.Commit()
+	require.NoError(t, target.LoadLatestVersion())
+	require.Equal(t, []byte("60"), target.GetStoreByName("store1").(types.KVStore).Get([]byte("b1")))
+
+	// the whole store is replaced
+	_, err = target.ImportStore(protoio.NewDelimitedReader(export(nil, nil), 1024))
+	require.NoError(t, err)
+	require.NoError(t, target.LoadLatestVersion())
+	target.Commit()
+	targetStore = target.GetStoreByName("store1").(types.KVStore)
+	iter := targetStore.Iterator(nil, nil)
+	defer iter.Close()
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.Equal(t, []string{"a", "b1", "b2", "c"}, keys)
+}
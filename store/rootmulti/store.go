@@ -32,11 +32,16 @@ import (
 )
 
 const (
-	latestVersionKey = "s/latest"
-	pruneHeightsKey  = "s/pruneheights"
-	commitInfoKeyFmt = "s/%d" // s/<version>
+	latestVersionKey        = "s/latest"
+	pruneHeightsKey         = "s/pruneheights"
+	storePruneHeightsKeyFmt = "s/pruneheights/%s" // s/pruneheights/<name>
+	commitInfoKeyFmt        = "s/%d"              // s/<version>
 
 	proofsPath = "proofs"
+
+	// StateDiffPath is the query subpath of a store for the state diff between
+	// two committed versions, ie. `/<substore>/diff`.
+	StateDiffPath = "/diff"
 )
 
 const iavlDisablefastNodeDefault = true
@@ -58,12 +63,21 @@ type Store struct {
 	pruneHeights        []int64
 	initialVersion      int64
 
+	// pruning strategies of the individual stores, overriding pruningOpts
+	storePruningOpts  map[string]types.StorePruningOptions
+	storePruneHeights map[string][]int64
+	archive           *pruningArchive
+
 	traceWriter       io.Writer
 	traceContext      types.TraceContext
 	traceContextMutex sync.Mutex
 
 	interBlockCache types.MultiStorePersistentCache
 
+	// names of the stores with the staged imports applied to their working
+	// states, which are deleted on the next commit
+	appliedImports []string
+
 	listeners map[types.StoreKey][]types.WriteListener
 }
 
@@ -87,6 +101,8 @@ func NewStore(db dbm.DB, logger log.Logger) *Store {
 		stores:              make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:          make(map[string]types.StoreKey),
 		pruneHeights:        make([]int64, 0),
+		storePruningOpts:    make(map[string]types.StorePruningOptions),
+		storePruneHeights:   make(map[string][]int64),
 		listeners:           make(map[types.StoreKey][]types.WriteListener),
 	}
 }
@@ -103,6 +119,21 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
 }
 
+// SetStorePruning sets the pruning strategy of the store of the given name,
+// which overrides the one of the root store. It must be called prior to
+// LoadVersion or LoadLatestVersion.
+func (rs *Store) SetStorePruning(name string, opts types.StorePruningOptions) {
+	rs.storePruningOpts[name] = opts
+}
+
+// SetPruningArchive sets the db the heights of the stores are archived into in
+// the background, if their pruning strategies enable the archive. The heights
+// are pruned once they have been archived, and the historical queries of the
+// pruned heights fall back to the archive.
+func (rs *Store) SetPruningArchive(db dbm.DB) {
+	rs.archive = newPruningArchive(db, rs.logger)
+}
+
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
@@ -269,6 +300,19 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	if err == nil && len(ph) > 0 {
 		rs.pruneHeights = ph
 	}
+	for name := range rs.storePruningOpts {
+		ph, err := getStorePruningHeights(rs.db, name)
+		if err == nil && len(ph) > 0 {
+			rs.storePruneHeights[name] = ph
+		}
+	}
+
+	// the staged imports are committed with the next version only
+	if ver == GetLatestVersion(rs.db) {
+		if err := rs.applyStagedImports(); err != nil {
+			return errors.Wrap(err, "failed to apply the staged imports")
+		}
+	}
 
 	return nil
 }
@@ -410,27 +454,22 @@ func (rs *Store) Commit() types.CommitID {
 	}
 
 	rs.lastCommitInfo = commitStores(version, rs.stores)
+	rs.archiveStores(version)
 
-	// Determine if pruneHeight height needs to be added to the list of heights to
-	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
-	if rs.pruningOpts.Interval > 0 && int64(rs.pruningOpts.KeepRecent) < previousHeight {
-		pruneHeight := previousHeight - int64(rs.pruningOpts.KeepRecent)
-		// We consider this height to be pruned iff:
-		//
-		// - KeepEvery is zero as that means that all heights should be pruned.
-		// - KeepEvery % (height - KeepRecent) != 0 as that means the height is not
-		// a 'snapshot' height.
-		if rs.pruningOpts.KeepEvery == 0 || pruneHeight%int64(rs.pruningOpts.KeepEvery) != 0 {
-			rs.pruneHeights = append(rs.pruneHeights, pruneHeight)
-		}
+	rs.pruneHeights = appendPruneHeight(rs.pruningOpts, rs.pruneHeights, previousHeight)
+	for name, opts := range rs.storePruningOpts {
+		rs.storePruneHeights[name] = appendPruneHeight(opts.PruningOptions, rs.storePruneHeights[name], previousHeight)
 	}
 
 	// batch prune if the current height is a pruning interval height
-	if rs.pruningOpts.Interval > 0 && version%int64(rs.pruningOpts.Interval) == 0 {
+	if isPruneInterval(rs.pruningOpts, version) {
 		rs.PruneStores(true, nil)
+	} else if len(rs.storePruningOpts) > 0 {
+		rs.pruneStores(nil, version)
 	}
 
-	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
+	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights, rs.storePruneHeights, rs.appliedImports...)
+	rs.appliedImports = nil
 
 	return types.CommitID{
 		Version: version,
@@ -441,32 +480,143 @@ func (rs *Store) Commit() types.CommitID {
 // PruneStores will batch delete a list of heights from each mounted sub-store.
 // If clearStorePruningHeihgts is true, store's pruneHeights is appended to the
 // pruningHeights and reset after finishing pruning.
+//
+// The stores with their own pruning strategies are not pruned by the heights,
+// but by the heights pending for them at their own pruning intervals, only if
+// clearStorePruningHeihgts is true.
 func (rs *Store) PruneStores(clearStorePruningHeihgts bool, pruningHeights []int64) {
 	if clearStorePruningHeihgts {
 		pruningHeights = append(pruningHeights, rs.pruneHeights...)
 	}
 
-	if len(rs.pruneHeights) == 0 {
-		return
+	var version int64
+	if clearStorePruningHeihgts && rs.lastCommitInfo != nil {
+		version = rs.lastCommitInfo.Version
 	}
+	rs.pruneStores(pruningHeights, version)
+
+	if clearStorePruningHeihgts {
+		rs.pruneHeights = make([]int64, 0)
+	}
+}
 
+// pruneStores deletes the heights from the stores without their own pruning
+// strategies, and the pending heights from the stores with their own pruning
+// strategies if the version is their pruning interval height. The heights of
+// the stores enabling the archive are deleted only once they have been
+// archived.
+func (rs *Store) pruneStores(pruningHeights []int64, version int64) {
 	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
 
-			if err := store.(*iavl.Store).DeleteVersions(pruningHeights...); err != nil {
-				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
-					panic(err)
-				}
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+
+		heights := pruningHeights
+		opts, ok := rs.storePruningOpts[key.Name()]
+		if ok {
+			if version == 0 || !isPruneInterval(opts.PruningOptions, version) {
+				continue
+			}
+			heights = rs.storePruneHeights[key.Name()]
+			rs.storePruneHeights[key.Name()] = make([]int64, 0)
+		}
+		if len(heights) == 0 {
+			continue
+		}
+
+		// the heights not archived yet are kept pending until they are archived
+		if opts.Archive && rs.archive != nil {
+			archived, err := rs.archive.archivedHeight(key.Name())
+			if err != nil {
+				panic(fmt.Errorf("failed to get the archived height of %s: %w", key.Name(), err))
+			}
+
+			var pending []int64
+			heights, pending = splitArchivedHeights(heights, archived)
+			rs.storePruneHeights[key.Name()] = pending
+			if len(heights) == 0 {
+				continue
+			}
+		}
+
+		if err := iavlStore.DeleteVersions(heights...); err != nil {
+			if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+				panic(err)
 			}
 		}
 	}
+}
 
-	if clearStorePruningHeihgts {
-		rs.pruneHeights = make([]int64, 0)
+// archiveStores queues the version of the stores enabling the archive to be
+// archived in the background, with their changes made in the version.
+func (rs *Store) archiveStores(version int64) {
+	if rs.archive == nil {
+		return
+	}
+
+	for name, opts := range rs.storePruningOpts {
+		key, ok := rs.keysByName[name]
+		if !opts.Archive || !ok {
+			continue
+		}
+		iavlStore, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			continue
+		}
+
+		changeSet, ok := iavlStore.PopChangeSet()
+		rs.archive.enqueue(archiveJob{
+			name:         name,
+			source:       iavlStore,
+			height:       version,
+			cacheSize:    rs.iavlCacheSize,
+			changeSet:    changeSet,
+			hasChangeSet: ok,
+		})
+	}
+}
+
+// splitArchivedHeights splits the heights into the ones archived, i.e. not
+// greater than the archived height, and the others.
+func splitArchivedHeights(heights []int64, archived int64) (archivedHeights, pending []int64) {
+	for _, height := range heights {
+		if height <= archived {
+			archivedHeights = append(archivedHeights, height)
+		} else {
+			pending = append(pending, height)
+		}
+	}
+
+	return archivedHeights, pending
+}
+
+// appendPruneHeight appends the height to be pruned after the commit of the
+// height following the previous height to the prune heights.
+func appendPruneHeight(opts types.PruningOptions, pruneHeights []int64, previousHeight int64) []int64 {
+	// Determine if pruneHeight height needs to be added to the list of heights to
+	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
+	if opts.Interval > 0 && int64(opts.KeepRecent) < previousHeight {
+		pruneHeight := previousHeight - int64(opts.KeepRecent)
+		// We consider this height to be pruned iff:
+		//
+		// - KeepEvery is zero as that means that all heights should be pruned.
+		// - KeepEvery % (height - KeepRecent) != 0 as that means the height is not
+		// a 'snapshot' height.
+		if opts.KeepEvery == 0 || pruneHeight%int64(opts.KeepEvery) != 0 {
+			pruneHeights = append(pruneHeights, pruneHeight)
+		}
 	}
+
+	return pruneHeights
+}
+
+// isPruneInterval returns whether the version is a pruning interval height.
+func isPruneInterval(opts types.PruningOptions, version int64) bool {
+	return opts.Interval > 0 && version%int64(opts.Interval) == 0
 }
 
 // CacheWrap implements CacheWrapper/Store/CommitStore.
@@ -509,7 +659,7 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 
 			// Attempt to lazy-load an already saved IAVL store version. If the
 			// version does not exist or is pruned, an error should be returned.
-			iavlStore, err := store.(*iavl.Store).GetImmutable(version)
+			iavlStore, err := rs.getImmutable(key.Name(), store.(*iavl.Store), version)
 			if err != nil {
 				return nil, err
 			}
@@ -524,6 +674,23 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners), nil
 }
 
+// getImmutable returns the state of the IAVL store at the version. If the
+// version has been pruned and offloaded into the archive, the archived state
+// is returned instead.
+func (rs *Store) getImmutable(name string, store *iavl.Store, version int64) (*iavl.Store, error) {
+	if !store.VersionExists(version) && rs.archive != nil {
+		archived, err := rs.archive.getImmutable(name, version, rs.iavlCacheSize)
+		if err != nil {
+			return nil, err
+		}
+		if archived != nil {
+			return archived, nil
+		}
+	}
+
+	return store.GetImmutable(version)
+}
+
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
 // not exist, it will panic. If the Store is wrapped in an inter-block cache, it
 // will be unwrapped prior to being returned.
@@ -575,12 +742,32 @@ func (rs *Store) GetStoreByName(name string) types.Store {
 	return rs.GetCommitKVStore(key)
 }
 
+// StateDiff returns the changes of the keys within the requested range of the
+// IAVL store of the given name between two committed versions. See
+// iavl.Store.Diff for the details.
+func (rs *Store) StateDiff(name string, req types.StateDiffRequest) (*types.StateDiff, error) {
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no such IAVL store: %s", name)
+	}
+
+	diff, err := store.Diff(req)
+	if err != nil {
+		return nil, err
+	}
+	diff.StoreKey = name
+
+	return diff, nil
+}
+
 // Query calls substore.Query with the same `req` where `req.Path` is
 // modified to remove the substore prefix.
 // Ie. `req.Path` here is `/<substore>/<path>`, and trimmed to `/<path>` for the substore.
 // Special case: if `req.Path` is `/proofs`, the commit hash is included
 // as response value. In addition, proofs of every store are appended to the response for
 // the requested height
+// Special case: if `req.Path` is `/<substore>/diff`, the state diff of the substore
+// between the versions of the StateDiffRequest in `req.Data` is the response value
 func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	path := req.Path
 	firstPath, subpath, err := parsePath(path)
@@ -597,6 +784,10 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no such store: %s", firstPath))
 	}
 
+	if subpath == StateDiffPath {
+		return rs.doStateDiffQuery(firstPath, req)
+	}
+
 	queryable, ok := store.(types.Queryable)
 	if !ok {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s (type %T) doesn't support queries", firstPath, store))
@@ -604,6 +795,9 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 
 	// trim the path and make the query
 	req.Path = subpath
+	if res, ok := rs.queryArchive(firstPath, store, req); ok {
+		return res
+	}
 	res := queryable.Query(req)
 
 	if !req.Prove || !RequireProof(subpath) {
@@ -634,6 +828,37 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	return res
 }
 
+// queryArchive makes the query on the archived state of the store, if the
+// height of the query has been pruned from the store and offloaded into the
+// archive. It returns false if the query is not for an archived height.
+func (rs *Store) queryArchive(name string, store types.Store, req abci.RequestQuery) (abci.ResponseQuery, bool) {
+	iavlStore, ok := store.(*iavl.Store)
+	if !ok || rs.archive == nil || req.Height <= 0 || iavlStore.VersionExists(req.Height) {
+		return abci.ResponseQuery{}, false
+	}
+
+	archived, err := rs.archive.getImmutable(name, req.Height, rs.iavlCacheSize)
+	if err != nil {
+		return sdkerrors.QueryResult(err), true
+	}
+	if archived == nil {
+		return abci.ResponseQuery{}, false
+	}
+
+	// the archived state is kept under a version of its own, whose hash differs
+	// from the one committed at the height
+	if req.Prove {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot query archived height %d with proof", req.Height)), true
+	}
+
+	height := req.Height
+	req.Height = 0
+	res := archived.Query(req)
+	res.Height = height
+
+	return res, true
+}
+
 // SetInitialVersion sets the initial version of the IAVL tree. It is used when
 // starting a new chain at an arbitrary height.
 // NOTE: this never errors. Can we fix the function signature ?
@@ -835,20 +1060,23 @@ loop:
 		importer.Close()
 	}
 
-	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{}, nil)
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
-
+// getStoreDB returns the db of the store of the params.
+func (rs *Store) getStoreDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
 
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+	db := rs.getStoreDB(params)
+
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -867,6 +1095,11 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 			return nil, err
 		}
 
+		// the changes are recorded from the load to be archived
+		if opts, ok := rs.storePruningOpts[key.Name()]; ok && opts.Archive && rs.archive != nil {
+			store.(*iavl.Store).EnableChangeSets()
+		}
+
 		if rs.interBlockCache != nil {
 			// Wrap and get a CommitKVStore with inter-block caching. Note, this should
 			// only wrap the primary CommitKVStore, not any store that is already
@@ -934,7 +1167,7 @@ func (rs *Store) RollbackToVersion(target int64) error {
 		}
 	}
 
-	flushMetadata(rs.db, target, rs.buildCommitInfo(target), []int64{})
+	flushMetadata(rs.db, target, rs.buildCommitInfo(target), []int64{}, nil)
 
 	return rs.LoadLatestVersion()
 }
@@ -1004,6 +1237,33 @@ func (rs *Store) doProofsQuery(req abci.RequestQuery) abci.ResponseQuery {
 	return res
 }
 
+func (rs *Store) doStateDiffQuery(name string, req abci.RequestQuery) abci.ResponseQuery {
+	if req.Prove {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot query state diff with proof"))
+	}
+
+	var diffReq types.StateDiffRequest
+	if err := diffReq.Unmarshal(req.Data); err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error()))
+	}
+
+	diff, err := rs.StateDiff(name, diffReq)
+	if err != nil {
+		return sdkerrors.QueryResult(err)
+	}
+
+	bz, err := diff.Marshal()
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+	}
+
+	return abci.ResponseQuery{
+		Height: diff.ToVersion,
+		Key:    req.Data,
+		Value:  bz,
+	}
+}
+
 // Gets commitInfo from disk.
 func getCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
 	cInfoKey := fmt.Sprintf(commitInfoKeyFmt, ver)
@@ -1053,8 +1313,29 @@ func setPruningHeights(batch dbm.Batch, pruneHeights []int64) {
 	batch.Set([]byte(pruneHeightsKey), bz)
 }
 
+func setStorePruningHeights(batch dbm.Batch, storePruneHeights map[string][]int64) {
+	for name, pruneHeights := range storePruneHeights {
+		bz := make([]byte, 0)
+		for _, ph := range pruneHeights {
+			buf := make([]byte, 8)
+			binary.BigEndian.PutUint64(buf, uint64(ph))
+			bz = append(bz, buf...)
+		}
+
+		batch.Set([]byte(fmt.Sprintf(storePruneHeightsKeyFmt, name)), bz)
+	}
+}
+
 func getPruningHeights(db dbm.DB) ([]int64, error) {
-	bz, err := db.Get([]byte(pruneHeightsKey))
+	return getPruningHeightsByKey(db, []byte(pruneHeightsKey))
+}
+
+func getStorePruningHeights(db dbm.DB, name string) ([]int64, error) {
+	return getPruningHeightsByKey(db, []byte(fmt.Sprintf(storePruneHeightsKeyFmt, name)))
+}
+
+func getPruningHeightsByKey(db dbm.DB, key []byte) ([]int64, error) {
+	bz, err := db.Get(key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pruned heights: %w", err)
 	}
@@ -1073,13 +1354,21 @@ func getPruningHeights(db dbm.DB) ([]int64, error) {
 	return prunedHeights, nil
 }
 
-func flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo, pruneHeights []int64) {
+// flushMetadata writes the metadata of the version, deleting the staged imports
+// of the given stores, which have been committed with the version.
+func flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo, pruneHeights []int64, storePruneHeights map[string][]int64, committedImports ...string) {
 	batch := db.NewBatch()
 	defer batch.Close()
 
 	setCommitInfo(batch, version, cInfo)
 	setLatestVersion(batch, version)
 	setPruningHeights(batch, pruneHeights)
+	setStorePruningHeights(batch, storePruneHeights)
+	for _, name := range committedImports {
+		if err := deleteStagedImport(db, batch, name); err != nil {
+			panic(fmt.Errorf("error on deleting the staged import %w", err))
+		}
+	}
 
 	if err := batch.Write(); err != nil {
 		panic(fmt.Errorf("error on batch write %w", err))
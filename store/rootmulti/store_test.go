@@ -494,6 +494,62 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, 3, len(qres.ProofOps.Ops)) // 3 mounted stores
 }
 
+func TestMultiStoreStateDiffQuery(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	k, v := []byte("wind"), []byte("blows")
+	k2, v2 := []byte("water"), []byte("flows")
+
+	store1 := multi.GetStoreByName("store1").(types.KVStore)
+	store1.Set(k, v)
+	cid1 := multi.Commit()
+
+	store1.Set(k2, v2)
+	store1.Delete(k)
+	cid2 := multi.Commit()
+
+	reqBz, err := (&types.StateDiffRequest{FromVersion: cid1.Version, ToVersion: cid2.Version}).Marshal()
+	require.NoError(t, err)
+
+	// Test invalid store name.
+	query := abci.RequestQuery{Path: "/garbage" + StateDiffPath, Data: reqBz}
+	qres := multi.Query(query)
+	require.EqualValues(t, sdkerrors.ErrUnknownRequest.ABCICode(), qres.Code)
+
+	// Test proof is not supported.
+	query = abci.RequestQuery{Path: "/store1" + StateDiffPath, Data: reqBz, Prove: true}
+	qres = multi.Query(query)
+	require.EqualValues(t, sdkerrors.ErrInvalidRequest.ABCICode(), qres.Code)
+
+	// Test valid query.
+	query.Prove = false
+	qres = multi.Query(query)
+	require.EqualValues(t, 0, qres.Code)
+	require.Equal(t, cid2.Version, qres.Height)
+
+	var diff types.StateDiff
+	require.NoError(t, diff.Unmarshal(qres.Value))
+	require.Equal(t, types.StateDiff{
+		StoreKey:    "store1",
+		FromVersion: cid1.Version,
+		ToVersion:   cid2.Version,
+		Added:       []types.StateChange{{Key: k2, NewValue: v2}},
+		Deleted:     []types.StateChange{{Key: k, OldValue: v}},
+	}, diff)
+
+	// Test unchanged store.
+	query.Path = "/store2" + StateDiffPath
+	qres = multi.Query(query)
+	require.EqualValues(t, 0, qres.Code)
+	diff = types.StateDiff{}
+	require.NoError(t, diff.Unmarshal(qres.Value))
+	require.Empty(t, diff.Added)
+	require.Empty(t, diff.Updated)
+	require.Empty(t, diff.Deleted)
+}
+
 func TestMultiStore_Pruning(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -0,0 +1,105 @@
+package rootmulti
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/line/lbm-sdk/store/iavl"
+	"github.com/line/lbm-sdk/store/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// StoreVerification is the result of the verification of a store.
+type StoreVerification struct {
+	StoreKey string
+	// Hash is the hash recomputed from the nodes of the store.
+	Hash []byte
+	// CommittedHash is the hash of the store in the commit info.
+	CommittedHash []byte
+	// Mismatch is the first node of the store not resulting in its hash, if any.
+	Mismatch *iavl.TreeMismatch
+}
+
+// Verified returns whether the store results in its committed hash.
+func (v StoreVerification) Verified() bool {
+	return v.Mismatch == nil && bytes.Equal(v.Hash, v.CommittedHash)
+}
+
+// StateVerification is the result of the verification of the state at a
+// version.
+type StateVerification struct {
+	Version int64
+	// AppHash is the hash of the commit info with the recomputed store hashes.
+	AppHash []byte
+	// CommittedAppHash is the hash of the persisted commit info.
+	CommittedAppHash []byte
+	// Stores is the results of the stores, in the order of their names.
+	Stores []StoreVerification
+}
+
+// Verified returns whether the state results in its committed app hash.
+func (v StateVerification) Verified() bool {
+	return v.FirstMismatch() == nil && bytes.Equal(v.AppHash, v.CommittedAppHash)
+}
+
+// FirstMismatch returns the first store not resulting in its committed hash,
+// or nil if there is none.
+func (v StateVerification) FirstMismatch() *StoreVerification {
+	for i := range v.Stores {
+		if !v.Stores[i].Verified() {
+			return &v.Stores[i]
+		}
+	}
+	return nil
+}
+
+// VerifyState recomputes the hashes of the IAVL stores at the version from
+// their nodes on disk, and compares them and the resulting app hash with the
+// persisted commit info of the version. Up to the given number of the
+// subtrees of a store are verified in parallel. The hashes of the stores of
+// the other types are taken from the commit info as is.
+func (rs *Store) VerifyState(version int64, parallelism int) (*StateVerification, error) {
+	cInfo, err := getCommitInfo(rs.db, version)
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &StateVerification{
+		Version:          version,
+		CommittedAppHash: cInfo.Hash(),
+	}
+	recomputed := &types.CommitInfo{Version: version}
+	for _, storeInfo := range cInfo.StoreInfos {
+		storeVerification := StoreVerification{
+			StoreKey:      storeInfo.Name,
+			Hash:          storeInfo.CommitId.Hash,
+			CommittedHash: storeInfo.CommitId.Hash,
+		}
+
+		key := rs.keysByName[storeInfo.Name]
+		if key == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %s in the commit info is not mounted", storeInfo.Name)
+		}
+		if params := rs.storesParams[key]; params.typ == types.StoreTypeIAVL {
+			hash, mismatch, err := iavl.VerifyTree(rs.getStoreDB(params), version, parallelism)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "store %s", storeInfo.Name)
+			}
+			storeVerification.Hash = hash
+			storeVerification.Mismatch = mismatch
+		}
+
+		verification.Stores = append(verification.Stores, storeVerification)
+		recomputed.StoreInfos = append(recomputed.StoreInfos, types.StoreInfo{
+			Name:     storeInfo.Name,
+			CommitId: types.CommitID{Version: version, Hash: storeVerification.Hash},
+		})
+	}
+	verification.AppHash = recomputed.Hash()
+
+	sort.Slice(verification.Stores, func(i, j int) bool {
+		return verification.Stores[i].StoreKey < verification.Stores[j].StoreKey
+	})
+
+	return verification, nil
+}
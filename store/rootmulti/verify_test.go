@@ -0,0 +1,60 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/types"
+)
+
+func TestMultiStoreVerifyState(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+	ms.GetKVStore(testStoreKey2).Set([]byte("b"), []byte("2"))
+	first := ms.Commit()
+	ms.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("10"))
+	second := ms.Commit()
+
+	for _, id := range []types.CommitID{first, second} {
+		verification, err := ms.VerifyState(id.Version, 2)
+		require.NoError(t, err)
+		require.True(t, verification.Verified())
+		require.Nil(t, verification.FirstMismatch())
+		require.Equal(t, id.Hash, verification.AppHash)
+		require.Equal(t, id.Hash, verification.CommittedAppHash)
+		require.Len(t, verification.Stores, 3)
+		require.Equal(t, testStoreKey1.Name(), verification.Stores[0].StoreKey)
+	}
+
+	_, err := ms.VerifyState(3, 2)
+	require.Error(t, err)
+
+	// corrupt the nodes of store2
+	storeDB := dbm.NewPrefixDB(db, []byte("s/k:"+testStoreKey2.Name()+"/"))
+	iter, err := storeDB.Iterator([]byte("n"), []byte("o"))
+	require.NoError(t, err)
+	var nodeKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		nodeKeys = append(nodeKeys, append([]byte{}, iter.Key()...))
+	}
+	require.NoError(t, iter.Close())
+	require.NotEmpty(t, nodeKeys)
+	for _, key := range nodeKeys {
+		require.NoError(t, storeDB.Delete(key))
+	}
+
+	verification, err := ms.VerifyState(second.Version, 2)
+	require.NoError(t, err)
+	require.False(t, verification.Verified())
+	mismatch := verification.FirstMismatch()
+	require.NotNil(t, mismatch)
+	require.Equal(t, testStoreKey2.Name(), mismatch.StoreKey)
+	require.NotNil(t, mismatch.Mismatch)
+	require.Nil(t, mismatch.Mismatch.Start)
+	require.Nil(t, mismatch.Mismatch.End)
+}
@@ -0,0 +1,1082 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/store/v1beta1/export.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	kv "github.com/line/lbm-sdk/types/kv"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StoreExportItem is an item of the stream of the key/value pairs exported
+// from a store. The stream starts with a header, followed by the pairs in the
+// order of the keys, and ends with a footer.
+type StoreExportItem struct {
+	// Types that are valid to be assigned to Item:
+	//	*StoreExportItem_Header
+	//	*StoreExportItem_Pair
+	//	*StoreExportItem_Footer
+	Item isStoreExportItem_Item `protobuf_oneof:"item"`
+}
+
+func (m *StoreExportItem) Reset()         { *m = StoreExportItem{} }
+func (m *StoreExportItem) String() string { return proto.CompactTextString(m) }
+func (*StoreExportItem) ProtoMessage()    {}
+func (*StoreExportItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66785b5b42a3be84, []int{0}
+}
+func (m *StoreExportItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreExportItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreExportItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreExportItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreExportItem.Merge(m, src)
+}
+func (m *StoreExportItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreExportItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreExportItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreExportItem proto.InternalMessageInfo
+
+type isStoreExportItem_Item interface {
+	isStoreExportItem_Item()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StoreExportItem_Header struct {
+	Header *StoreExportHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof" json:"header,omitempty"`
+}
+type StoreExportItem_Pair struct {
+	Pair *kv.Pair `protobuf:"bytes,2,opt,name=pair,proto3,oneof" json:"pair,omitempty"`
+}
+type StoreExportItem_Footer struct {
+	Footer *StoreExportFooter `protobuf:"bytes,3,opt,name=footer,proto3,oneof" json:"footer,omitempty"`
+}
+
+func (*StoreExportItem_Header) isStoreExportItem_Item() {}
+func (*StoreExportItem_Pair) isStoreExportItem_Item()   {}
+func (*StoreExportItem_Footer) isStoreExportItem_Item() {}
+
+func (m *StoreExportItem) GetItem() isStoreExportItem_Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *StoreExportItem) GetHeader() *StoreExportHeader {
+	if x, ok := m.GetItem().(*StoreExportItem_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (m *StoreExportItem) GetPair() *kv.Pair {
+	if x, ok := m.GetItem().(*StoreExportItem_Pair); ok {
+		return x.Pair
+	}
+	return nil
+}
+
+func (m *StoreExportItem) GetFooter() *StoreExportFooter {
+	if x, ok := m.GetItem().(*StoreExportItem_Footer); ok {
+		return x.Footer
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StoreExportItem) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StoreExportItem_Header)(nil),
+		(*StoreExportItem_Pair)(nil),
+		(*StoreExportItem_Footer)(nil),
+	}
+}
+
+// StoreExportHeader contains the store and the key range [start, end) the
+// pairs have been exported from. An empty start or end means the range is
+// unbounded on that side.
+type StoreExportHeader struct {
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	Version  int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Start    []byte `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End      []byte `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *StoreExportHeader) Reset()         { *m = StoreExportHeader{} }
+func (m *StoreExportHeader) String() string { return proto.CompactTextString(m) }
+func (*StoreExportHeader) ProtoMessage()    {}
+func (*StoreExportHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66785b5b42a3be84, []int{1}
+}
+func (m *StoreExportHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreExportHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreExportHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreExportHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreExportHeader.Merge(m, src)
+}
+func (m *StoreExportHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreExportHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreExportHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreExportHeader proto.InternalMessageInfo
+
+func (m *StoreExportHeader) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreExportHeader) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *StoreExportHeader) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *StoreExportHeader) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+// StoreExportFooter contains the number of the exported pairs and their
+// SHA-256 checksum, taken over the length-delimited encoding of the pairs.
+type StoreExportFooter struct {
+	Count    uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *StoreExportFooter) Reset()         { *m = StoreExportFooter{} }
+func (m *StoreExportFooter) String() string { return proto.CompactTextString(m) }
+func (*StoreExportFooter) ProtoMessage()    {}
+func (*StoreExportFooter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66785b5b42a3be84, []int{2}
+}
+func (m *StoreExportFooter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreExportFooter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreExportFooter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreExportFooter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreExportFooter.Merge(m, src)
+}
+func (m *StoreExportFooter) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreExportFooter) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreExportFooter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreExportFooter proto.InternalMessageInfo
+
+func (m *StoreExportFooter) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *StoreExportFooter) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StoreExportItem)(nil), "cosmos.base.store.v1beta1.StoreExportItem")
+	proto.RegisterType((*StoreExportHeader)(nil), "cosmos.base.store.v1beta1.StoreExportHeader")
+	proto.RegisterType((*StoreExportFooter)(nil), "cosmos.base.store.v1beta1.StoreExportFooter")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/store/v1beta1/export.proto", fileDescriptor_66785b5b42a3be84)
+}
+
+var fileDescriptor_66785b5b42a3be84 = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x4b, 0xc3, 0x40,
+	0x1c, 0xc5, 0x13, 0x1b, 0x6b, 0x7b, 0x16, 0xb4, 0x87, 0x43, 0xac, 0x12, 0xa5, 0x82, 0x38, 0xe8,
+	0x85, 0xd6, 0xd9, 0xa5, 0xd0, 0x52, 0x71, 0x91, 0xb8, 0xb9, 0x48, 0x92, 0xfe, 0xb5, 0x21, 0x4d,
+	0x2e, 0xdc, 0x5d, 0x83, 0xfd, 0x16, 0x7e, 0x2c, 0xc7, 0x8e, 0x2e, 0x82, 0xb4, 0x5f, 0x44, 0xee,
+	0x9f, 0x1a, 0x2a, 0xc5, 0xc1, 0x2d, 0xef, 0xcf, 0xfb, 0xbd, 0xf7, 0x42, 0x42, 0xce, 0x43, 0x2e,
+	0x13, 0x2e, 0xdd, 0xc0, 0x97, 0xe0, 0x4a, 0xc5, 0x05, 0xb8, 0x79, 0x27, 0x00, 0xe5, 0x77, 0x5c,
+	0x78, 0xcd, 0xb8, 0x50, 0x2c, 0x13, 0x5c, 0x71, 0x7a, 0x58, 0xf8, 0x98, 0xf6, 0x31, 0xf4, 0xb1,
+	0x95, 0xaf, 0x75, 0xb2, 0x1e, 0x11, 0xe7, 0x25, 0x1f, 0xe7, 0x05, 0xdb, 0xfe, 0x34, 0xc9, 0xde,
+	0x83, 0x46, 0xfa, 0x98, 0x78, 0xab, 0x20, 0xa1, 0x03, 0x52, 0x1d, 0x83, 0x3f, 0x02, 0x61, 0x9b,
+	0xa7, 0xe6, 0xc5, 0x6e, 0xf7, 0x92, 0xfd, 0x59, 0xc0, 0xd6, 0xd8, 0x21, 0x32, 0x43, 0xc3, 0x5b,
+	0xd1, 0xb4, 0x4b, 0xac, 0xcc, 0x8f, 0x84, 0xbd, 0x85, 0x29, 0xc7, 0xbf, 0x52, 0xe2, 0xbc, 0x8c,
+	0xb8, 0xf7, 0x23, 0x4d, 0xa1, 0x57, 0x77, 0x3f, 0x73, 0xae, 0x40, 0xd8, 0x95, 0xff, 0x74, 0x0f,
+	0x90, 0xd1, 0xdd, 0x05, 0xdd, 0xab, 0x12, 0x2b, 0x52, 0x90, 0xb4, 0x05, 0x69, 0x6e, 0x4c, 0xa4,
+	0x47, 0xa4, 0x8e, 0x49, 0x4f, 0x31, 0xcc, 0xf0, 0x1d, 0xeb, 0x5e, 0x0d, 0x0f, 0x77, 0x30, 0xa3,
+	0x36, 0xd9, 0xc9, 0x41, 0xc8, 0x88, 0xa7, 0x38, 0xbc, 0xe2, 0xfd, 0x48, 0x7a, 0x40, 0xb6, 0xa5,
+	0xf2, 0x85, 0xc2, 0x69, 0x0d, 0xaf, 0x10, 0x74, 0x9f, 0x54, 0x20, 0x1d, 0xd9, 0x16, 0xde, 0xf4,
+	0x63, 0xbb, 0x4f, 0x9a, 0x1b, 0xd3, 0x34, 0x1c, 0xf2, 0x69, 0xaa, 0xb0, 0xcf, 0xf2, 0x0a, 0x41,
+	0x5b, 0xa4, 0x16, 0x8e, 0x21, 0x8c, 0xe5, 0x34, 0xc1, 0xb6, 0x86, 0x57, 0xea, 0xde, 0xcd, 0xfb,
+	0xc2, 0x31, 0xe7, 0x0b, 0xc7, 0xfc, 0x5a, 0x38, 0xe6, 0xdb, 0xd2, 0x31, 0xe6, 0x4b, 0xc7, 0xf8,
+	0x58, 0x3a, 0xc6, 0xe3, 0xd9, 0x4b, 0xa4, 0xc6, 0xd3, 0x80, 0x85, 0x3c, 0x71, 0x27, 0x51, 0x0a,
+	0xee, 0x24, 0x48, 0xae, 0xe4, 0x28, 0x5e, 0xfd, 0x24, 0x6a, 0x96, 0x81, 0x0c, 0xaa, 0xf8, 0x81,
+	0xaf, 0xbf, 0x07, 0x00, 0x4d, 0x5a, 0xa2, 0x00, 0x46, 0x02, 0x00, 0x00,
+}
+
+func (m *StoreExportItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreExportItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreExportItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Item != nil {
+		{
+			size := m.Item.Size()
+			i -= size
+			if _, err := m.Item.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreExportItem_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreExportItem_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StoreExportItem_Pair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreExportItem_Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Pair != nil {
+		{
+			size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StoreExportItem_Footer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreExportItem_Footer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Footer != nil {
+		{
+			size, err := m.Footer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StoreExportHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreExportHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreExportHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintExport(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintExport(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintExport(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintExport(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreExportFooter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreExportFooter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreExportFooter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintExport(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Count != 0 {
+		i = encodeVarintExport(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExport(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExport(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StoreExportItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		n += m.Item.Size()
+	}
+	return n
+}
+
+func (m *StoreExportItem_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovExport(uint64(l))
+	}
+	return n
+}
+func (m *StoreExportItem_Pair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pair != nil {
+		l = m.Pair.Size()
+		n += 1 + l + sovExport(uint64(l))
+	}
+	return n
+}
+func (m *StoreExportItem_Footer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Footer != nil {
+		l = m.Footer.Size()
+		n += 1 + l + sovExport(uint64(l))
+	}
+	return n
+}
+func (m *StoreExportHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovExport(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovExport(uint64(m.Version))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovExport(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovExport(uint64(l))
+	}
+	return n
+}
+
+func (m *StoreExportFooter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovExport(uint64(m.Count))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovExport(uint64(l))
+	}
+	return n
+}
+
+func sovExport(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExport(x uint64) (n int) {
+	return sovExport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StoreExportItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreExportItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreExportItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StoreExportHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &StoreExportItem_Header{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &kv.Pair{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &StoreExportItem_Pair{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Footer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StoreExportFooter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &StoreExportItem_Footer{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreExportHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreExportHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreExportHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreExportFooter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreExportFooter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreExportFooter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExport(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExport
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExport
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExport
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExport
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExport        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExport          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExport = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,999 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/store/v1beta1/inter_block_cache.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InterBlockCacheStats defines the statistics of the inter-block cache.
+type InterBlockCacheStats struct {
+	// shared is the statistics of the cache shared by the stores without their
+	// own cache sizes.
+	Shared CacheStats `protobuf:"bytes,1,opt,name=shared,proto3" json:"shared"`
+	// stores is the statistics of the stores, in the order of their names.
+	Stores []StoreCacheStats `protobuf:"bytes,2,rep,name=stores,proto3" json:"stores"`
+}
+
+func (m *InterBlockCacheStats) Reset()         { *m = InterBlockCacheStats{} }
+func (m *InterBlockCacheStats) String() string { return proto.CompactTextString(m) }
+func (*InterBlockCacheStats) ProtoMessage()    {}
+func (*InterBlockCacheStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3fc4d076ed4e615, []int{0}
+}
+func (m *InterBlockCacheStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterBlockCacheStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterBlockCacheStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterBlockCacheStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterBlockCacheStats.Merge(m, src)
+}
+func (m *InterBlockCacheStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterBlockCacheStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterBlockCacheStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterBlockCacheStats proto.InternalMessageInfo
+
+func (m *InterBlockCacheStats) GetShared() CacheStats {
+	if m != nil {
+		return m.Shared
+	}
+	return CacheStats{}
+}
+
+func (m *InterBlockCacheStats) GetStores() []StoreCacheStats {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+// CacheStats defines the statistics of a cache.
+type CacheStats struct {
+	Entries      uint64 `protobuf:"varint,1,opt,name=entries,proto3" json:"entries,omitempty"`
+	BytesSize    uint64 `protobuf:"varint,2,opt,name=bytes_size,json=bytesSize,proto3" json:"bytes_size,omitempty"`
+	MaxBytesSize uint64 `protobuf:"varint,3,opt,name=max_bytes_size,json=maxBytesSize,proto3" json:"max_bytes_size,omitempty"`
+	// evictions is the estimated number of the entries evicted to make room for
+	// the new ones.
+	Evictions uint64 `protobuf:"varint,4,opt,name=evictions,proto3" json:"evictions,omitempty"`
+}
+
+func (m *CacheStats) Reset()         { *m = CacheStats{} }
+func (m *CacheStats) String() string { return proto.CompactTextString(m) }
+func (*CacheStats) ProtoMessage()    {}
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3fc4d076ed4e615, []int{1}
+}
+func (m *CacheStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CacheStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheStats.Merge(m, src)
+}
+func (m *CacheStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheStats proto.InternalMessageInfo
+
+func (m *CacheStats) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *CacheStats) GetBytesSize() uint64 {
+	if m != nil {
+		return m.BytesSize
+	}
+	return 0
+}
+
+func (m *CacheStats) GetMaxBytesSize() uint64 {
+	if m != nil {
+		return m.MaxBytesSize
+	}
+	return 0
+}
+
+func (m *CacheStats) GetEvictions() uint64 {
+	if m != nil {
+		return m.Evictions
+	}
+	return 0
+}
+
+// StoreCacheStats defines the statistics of the inter-block cache of a store.
+type StoreCacheStats struct {
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	Hits     uint64 `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses   uint64 `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	// dedicated defines if the store has its own cache, whose statistics are in
+	// cache. Otherwise, the store is on the shared cache.
+	Dedicated bool       `protobuf:"varint,4,opt,name=dedicated,proto3" json:"dedicated,omitempty"`
+	Cache     CacheStats `protobuf:"bytes,5,opt,name=cache,proto3" json:"cache"`
+}
+
+func (m *StoreCacheStats) Reset()         { *m = StoreCacheStats{} }
+func (m *StoreCacheStats) String() string { return proto.CompactTextString(m) }
+func (*StoreCacheStats) ProtoMessage()    {}
+func (*StoreCacheStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3fc4d076ed4e615, []int{2}
+}
+func (m *StoreCacheStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreCacheStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreCacheStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreCacheStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreCacheStats.Merge(m, src)
+}
+func (m *StoreCacheStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreCacheStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreCacheStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreCacheStats proto.InternalMessageInfo
+
+func (m *StoreCacheStats) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreCacheStats) GetHits() uint64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *StoreCacheStats) GetMisses() uint64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *StoreCacheStats) GetDedicated() bool {
+	if m != nil {
+		return m.Dedicated
+	}
+	return false
+}
+
+func (m *StoreCacheStats) GetCache() CacheStats {
+	if m != nil {
+		return m.Cache
+	}
+	return CacheStats{}
+}
+
+func init() {
+	proto.RegisterType((*InterBlockCacheStats)(nil), "cosmos.base.store.v1beta1.InterBlockCacheStats")
+	proto.RegisterType((*CacheStats)(nil), "cosmos.base.store.v1beta1.CacheStats")
+	proto.RegisterType((*StoreCacheStats)(nil), "cosmos.base.store.v1beta1.StoreCacheStats")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/store/v1beta1/inter_block_cache.proto", fileDescriptor_e3fc4d076ed4e615)
+}
+
+var fileDescriptor_e3fc4d076ed4e615 = []byte{
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x33, 0xf7, 0xe6, 0xc6, 0x9b, 0x73, 0x45, 0x61, 0xb8, 0x48, 0xfc, 0x17, 0x2f, 0x55,
+	0xe1, 0x22, 0x98, 0xd0, 0xeb, 0xda, 0x85, 0xe9, 0x46, 0x71, 0x97, 0xee, 0xdc, 0x84, 0x49, 0x72,
+	0x68, 0x86, 0x36, 0x99, 0x92, 0x33, 0x96, 0xa6, 0x6f, 0xe0, 0xce, 0x97, 0xf0, 0x29, 0x7c, 0x81,
+	0x2e, 0xbb, 0x74, 0x25, 0xd2, 0xbe, 0x88, 0x64, 0x12, 0xdb, 0x22, 0x2a, 0xb8, 0x9b, 0xf3, 0xcd,
+	0xf7, 0xfb, 0xe6, 0x83, 0x39, 0x30, 0xcc, 0x14, 0x95, 0x8a, 0xc2, 0x54, 0x10, 0x86, 0xa4, 0x55,
+	0x8d, 0xe1, 0x62, 0x98, 0xa2, 0x16, 0xc3, 0x50, 0x56, 0x1a, 0xeb, 0x24, 0x9d, 0xa9, 0x6c, 0x9a,
+	0x64, 0x22, 0x2b, 0x30, 0x98, 0xd7, 0x4a, 0x2b, 0x7e, 0xbf, 0x43, 0x82, 0x16, 0x09, 0x0c, 0x12,
+	0xf4, 0xc8, 0x83, 0xcb, 0x89, 0x9a, 0x28, 0xe3, 0x0a, 0xdb, 0x53, 0x07, 0x0c, 0xbe, 0x30, 0xb8,
+	0x7c, 0xd7, 0x86, 0x45, 0x6d, 0xd6, 0xa8, 0x8d, 0x1a, 0x6b, 0xa1, 0x89, 0x8f, 0xc0, 0xa1, 0x42,
+	0xd4, 0x98, 0x7b, 0xec, 0x8a, 0x5d, 0x5f, 0xdc, 0x3c, 0x0f, 0xfe, 0x1a, 0x1d, 0x1c, 0xb0, 0xc8,
+	0x5e, 0x7f, 0x7f, 0x62, 0xc5, 0x3d, 0xca, 0xdf, 0x82, 0x63, 0x9c, 0xe4, 0x9d, 0x5c, 0x9d, 0x5e,
+	0x5f, 0xdc, 0xbc, 0xf8, 0x47, 0xc8, 0xb8, 0x9d, 0xfe, 0x90, 0x64, 0xf8, 0xc1, 0x27, 0x06, 0x70,
+	0xd4, 0xce, 0x83, 0x5b, 0x58, 0xe9, 0x5a, 0x22, 0x99, 0x7a, 0x76, 0xfc, 0x6b, 0xe4, 0x8f, 0x01,
+	0xd2, 0x46, 0x23, 0x25, 0x24, 0x57, 0xe8, 0x9d, 0x98, 0x4b, 0xd7, 0x28, 0x63, 0xb9, 0x42, 0xfe,
+	0x0c, 0xee, 0x94, 0x62, 0x99, 0x1c, 0x59, 0x4e, 0x8d, 0xe5, 0x76, 0x29, 0x96, 0xd1, 0xde, 0xf5,
+	0x08, 0x5c, 0x5c, 0xc8, 0x4c, 0x4b, 0x55, 0x91, 0x67, 0x77, 0x19, 0x7b, 0x61, 0xf0, 0x95, 0xc1,
+	0xdd, 0xdf, 0xda, 0xf2, 0x87, 0xe0, 0x9a, 0xa6, 0xc9, 0x14, 0x1b, 0x53, 0xc9, 0x8d, 0xcf, 0x8d,
+	0xf0, 0x1e, 0x1b, 0xce, 0xc1, 0x2e, 0xa4, 0xa6, 0xbe, 0x8d, 0x39, 0xf3, 0x7b, 0xe0, 0x94, 0x92,
+	0x08, 0xa9, 0x2f, 0xd0, 0x4f, 0xed, 0xd3, 0x39, 0xe6, 0x32, 0x13, 0x1a, 0x73, 0xf3, 0xf4, 0x79,
+	0x7c, 0x10, 0xf8, 0x1b, 0x38, 0x33, 0xdf, 0xed, 0x9d, 0xfd, 0xff, 0xa7, 0x74, 0x64, 0xf4, 0x7a,
+	0xbd, 0xf5, 0xd9, 0x66, 0xeb, 0xb3, 0x1f, 0x5b, 0x9f, 0x7d, 0xde, 0xf9, 0xd6, 0x66, 0xe7, 0x5b,
+	0xdf, 0x76, 0xbe, 0xf5, 0xe1, 0xe9, 0x44, 0xea, 0xe2, 0x63, 0x1a, 0x64, 0xaa, 0x0c, 0x67, 0xb2,
+	0xc2, 0x70, 0x96, 0x96, 0x2f, 0x29, 0x9f, 0xf6, 0xbb, 0xa7, 0x9b, 0x39, 0x52, 0xea, 0x98, 0xbd,
+	0x79, 0xf5, 0x73, 0x00, 0xd3, 0x4d, 0x92, 0x27, 0x9d, 0x02, 0x00, 0x00,
+}
+
+func (m *InterBlockCacheStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterBlockCacheStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterBlockCacheStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterBlockCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Shared.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInterBlockCache(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evictions != 0 {
+		i = encodeVarintInterBlockCache(dAtA, i, uint64(m.Evictions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBytesSize != 0 {
+		i = encodeVarintInterBlockCache(dAtA, i, uint64(m.MaxBytesSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BytesSize != 0 {
+		i = encodeVarintInterBlockCache(dAtA, i, uint64(m.BytesSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Entries != 0 {
+		i = encodeVarintInterBlockCache(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreCacheStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreCacheStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreCacheStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cache.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInterBlockCache(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Dedicated {
+		i--
+		if m.Dedicated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Misses != 0 {
+		i = encodeVarintInterBlockCache(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Hits != 0 {
+		i = encodeVarintInterBlockCache(dAtA, i, uint64(m.Hits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintInterBlockCache(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterBlockCache(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterBlockCache(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterBlockCacheStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shared.Size()
+	n += 1 + l + sovInterBlockCache(uint64(l))
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovInterBlockCache(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CacheStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entries != 0 {
+		n += 1 + sovInterBlockCache(uint64(m.Entries))
+	}
+	if m.BytesSize != 0 {
+		n += 1 + sovInterBlockCache(uint64(m.BytesSize))
+	}
+	if m.MaxBytesSize != 0 {
+		n += 1 + sovInterBlockCache(uint64(m.MaxBytesSize))
+	}
+	if m.Evictions != 0 {
+		n += 1 + sovInterBlockCache(uint64(m.Evictions))
+	}
+	return n
+}
+
+func (m *StoreCacheStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovInterBlockCache(uint64(l))
+	}
+	if m.Hits != 0 {
+		n += 1 + sovInterBlockCache(uint64(m.Hits))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovInterBlockCache(uint64(m.Misses))
+	}
+	if m.Dedicated {
+		n += 2
+	}
+	l = m.Cache.Size()
+	n += 1 + l + sovInterBlockCache(uint64(l))
+	return n
+}
+
+func sovInterBlockCache(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterBlockCache(x uint64) (n int) {
+	return sovInterBlockCache(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterBlockCacheStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterBlockCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterBlockCacheStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterBlockCacheStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shared.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, StoreCacheStats{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterBlockCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterBlockCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			m.Entries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesSize", wireType)
+			}
+			m.BytesSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytesSize", wireType)
+			}
+			m.MaxBytesSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytesSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evictions", wireType)
+			}
+			m.Evictions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Evictions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterBlockCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreCacheStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterBlockCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreCacheStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreCacheStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
+			}
+			m.Hits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dedicated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Dedicated = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterBlockCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterBlockCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInterBlockCache(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInterBlockCache
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterBlockCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInterBlockCache
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInterBlockCache
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInterBlockCache
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInterBlockCache        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInterBlockCache          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInterBlockCache = fmt.Errorf("proto: unexpected end of group")
+)
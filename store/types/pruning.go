@@ -40,6 +40,16 @@ type PruningOptions struct {
 	Interval uint64
 }
 
+// StorePruningOptions defines the pruning strategy of a single store, which
+// overrides the one of the multistore for the store.
+type StorePruningOptions struct {
+	PruningOptions
+
+	// Archive defines whether the pruned heights of the store are offloaded
+	// into the archive of the multistore, which historical queries fall back to.
+	Archive bool
+}
+
 func NewPruningOptions(keepRecent, keepEvery, interval uint64) PruningOptions {
 	return PruningOptions{
 		KeepRecent: keepRecent,
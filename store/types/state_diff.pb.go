@@ -0,0 +1,1264 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/store/v1beta1/state_diff.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StateDiffRequest defines the request of the state diff of a store between
+// two committed versions, restricted to the key range [start, end).
+type StateDiffRequest struct {
+	FromVersion int64 `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion   int64 `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// start is the inclusive start of the key range; empty means the first key.
+	Start []byte `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the exclusive end of the key range; empty means after the last key.
+	End []byte `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// limit is the maximum number of changes to return; 0 means the default limit.
+	// It is capped by the maximum limit of the node.
+	Limit uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *StateDiffRequest) Reset()         { *m = StateDiffRequest{} }
+func (m *StateDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*StateDiffRequest) ProtoMessage()    {}
+func (*StateDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e09185dfcb403e, []int{0}
+}
+func (m *StateDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiffRequest.Merge(m, src)
+}
+func (m *StateDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateDiffRequest proto.InternalMessageInfo
+
+func (m *StateDiffRequest) GetFromVersion() int64 {
+	if m != nil {
+		return m.FromVersion
+	}
+	return 0
+}
+
+func (m *StateDiffRequest) GetToVersion() int64 {
+	if m != nil {
+		return m.ToVersion
+	}
+	return 0
+}
+
+func (m *StateDiffRequest) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *StateDiffRequest) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *StateDiffRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// StateChange is a change of a single key between two versions of a store.
+// old_value is empty for an added key, and new_value is empty for a deleted key.
+type StateChange struct {
+	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	OldValue []byte `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue []byte `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *StateChange) Reset()         { *m = StateChange{} }
+func (m *StateChange) String() string { return proto.CompactTextString(m) }
+func (*StateChange) ProtoMessage()    {}
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e09185dfcb403e, []int{1}
+}
+func (m *StateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChange.Merge(m, src)
+}
+func (m *StateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChange proto.InternalMessageInfo
+
+func (m *StateChange) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateChange) GetOldValue() []byte {
+	if m != nil {
+		return m.OldValue
+	}
+	return nil
+}
+
+func (m *StateChange) GetNewValue() []byte {
+	if m != nil {
+		return m.NewValue
+	}
+	return nil
+}
+
+// StateDiff is the state diff of a store between two committed versions.
+type StateDiff struct {
+	StoreKey    string        `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	FromVersion int64         `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion   int64         `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	Added       []StateChange `protobuf:"bytes,4,rep,name=added,proto3" json:"added"`
+	Updated     []StateChange `protobuf:"bytes,5,rep,name=updated,proto3" json:"updated"`
+	Deleted     []StateChange `protobuf:"bytes,6,rep,name=deleted,proto3" json:"deleted"`
+	// next_key is the key to start the following request from, to continue the
+	// diff truncated by the limit or by the maximum number of keys walked in a
+	// request. It is empty if the whole range has been walked. A truncated diff
+	// may have no changes at all.
+	NextKey []byte `protobuf:"bytes,7,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *StateDiff) Reset()         { *m = StateDiff{} }
+func (m *StateDiff) String() string { return proto.CompactTextString(m) }
+func (*StateDiff) ProtoMessage()    {}
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e09185dfcb403e, []int{2}
+}
+func (m *StateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiff.Merge(m, src)
+}
+func (m *StateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateDiff proto.InternalMessageInfo
+
+func (m *StateDiff) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StateDiff) GetFromVersion() int64 {
+	if m != nil {
+		return m.FromVersion
+	}
+	return 0
+}
+
+func (m *StateDiff) GetToVersion() int64 {
+	if m != nil {
+		return m.ToVersion
+	}
+	return 0
+}
+
+func (m *StateDiff) GetAdded() []StateChange {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *StateDiff) GetUpdated() []StateChange {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+func (m *StateDiff) GetDeleted() []StateChange {
+	if m != nil {
+		return m.Deleted
+	}
+	return nil
+}
+
+func (m *StateDiff) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StateDiffRequest)(nil), "cosmos.base.store.v1beta1.StateDiffRequest")
+	proto.RegisterType((*StateChange)(nil), "cosmos.base.store.v1beta1.StateChange")
+	proto.RegisterType((*StateDiff)(nil), "cosmos.base.store.v1beta1.StateDiff")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/store/v1beta1/state_diff.proto", fileDescriptor_e4e09185dfcb403e)
+}
+
+var fileDescriptor_e4e09185dfcb403e = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x33, 0x4d, 0xb3, 0x4d, 0xa6, 0x39, 0x2c, 0x61, 0x0f, 0x59, 0xc5, 0x58, 0x2b, 0x48,
+	0x10, 0x4c, 0x58, 0x3d, 0x7b, 0xa9, 0xe2, 0x65, 0x6f, 0x23, 0x2c, 0xe8, 0x25, 0x4c, 0x76, 0xde,
+	0x64, 0xc3, 0x26, 0x99, 0x9a, 0x99, 0xb4, 0xe6, 0x5b, 0x88, 0x9f, 0xaa, 0xc7, 0x1e, 0xf5, 0x22,
+	0xd2, 0x7e, 0x11, 0x99, 0x49, 0x5a, 0x44, 0x11, 0xa1, 0xb7, 0xf7, 0xcf, 0xef, 0x7d, 0x66, 0xde,
+	0x67, 0x06, 0x3f, 0xbf, 0xe5, 0xa2, 0xe2, 0x22, 0x4e, 0xa9, 0x80, 0x58, 0x48, 0xde, 0x40, 0xbc,
+	0xba, 0x4a, 0x41, 0xd2, 0xab, 0x58, 0x48, 0x2a, 0x21, 0x61, 0x45, 0x96, 0x45, 0xcb, 0x86, 0x4b,
+	0xee, 0x5d, 0xf6, 0x6c, 0xa4, 0xd8, 0x48, 0xb3, 0xd1, 0xc0, 0x3e, 0xb8, 0xc8, 0x79, 0xce, 0x35,
+	0x15, 0xab, 0xa8, 0x1f, 0x98, 0x7f, 0x45, 0xf8, 0xfc, 0xbd, 0x52, 0x79, 0x5b, 0x64, 0x19, 0x81,
+	0x4f, 0x2d, 0x08, 0xe9, 0x3d, 0xc1, 0x6e, 0xd6, 0xf0, 0x2a, 0x59, 0x41, 0x23, 0x0a, 0x5e, 0xfb,
+	0x68, 0x86, 0x42, 0x93, 0x4c, 0x55, 0xed, 0xa6, 0x2f, 0x79, 0x8f, 0x30, 0x96, 0xfc, 0x08, 0x8c,
+	0x34, 0xe0, 0x48, 0x7e, 0x68, 0x5f, 0x60, 0x4b, 0x48, 0xda, 0x48, 0xdf, 0x9c, 0xa1, 0xd0, 0x25,
+	0x7d, 0xe2, 0x9d, 0x63, 0x13, 0x6a, 0xe6, 0x8f, 0x75, 0x4d, 0x85, 0x8a, 0x2b, 0x8b, 0xaa, 0x90,
+	0xbe, 0x35, 0x43, 0xe1, 0x98, 0xf4, 0xc9, 0xfc, 0x03, 0x9e, 0xea, 0x3b, 0xbd, 0xb9, 0xa3, 0x75,
+	0x0e, 0x6a, 0xec, 0x1e, 0x3a, 0x7d, 0x0b, 0x97, 0xa8, 0xd0, 0x7b, 0x88, 0x1d, 0x5e, 0xb2, 0x64,
+	0x45, 0xcb, 0x16, 0xf4, 0xe1, 0x2e, 0xb1, 0x79, 0xc9, 0x6e, 0x54, 0xae, 0x9a, 0x35, 0xac, 0x87,
+	0x66, 0x7f, 0xbe, 0x5d, 0xc3, 0x5a, 0x37, 0xe7, 0xdf, 0x47, 0xd8, 0x39, 0xee, 0xab, 0x50, 0x6d,
+	0x52, 0x72, 0xd0, 0x77, 0x88, 0xad, 0x0b, 0xd7, 0xd0, 0xfd, 0xe5, 0xc2, 0xe8, 0x7f, 0x2e, 0x98,
+	0x7f, 0xba, 0xb0, 0xc0, 0x16, 0x65, 0x0c, 0xd4, 0xc6, 0x66, 0x38, 0x7d, 0xf9, 0x2c, 0xfa, 0xe7,
+	0xeb, 0x44, 0xbf, 0xed, 0xbb, 0x18, 0x6f, 0x7e, 0x3c, 0x36, 0x48, 0x3f, 0xea, 0xbd, 0xc3, 0x93,
+	0x76, 0xc9, 0xa8, 0x04, 0xe6, 0x5b, 0x27, 0xa8, 0x1c, 0x86, 0x95, 0x0e, 0x83, 0x12, 0x94, 0xce,
+	0xd9, 0x29, 0x3a, 0xc3, 0xb0, 0x77, 0x89, 0xed, 0x1a, 0x3e, 0x4b, 0xed, 0xd8, 0x44, 0x9b, 0x3b,
+	0x51, 0xf9, 0x35, 0x74, 0x8b, 0xd7, 0x9b, 0x5d, 0x80, 0xb6, 0xbb, 0x00, 0xfd, 0xdc, 0x05, 0xe8,
+	0xcb, 0x3e, 0x30, 0xb6, 0xfb, 0xc0, 0xf8, 0xb6, 0x0f, 0x8c, 0x8f, 0x4f, 0xf3, 0x42, 0xde, 0xb5,
+	0x69, 0x74, 0xcb, 0xab, 0xb8, 0x2c, 0x6a, 0x88, 0xcb, 0xb4, 0x7a, 0x21, 0xd8, 0xfd, 0xf0, 0x9d,
+	0x65, 0xb7, 0x04, 0x91, 0x9e, 0xe9, 0x1f, 0xf9, 0xea, 0xd7, 0x00, 0x0b, 0xbc, 0x08, 0xbe, 0xf0,
+	0x02, 0x00, 0x00,
+}
+
+func (m *StateDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToVersion != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.ToVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromVersion != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.FromVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Deleted) > 0 {
+		for iNdEx := len(m.Deleted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deleted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Updated) > 0 {
+		for iNdEx := len(m.Updated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Added[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ToVersion != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.ToVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromVersion != 0 {
+		i = encodeVarintStateDiff(dAtA, i, uint64(m.FromVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintStateDiff(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStateDiff(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStateDiff(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StateDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromVersion != 0 {
+		n += 1 + sovStateDiff(uint64(m.FromVersion))
+	}
+	if m.ToVersion != 0 {
+		n += 1 + sovStateDiff(uint64(m.ToVersion))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovStateDiff(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *StateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	return n
+}
+
+func (m *StateDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	if m.FromVersion != 0 {
+		n += 1 + sovStateDiff(uint64(m.FromVersion))
+	}
+	if m.ToVersion != 0 {
+		n += 1 + sovStateDiff(uint64(m.ToVersion))
+	}
+	if len(m.Added) > 0 {
+		for _, e := range m.Added {
+			l = e.Size()
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if len(m.Updated) > 0 {
+		for _, e := range m.Updated {
+			l = e.Size()
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	if len(m.Deleted) > 0 {
+		for _, e := range m.Deleted {
+			l = e.Size()
+			n += 1 + l + sovStateDiff(uint64(l))
+		}
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovStateDiff(uint64(l))
+	}
+	return n
+}
+
+func sovStateDiff(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStateDiff(x uint64) (n int) {
+	return sovStateDiff(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StateDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			m.FromVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			m.ToVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = append(m.OldValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldValue == nil {
+				m.OldValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = append(m.NewValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewValue == nil {
+				m.NewValue = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			m.FromVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			m.ToVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, StateChange{})
+			if err := m.Added[len(m.Added)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updated = append(m.Updated, StateChange{})
+			if err := m.Updated[len(m.Updated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deleted = append(m.Deleted, StateChange{})
+			if err := m.Deleted[len(m.Deleted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStateDiff(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStateDiff
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateDiff
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStateDiff
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStateDiff
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStateDiff
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStateDiff        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStateDiff          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStateDiff = fmt.Errorf("proto: unexpected end of group")
+)
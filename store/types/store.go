@@ -196,6 +196,14 @@ type CommitMultiStore interface {
 
 	// RollbackToVersion rollback the db to specific version(height).
 	RollbackToVersion(version int64) error
+
+	// SetStorePruning sets the pruning strategy of the store of the given name,
+	// overriding the one of the multistore.
+	SetStorePruning(name string, opts StorePruningOptions)
+
+	// SetPruningArchive sets the db the heights of the stores are archived
+	// into, if their pruning strategies enable the archive.
+	SetPruningArchive(db dbm.DB)
 }
 
 //---------subsp-------------------------------
@@ -430,6 +438,9 @@ type MultiStorePersistentCache interface {
 
 	// Reset the entire set of internal caches.
 	Reset()
+
+	// Return the statistics of the internal caches.
+	Stats() InterBlockCacheStats
 }
 
 // StoreWithInitialVersion is a store that can have an arbitrary initial
@@ -52,6 +52,10 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// Tracing defines the configuration of the OpenTelemetry tracing, which is
+	// independent of the metrics enabled above.
+	Tracing TracingConfig `mapstructure:"tracing"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -0,0 +1,359 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// otlpTracesPath is the default OTLP/HTTP path for trace export requests.
+const otlpTracesPath = "/v1/traces"
+
+// otlpExporter exports spans to an OpenTelemetry collector using the OTLP/HTTP
+// protocol with JSON encoded payloads.
+//
+// NOTE: It stands in for go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp,
+// whose shared otlpconfig package imports google.golang.org/grpc/credentials/insecure
+// even for the HTTP exporter. That package exists since grpc v1.34 only, while go.mod
+// replaces grpc with v1.33.2. Switch to otlptracehttp once the replace is lifted.
+type otlpExporter struct {
+	endpoint string
+	client   *http.Client
+
+	mtx     sync.RWMutex
+	stopped bool
+}
+
+var _ sdktrace.SpanExporter = (*otlpExporter)(nil)
+
+// newOTLPExporter returns an OTLP/HTTP span exporter sending requests to the
+// given collector endpoint.
+func newOTLPExporter(endpoint string) (*otlpExporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid otlp endpoint %q: %w", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid otlp endpoint %q: scheme must be http or https", endpoint)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = otlpTracesPath
+	}
+
+	return &otlpExporter{
+		endpoint: u.String(),
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// ExportSpans implements the SpanExporter interface.
+func (e *otlpExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mtx.RLock()
+	stopped := e.stopped
+	e.mtx.RUnlock()
+	if stopped || len(spans) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(otlpRequestFromSpans(spans))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to export spans: %w", err)
+	}
+	defer res.Body.Close()
+
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("failed to export spans: collector responded with %s", res.Status)
+	}
+
+	return nil
+}
+
+// Shutdown implements the SpanExporter interface.
+func (e *otlpExporter) Shutdown(_ context.Context) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.stopped = true
+	e.client.CloseIdleConnections()
+
+	return nil
+}
+
+// The types below mirror the JSON mapping of the OTLP ExportTraceServiceRequest
+// protobuf message.
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	SchemaURL  string           `json:"schemaUrl,omitempty"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope     otlpScope  `json:"scope"`
+	Spans     []otlpSpan `json:"spans"`
+	SchemaURL string     `json:"schemaUrl,omitempty"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID                string         `json:"traceId"`
+	SpanID                 string         `json:"spanId"`
+	TraceState             string         `json:"traceState,omitempty"`
+	ParentSpanID           string         `json:"parentSpanId,omitempty"`
+	Name                   string         `json:"name"`
+	Kind                   int            `json:"kind"`
+	StartTimeUnixNano      string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano        string         `json:"endTimeUnixNano"`
+	Attributes             []otlpKeyValue `json:"attributes,omitempty"`
+	DroppedAttributesCount int            `json:"droppedAttributesCount,omitempty"`
+	Events                 []otlpEvent    `json:"events,omitempty"`
+	DroppedEventsCount     int            `json:"droppedEventsCount,omitempty"`
+	Links                  []otlpLink     `json:"links,omitempty"`
+	DroppedLinksCount      int            `json:"droppedLinksCount,omitempty"`
+	Status                 otlpStatus     `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano           string         `json:"timeUnixNano"`
+	Name                   string         `json:"name"`
+	Attributes             []otlpKeyValue `json:"attributes,omitempty"`
+	DroppedAttributesCount int            `json:"droppedAttributesCount,omitempty"`
+}
+
+type otlpLink struct {
+	TraceID                string         `json:"traceId"`
+	SpanID                 string         `json:"spanId"`
+	TraceState             string         `json:"traceState,omitempty"`
+	Attributes             []otlpKeyValue `json:"attributes,omitempty"`
+	DroppedAttributesCount int            `json:"droppedAttributesCount,omitempty"`
+}
+
+type otlpStatus struct {
+	Message string `json:"message,omitempty"`
+	Code    int    `json:"code"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+// otlpRequestFromSpans groups the spans by resource and instrumentation scope
+// into an export request.
+func otlpRequestFromSpans(spans []sdktrace.ReadOnlySpan) otlpRequest {
+	type scopeKey struct {
+		res   *resource.Resource
+		scope instrumentation.Scope
+	}
+
+	var (
+		resources []*resource.Resource
+		scopes    = map[*resource.Resource][]instrumentation.Scope{}
+		grouped   = map[scopeKey][]otlpSpan{}
+	)
+
+	for _, s := range spans {
+		res, scope := s.Resource(), s.InstrumentationScope()
+		if _, ok := scopes[res]; !ok {
+			resources = append(resources, res)
+			scopes[res] = nil
+		}
+
+		key := scopeKey{res, scope}
+		if _, ok := grouped[key]; !ok {
+			scopes[res] = append(scopes[res], scope)
+		}
+		grouped[key] = append(grouped[key], otlpSpanFromSpan(s))
+	}
+
+	req := otlpRequest{ResourceSpans: make([]otlpResourceSpans, 0, len(resources))}
+	for _, res := range resources {
+		rs := otlpResourceSpans{
+			Resource:   otlpResource{Attributes: otlpAttributes(res.Attributes())},
+			ScopeSpans: make([]otlpScopeSpans, 0, len(scopes[res])),
+			SchemaURL:  res.SchemaURL(),
+		}
+
+		for _, scope := range scopes[res] {
+			rs.ScopeSpans = append(rs.ScopeSpans, otlpScopeSpans{
+				Scope:     otlpScope{Name: scope.Name, Version: scope.Version},
+				Spans:     grouped[scopeKey{res, scope}],
+				SchemaURL: scope.SchemaURL,
+			})
+		}
+
+		req.ResourceSpans = append(req.ResourceSpans, rs)
+	}
+
+	return req
+}
+
+func otlpSpanFromSpan(s sdktrace.ReadOnlySpan) otlpSpan {
+	sc := s.SpanContext()
+	span := otlpSpan{
+		TraceID:                sc.TraceID().String(),
+		SpanID:                 sc.SpanID().String(),
+		TraceState:             sc.TraceState().String(),
+		Name:                   s.Name(),
+		Kind:                   int(s.SpanKind()),
+		StartTimeUnixNano:      otlpTime(s.StartTime()),
+		EndTimeUnixNano:        otlpTime(s.EndTime()),
+		Attributes:             otlpAttributes(s.Attributes()),
+		DroppedAttributesCount: s.DroppedAttributes(),
+		DroppedEventsCount:     s.DroppedEvents(),
+		DroppedLinksCount:      s.DroppedLinks(),
+		Status:                 otlpStatus{Message: s.Status().Description},
+	}
+
+	if parent := s.Parent(); parent.HasSpanID() {
+		span.ParentSpanID = parent.SpanID().String()
+	}
+
+	// NOTE: the OTLP status codes do not share the values of the API ones.
+	switch s.Status().Code {
+	case codes.Ok:
+		span.Status.Code = 1
+	case codes.Error:
+		span.Status.Code = 2
+	}
+
+	for _, ev := range s.Events() {
+		span.Events = append(span.Events, otlpEvent{
+			TimeUnixNano:           otlpTime(ev.Time),
+			Name:                   ev.Name,
+			Attributes:             otlpAttributes(ev.Attributes),
+			DroppedAttributesCount: ev.DroppedAttributeCount,
+		})
+	}
+
+	for _, link := range s.Links() {
+		span.Links = append(span.Links, otlpLink{
+			TraceID:                link.SpanContext.TraceID().String(),
+			SpanID:                 link.SpanContext.SpanID().String(),
+			TraceState:             link.SpanContext.TraceState().String(),
+			Attributes:             otlpAttributes(link.Attributes),
+			DroppedAttributesCount: link.DroppedAttributeCount,
+		})
+	}
+
+	return span
+}
+
+func otlpTime(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func otlpAttributes(attrs []attribute.KeyValue) []otlpKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	kvs := make([]otlpKeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		kvs = append(kvs, otlpKeyValue{Key: string(attr.Key), Value: otlpValue(attr.Value)})
+	}
+
+	return kvs
+}
+
+func otlpValue(v attribute.Value) otlpAnyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return otlpAnyValue{BoolValue: &b}
+
+	case attribute.INT64:
+		i := strconv.FormatInt(v.AsInt64(), 10)
+		return otlpAnyValue{IntValue: &i}
+
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return otlpAnyValue{DoubleValue: &f}
+
+	case attribute.BOOLSLICE:
+		var values []otlpAnyValue
+		for _, b := range v.AsBoolSlice() {
+			values = append(values, otlpValue(attribute.BoolValue(b)))
+		}
+		return otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}
+
+	case attribute.INT64SLICE:
+		var values []otlpAnyValue
+		for _, i := range v.AsInt64Slice() {
+			values = append(values, otlpValue(attribute.Int64Value(i)))
+		}
+		return otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}
+
+	case attribute.FLOAT64SLICE:
+		var values []otlpAnyValue
+		for _, f := range v.AsFloat64Slice() {
+			values = append(values, otlpValue(attribute.Float64Value(f)))
+		}
+		return otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}
+
+	case attribute.STRINGSLICE:
+		var values []otlpAnyValue
+		for _, s := range v.AsStringSlice() {
+			values = append(values, otlpValue(attribute.StringValue(s)))
+		}
+		return otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}
+
+	default:
+		s := v.Emit()
+		return otlpAnyValue{StringValue: &s}
+	}
+}
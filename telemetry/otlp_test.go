@@ -0,0 +1,91 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestOTLPExporter_Endpoint(t *testing.T) {
+	e, err := newOTLPExporter("http://localhost:4318")
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:4318/v1/traces", e.endpoint)
+
+	e, err = newOTLPExporter("https://collector.example.com/custom/traces")
+	require.NoError(t, err)
+	require.Equal(t, "https://collector.example.com/custom/traces", e.endpoint)
+
+	_, err = newOTLPExporter("localhost:4318")
+	require.Error(t, err)
+}
+
+func TestTracing_OTLP(t *testing.T) {
+	requests := make(chan otlpRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, otlpTracesPath, r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var req otlpRequest
+		require.NoError(t, json.Unmarshal(body, &req))
+		requests <- req
+	}))
+	defer srv.Close()
+
+	tr, err := NewTracing(Config{
+		ServiceName: "test",
+		Tracing: TracingConfig{
+			Enabled:      true,
+			Exporter:     TracingExporterOTLP,
+			OTLPEndpoint: srv.URL,
+			SampleRate:   1,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, parent := StartSpan(context.Background(), "parent", attribute.Int64("height", 3), attribute.Bool("simulate", false))
+	_, child := StartSpan(ctx, "child", attribute.StringSlice("msgs", []string{"a", "b"}))
+	child.End()
+	parent.End()
+
+	require.NoError(t, tr.Shutdown(context.Background()))
+
+	req := <-requests
+	require.Len(t, req.ResourceSpans, 1)
+
+	var serviceName string
+	for _, attr := range req.ResourceSpans[0].Resource.Attributes {
+		if attr.Key == "service.name" {
+			serviceName = *attr.Value.StringValue
+		}
+	}
+	require.Equal(t, "test", serviceName)
+
+	require.Len(t, req.ResourceSpans[0].ScopeSpans, 1)
+	scope := req.ResourceSpans[0].ScopeSpans[0]
+	require.Equal(t, TracerName, scope.Scope.Name)
+	require.Len(t, scope.Spans, 2)
+
+	childSpan, parentSpan := scope.Spans[0], scope.Spans[1]
+	require.Equal(t, "child", childSpan.Name)
+	require.Equal(t, "parent", parentSpan.Name)
+	require.Len(t, parentSpan.TraceID, 32)
+	require.Len(t, parentSpan.SpanID, 16)
+	require.Equal(t, parentSpan.TraceID, childSpan.TraceID)
+	require.Equal(t, parentSpan.SpanID, childSpan.ParentSpanID)
+	require.Empty(t, parentSpan.ParentSpanID)
+
+	require.Equal(t, "height", parentSpan.Attributes[0].Key)
+	require.Equal(t, "3", *parentSpan.Attributes[0].Value.IntValue)
+	require.False(t, *parentSpan.Attributes[1].Value.BoolValue)
+	require.Len(t, childSpan.Attributes[0].Value.ArrayValue.Values, 2)
+}
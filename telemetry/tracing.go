@@ -0,0 +1,188 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation scope name of the spans emitted by the
+// application.
+const TracerName = "github.com/line/lbm-sdk"
+
+// Tracing exporter types.
+const (
+	TracingExporterOTLP   = "otlp"
+	TracingExporterStdout = "stdout"
+	TracingExporterFile   = "file"
+)
+
+// tracingEnabled reports whether a tracer provider has been installed by
+// NewTracing. Instrumented code paths check it before creating spans so that
+// disabled tracing costs nothing beyond a boolean check.
+var tracingEnabled = false
+
+// TracingConfig defines the configuration options for distributed tracing.
+type TracingConfig struct {
+	// Enabled enables OpenTelemetry tracing of ABCI methods, ante decorators,
+	// Msg service handlers and gRPC queries.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Exporter defines where finished spans are sent to. It is one of "otlp",
+	// "stdout" or "file".
+	Exporter string `mapstructure:"exporter"`
+
+	// OTLPEndpoint defines the OTLP/HTTP collector endpoint used by the "otlp"
+	// exporter, e.g. "http://localhost:4318". The "/v1/traces" path is appended
+	// when the endpoint has no path.
+	OTLPEndpoint string `mapstructure:"otlp-endpoint"`
+
+	// File defines the path of the file the "file" exporter appends spans to.
+	File string `mapstructure:"file"`
+
+	// SampleRate defines the fraction of root spans which are sampled, between
+	// 0 and 1. Child spans follow the decision of their parent.
+	SampleRate float64 `mapstructure:"sample-rate"`
+}
+
+// Validate returns an error if the tracing configuration is invalid.
+func (c TracingConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("tracing sample rate must be between 0 and 1: %v", c.SampleRate)
+	}
+
+	switch c.Exporter {
+	case TracingExporterOTLP:
+		if c.OTLPEndpoint == "" {
+			return fmt.Errorf("tracing exporter %s requires an endpoint", c.Exporter)
+		}
+	case TracingExporterStdout:
+	case TracingExporterFile:
+		if c.File == "" {
+			return fmt.Errorf("tracing exporter %s requires a file", c.Exporter)
+		}
+	default:
+		return fmt.Errorf("unsupported tracing exporter: %s", c.Exporter)
+	}
+
+	return nil
+}
+
+// Tracing defines a wrapper around the OpenTelemetry tracer provider installed
+// as the global one by NewTracing.
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+	closer   io.Closer
+}
+
+// NewTracing creates a tracer provider exporting spans as configured by the
+// operator, and installs it as the global one. It returns nil if tracing is
+// disabled.
+func NewTracing(cfg Config) (*Tracing, error) {
+	if !cfg.Tracing.Enabled {
+		return nil, nil
+	}
+
+	if err := cfg.Tracing.Validate(); err != nil {
+		return nil, err
+	}
+
+	t := &Tracing{}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch cfg.Tracing.Exporter {
+	case TracingExporterOTLP:
+		exporter, err = newOTLPExporter(cfg.Tracing.OTLPEndpoint)
+
+	case TracingExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+
+	case TracingExporterFile:
+		var f *os.File
+		f, err = os.OpenFile(cfg.Tracing.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open tracing file: %w", err)
+		}
+
+		t.closer = f
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(f))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = "lbm"
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewSchemaless(attribute.String("service.name", serviceName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	t.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.Tracing.SampleRate))),
+	)
+
+	otel.SetTracerProvider(t.provider)
+	tracingEnabled = true
+
+	return t, nil
+}
+
+// Shutdown flushes the spans which are not exported yet and stops the tracer
+// provider.
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	tracingEnabled = false
+
+	err := t.provider.Shutdown(ctx)
+	if t.closer != nil {
+		if cerr := t.closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+}
+
+// IsTracingEnabled reports whether spans are being recorded.
+func IsTracingEnabled() bool {
+	return tracingEnabled
+}
+
+// StartSpan starts a span with the given name and attributes as a child of the
+// span in ctx, if any. The returned context holds the new span.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span, recording err on it if not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,130 @@
+package telemetry
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestTracing_Disabled(t *testing.T) {
+	tr, err := NewTracing(Config{})
+	require.Nil(t, tr)
+	require.Nil(t, err)
+	require.False(t, IsTracingEnabled())
+}
+
+func TestTracingConfig_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		cfg   TracingConfig
+		valid bool
+	}{
+		"disabled": {
+			cfg:   TracingConfig{Exporter: "invalid"},
+			valid: true,
+		},
+		"otlp": {
+			cfg:   TracingConfig{Enabled: true, Exporter: TracingExporterOTLP, OTLPEndpoint: "http://localhost:4318", SampleRate: 1},
+			valid: true,
+		},
+		"otlp without endpoint": {
+			cfg: TracingConfig{Enabled: true, Exporter: TracingExporterOTLP, SampleRate: 1},
+		},
+		"stdout": {
+			cfg:   TracingConfig{Enabled: true, Exporter: TracingExporterStdout},
+			valid: true,
+		},
+		"file without path": {
+			cfg: TracingConfig{Enabled: true, Exporter: TracingExporterFile, SampleRate: 1},
+		},
+		"unsupported exporter": {
+			cfg: TracingConfig{Enabled: true, Exporter: "jaeger", SampleRate: 1},
+		},
+		"invalid sample rate": {
+			cfg: TracingConfig{Enabled: true, Exporter: TracingExporterStdout, SampleRate: 1.5},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTracing_File(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "traces.json")
+	tr, err := NewTracing(Config{
+		ServiceName: "test",
+		Tracing: TracingConfig{
+			Enabled:    true,
+			Exporter:   TracingExporterFile,
+			File:       file,
+			SampleRate: 1,
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, tr)
+	require.True(t, IsTracingEnabled())
+
+	ctx, parent := StartSpan(context.Background(), "parent", attribute.Int64("height", 1))
+	_, child := StartSpan(ctx, "child")
+	EndSpan(child, errors.New("failure"))
+	EndSpan(parent, nil)
+
+	require.NoError(t, tr.Shutdown(context.Background()))
+	require.False(t, IsTracingEnabled())
+
+	spans := readSpans(t, file)
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, "Error", spans[0].Status.Code)
+	require.Equal(t, "failure", spans[0].Status.Description)
+	require.Equal(t, "parent", spans[1].Name)
+	require.Equal(t, spans[1].SpanContext.SpanID, spans[0].Parent.SpanID)
+	require.Equal(t, spans[1].SpanContext.TraceID, spans[0].SpanContext.TraceID)
+}
+
+type fileSpan struct {
+	Name        string
+	SpanContext struct {
+		TraceID string
+		SpanID  string
+	}
+	Parent struct {
+		SpanID string
+	}
+	Status struct {
+		Code        string
+		Description string
+	}
+}
+
+func readSpans(t *testing.T, file string) []fileSpan {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var spans []fileSpan
+	dec := json.NewDecoder(bufio.NewReader(f))
+	for dec.More() {
+		var span fileSpan
+		require.NoError(t, dec.Decode(&span))
+		spans = append(spans, span)
+	}
+
+	return spans
+}
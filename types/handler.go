@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/line/lbm-sdk/telemetry"
+)
+
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) (*Result, error)
 
@@ -37,8 +44,34 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 
 	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
-		return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+		if !telemetry.IsTracingEnabled() || ctx.Context() == nil {
+			return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+		}
+
+		return traceAnteHandle(chain[0], ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+	}
+}
+
+// traceAnteHandle runs the AnteDecorator within a span named after its type.
+// As decorators call the next AnteHandler themselves, the spans of the
+// decorators further along the chain are nested in this one.
+func traceAnteHandle(decorator AnteDecorator, ctx Context, tx Tx, simulate bool, next AnteHandler) (Context, error) {
+	if _, ok := decorator.(Terminator); ok {
+		return decorator.AnteHandle(ctx, tx, simulate, next)
 	}
+
+	parent := ctx.Context()
+	goCtx, span := telemetry.StartSpan(parent, strings.TrimPrefix(fmt.Sprintf("%T", decorator), "*"))
+	newCtx, err := decorator.AnteHandle(ctx.WithContext(goCtx), tx, simulate, next)
+	telemetry.EndSpan(span, err)
+
+	// Detach the ended span from the returned Context, unless the decorator
+	// replaced the context.Context on its own.
+	if !newCtx.IsZero() && newCtx.Context() == goCtx {
+		newCtx = newCtx.WithContext(parent)
+	}
+
+	return newCtx, err
 }
 
 // Terminator AnteDecorator will get added to the chain to simplify decorator code
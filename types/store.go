@@ -10,7 +10,8 @@ import (
 )
 
 type (
-	PruningOptions = types.PruningOptions
+	PruningOptions      = types.PruningOptions
+	StorePruningOptions = types.StorePruningOptions
 )
 
 type (
@@ -13,6 +13,7 @@ import (
 	query "github.com/line/lbm-sdk/types/query"
 	tx "github.com/line/lbm-sdk/types/tx"
 	types1 "github.com/line/ostracon/proto/ostracon/types"
+	types2 "github.com/tendermint/tendermint/abci/types"
 	types "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -166,11 +167,264 @@ func (m *GetBlockWithTxsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// GetBlockWithTxResultsRequest is the request type for the
+// Service.GetBlockWithTxResults RPC method.
+//
+// Since: lbm-sdk 0.47.0
+type GetBlockWithTxResultsRequest struct {
+	// height is the height of the block to query.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// msg_type_urls filters the txs which have a message of any of the type urls,
+	// if not empty.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// addresses filters the txs which involve any of the addresses, either as a
+	// signer of its messages or as an attribute value of its events, if not empty.
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines a pagination for the request, which applies to the
+	// filtered txs.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetBlockWithTxResultsRequest) Reset()         { *m = GetBlockWithTxResultsRequest{} }
+func (m *GetBlockWithTxResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockWithTxResultsRequest) ProtoMessage()    {}
+func (*GetBlockWithTxResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fc6bd78191bf1b3, []int{2}
+}
+func (m *GetBlockWithTxResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockWithTxResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockWithTxResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockWithTxResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockWithTxResultsRequest.Merge(m, src)
+}
+func (m *GetBlockWithTxResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockWithTxResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockWithTxResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockWithTxResultsRequest proto.InternalMessageInfo
+
+func (m *GetBlockWithTxResultsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockWithTxResultsRequest) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxResultsRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxResultsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// TxWithResult is a tx in a block with its result.
+//
+// Since: lbm-sdk 0.47.0
+type TxWithResult struct {
+	// index is the index of the tx in the block.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// hash is the hash of the tx, in hex.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// tx is the decoded tx.
+	Tx *tx.Tx `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	// result is the result of the execution of the tx.
+	Result *types2.ResponseDeliverTx `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *TxWithResult) Reset()         { *m = TxWithResult{} }
+func (m *TxWithResult) String() string { return proto.CompactTextString(m) }
+func (*TxWithResult) ProtoMessage()    {}
+func (*TxWithResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fc6bd78191bf1b3, []int{3}
+}
+func (m *TxWithResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxWithResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxWithResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxWithResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxWithResult.Merge(m, src)
+}
+func (m *TxWithResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxWithResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxWithResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxWithResult proto.InternalMessageInfo
+
+func (m *TxWithResult) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TxWithResult) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TxWithResult) GetTx() *tx.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *TxWithResult) GetResult() *types2.ResponseDeliverTx {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+// GetBlockWithTxResultsResponse is the response type for the
+// Service.GetBlockWithTxResults method.
+//
+// Since: lbm-sdk 0.47.0
+type GetBlockWithTxResultsResponse struct {
+	// txs are the filtered transactions in the block with their results.
+	Txs     []*TxWithResult `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	BlockId *types.BlockID  `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block   *types1.Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	// begin_block_events are the events emitted in BeginBlock.
+	BeginBlockEvents []types2.Event `protobuf:"bytes,4,rep,name=begin_block_events,json=beginBlockEvents,proto3" json:"begin_block_events"`
+	// end_block_events are the events emitted in EndBlock.
+	EndBlockEvents []types2.Event `protobuf:"bytes,5,rep,name=end_block_events,json=endBlockEvents,proto3" json:"end_block_events"`
+	// pagination defines a pagination for the response, whose total is the
+	// number of the filtered txs.
+	Pagination *query.PageResponse `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetBlockWithTxResultsResponse) Reset()         { *m = GetBlockWithTxResultsResponse{} }
+func (m *GetBlockWithTxResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockWithTxResultsResponse) ProtoMessage()    {}
+func (*GetBlockWithTxResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fc6bd78191bf1b3, []int{4}
+}
+func (m *GetBlockWithTxResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockWithTxResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockWithTxResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockWithTxResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockWithTxResultsResponse.Merge(m, src)
+}
+func (m *GetBlockWithTxResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockWithTxResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockWithTxResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockWithTxResultsResponse proto.InternalMessageInfo
+
+func (m *GetBlockWithTxResultsResponse) GetTxs() []*TxWithResult {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxResultsResponse) GetBlockId() *types.BlockID {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxResultsResponse) GetBlock() *types1.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxResultsResponse) GetBeginBlockEvents() []types2.Event {
+	if m != nil {
+		return m.BeginBlockEvents
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxResultsResponse) GetEndBlockEvents() []types2.Event {
+	if m != nil {
+		return m.EndBlockEvents
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxResultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "lbm.tx.v1beta1.GetBlockWithTxsRequest")
 	golang_proto.RegisterType((*GetBlockWithTxsRequest)(nil), "lbm.tx.v1beta1.GetBlockWithTxsRequest")
 	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "lbm.tx.v1beta1.GetBlockWithTxsResponse")
 	golang_proto.RegisterType((*GetBlockWithTxsResponse)(nil), "lbm.tx.v1beta1.GetBlockWithTxsResponse")
+	proto.RegisterType((*GetBlockWithTxResultsRequest)(nil), "lbm.tx.v1beta1.GetBlockWithTxResultsRequest")
+	golang_proto.RegisterType((*GetBlockWithTxResultsRequest)(nil), "lbm.tx.v1beta1.GetBlockWithTxResultsRequest")
+	proto.RegisterType((*TxWithResult)(nil), "lbm.tx.v1beta1.TxWithResult")
+	golang_proto.RegisterType((*TxWithResult)(nil), "lbm.tx.v1beta1.TxWithResult")
+	proto.RegisterType((*GetBlockWithTxResultsResponse)(nil), "lbm.tx.v1beta1.GetBlockWithTxResultsResponse")
+	golang_proto.RegisterType((*GetBlockWithTxResultsResponse)(nil), "lbm.tx.v1beta1.GetBlockWithTxResultsResponse")
 }
 
 func init() { proto.RegisterFile("lbm/tx/v1beta1/service.proto", fileDescriptor_6fc6bd78191bf1b3) }
@@ -179,37 +433,53 @@ func init() {
 }
 
 var fileDescriptor_6fc6bd78191bf1b3 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0xda, 0xca, 0x14, 0x14, 0x06, 0x5b, 0x63, 0x08, 0x4b, 0x0c, 0xd2, 0x84, 0x8a,
-	0x33, 0x34, 0x7a, 0x17, 0x8a, 0x58, 0x7a, 0x93, 0xb5, 0x20, 0x78, 0x91, 0xdd, 0xcd, 0x63, 0x33,
-	0x74, 0x77, 0x66, 0xbb, 0xf3, 0x52, 0xa6, 0x88, 0x17, 0x7f, 0x80, 0x08, 0xde, 0xfc, 0x35, 0x1e,
-	0x3d, 0x16, 0xbc, 0x78, 0x94, 0xc4, 0xb3, 0xbf, 0x41, 0x76, 0x76, 0xd6, 0xa4, 0xad, 0xd2, 0x4b,
-	0x78, 0xd9, 0xef, 0x7b, 0xdf, 0x9b, 0xef, 0xbd, 0x8f, 0xf6, 0xb2, 0x38, 0x17, 0x68, 0xc5, 0xe9,
-	0x5e, 0x0c, 0x18, 0xed, 0x09, 0x03, 0xe5, 0xa9, 0x4c, 0x80, 0x17, 0xa5, 0x46, 0xcd, 0x6e, 0x67,
-	0x71, 0xce, 0xd1, 0x72, 0x8f, 0x76, 0x7b, 0xa9, 0xd6, 0x69, 0x06, 0x22, 0x2a, 0xa4, 0x88, 0x94,
-	0xd2, 0x18, 0xa1, 0xd4, 0xca, 0xd4, 0xec, 0xee, 0xdd, 0x54, 0xa7, 0xda, 0x95, 0xa2, 0xaa, 0xfc,
-	0xd7, 0x6e, 0xa2, 0x4d, 0xae, 0xcd, 0xea, 0x10, 0xb4, 0x1e, 0xdb, 0xf5, 0x58, 0x1c, 0x19, 0x10,
-	0x27, 0x33, 0x28, 0xcf, 0xfe, 0x72, 0x8a, 0x28, 0x95, 0xca, 0xc9, 0x37, 0x3a, 0xda, 0x60, 0x19,
-	0x25, 0x5a, 0x09, 0x3c, 0x2b, 0xc0, 0x88, 0x38, 0xd3, 0xc9, 0xf1, 0x7f, 0x30, 0xf7, 0xeb, 0xb1,
-	0x1e, 0x82, 0x9a, 0x40, 0x99, 0x4b, 0x85, 0x57, 0xd1, 0x81, 0xa5, 0xdb, 0x07, 0x80, 0xfb, 0x95,
-	0xd6, 0x6b, 0x89, 0xd3, 0x23, 0x6b, 0x42, 0x38, 0x99, 0x81, 0x41, 0xb6, 0x4d, 0xd7, 0xa7, 0x20,
-	0xd3, 0x29, 0x76, 0x48, 0x9f, 0x8c, 0xda, 0xa1, 0xff, 0xc7, 0x5e, 0x50, 0xba, 0x7c, 0x5b, 0x67,
-	0xad, 0x4f, 0x46, 0x9b, 0xe3, 0x1d, 0x5e, 0x1b, 0xe1, 0x95, 0x11, 0xee, 0x8c, 0x34, 0x3b, 0xe3,
-	0x2f, 0xa3, 0x14, 0xbc, 0x66, 0xb8, 0xd2, 0x39, 0xf8, 0x4d, 0xe8, 0xbd, 0x2b, 0xa3, 0x4d, 0xa1,
-	0x95, 0x01, 0x36, 0xa4, 0x6d, 0xb4, 0xa6, 0x43, 0xfa, 0xed, 0xd1, 0xe6, 0x78, 0xab, 0x11, 0x5f,
-	0x1e, 0x82, 0x1f, 0xd9, 0xb0, 0x62, 0xb0, 0xa7, 0xf4, 0x96, 0xdb, 0xc3, 0x5b, 0x39, 0xf1, 0x4f,
-	0xb9, 0xcf, 0x97, 0x7e, 0x79, 0xed, 0xd4, 0x8d, 0x38, 0x7c, 0x1e, 0x6e, 0x38, 0xea, 0xe1, 0x84,
-	0x3d, 0xa2, 0x37, 0x5d, 0xd9, 0x69, 0xbb, 0x96, 0x2d, 0xde, 0xac, 0x6f, 0xb5, 0x21, 0xac, 0x39,
-	0xec, 0xe0, 0x82, 0xdf, 0x1b, 0xae, 0x63, 0x78, 0xad, 0xdf, 0xda, 0xc8, 0xaa, 0xe1, 0xf1, 0x17,
-	0x42, 0x37, 0x5e, 0xd5, 0xf1, 0x62, 0x1f, 0x09, 0xbd, 0x73, 0xc9, 0x3c, 0xdb, 0xe1, 0x17, 0xd3,
-	0xc6, 0xff, 0x7d, 0x98, 0xee, 0xf0, 0x5a, 0x5e, 0x3d, 0x7c, 0xb0, 0xfb, 0xe1, 0xfb, 0xaf, 0xcf,
-	0x6b, 0x0f, 0xd9, 0x40, 0x5c, 0x0a, 0x39, 0x5a, 0x1f, 0x1f, 0xf1, 0xae, 0x3e, 0xea, 0xfb, 0xfd,
-	0x67, 0xdf, 0xe6, 0x01, 0x39, 0x9f, 0x07, 0xe4, 0xe7, 0x3c, 0x20, 0x9f, 0x16, 0x41, 0xeb, 0xeb,
-	0x22, 0x20, 0xe7, 0x8b, 0xa0, 0xf5, 0x63, 0x11, 0xb4, 0xde, 0x3c, 0x48, 0x25, 0x4e, 0x67, 0x31,
-	0x4f, 0x74, 0x2e, 0x32, 0xa9, 0xa0, 0x12, 0x7c, 0x6c, 0x26, 0xc7, 0x4d, 0xa0, 0xec, 0x38, 0x5e,
-	0x77, 0x79, 0x7a, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x33, 0x8e, 0xe5, 0xd9, 0x51, 0x03, 0x00,
-	0x00,
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0xb6, 0xa5, 0xfc, 0x19, 0xfe, 0xf0, 0x27, 0x13, 0xe0, 0x5f, 0x6b, 0xad, 0xb5, 0x51,
+	0x68, 0x10, 0x66, 0x43, 0xf5, 0xe4, 0xc5, 0x84, 0x20, 0x04, 0x4f, 0x66, 0xad, 0x31, 0xf1, 0xd2,
+	0xec, 0x76, 0x5f, 0xb6, 0x13, 0xb6, 0x33, 0x65, 0x67, 0xda, 0x0c, 0x31, 0x5e, 0xfc, 0x00, 0x6a,
+	0xe2, 0xd1, 0x2f, 0xe0, 0xc7, 0xe0, 0x62, 0xc2, 0x91, 0xc4, 0x8b, 0x27, 0x63, 0xa8, 0x67, 0x3f,
+	0x83, 0xd9, 0xd9, 0x2d, 0xdd, 0x42, 0xb1, 0x84, 0x83, 0x97, 0x66, 0x76, 0xde, 0x7b, 0xbf, 0xf7,
+	0xfb, 0xbd, 0xf7, 0xe6, 0x15, 0x15, 0x7d, 0xa7, 0x6d, 0x4a, 0x65, 0xf6, 0x36, 0x1d, 0x90, 0xf6,
+	0xa6, 0x29, 0x20, 0xe8, 0xd1, 0x26, 0x90, 0x4e, 0xc0, 0x25, 0xc7, 0xf3, 0xbe, 0xd3, 0x26, 0x52,
+	0x91, 0xd8, 0x5a, 0x28, 0x7a, 0x9c, 0x7b, 0x3e, 0x98, 0x76, 0x87, 0x9a, 0x36, 0x63, 0x5c, 0xda,
+	0x92, 0x72, 0x26, 0x22, 0xef, 0xc2, 0xa2, 0xc7, 0x3d, 0xae, 0x8f, 0x66, 0x78, 0x8a, 0x6f, 0x0b,
+	0x4d, 0x2e, 0xda, 0x5c, 0x24, 0x93, 0x48, 0x15, 0xdb, 0xd6, 0x62, 0x9b, 0x63, 0x0b, 0x30, 0x0f,
+	0xba, 0x10, 0x1c, 0x9e, 0xf9, 0x74, 0x6c, 0x8f, 0x32, 0x0d, 0x3f, 0xc0, 0xe1, 0x42, 0x06, 0x76,
+	0x93, 0x33, 0x53, 0x1e, 0x76, 0x40, 0x98, 0x8e, 0xcf, 0x9b, 0xfb, 0x97, 0xd8, 0xf4, 0x6f, 0x6c,
+	0x2b, 0x4a, 0x60, 0x2e, 0x04, 0x6d, 0xca, 0xe4, 0x18, 0xeb, 0xcd, 0x84, 0xd5, 0x76, 0x9a, 0x34,
+	0x69, 0xac, 0x28, 0xb4, 0xbc, 0x0b, 0x72, 0x2b, 0x4c, 0xf4, 0x92, 0xca, 0x56, 0x5d, 0x09, 0x0b,
+	0x0e, 0xba, 0x20, 0x24, 0x5e, 0x46, 0xb9, 0x16, 0x50, 0xaf, 0x25, 0xf3, 0x46, 0xd9, 0xa8, 0x66,
+	0xac, 0xf8, 0x0b, 0xef, 0x20, 0x34, 0x24, 0x9e, 0x4f, 0x97, 0x8d, 0xea, 0x6c, 0x6d, 0x85, 0x44,
+	0x2a, 0x49, 0xa8, 0x92, 0x68, 0x95, 0x83, 0x82, 0x92, 0x67, 0xb6, 0x07, 0x31, 0xa6, 0x95, 0x88,
+	0xac, 0xfc, 0x32, 0xd0, 0xff, 0x17, 0x52, 0x8b, 0x0e, 0x67, 0x02, 0xf0, 0x2a, 0xca, 0x48, 0x25,
+	0xf2, 0x46, 0x39, 0x53, 0x9d, 0xad, 0x2d, 0x0d, 0xc0, 0x87, 0x5d, 0x22, 0x75, 0x65, 0x85, 0x1e,
+	0xf8, 0x21, 0xfa, 0x47, 0x17, 0xa9, 0x41, 0xdd, 0x98, 0xca, 0x0d, 0x32, 0x94, 0x4b, 0x22, 0xa5,
+	0x3a, 0xc5, 0xde, 0xb6, 0x35, 0xad, 0x5d, 0xf7, 0x5c, 0x7c, 0x1f, 0x4d, 0xe9, 0x63, 0x3e, 0xa3,
+	0x43, 0x96, 0xc8, 0xa0, 0xb6, 0xc9, 0x00, 0x2b, 0xf2, 0xc1, 0xbb, 0x23, 0x7a, 0xb3, 0x3a, 0x62,
+	0x75, 0xa2, 0xde, 0x48, 0xc8, 0x88, 0xe0, 0x23, 0x03, 0x15, 0x47, 0x05, 0x5b, 0x20, 0xba, 0xbe,
+	0x9c, 0x58, 0xf1, 0x0a, 0x9a, 0x6b, 0x0b, 0xaf, 0x11, 0x72, 0x6b, 0x74, 0x03, 0x5f, 0xe4, 0xd3,
+	0xe5, 0x4c, 0x75, 0xc6, 0x9a, 0x6d, 0x0b, 0xaf, 0x7e, 0xd8, 0x81, 0x17, 0x81, 0x2f, 0x70, 0x11,
+	0xcd, 0xd8, 0xae, 0x1b, 0x80, 0x10, 0x20, 0xf2, 0x19, 0x6d, 0x1f, 0x5e, 0xe0, 0x9d, 0x31, 0x1a,
+	0xae, 0xd3, 0xb3, 0x4f, 0x06, 0xfa, 0xb7, 0xae, 0x42, 0xf2, 0x11, 0x75, 0xbc, 0x88, 0xa6, 0x28,
+	0x73, 0x41, 0x69, 0xc6, 0x73, 0x56, 0xf4, 0x81, 0x31, 0xca, 0xb6, 0x6c, 0xd1, 0xd2, 0x1d, 0x99,
+	0xb1, 0xf4, 0x19, 0xdf, 0x43, 0x69, 0xa9, 0xce, 0x0a, 0x3e, 0xb6, 0xa3, 0x69, 0xa9, 0xf0, 0x23,
+	0x94, 0x0b, 0x34, 0x74, 0xcc, 0xb2, 0x92, 0x6c, 0x67, 0x38, 0xbd, 0x64, 0x50, 0xdb, 0x6d, 0xf0,
+	0x69, 0x0f, 0x82, 0xba, 0xb2, 0xe2, 0x88, 0xca, 0xfb, 0x0c, 0xba, 0x75, 0x49, 0x81, 0xe3, 0xb9,
+	0x22, 0xc9, 0xb9, 0x2a, 0x92, 0xd1, 0xa7, 0x4f, 0x92, 0xca, 0xfe, 0xda, 0x78, 0x3d, 0x45, 0xd8,
+	0x01, 0x8f, 0xb2, 0x46, 0x94, 0x08, 0x7a, 0xc0, 0xa4, 0xc8, 0x67, 0x35, 0xc3, 0xe5, 0x0b, 0xe2,
+	0x9f, 0x84, 0xe6, 0xad, 0xec, 0xf1, 0xf7, 0xdb, 0x29, 0x6b, 0x41, 0xc7, 0x69, 0x30, 0x7d, 0x1d,
+	0xb6, 0x79, 0x01, 0x98, 0x3b, 0x8a, 0x34, 0x75, 0x05, 0xa4, 0x79, 0x60, 0x6e, 0x12, 0x67, 0x74,
+	0xe4, 0x73, 0xd7, 0x1e, 0xf9, 0xda, 0x97, 0x34, 0x9a, 0x7e, 0x1e, 0xad, 0x5b, 0xfc, 0xce, 0x40,
+	0xff, 0x9d, 0x7b, 0xef, 0x78, 0xe5, 0x7c, 0x0b, 0xc6, 0xef, 0xa2, 0xc2, 0xea, 0x44, 0xbf, 0x28,
+	0x79, 0x65, 0xed, 0xed, 0xd7, 0x9f, 0x1f, 0xd3, 0x77, 0x71, 0xc5, 0x3c, 0xb7, 0xf4, 0xa5, 0x8a,
+	0xd7, 0xa9, 0xf9, 0x3a, 0x7a, 0x55, 0x6f, 0xf0, 0x67, 0x03, 0x2d, 0x8d, 0x1d, 0x17, 0xbc, 0xfe,
+	0xe7, 0x74, 0xa3, 0xcf, 0xb6, 0xb0, 0x71, 0x45, 0xef, 0x98, 0x62, 0x4d, 0x53, 0x5c, 0xc7, 0x6b,
+	0x93, 0x29, 0x9a, 0xd1, 0x60, 0x8b, 0xad, 0xc7, 0xc7, 0xa7, 0x25, 0xe3, 0xe4, 0xb4, 0x64, 0xfc,
+	0x38, 0x2d, 0x19, 0x1f, 0xfa, 0xa5, 0xd4, 0x51, 0xbf, 0x64, 0x9c, 0xf4, 0x4b, 0xa9, 0x6f, 0xfd,
+	0x52, 0xea, 0xd5, 0x1d, 0x8f, 0xca, 0x56, 0xd7, 0x21, 0x4d, 0xde, 0x36, 0x7d, 0xca, 0x20, 0x04,
+	0xde, 0x10, 0xee, 0xfe, 0xe0, 0xbf, 0x40, 0xd5, 0x9c, 0x9c, 0xde, 0xf6, 0x0f, 0x7e, 0x0f, 0x00,
+	0xe7, 0x43, 0xe1, 0x1a, 0x0c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -228,6 +498,12 @@ type ServiceClient interface {
 	//
 	// Since: lbm-sdk 0.47.0
 	GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error)
+	// GetBlockWithTxResults fetches a block with decoded txs, their results and
+	// the events of BeginBlock and EndBlock, filtering the txs by the messages
+	// and the addresses involved.
+	//
+	// Since: lbm-sdk 0.47.0
+	GetBlockWithTxResults(ctx context.Context, in *GetBlockWithTxResultsRequest, opts ...grpc.CallOption) (*GetBlockWithTxResultsResponse, error)
 }
 
 type serviceClient struct {
@@ -247,12 +523,27 @@ func (c *serviceClient) GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxs
 	return out, nil
 }
 
+func (c *serviceClient) GetBlockWithTxResults(ctx context.Context, in *GetBlockWithTxResultsRequest, opts ...grpc.CallOption) (*GetBlockWithTxResultsResponse, error) {
+	out := new(GetBlockWithTxResultsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.tx.v1beta1.Service/GetBlockWithTxResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// GetBlockWithTxs fetches a block with decoded txs.
 	//
 	// Since: lbm-sdk 0.47.0
 	GetBlockWithTxs(context.Context, *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error)
+	// GetBlockWithTxResults fetches a block with decoded txs, their results and
+	// the events of BeginBlock and EndBlock, filtering the txs by the messages
+	// and the addresses involved.
+	//
+	// Since: lbm-sdk 0.47.0
+	GetBlockWithTxResults(context.Context, *GetBlockWithTxResultsRequest) (*GetBlockWithTxResultsResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -262,6 +553,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) GetBlockWithTxs(ctx context.Context, req *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockWithTxs not implemented")
 }
+func (*UnimplementedServiceServer) GetBlockWithTxResults(ctx context.Context, req *GetBlockWithTxResultsRequest) (*GetBlockWithTxResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockWithTxResults not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -285,6 +579,24 @@ func _Service_GetBlockWithTxs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetBlockWithTxResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockWithTxResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockWithTxResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.tx.v1beta1.Service/GetBlockWithTxResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockWithTxResults(ctx, req.(*GetBlockWithTxResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -293,6 +605,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockWithTxs",
 			Handler:    _Service_GetBlockWithTxs_Handler,
 		},
+		{
+			MethodName: "GetBlockWithTxResults",
+			Handler:    _Service_GetBlockWithTxResults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/tx/v1beta1/service.proto",
@@ -364,114 +680,889 @@ func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxWithResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxWithResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxWithResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EndBlockEvents) > 0 {
+		for iNdEx := len(m.EndBlockEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndBlockEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BeginBlockEvents) > 0 {
+		for iNdEx := len(m.BeginBlockEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeginBlockEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetBlockWithTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *TxWithResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovService(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.BeginBlockEvents) > 0 {
+		for _, e := range m.BeginBlockEvents {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.EndBlockEvents) > 0 {
+		for _, e := range m.EndBlockEvents {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetBlockWithTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockWithTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockWithTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockWithTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockWithTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &tx.Tx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &types1.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockWithTxResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockWithTxResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockWithTxResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockId != nil {
-		{
-			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-			i--
-			dAtA[i] = 0xa
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GetBlockWithTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovService(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	return n
-}
 
-func (m *GetBlockWithTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
-	if m.BlockId != nil {
-		l = m.BlockId.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovService(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetBlockWithTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *TxWithResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -494,17 +1585,17 @@ func (m *GetBlockWithTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockWithTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxWithResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockWithTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxWithResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Height = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -514,14 +1605,46 @@ func (m *GetBlockWithTxsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -548,10 +1671,46 @@ func (m *GetBlockWithTxsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Tx == nil {
+				m.Tx = &tx.Tx{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types2.ResponseDeliverTx{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -576,7 +1735,7 @@ func (m *GetBlockWithTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetBlockWithTxResultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -599,10 +1758,10 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockWithTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBlockWithTxResultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockWithTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBlockWithTxResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -634,7 +1793,7 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &tx.Tx{})
+			m.Txs = append(m.Txs, &TxWithResult{})
 			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -712,6 +1871,74 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlockEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeginBlockEvents = append(m.BeginBlockEvents, types2.Event{})
+			if err := m.BeginBlockEvents[len(m.BeginBlockEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBlockEvents = append(m.EndBlockEvents, types2.Event{})
+			if err := m.EndBlockEvents[len(m.EndBlockEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
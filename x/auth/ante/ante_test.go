@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/line/lbm-sdk/crypto/keys/bls12381"
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
@@ -19,6 +20,7 @@ import (
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/types/tx/signing"
 	"github.com/line/lbm-sdk/x/auth/ante"
+	xauthsigning "github.com/line/lbm-sdk/x/auth/signing"
 	"github.com/line/lbm-sdk/x/auth/types"
 	minttypes "github.com/line/lbm-sdk/x/mint/types"
 )
@@ -1064,6 +1066,79 @@ func (suite *AnteTestSuite) TestCustomSignatureVerificationGasConsumer() {
 	}
 }
 
+func (suite *AnteTestSuite) TestAnteHandlerBls12381() {
+	suite.SetupTest(false) // setup
+
+	fundAccount := func(pubKey cryptotypes.PubKey, accNum uint64) {
+		addr := sdk.AccAddress(pubKey.Address())
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.Require().NoError(acc.SetAccountNumber(accNum))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10000000))
+		suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+		suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, addr, coins))
+	}
+
+	priv := bls12381.GenPrivKey()
+	fundAccount(priv.PubKey(), 0)
+
+	var members []*bls12381.PrivKey
+	var memberPubKeys []*bls12381.PubKey
+	for i := 0; i < 3; i++ {
+		members = append(members, bls12381.GenPrivKey())
+		memberPubKeys = append(memberPubKeys, members[i].PubKey().(*bls12381.PubKey))
+	}
+	aggregateKey := bls12381.NewAggregatePubKey(2, memberPubKeys)
+	fundAccount(aggregateKey, 1)
+
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	suite.Run("bls12381 key", func() {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		msgs := []sdk.Msg{testdata.NewTestMsg(sdk.AccAddress(priv.PubKey().Address()))}
+		suite.RunTestCase([]cryptotypes.PrivKey{priv}, msgs, feeAmount, gasLimit, []uint64{0}, []uint64{0}, suite.ctx.ChainID(),
+			TestCase{"bls12381 account", func() {}, false, true, nil})
+	})
+
+	// the members sign the same sign bytes, of which the signatures are aggregated
+	signAggregate := func(signers []*bls12381.PrivKey) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress(aggregateKey.Address()))))
+		suite.txBuilder.SetFeeAmount(feeAmount)
+		suite.txBuilder.SetGasLimit(gasLimit)
+
+		signMode := suite.clientCtx.TxConfig.SignModeHandler().DefaultMode()
+		sigData := &signing.SingleSignatureData{SignMode: signMode}
+		suite.Require().NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{PubKey: aggregateKey, Data: sigData}))
+		signerData := xauthsigning.SignerData{ChainID: suite.ctx.ChainID(), AccountNumber: 1, Sequence: 0}
+		signBytes, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, suite.txBuilder.GetTx())
+		suite.Require().NoError(err)
+
+		var signerPubKeys []cryptotypes.PubKey
+		var sigs [][]byte
+		for _, signer := range signers {
+			sig, err := signer.Sign(signBytes)
+			suite.Require().NoError(err)
+			signerPubKeys = append(signerPubKeys, signer.PubKey())
+			sigs = append(sigs, sig)
+		}
+		sigData.Signature, err = aggregateKey.AggregateSignatures(signerPubKeys, sigs)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{PubKey: aggregateKey, Data: sigData}))
+
+		return suite.txBuilder.GetTx()
+	}
+
+	_, err := suite.anteHandler(suite.ctx, signAggregate(members[:1]), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	newCtx, err := suite.anteHandler(suite.ctx, signAggregate(members[1:]), false)
+	suite.Require().NoError(err)
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	suite.Require().GreaterOrEqual(newCtx.GasMeter().GasConsumed(), params.SigVerifyCostBls12381Aggregate(3))
+}
+
 func (suite *AnteTestSuite) TestAnteHandlerReCheck() {
 	suite.SetupTest(false) // setup
 	// Set recheck=true
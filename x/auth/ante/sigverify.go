@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/line/lbm-sdk/crypto/keys/bls12381"
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
@@ -480,6 +481,14 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *bls12381.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostBls12381(), "ante verify: bls12381")
+		return nil
+
+	case *bls12381.AggregatePubKey:
+		meter.ConsumeGas(params.SigVerifyCostBls12381Aggregate(len(pubkey.PubKeys)), "ante verify: bls12381 aggregate")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
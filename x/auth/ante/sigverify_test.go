@@ -5,6 +5,7 @@ import (
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/crypto/keys/bls12381"
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
@@ -71,6 +72,8 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 
 	p := types.DefaultParams()
 	skR1, _ := secp256r1.GenPrivKey()
+	pkBls := bls12381.GenPrivKey().PubKey().(*bls12381.PubKey)
+	aggregateKey := bls12381.NewAggregatePubKey(2, []*bls12381.PubKey{pkBls, bls12381.GenPrivKey().PubKey().(*bls12381.PubKey)})
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
 	multisignature1 := multisig.NewMultisig(len(pkSet1))
@@ -98,6 +101,8 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyBls12381", args{sdk.NewInfiniteGasMeter(), nil, pkBls, params}, p.SigVerifyCostBls12381(), false},
+		{"AggregatePubKeyBls12381", args{sdk.NewInfiniteGasMeter(), nil, aggregateKey, params}, p.SigVerifyCostBls12381Aggregate(2), false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -0,0 +1,284 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/client/tx"
+	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/version"
+	authclient "github.com/line/lbm-sdk/x/auth/client"
+)
+
+// GetMultiSignSessionCommand returns the command to collect the signatures of
+// a multisig key in a signing session file.
+func GetMultiSignSessionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-session",
+		Short: "Collect multisig signatures for a transaction generated offline in a session file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Collect the signatures of the members of a multisig key in a session file, which
+tracks the members which have signed and validates every signature against the sign bytes.
+
+Example:
+$ %s tx multisign-session create transaction.json k1k2k3 --output-document session.json
+$ %s tx sign transaction.json --from k1 --multisig k1k2k3 --sign-mode amino-json --output-document k1sig.json
+$ %s tx multisign-session add-signature session.json k1sig.json
+$ %s tx multisign-session status session.json
+$ %s tx multisign-session finalize session.json
+
+The current multisig implementation only supports the amino-json sign mode.
+`, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName,
+			),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetMultiSignSessionCreateCmd(),
+		GetMultiSignSessionAddSignatureCmd(),
+		GetMultiSignSessionStatusCmd(),
+		GetMultiSignSessionFinalizeCmd(),
+	)
+
+	return cmd
+}
+
+// GetMultiSignSessionCreateCmd returns the command to create a signing session.
+func GetMultiSignSessionCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file] [name]",
+		Short: "Create a signing session of the multisig key [name] on the transaction in [file]",
+		Long: `Create a signing session of the multisig key [name] on the transaction in [file].
+The account number and the sequence of the multisig account are queried, unless
+the --offline flag is on, in which case they must be set manually.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			multisigInfo, err := getMultisigInfo(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if !clientCtx.Offline {
+				accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigInfo.GetAddress())
+				if err != nil {
+					return err
+				}
+
+				txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
+			}
+
+			session, err := tx.NewSigningSession(parsedTx, multisigInfo.GetPubKey().(*kmultisig.LegacyAminoPubKey),
+				txFactory.ChainID(), txFactory.AccountNumber(), txFactory.Sequence())
+			if err != nil {
+				return err
+			}
+
+			return writeSigningSession(cmd, clientCtx, session)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The session is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMultiSignSessionAddSignatureCmd returns the command to add signatures to
+// a signing session.
+func GetMultiSignSessionAddSignatureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-signature [session-file] [[signature-file]...]",
+		Short: "Add the signatures of the members to the signing session in [session-file]",
+		Long: `Add the signatures in [signature-file]s, generated by the sign command with the
+--multisig flag, to the signing session in [session-file], which is updated in
+place. Every signature must be of a member which has not signed yet, and valid
+for the transaction, the account number and the sequence of the session.
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readSigningSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, filename := range args[1:] {
+				sigs, err := unmarshalSignatureJSON(clientCtx, filename)
+				if err != nil {
+					return err
+				}
+				for _, sig := range sigs {
+					if err := session.AddSignature(clientCtx.TxConfig, sig); err != nil {
+						return fmt.Errorf("%s: %w", filename, err)
+					}
+				}
+			}
+
+			bz, err := tx.MarshalSigningSession(clientCtx, session)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(args[0], bz, 0o644); err != nil {
+				return err
+			}
+
+			cmd.Print(session.Status().String())
+			return nil
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMultiSignSessionStatusCmd returns the command to show the status of a
+// signing session.
+func GetMultiSignSessionStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session-file]",
+		Short: "Show the members which have signed in the signing session in [session-file]",
+		Long: `Show the members which have signed in the signing session in [session-file].
+Unless the --offline flag is on, it also checks whether the account number and
+the sequence of the multisig account have drifted from the session.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readSigningSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.Print(session.Status().String())
+			if clientCtx.Offline {
+				return nil
+			}
+
+			if err := checkSigningSessionDrift(clientCtx, session); err != nil {
+				cmd.Printf("WARNING: %s\n", err)
+			}
+			return nil
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMultiSignSessionFinalizeCmd returns the command to finalize a signing
+// session into the signed transaction.
+func GetMultiSignSessionFinalizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session-file]",
+		Short: "Generate the transaction signed by the multisig key from the signing session in [session-file]",
+		Long: `Generate the transaction signed by the multisig key from the signing session in
+[session-file], which must have reached the threshold of the multisig key.
+Unless the --offline flag is on, it fails if the account number or the sequence
+of the multisig account has drifted from the session, as the transaction would
+be rejected.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readSigningSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !clientCtx.Offline {
+				if err := checkSigningSessionDrift(clientCtx, session); err != nil {
+					return err
+				}
+			}
+
+			signedTx, err := session.Finalize(clientCtx.TxConfig)
+			if err != nil {
+				return err
+			}
+
+			json, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+
+			return writeOutputDocument(cmd, json)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readSigningSession(clientCtx client.Context, filename string) (*tx.SigningSession, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.UnmarshalSigningSession(clientCtx, bz)
+}
+
+func writeSigningSession(cmd *cobra.Command, clientCtx client.Context, session *tx.SigningSession) error {
+	bz, err := tx.MarshalSigningSession(clientCtx, session)
+	if err != nil {
+		return err
+	}
+
+	return writeOutputDocument(cmd, bz)
+}
+
+func writeOutputDocument(cmd *cobra.Command, bz []byte) error {
+	outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if outputDoc == "" {
+		cmd.Printf("%s\n", bz)
+		return nil
+	}
+
+	return os.WriteFile(outputDoc, append(bz, '\n'), 0o644)
+}
+
+func checkSigningSessionDrift(clientCtx client.Context, session *tx.SigningSession) error {
+	accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, sdk.AccAddress(session.PubKey.Address()))
+	if err != nil {
+		return err
+	}
+
+	return session.CheckDrift(accnum, seq)
+}
@@ -2,12 +2,17 @@ package tx2
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	octypes "github.com/line/ostracon/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/grpc/tmservice"
 	codectypes "github.com/line/lbm-sdk/codec/types"
@@ -109,6 +114,162 @@ func (s tx2Server) GetBlockWithTxs(ctx context.Context, req *tx2types.GetBlockWi
 	}, nil
 }
 
+func (s tx2Server) GetBlockWithTxResults(ctx context.Context, req *tx2types.GetBlockWithTxResultsRequest) (*tx2types.GetBlockWithTxResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+
+	if req.Height < 1 || req.Height > currentHeight {
+		return nil, sdkerrors.ErrInvalidHeight.Wrapf("requested height %d but height must not be less than 1 "+
+			"or greater than the current height %d", req.Height, currentHeight)
+	}
+
+	for _, addr := range req.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", addr, err)
+		}
+	}
+
+	blockID, block, err := tmservice.GetProtoBlock(ctx, s.clientCtx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	blockResults, err := node.BlockResults(ctx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTxs := block.Data.Txs
+	if len(blockResults.TxsResults) != len(blockTxs) {
+		return nil, sdkerrors.ErrLogic.Wrapf("%d txs in the block but %d results", len(blockTxs), len(blockResults.TxsResults))
+	}
+
+	// the txs are filtered before the pagination, so all of them are decoded
+	var txs []*tx2types.TxWithResult
+	for i, bz := range blockTxs {
+		txb, err := s.clientCtx.TxConfig.TxDecoder()(bz)
+		if err != nil {
+			return nil, err
+		}
+		result := blockResults.TxsResults[i]
+		if !matchMsgTypeURLs(txb, req.MsgTypeUrls) || !matchAddresses(txb, result, req.Addresses) {
+			continue
+		}
+
+		p, ok := txb.(protoTxProvider)
+		if !ok {
+			return nil, sdkerrors.ErrTxDecode.Wrapf("could not cast %T to %T", txb, txtypes.Tx{})
+		}
+		txs = append(txs, &tx2types.TxWithResult{
+			Index:  uint32(i),
+			Hash:   fmt.Sprintf("%X", octypes.Tx(bz).Hash()),
+			Tx:     p.GetProtoTx(),
+			Result: result,
+		})
+	}
+
+	var offset, limit uint64
+	reverse := false
+	if req.Pagination != nil {
+		offset = req.Pagination.Offset
+		limit = req.Pagination.Limit
+		reverse = req.Pagination.Reverse
+	}
+	if limit == 0 {
+		limit = pagination.DefaultLimit
+	}
+
+	total := uint64(len(txs))
+	if offset > 0 && offset >= total {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("out of range: cannot paginate %d txs with offset %d and limit %d", total, offset, limit)
+	}
+	if reverse {
+		for i, j := 0, len(txs)-1; i < j; i, j = i+1, j-1 {
+			txs[i], txs[j] = txs[j], txs[i]
+		}
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+
+	return &tx2types.GetBlockWithTxResultsResponse{
+		Txs:              txs[offset:end],
+		BlockId:          &blockID,
+		Block:            block,
+		BeginBlockEvents: blockResults.BeginBlockEvents,
+		EndBlockEvents:   blockResults.EndBlockEvents,
+		Pagination: &pagination.PageResponse{
+			Total: total,
+		},
+	}, nil
+}
+
+// matchMsgTypeURLs returns whether the tx has a message of any of the type
+// urls. Any tx matches the empty type urls.
+func matchMsgTypeURLs(tx sdk.Tx, typeURLs []string) bool {
+	if len(typeURLs) == 0 {
+		return true
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		for _, typeURL := range typeURLs {
+			if msgTypeURL == typeURL {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchAddresses returns whether the tx involves any of the addresses, either
+// as a signer of its messages or as an attribute value of the events in its
+// result. The values of the typed events are JSON encoded, so the quoted
+// values are unquoted first. Any tx matches the empty addresses.
+func matchAddresses(tx sdk.Tx, result *abci.ResponseDeliverTx, addrs []string) bool {
+	if len(addrs) == 0 {
+		return true
+	}
+
+	involved := map[string]bool{}
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			involved[signer.String()] = true
+		}
+	}
+	for _, event := range result.Events {
+		for _, attr := range event.Attributes {
+			involved[attributeValue(attr.Value)] = true
+		}
+	}
+
+	for _, addr := range addrs {
+		if involved[addr] {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeValue returns the value of an event attribute, unquoting it if it
+// is a JSON string as the typed events encode them.
+func attributeValue(bz []byte) string {
+	var value string
+	if len(bz) >= 2 && bz[0] == '"' && json.Unmarshal(bz, &value) == nil {
+		return value
+	}
+	return string(bz)
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
@@ -0,0 +1,76 @@
+package tx2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/testutil/testdata"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/token"
+)
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestMatchAddresses(t *testing.T) {
+	addr := func() string {
+		return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+	signer, from, to, stranger := addr(), addr(), addr(), addr()
+
+	tx := mockTx{msgs: []sdk.Msg{testdata.NewTestMsg(sdk.MustAccAddressFromBech32(signer))}}
+
+	event, err := sdk.TypedEventToEvent(&token.EventSent{
+		ContractId: "deadbeef",
+		Operator:   from,
+		From:       from,
+		To:         to,
+		Amount:     sdk.OneInt(),
+	})
+	require.NoError(t, err)
+	result := &abci.ResponseDeliverTx{Events: sdk.Events{event}.ToABCIEvents()}
+
+	testCases := map[string]struct {
+		addrs   []string
+		matches bool
+	}{
+		"no addresses": {
+			matches: true,
+		},
+		"signer": {
+			addrs:   []string{signer},
+			matches: true,
+		},
+		"typed event attribute": {
+			addrs:   []string{to},
+			matches: true,
+		},
+		"stranger": {
+			addrs: []string{stranger},
+		},
+		"stranger and typed event attribute": {
+			addrs:   []string{stranger, from},
+			matches: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.matches, matchAddresses(tx, result, tc.addrs))
+		})
+	}
+}
+
+func TestAttributeValue(t *testing.T) {
+	require.Equal(t, "link1abc", attributeValue([]byte(`"link1abc"`)))
+	require.Equal(t, "link1abc", attributeValue([]byte("link1abc")))
+	require.Equal(t, `"`, attributeValue([]byte(`"`)))
+	require.Equal(t, `"broken`, attributeValue([]byte(`"broken`)))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/line/lbm-sdk/types/query"
 	"github.com/line/lbm-sdk/types/tx2"
 	bankcli "github.com/line/lbm-sdk/x/bank/client/testutil"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
 )
 
 type IntegrationTestSuite struct {
@@ -168,6 +169,99 @@ func (s IntegrationTestSuite) TestGetBlockWithTxs_GRPCGateway() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetBlockWithTxResults_GRPC() {
+	val := s.network.Validators[0]
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	testCases := []struct {
+		name      string
+		req       *tx2.GetBlockWithTxResultsRequest
+		expErr    bool
+		expErrMsg string
+		expTxs    int
+	}{
+		{"nil request", nil, true, "request cannot be nil", 0},
+		{"empty request", &tx2.GetBlockWithTxResultsRequest{}, true, "height must not be less than 1 or greater than the current height", 0},
+		{"bad height", &tx2.GetBlockWithTxResultsRequest{Height: 99999999}, true, "height must not be less than 1 or greater than the current height", 0},
+		{"bad address", &tx2.GetBlockWithTxResultsRequest{Height: s.txHeight, Addresses: []string{"foo"}}, true, "invalid address", 0},
+		{"bad pagination", &tx2.GetBlockWithTxResultsRequest{Height: s.txHeight, Pagination: &query.PageRequest{Offset: 1000, Limit: 100}}, true, "out of range", 0},
+		{"good request", &tx2.GetBlockWithTxResultsRequest{Height: s.txHeight}, false, "", 1},
+		{"with pagination request", &tx2.GetBlockWithTxResultsRequest{Height: s.txHeight, Pagination: &query.PageRequest{Limit: 1, Reverse: true}}, false, "", 1},
+		{"matching msg type url", &tx2.GetBlockWithTxResultsRequest{Height: s.txHeight, MsgTypeUrls: []string{msgSendTypeURL}}, false, "", 1},
+		{"unmatching msg type url", &tx2.GetBlockWithTxResultsRequest{Height: s.txHeight, MsgTypeUrls: []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}}, false, "", 0},
+		{"matching address", &tx2.GetBlockWithTxResultsRequest{Height: s.txHeight, Addresses: []string{val.Address.String()}}, false, "", 1},
+		{"unmatching address", &tx2.GetBlockWithTxResultsRequest{Height: s.txHeight, Addresses: []string{sdk.AccAddress("unknown").String()}}, false, "", 0},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			grpcRes, err := s.queryClient.GetBlockWithTxResults(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Height, grpcRes.Block.Header.Height)
+			s.Require().EqualValues(tc.expTxs, grpcRes.Pagination.Total)
+			s.Require().Len(grpcRes.Txs, tc.expTxs)
+			s.Require().NotEmpty(grpcRes.BeginBlockEvents)
+			for _, tx := range grpcRes.Txs {
+				s.Require().Equal("foobar", tx.Tx.Body.Memo)
+				s.Require().Equal(uint32(0), tx.Result.Code)
+				s.Require().NotEmpty(tx.Result.Events)
+				s.Require().Len(tx.Hash, 64)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetBlockWithTxResults_GRPCGateway() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		url       string
+		expErr    bool
+		expErrMsg string
+		expTxs    int
+	}{
+		{
+			"bad height",
+			fmt.Sprintf("%s/lbm/tx/v1beta1/txs/block/%d/results", val.APIAddress, 9999999),
+			true, "height must not be less than 1 or greater than the current height", 0,
+		},
+		{
+			"good request",
+			fmt.Sprintf("%s/lbm/tx/v1beta1/txs/block/%d/results", val.APIAddress, s.txHeight),
+			false, "", 1,
+		},
+		{
+			"with filters",
+			fmt.Sprintf("%s/lbm/tx/v1beta1/txs/block/%d/results?msg_type_urls=%s&addresses=%s", val.APIAddress, s.txHeight,
+				sdk.MsgTypeURL(&banktypes.MsgSend{}), val.Address),
+			false, "", 1,
+		},
+		{
+			"with unmatching filters",
+			fmt.Sprintf("%s/lbm/tx/v1beta1/txs/block/%d/results?addresses=%s", val.APIAddress, s.txHeight, sdk.AccAddress("unknown")),
+			false, "", 0,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := rest.GetRequest(tc.url)
+			s.Require().NoError(err)
+			if tc.expErr {
+				s.Require().Contains(string(res), tc.expErrMsg)
+				return
+			}
+			var result tx2.GetBlockWithTxResultsResponse
+			err = val.ClientCtx.Codec.UnmarshalJSON(res, &result)
+			s.Require().NoError(err)
+			s.Require().Equal(s.txHeight, result.Block.Header.Height)
+			s.Require().Len(result.Txs, tc.expTxs)
+		})
+	}
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
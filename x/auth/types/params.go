@@ -81,6 +81,29 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBls12381 returns gas fee of bls12381 signature verification.
+// Set by benchmarking current implementation:
+//
+//	BenchmarkVerification/secp256k1     5890    411369 ns/op    4184 B/op    85 allocs/op
+//	BenchmarkVerification/bls12381       691   3171953 ns/op   85528 B/op   264 allocs/op
+//
+// Based on the results above bls12381 is 7.7x slower, dominated by the pairings.
+func (p Params) SigVerifyCostBls12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 8
+}
+
+// SigVerifyCostBls12381Aggregate returns gas fee of verifying the signature of
+// a bls12381 aggregate key of the given number of members.
+// Set by benchmarking current implementation:
+//
+//	BenchmarkAggregateVerification/100-of-100    28   38966286 ns/op   1823168 B/op   16579 allocs/op
+//
+// Based on the results above every member costs as much as a secp256k1 signature
+// verification, for decoding the pubkey and weighting it, on top of the pairings.
+func (p Params) SigVerifyCostBls12381Aggregate(members int) uint64 {
+	return p.SigVerifyCostBls12381() + uint64(members)*p.SigVerifyCostSecp256k1
+}
+
 // String implements the stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
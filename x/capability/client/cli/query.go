@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/version"
+	"github.com/line/lbm-sdk/x/capability/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	capabilityQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the capability module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	capabilityQueryCmd.AddCommand(
+		GetCmdQueryCapabilities(),
+		GetCmdQueryCapability(),
+		GetCmdQueryCapabilityByName(),
+	)
+
+	return capabilityQueryCmd
+}
+
+// GetCmdQueryCapabilities returns cmd to query for all the capabilities.
+func GetCmdQueryCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capabilities",
+		Args:  cobra.NoArgs,
+		Short: "Query all the capabilities with their owners",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the capabilities with their owners, ordered by their indexes.
+
+Example:
+$ %s query %s capabilities --page=2 --limit=50
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Capabilities(cmd.Context(), &types.QueryCapabilitiesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "capabilities")
+
+	return cmd
+}
+
+// GetCmdQueryCapability returns cmd to query for a capability by its index.
+func GetCmdQueryCapability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capability [index]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a capability by its index",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a capability with its owners by its index.
+
+Example:
+$ %s query %s capability 1
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			index, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Capability(cmd.Context(), &types.QueryCapabilityRequest{
+				Index: index,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Capability)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCapabilityByName returns cmd to query for a capability by its
+// owner module and name.
+func GetCmdQueryCapabilityByName() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capability-by-name [module] [name]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query a capability by its owner module and name",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a capability with its owners by the name its owner module refers to it.
+
+Example:
+$ %s query %s capability-by-name ibc ports/transfer
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CapabilityByName(cmd.Context(), &types.QueryCapabilityByNameRequest{
+				Module: args[0],
+				Name:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Capability)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
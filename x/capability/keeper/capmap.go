@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/line/lbm-sdk/x/capability/types"
+)
+
+// capabilityMap maps the capability indexes to the in-memory capabilities. It is
+// shared by the keeper and its scoped keepers, and guarded by a mutex because
+// the gRPC queries and the invariants read it concurrently with the block
+// execution.
+type capabilityMap struct {
+	mtx  sync.RWMutex
+	caps map[uint64]*types.Capability
+}
+
+func newCapabilityMap() *capabilityMap {
+	return &capabilityMap{caps: make(map[uint64]*types.Capability)}
+}
+
+func (m *capabilityMap) get(index uint64) *types.Capability {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.caps[index]
+}
+
+func (m *capabilityMap) set(index uint64, cap *types.Capability) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.caps[index] = cap
+}
+
+func (m *capabilityMap) delete(index uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.caps, index)
+}
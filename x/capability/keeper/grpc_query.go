@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/query"
+	"github.com/line/lbm-sdk/x/capability/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Capabilities implements the Query/Capabilities gRPC method
+func (k Keeper) Capabilities(c context.Context, req *types.QueryCapabilitiesRequest) (*types.QueryCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	var capabilities []types.CapabilityInfo
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		var owners types.CapabilityOwners
+		if err := k.cdc.Unmarshal(value, &owners); err != nil {
+			return err
+		}
+
+		capabilities = append(capabilities, k.capabilityInfo(types.IndexFromKey(key), owners))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCapabilitiesResponse{Capabilities: capabilities, Pagination: pageRes}, nil
+}
+
+// Capability implements the Query/Capability gRPC method
+func (k Keeper) Capability(c context.Context, req *types.QueryCapabilityRequest) (*types.QueryCapabilityResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	owners, ok := k.GetOwners(ctx, req.Index)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "capability %d not found", req.Index)
+	}
+
+	return &types.QueryCapabilityResponse{Capability: k.capabilityInfo(req.Index, owners)}, nil
+}
+
+// CapabilityByName implements the Query/CapabilityByName gRPC method
+func (k Keeper) CapabilityByName(c context.Context, req *types.QueryCapabilityByNameRequest) (*types.QueryCapabilityByNameResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if strings.TrimSpace(req.Module) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty module")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty name")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	owner := types.NewOwner(req.Module, req.Name)
+
+	// use the reverse mapping of the in-memory store first, and fall back to
+	// the persistent store, so the capabilities lost in memory are found as well.
+	memStore := ctx.KVStore(k.memKey)
+	if indexBytes := memStore.Get(types.RevCapabilityKey(req.Module, req.Name)); len(indexBytes) != 0 {
+		index := sdk.BigEndianToUint64(indexBytes)
+		if owners, ok := k.GetOwners(ctx, index); ok && hasOwner(owners, owner) {
+			return &types.QueryCapabilityByNameResponse{Capability: k.capabilityInfo(index, owners)}, nil
+		}
+	}
+
+	var info *types.CapabilityInfo
+	k.iterateOwners(ctx, func(index uint64, owners types.CapabilityOwners) (stop bool) {
+		if hasOwner(owners, owner) {
+			found := k.capabilityInfo(index, owners)
+			info = &found
+			return true
+		}
+		return false
+	})
+	if info == nil {
+		return nil, status.Errorf(codes.NotFound, "capability of %s not found", owner.Key())
+	}
+
+	return &types.QueryCapabilityByNameResponse{Capability: *info}, nil
+}
+
+func (k Keeper) capabilityInfo(index uint64, owners types.CapabilityOwners) types.CapabilityInfo {
+	inMemory := k.capMap.get(index) != nil
+	return types.CapabilityInfo{
+		Index:    index,
+		Owners:   owners.Owners,
+		InMemory: inMemory,
+	}
+}
+
+func hasOwner(owners types.CapabilityOwners, owner types.Owner) bool {
+	_, found := owners.Get(owner)
+	return found
+}
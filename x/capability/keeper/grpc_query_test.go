@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/query"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/capability/types"
+	stakingtypes "github.com/line/lbm-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryCapabilities() {
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	cap1, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap1, "bank-transfer"))
+	cap2, err := sk2.NewCapability(suite.ctx, "delegation")
+	suite.Require().NoError(err)
+
+	// a capability which got lost in memory
+	lost := types.CapabilityOwners{Owners: []types.Owner{types.NewOwner(banktypes.ModuleName, "lost")}}
+	suite.keeper.SetOwners(suite.ctx, cap2.GetIndex()+1, lost)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	expected := []types.CapabilityInfo{
+		{
+			Index: cap1.GetIndex(),
+			Owners: []types.Owner{
+				types.NewOwner(banktypes.ModuleName, "transfer"),
+				types.NewOwner(stakingtypes.ModuleName, "bank-transfer"),
+			},
+			InMemory: true,
+		},
+		{
+			Index:    cap2.GetIndex(),
+			Owners:   []types.Owner{types.NewOwner(stakingtypes.ModuleName, "delegation")},
+			InMemory: true,
+		},
+		{
+			Index:  cap2.GetIndex() + 1,
+			Owners: lost.Owners,
+		},
+	}
+
+	suite.Run("capabilities", func() {
+		_, err := suite.keeper.Capabilities(ctx, nil)
+		suite.Require().Error(err)
+
+		res, err := suite.keeper.Capabilities(ctx, &types.QueryCapabilitiesRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, res.Capabilities)
+
+		res, err = suite.keeper.Capabilities(ctx, &types.QueryCapabilitiesRequest{
+			Pagination: &query.PageRequest{Limit: 2},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected[:2], res.Capabilities)
+		suite.Require().NotNil(res.Pagination.NextKey)
+	})
+
+	suite.Run("capability", func() {
+		for _, info := range expected {
+			res, err := suite.keeper.Capability(ctx, &types.QueryCapabilityRequest{Index: info.Index})
+			suite.Require().NoError(err)
+			suite.Require().Equal(info, res.Capability)
+		}
+
+		_, err := suite.keeper.Capability(ctx, &types.QueryCapabilityRequest{Index: cap2.GetIndex() + 2})
+		suite.Require().Error(err)
+	})
+
+	suite.Run("capability by name", func() {
+		testCases := map[string]struct {
+			module   string
+			name     string
+			expected *types.CapabilityInfo
+		}{
+			"owner": {
+				module:   banktypes.ModuleName,
+				name:     "transfer",
+				expected: &expected[0],
+			},
+			"claimer": {
+				module:   stakingtypes.ModuleName,
+				name:     "bank-transfer",
+				expected: &expected[0],
+			},
+			"lost in memory": {
+				module:   banktypes.ModuleName,
+				name:     "lost",
+				expected: &expected[2],
+			},
+			"not found": {
+				module: stakingtypes.ModuleName,
+				name:   "transfer",
+			},
+			"empty module": {
+				name: "transfer",
+			},
+			"empty name": {
+				module: banktypes.ModuleName,
+			},
+		}
+
+		for name, tc := range testCases {
+			tc := tc
+			suite.Run(name, func() {
+				res, err := suite.keeper.CapabilityByName(ctx, &types.QueryCapabilityByNameRequest{
+					Module: tc.module,
+					Name:   tc.name,
+				})
+				if tc.expected == nil {
+					suite.Require().Error(err)
+					return
+				}
+				suite.Require().NoError(err)
+				suite.Require().Equal(*tc.expected, res.Capability)
+			})
+		}
+	})
+}
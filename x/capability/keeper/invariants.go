@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/capability/types"
+)
+
+// RegisterInvariants registers the capability module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "capability-owners", CapabilityOwnersInvariant(k))
+}
+
+// CapabilityOwnersInvariant checks that the in-memory capabilities are
+// consistent with the persistent capability owners: every capability in the
+// persistent store must be loaded in memory, and the forward and reverse
+// mappings of its owners must point to it.
+//
+// It is no-op until the memory store gets initialized.
+func CapabilityOwnersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if !k.IsInitialized(ctx) {
+			return sdk.FormatInvariant(types.ModuleName, "capability-owners", "memory store not initialized yet"), false
+		}
+
+		var (
+			msg   string
+			count int
+		)
+
+		memStore := ctx.KVStore(k.memKey)
+		k.iterateOwners(ctx, func(index uint64, owners types.CapabilityOwners) (stop bool) {
+			cap := k.capMap.get(index)
+			if cap == nil {
+				count++
+				msg += fmt.Sprintf("\tcapability %d is missing in memory\n", index)
+				return false
+			}
+
+			for _, owner := range owners.Owners {
+				if name := string(memStore.Get(types.FwdCapabilityKey(owner.Module, cap))); name != owner.Name {
+					count++
+					msg += fmt.Sprintf("\tcapability %d has forward mapping for %s to %q\n", index, owner.Key(), name)
+				}
+
+				if indexBytes := memStore.Get(types.RevCapabilityKey(owner.Module, owner.Name)); !bytes.Equal(indexBytes, sdk.Uint64ToBigEndian(index)) {
+					count++
+					msg += fmt.Sprintf("\tcapability %d has reverse mapping for %s to %X\n", index, owner.Key(), indexBytes)
+				}
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "capability-owners", fmt.Sprintf(
+			"found %d inconsistent capability ownerships\n%s", count, msg)), broken
+	}
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/capability/keeper"
+	"github.com/line/lbm-sdk/x/capability/types"
+)
+
+func (suite *KeeperTestSuite) TestCapabilityOwnersInvariant() {
+	sk := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	cap, err := sk.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+
+	invariant := keeper.CapabilityOwnersInvariant(*suite.keeper)
+
+	testCases := map[string]struct {
+		malleate func()
+		broken   bool
+	}{
+		"consistent": {},
+		"reverse mapping missing": {
+			malleate: func() {
+				memStore := suite.ctx.KVStore(suite.app.GetMemKey(types.MemStoreKey))
+				memStore.Delete(types.RevCapabilityKey(banktypes.ModuleName, "transfer"))
+			},
+			broken: true,
+		},
+		"forward mapping missing": {
+			malleate: func() {
+				memStore := suite.ctx.KVStore(suite.app.GetMemKey(types.MemStoreKey))
+				memStore.Delete(types.FwdCapabilityKey(banktypes.ModuleName, cap))
+			},
+			broken: true,
+		},
+		"capability missing in memory": {
+			malleate: func() {
+				owners := types.CapabilityOwners{Owners: []types.Owner{types.NewOwner(banktypes.ModuleName, "lost")}}
+				suite.keeper.SetOwners(suite.ctx, cap.GetIndex()+1, owners)
+			},
+			broken: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx := suite.ctx
+			suite.ctx, _ = ctx.CacheContext()
+			defer func() { suite.ctx = ctx }()
+
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			msg, broken := invariant(suite.ctx)
+			suite.Require().Equal(tc.broken, broken, msg)
+		})
+	}
+}
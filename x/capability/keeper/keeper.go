@@ -30,7 +30,7 @@ type (
 		cdc           codec.BinaryCodec
 		storeKey      sdk.StoreKey
 		memKey        sdk.StoreKey
-		capMap        map[uint64]*types.Capability
+		capMap        *capabilityMap
 		scopedModules map[string]struct{}
 		sealed        bool
 	}
@@ -45,7 +45,7 @@ type (
 		cdc      codec.BinaryCodec
 		storeKey sdk.StoreKey
 		memKey   sdk.StoreKey
-		capMap   map[uint64]*types.Capability
+		capMap   *capabilityMap
 		module   string
 	}
 )
@@ -57,7 +57,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey, memKey sdk.StoreKey) *Keeper {
 		cdc:           cdc,
 		storeKey:      storeKey,
 		memKey:        memKey,
-		capMap:        make(map[uint64]*types.Capability),
+		capMap:        newCapabilityMap(),
 		scopedModules: make(map[string]struct{}),
 		sealed:        false,
 	}
@@ -191,6 +191,23 @@ func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners
 	return owners, true
 }
 
+// iterateOwners iterates over the capability owners in the persistent store
+// in the order of their indexes.
+func (k Keeper) iterateOwners(ctx sdk.Context, cb func(index uint64, owners types.CapabilityOwners) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+	iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var owners types.CapabilityOwners
+		k.cdc.MustUnmarshal(iterator.Value(), &owners)
+
+		if cb(types.IndexFromKey(iterator.Key()), owners) {
+			break
+		}
+	}
+}
+
 // InitializeCapability takes in an index and an owners array. It creates the capability in memory
 // and sets the fwd and reverse keys for each owner in the memstore.
 // It is used during initialization from genesis.
@@ -210,7 +227,7 @@ func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types
 		memStore.Set(types.RevCapabilityKey(owner.Module, owner.Name), sdk.Uint64ToBigEndian(index))
 
 		// Set the mapping from index from index to in-memory capability in the go map
-		k.capMap[index] = cap
+		k.capMap.set(index, cap)
 	}
 }
 
@@ -258,7 +275,7 @@ func (sk ScopedKeeper) NewCapability(ctx sdk.Context, name string) (*types.Capab
 	memStore.Set(types.RevCapabilityKey(sk.module, name), sdk.Uint64ToBigEndian(index))
 
 	// Set the mapping from index from index to in-memory capability in the go map
-	sk.capMap[index] = cap
+	sk.capMap.set(index, cap)
 
 	logger(ctx).Info("created new capability", "module", sk.module, "name", name)
 
@@ -347,7 +364,7 @@ func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability)
 		// remove capability owner set
 		prefixStore.Delete(indexKey)
 		// since no one owns capability, we can delete capability from map
-		delete(sk.capMap, cap.GetIndex())
+		sk.capMap.delete(cap.GetIndex())
 	} else {
 		// update capability owner set
 		prefixStore.Set(indexKey, sk.cdc.MustMarshal(capOwners))
@@ -380,7 +397,7 @@ func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capab
 		return nil, false
 	}
 
-	cap := sk.capMap[index]
+	cap := sk.capMap.get(index)
 	if cap == nil {
 		panic("capability found in memstore is missing from map")
 	}
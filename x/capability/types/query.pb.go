@@ -0,0 +1,1753 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/capability/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	query "github.com/line/lbm-sdk/types/query"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CapabilityInfo defines a capability with its owners.
+type CapabilityInfo struct {
+	// index is the index of the capability.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// owners are the owners of the capability, from the persistent store.
+	Owners []Owner `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners"`
+	// in_memory tells whether the capability is loaded in memory.
+	InMemory bool `protobuf:"varint,3,opt,name=in_memory,json=inMemory,proto3" json:"in_memory,omitempty"`
+}
+
+func (m *CapabilityInfo) Reset()         { *m = CapabilityInfo{} }
+func (m *CapabilityInfo) String() string { return proto.CompactTextString(m) }
+func (*CapabilityInfo) ProtoMessage()    {}
+func (*CapabilityInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840d63d579edfedf, []int{0}
+}
+func (m *CapabilityInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapabilityInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapabilityInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CapabilityInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapabilityInfo.Merge(m, src)
+}
+func (m *CapabilityInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapabilityInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapabilityInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapabilityInfo proto.InternalMessageInfo
+
+func (m *CapabilityInfo) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *CapabilityInfo) GetOwners() []Owner {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *CapabilityInfo) GetInMemory() bool {
+	if m != nil {
+		return m.InMemory
+	}
+	return false
+}
+
+// QueryCapabilitiesRequest is the request type for the Query/Capabilities RPC method.
+type QueryCapabilitiesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCapabilitiesRequest) Reset()         { *m = QueryCapabilitiesRequest{} }
+func (m *QueryCapabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCapabilitiesRequest) ProtoMessage()    {}
+func (*QueryCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840d63d579edfedf, []int{1}
+}
+func (m *QueryCapabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapabilitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCapabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapabilitiesRequest.Merge(m, src)
+}
+func (m *QueryCapabilitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapabilitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCapabilitiesRequest proto.InternalMessageInfo
+
+func (m *QueryCapabilitiesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCapabilitiesResponse is the response type for the Query/Capabilities RPC method.
+type QueryCapabilitiesResponse struct {
+	// capabilities are the capabilities ordered by their indexes.
+	Capabilities []CapabilityInfo `protobuf:"bytes,1,rep,name=capabilities,proto3" json:"capabilities"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCapabilitiesResponse) Reset()         { *m = QueryCapabilitiesResponse{} }
+func (m *QueryCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCapabilitiesResponse) ProtoMessage()    {}
+func (*QueryCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840d63d579edfedf, []int{2}
+}
+func (m *QueryCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapabilitiesResponse.Merge(m, src)
+}
+func (m *QueryCapabilitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapabilitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCapabilitiesResponse proto.InternalMessageInfo
+
+func (m *QueryCapabilitiesResponse) GetCapabilities() []CapabilityInfo {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *QueryCapabilitiesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCapabilityRequest is the request type for the Query/Capability RPC method.
+type QueryCapabilityRequest struct {
+	// index is the index of the capability.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryCapabilityRequest) Reset()         { *m = QueryCapabilityRequest{} }
+func (m *QueryCapabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCapabilityRequest) ProtoMessage()    {}
+func (*QueryCapabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840d63d579edfedf, []int{3}
+}
+func (m *QueryCapabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCapabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapabilityRequest.Merge(m, src)
+}
+func (m *QueryCapabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapabilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCapabilityRequest proto.InternalMessageInfo
+
+func (m *QueryCapabilityRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// QueryCapabilityResponse is the response type for the Query/Capability RPC method.
+type QueryCapabilityResponse struct {
+	Capability CapabilityInfo `protobuf:"bytes,1,opt,name=capability,proto3" json:"capability"`
+}
+
+func (m *QueryCapabilityResponse) Reset()         { *m = QueryCapabilityResponse{} }
+func (m *QueryCapabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCapabilityResponse) ProtoMessage()    {}
+func (*QueryCapabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840d63d579edfedf, []int{4}
+}
+func (m *QueryCapabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCapabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapabilityResponse.Merge(m, src)
+}
+func (m *QueryCapabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCapabilityResponse proto.InternalMessageInfo
+
+func (m *QueryCapabilityResponse) GetCapability() CapabilityInfo {
+	if m != nil {
+		return m.Capability
+	}
+	return CapabilityInfo{}
+}
+
+// QueryCapabilityByNameRequest is the request type for the Query/CapabilityByName RPC method.
+type QueryCapabilityByNameRequest struct {
+	// module is the name of the owner module.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// name is the name of the capability in the owner module.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryCapabilityByNameRequest) Reset()         { *m = QueryCapabilityByNameRequest{} }
+func (m *QueryCapabilityByNameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCapabilityByNameRequest) ProtoMessage()    {}
+func (*QueryCapabilityByNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840d63d579edfedf, []int{5}
+}
+func (m *QueryCapabilityByNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapabilityByNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapabilityByNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCapabilityByNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapabilityByNameRequest.Merge(m, src)
+}
+func (m *QueryCapabilityByNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapabilityByNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapabilityByNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCapabilityByNameRequest proto.InternalMessageInfo
+
+func (m *QueryCapabilityByNameRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *QueryCapabilityByNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryCapabilityByNameResponse is the response type for the Query/CapabilityByName RPC method.
+type QueryCapabilityByNameResponse struct {
+	Capability CapabilityInfo `protobuf:"bytes,1,opt,name=capability,proto3" json:"capability"`
+}
+
+func (m *QueryCapabilityByNameResponse) Reset()         { *m = QueryCapabilityByNameResponse{} }
+func (m *QueryCapabilityByNameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCapabilityByNameResponse) ProtoMessage()    {}
+func (*QueryCapabilityByNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840d63d579edfedf, []int{6}
+}
+func (m *QueryCapabilityByNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapabilityByNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapabilityByNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCapabilityByNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapabilityByNameResponse.Merge(m, src)
+}
+func (m *QueryCapabilityByNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapabilityByNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapabilityByNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCapabilityByNameResponse proto.InternalMessageInfo
+
+func (m *QueryCapabilityByNameResponse) GetCapability() CapabilityInfo {
+	if m != nil {
+		return m.Capability
+	}
+	return CapabilityInfo{}
+}
+
+func init() {
+	proto.RegisterType((*CapabilityInfo)(nil), "cosmos.capability.v1beta1.CapabilityInfo")
+	proto.RegisterType((*QueryCapabilitiesRequest)(nil), "cosmos.capability.v1beta1.QueryCapabilitiesRequest")
+	proto.RegisterType((*QueryCapabilitiesResponse)(nil), "cosmos.capability.v1beta1.QueryCapabilitiesResponse")
+	proto.RegisterType((*QueryCapabilityRequest)(nil), "cosmos.capability.v1beta1.QueryCapabilityRequest")
+	proto.RegisterType((*QueryCapabilityResponse)(nil), "cosmos.capability.v1beta1.QueryCapabilityResponse")
+	proto.RegisterType((*QueryCapabilityByNameRequest)(nil), "cosmos.capability.v1beta1.QueryCapabilityByNameRequest")
+	proto.RegisterType((*QueryCapabilityByNameResponse)(nil), "cosmos.capability.v1beta1.QueryCapabilityByNameResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/capability/v1beta1/query.proto", fileDescriptor_840d63d579edfedf)
+}
+
+var fileDescriptor_840d63d579edfedf = []byte{
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0x12, 0x41,
+	0x18, 0x67, 0x28, 0x25, 0xe5, 0x6b, 0x63, 0xcc, 0xa4, 0xa9, 0x14, 0xeb, 0x4a, 0x36, 0xd1, 0xd2,
+	0x26, 0xee, 0x04, 0x6a, 0x6c, 0x4f, 0x3d, 0xa0, 0xd1, 0x68, 0xa2, 0xd5, 0xf5, 0xe6, 0xc5, 0xec,
+	0xc2, 0xb8, 0xae, 0xb2, 0x33, 0x5b, 0x66, 0xd1, 0x6e, 0x9a, 0x5e, 0xf4, 0x05, 0x4c, 0x7c, 0x0b,
+	0xf5, 0x05, 0x7c, 0x00, 0x93, 0x1e, 0x9b, 0x78, 0xf1, 0x64, 0x0c, 0xf8, 0x20, 0x86, 0x99, 0x01,
+	0x76, 0x2b, 0x20, 0x1c, 0xbc, 0x7d, 0x33, 0x7c, 0xdf, 0xef, 0xdf, 0x7c, 0x2c, 0x5c, 0x6b, 0x70,
+	0x11, 0x70, 0x41, 0x1a, 0x4e, 0xe8, 0xb8, 0x7e, 0xcb, 0x8f, 0x62, 0xf2, 0xa6, 0xea, 0xd2, 0xc8,
+	0xa9, 0x92, 0xc3, 0x0e, 0x6d, 0xc7, 0x56, 0xd8, 0xe6, 0x11, 0xc7, 0xeb, 0xaa, 0xcd, 0x1a, 0xb5,
+	0x59, 0xba, 0xad, 0xb4, 0xad, 0x11, 0x5c, 0x47, 0x50, 0x35, 0x33, 0x44, 0x08, 0x1d, 0xcf, 0x67,
+	0x4e, 0xe4, 0x73, 0xa6, 0x60, 0x86, 0xbd, 0x63, 0xd8, 0x12, 0xc8, 0xaa, 0x77, 0xd5, 0xe3, 0x1e,
+	0x97, 0x25, 0xe9, 0x57, 0xfa, 0x76, 0xc3, 0xe3, 0xdc, 0x6b, 0x51, 0xe2, 0x84, 0x3e, 0x71, 0x18,
+	0xe3, 0x91, 0x84, 0x17, 0xea, 0x57, 0xf3, 0x3d, 0x82, 0x0b, 0xb7, 0x87, 0x40, 0xf7, 0xd9, 0x0b,
+	0x8e, 0x57, 0x61, 0xd1, 0x67, 0x4d, 0x7a, 0x54, 0x44, 0x65, 0x54, 0xc9, 0xd9, 0xea, 0x80, 0xf7,
+	0x21, 0xcf, 0xdf, 0x32, 0xda, 0x16, 0xc5, 0x6c, 0x79, 0xa1, 0xb2, 0x5c, 0x2b, 0x5b, 0x13, 0x0d,
+	0x5a, 0x07, 0xfd, 0xc6, 0x7a, 0xee, 0xf4, 0xe7, 0xd5, 0x8c, 0xad, 0xa7, 0xf0, 0x65, 0x28, 0xf8,
+	0xec, 0x79, 0x40, 0x03, 0xde, 0x8e, 0x8b, 0x0b, 0x65, 0x54, 0x59, 0xb2, 0x97, 0x7c, 0xf6, 0x50,
+	0x9e, 0x4d, 0x17, 0x8a, 0x4f, 0xfa, 0x39, 0x0c, 0x95, 0xf8, 0x54, 0xd8, 0xf4, 0xb0, 0x43, 0x45,
+	0x84, 0xef, 0x02, 0x8c, 0x52, 0x91, 0x9a, 0x96, 0x6b, 0xd7, 0x07, 0xe4, 0xfd, 0x08, 0x2d, 0x15,
+	0xfb, 0x80, 0xfc, 0xb1, 0xe3, 0x51, 0x3d, 0x6b, 0x27, 0x26, 0xcd, 0xaf, 0x08, 0xd6, 0xc7, 0x90,
+	0x88, 0x90, 0x33, 0x41, 0xf1, 0x53, 0x58, 0x69, 0x24, 0xee, 0x8b, 0x48, 0x9a, 0xdc, 0x9a, 0x62,
+	0x32, 0x9d, 0x9a, 0x76, 0x9b, 0x02, 0xc1, 0xf7, 0x52, 0xd2, 0xb3, 0x52, 0xfa, 0xe6, 0x3f, 0xa5,
+	0x2b, 0x45, 0x29, 0xed, 0x16, 0xac, 0xa5, 0xa5, 0xc7, 0x83, 0x74, 0xc6, 0x3e, 0x96, 0xf9, 0x0a,
+	0x2e, 0xfd, 0xd5, 0xaf, 0x8d, 0x1e, 0x00, 0x8c, 0xcc, 0xe8, 0x38, 0xe7, 0xb6, 0x99, 0x80, 0x30,
+	0x1f, 0xc0, 0xc6, 0x39, 0xae, 0x7a, 0xfc, 0xc8, 0x09, 0x06, 0x6f, 0x80, 0xd7, 0x20, 0x1f, 0xf0,
+	0x66, 0xa7, 0x45, 0x25, 0x59, 0xc1, 0xd6, 0x27, 0x8c, 0x21, 0xc7, 0x9c, 0x80, 0xca, 0x58, 0x0a,
+	0xb6, 0xac, 0xcd, 0x10, 0xae, 0x4c, 0xc0, 0xfa, 0x4f, 0xea, 0x6b, 0x9f, 0x73, 0xb0, 0x28, 0x29,
+	0xf1, 0x27, 0x04, 0x2b, 0xc9, 0xd5, 0xc0, 0x3b, 0x53, 0x70, 0x27, 0x6d, 0x6b, 0xe9, 0xe6, 0x7c,
+	0x43, 0xca, 0x96, 0x49, 0xde, 0x7d, 0xff, 0xfd, 0x31, 0xbb, 0x85, 0x37, 0xc9, 0x0c, 0x7f, 0xf7,
+	0xbe, 0xb6, 0x2f, 0x08, 0x60, 0xe4, 0x0d, 0x57, 0x67, 0x66, 0x1d, 0x2c, 0x4e, 0xa9, 0x36, 0xcf,
+	0x88, 0x96, 0xb9, 0x2b, 0x65, 0x56, 0x31, 0x99, 0x51, 0x26, 0x39, 0x96, 0xeb, 0x78, 0x82, 0xbf,
+	0x21, 0xb8, 0x78, 0xfe, 0x4d, 0xf1, 0xee, 0xec, 0x0a, 0x52, 0x1b, 0x55, 0xda, 0x9b, 0x7f, 0x50,
+	0x1b, 0xd8, 0x97, 0x06, 0xf6, 0xf0, 0xad, 0x29, 0x06, 0xd4, 0x7a, 0x0a, 0x72, 0xac, 0x8a, 0x93,
+	0x44, 0x4f, 0xfd, 0xce, 0x69, 0xd7, 0x40, 0x67, 0x5d, 0x03, 0xfd, 0xea, 0x1a, 0xe8, 0x43, 0xcf,
+	0xc8, 0x9c, 0xf5, 0x8c, 0xcc, 0x8f, 0x9e, 0x91, 0x79, 0xb6, 0xed, 0xf9, 0xd1, 0xcb, 0x8e, 0x6b,
+	0x35, 0x78, 0x40, 0x5a, 0x3e, 0xa3, 0xa4, 0xe5, 0x06, 0x37, 0x44, 0xf3, 0x35, 0x39, 0x4a, 0x72,
+	0x44, 0x71, 0x48, 0x85, 0x9b, 0x97, 0x9f, 0xde, 0x9d, 0x3f, 0x03, 0x00, 0xea, 0xf6, 0x80, 0xdd,
+	0x4a, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Capabilities queries all the capabilities with their owners.
+	Capabilities(ctx context.Context, in *QueryCapabilitiesRequest, opts ...grpc.CallOption) (*QueryCapabilitiesResponse, error)
+	// Capability queries a capability by its index.
+	Capability(ctx context.Context, in *QueryCapabilityRequest, opts ...grpc.CallOption) (*QueryCapabilityResponse, error)
+	// CapabilityByName queries a capability by the name its owner module refers
+	// to it.
+	CapabilityByName(ctx context.Context, in *QueryCapabilityByNameRequest, opts ...grpc.CallOption) (*QueryCapabilityByNameResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Capabilities(ctx context.Context, in *QueryCapabilitiesRequest, opts ...grpc.CallOption) (*QueryCapabilitiesResponse, error) {
+	out := new(QueryCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.capability.v1beta1.Query/Capabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Capability(ctx context.Context, in *QueryCapabilityRequest, opts ...grpc.CallOption) (*QueryCapabilityResponse, error) {
+	out := new(QueryCapabilityResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.capability.v1beta1.Query/Capability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CapabilityByName(ctx context.Context, in *QueryCapabilityByNameRequest, opts ...grpc.CallOption) (*QueryCapabilityByNameResponse, error) {
+	out := new(QueryCapabilityByNameResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.capability.v1beta1.Query/CapabilityByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Capabilities queries all the capabilities with their owners.
+	Capabilities(context.Context, *QueryCapabilitiesRequest) (*QueryCapabilitiesResponse, error)
+	// Capability queries a capability by its index.
+	Capability(context.Context, *QueryCapabilityRequest) (*QueryCapabilityResponse, error)
+	// CapabilityByName queries a capability by the name its owner module refers
+	// to it.
+	CapabilityByName(context.Context, *QueryCapabilityByNameRequest) (*QueryCapabilityByNameResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Capabilities(ctx context.Context, req *QueryCapabilitiesRequest) (*QueryCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capabilities not implemented")
+}
+func (*UnimplementedQueryServer) Capability(ctx context.Context, req *QueryCapabilityRequest) (*QueryCapabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capability not implemented")
+}
+func (*UnimplementedQueryServer) CapabilityByName(ctx context.Context, req *QueryCapabilityByNameRequest) (*QueryCapabilityByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapabilityByName not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Capabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.capability.v1beta1.Query/Capabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Capabilities(ctx, req.(*QueryCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Capability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCapabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Capability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.capability.v1beta1.Query/Capability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Capability(ctx, req.(*QueryCapabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CapabilityByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCapabilityByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CapabilityByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.capability.v1beta1.Query/CapabilityByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CapabilityByName(ctx, req.(*QueryCapabilityByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.capability.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Capabilities",
+			Handler:    _Query_Capabilities_Handler,
+		},
+		{
+			MethodName: "Capability",
+			Handler:    _Query_Capability_Handler,
+		},
+		{
+			MethodName: "CapabilityByName",
+			Handler:    _Query_CapabilityByName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/capability/v1beta1/query.proto",
+}
+
+func (m *CapabilityInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapabilityInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapabilityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InMemory {
+		i--
+		if m.InMemory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapabilitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapabilitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapabilitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Capabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Capability.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapabilityByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapabilityByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapabilityByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapabilityByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapabilityByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapabilityByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Capability.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CapabilityInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.InMemory {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCapabilitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCapabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Capabilities) > 0 {
+		for _, e := range m.Capabilities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCapabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryCapabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Capability.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCapabilityByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCapabilityByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Capability.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CapabilityInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapabilityInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapabilityInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Owner{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InMemory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InMemory = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCapabilitiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCapabilitiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCapabilitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCapabilitiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCapabilitiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, CapabilityInfo{})
+			if err := m.Capabilities[len(m.Capabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCapabilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCapabilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCapabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCapabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCapabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCapabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCapabilityByNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCapabilityByNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCapabilityByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCapabilityByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCapabilityByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCapabilityByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
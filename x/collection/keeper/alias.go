@@ -8,7 +8,7 @@ import (
 )
 
 // iterate through the balances of a contract and perform the provided function
-func (k Keeper) iterateContractBalances(ctx sdk.Context, contractID string, fn func(address sdk.AccAddress, balance collection.Coin) (stop bool)) {
+func (k Keeper) IterateContractBalances(ctx sdk.Context, contractID string, fn func(address sdk.AccAddress, balance collection.Coin) (stop bool)) {
 	k.iterateBalancesImpl(ctx, balanceKeyPrefixByContractID(contractID), func(_ string, address sdk.AccAddress, balance collection.Coin) (stop bool) {
 		return fn(address, balance)
 	})
@@ -36,10 +36,10 @@ func (k Keeper) iterateBalancesImpl(ctx sdk.Context, prefix []byte, fn func(cont
 	}
 }
 
-func (k Keeper) iterateContracts(ctx sdk.Context, fn func(contract collection.Contract) (stop bool)) {
+func (k Keeper) IterateContracts(ctx sdk.Context, fn func(contract collection.Contract) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, contractKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, ContractKeyPrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -53,7 +53,7 @@ func (k Keeper) iterateContracts(ctx sdk.Context, fn func(contract collection.Co
 	}
 }
 
-func (k Keeper) iterateContractClasses(ctx sdk.Context, contractID string, fn func(class collection.TokenClass) (stop bool)) {
+func (k Keeper) IterateContractClasses(ctx sdk.Context, contractID string, fn func(class collection.TokenClass) (stop bool)) {
 	k.iterateClassesImpl(ctx, classKeyPrefixByContractID(contractID), fn)
 }
 
@@ -77,7 +77,7 @@ func (k Keeper) iterateClassesImpl(ctx sdk.Context, prefix []byte, fn func(class
 	}
 }
 
-func (k Keeper) iterateContractGrants(ctx sdk.Context, contractID string, fn func(grant collection.Grant) (stop bool)) {
+func (k Keeper) IterateContractGrants(ctx sdk.Context, contractID string, fn func(grant collection.Grant) (stop bool)) {
 	k.iterateGrantsImpl(ctx, grantKeyPrefixByContractID(contractID), func(_ string, grant collection.Grant) (stop bool) {
 		return fn(grant)
 	})
@@ -103,7 +103,7 @@ func (k Keeper) iterateGrantsImpl(ctx sdk.Context, prefix []byte, fn func(contra
 	}
 }
 
-func (k Keeper) iterateContractAuthorizations(ctx sdk.Context, contractID string, fn func(authorization collection.Authorization) (stop bool)) {
+func (k Keeper) IterateContractAuthorizations(ctx sdk.Context, contractID string, fn func(authorization collection.Authorization) (stop bool)) {
 	k.iterateAuthorizationsImpl(ctx, authorizationKeyPrefixByContractID(contractID), func(_ string, authorization collection.Authorization) (stop bool) {
 		return fn(authorization)
 	})
@@ -129,7 +129,7 @@ func (k Keeper) iterateAuthorizationsImpl(ctx sdk.Context, prefix []byte, fn fun
 	}
 }
 
-func (k Keeper) iterateContractNFTs(ctx sdk.Context, contractID string, fn func(nft collection.NFT) (stop bool)) {
+func (k Keeper) IterateContractNFTs(ctx sdk.Context, contractID string, fn func(nft collection.NFT) (stop bool)) {
 	k.iterateNFTsImpl(ctx, nftKeyPrefixByContractID(contractID), func(_ string, nft collection.NFT) (stop bool) {
 		return fn(nft)
 	})
@@ -152,7 +152,7 @@ func (k Keeper) iterateNFTsImpl(ctx sdk.Context, prefix []byte, fn func(contract
 	}
 }
 
-func (k Keeper) iterateContractParents(ctx sdk.Context, contractID string, fn func(tokenID, parentID string) (stop bool)) {
+func (k Keeper) IterateContractParents(ctx sdk.Context, contractID string, fn func(tokenID, parentID string) (stop bool)) {
 	k.iterateParentsImpl(ctx, parentKeyPrefixByContractID(contractID), func(_ string, tokenID, parentID string) (stop bool) {
 		return fn(tokenID, parentID)
 	})
@@ -211,13 +211,13 @@ func (k Keeper) iterateStatisticsImpl(ctx sdk.Context, prefix []byte, fn func(co
 }
 
 func (k Keeper) iterateContractSupplies(ctx sdk.Context, contractID string, fn func(classID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatisticsImpl(ctx, statisticKeyPrefixByContractID(supplyKeyPrefix, contractID), func(_ string, classID string, amount sdk.Int) (stop bool) {
+	k.iterateStatisticsImpl(ctx, statisticKeyPrefixByContractID(SupplyKeyPrefix, contractID), func(_ string, classID string, amount sdk.Int) (stop bool) {
 		return fn(classID, amount)
 	})
 }
 
 func (k Keeper) iterateContractBurnts(ctx sdk.Context, contractID string, fn func(classID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatisticsImpl(ctx, statisticKeyPrefixByContractID(burntKeyPrefix, contractID), func(_ string, classID string, amount sdk.Int) (stop bool) {
+	k.iterateStatisticsImpl(ctx, statisticKeyPrefixByContractID(BurntKeyPrefix, contractID), func(_ string, classID string, amount sdk.Int) (stop bool) {
 		return fn(classID, amount)
 	})
 }
@@ -226,7 +226,7 @@ func (k Keeper) iterateContractBurnts(ctx sdk.Context, contractID string, fn fun
 func (k Keeper) iterateNextTokenClassIDs(ctx sdk.Context, fn func(class collection.NextClassIDs) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, nextClassIDKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, NextClassIDKeyPrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
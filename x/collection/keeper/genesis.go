@@ -87,6 +87,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *collection.GenesisState) {
 			if nftClass, ok := class.(*collection.NFTClass); ok {
 				k.setLegacyTokenType(ctx, contractID, nftClass.Id)
 			}
+			if ftClass, ok := class.(*collection.FTClass); ok {
+				k.setLegacyToken(ctx, contractID, collection.NewFTID(ftClass.Id))
+			}
 		}
 
 		reporter.Tick()
@@ -131,6 +134,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *collection.GenesisState) {
 
 		for _, nft := range contractNFTs.Nfts {
 			k.setNFT(ctx, contractID, nft)
+
+			// legacy
+			k.setLegacyToken(ctx, contractID, nft.TokenId)
 		}
 
 		reporter.Tick()
@@ -185,6 +191,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *collection.GenesisState) {
 		contractID := contractBurnts.ContractId
 		for _, burnt := range contractBurnts.Statistics {
 			k.setBurnt(ctx, contractID, burnt.ClassId, burnt.Amount)
+
+			// the amount of minted tokens of the classes without supply
+			k.setMinted(ctx, contractID, burnt.ClassId, burnt.Amount)
 		}
 
 		reporter.Tick()
@@ -211,6 +220,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *collection.GenesisState {
 	contracts := k.getContracts(ctx)
 
 	return &collection.GenesisState{
+		Params:         k.GetParams(ctx),
 		Contracts:      contracts,
 		NextClassIds:   k.getAllNextClassIDs(ctx),
 		Classes:        k.getClasses(ctx, contracts),
@@ -227,7 +237,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *collection.GenesisState {
 
 func (k Keeper) getContracts(ctx sdk.Context) []collection.Contract {
 	var contracts []collection.Contract
-	k.iterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+	k.IterateContracts(ctx, func(contract collection.Contract) (stop bool) {
 		contracts = append(contracts, contract)
 		return false
 	})
@@ -243,7 +253,7 @@ func (k Keeper) getClasses(ctx sdk.Context, contracts []collection.Contract) []c
 			ContractId: contractID,
 		}
 
-		k.iterateContractClasses(ctx, contractID, func(class collection.TokenClass) (stop bool) {
+		k.IterateContractClasses(ctx, contractID, func(class collection.TokenClass) (stop bool) {
 			any := collection.TokenClassToAny(class)
 			contractClasses.Classes = append(contractClasses.Classes, *any)
 			return false
@@ -307,7 +317,7 @@ func (k Keeper) getContractBalances(ctx sdk.Context, contractID string) []collec
 	var balances []collection.Balance
 	addressToBalanceIndex := make(map[string]int)
 
-	k.iterateContractBalances(ctx, contractID, func(address sdk.AccAddress, balance collection.Coin) (stop bool) {
+	k.IterateContractBalances(ctx, contractID, func(address sdk.AccAddress, balance collection.Coin) (stop bool) {
 		index, ok := addressToBalanceIndex[address.String()]
 		if ok {
 			balances[index].Amount = append(balances[index].Amount, balance)
@@ -334,7 +344,7 @@ func (k Keeper) getNFTs(ctx sdk.Context, contracts []collection.Contract) []coll
 			ContractId: contractID,
 		}
 
-		k.iterateContractNFTs(ctx, contractID, func(nft collection.NFT) (stop bool) {
+		k.IterateContractNFTs(ctx, contractID, func(nft collection.NFT) (stop bool) {
 			contractParents.Nfts = append(contractParents.Nfts, nft)
 			return false
 		})
@@ -354,7 +364,7 @@ func (k Keeper) getParents(ctx sdk.Context, contracts []collection.Contract) []c
 			ContractId: contractID,
 		}
 
-		k.iterateContractParents(ctx, contractID, func(tokenID, parentID string) (stop bool) {
+		k.IterateContractParents(ctx, contractID, func(tokenID, parentID string) (stop bool) {
 			relation := collection.TokenRelation{
 				Self:  tokenID,
 				Other: parentID,
@@ -378,7 +388,7 @@ func (k Keeper) getAuthorizations(ctx sdk.Context, contracts []collection.Contra
 			ContractId: contractID,
 		}
 
-		k.iterateContractAuthorizations(ctx, contractID, func(authorization collection.Authorization) (stop bool) {
+		k.IterateContractAuthorizations(ctx, contractID, func(authorization collection.Authorization) (stop bool) {
 			contractAuthorizations.Authorizations = append(contractAuthorizations.Authorizations, authorization)
 			return false
 		})
@@ -398,7 +408,7 @@ func (k Keeper) getGrants(ctx sdk.Context, contracts []collection.Contract) []co
 			ContractId: contractID,
 		}
 
-		k.iterateContractGrants(ctx, contractID, func(grant collection.Grant) (stop bool) {
+		k.IterateContractGrants(ctx, contractID, func(grant collection.Grant) (stop bool) {
 			contractGrants.Grants = append(contractGrants.Grants, grant)
 			return false
 		})
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/collection"
 )
 
@@ -28,3 +32,48 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	newGenesis := s.keeper.ExportGenesis(s.ctx)
 	s.Require().Equal(genesis, newGenesis)
 }
+
+func (s *KeeperTestSuite) TestInitGenesisMinted() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// burn the whole supply of the class
+	for _, holder := range []sdk.AccAddress{s.vendor, s.operator, s.customer} {
+		balance := s.keeper.GetBalance(ctx, s.contractID, holder, collection.NewFTID(s.ftClassID))
+		if balance.IsPositive() {
+			_, err := s.keeper.BurnCoins(ctx, s.contractID, holder, collection.NewCoins(collection.NewFTCoin(s.ftClassID, balance)))
+			s.Require().NoError(err)
+		}
+	}
+	s.Require().True(s.keeper.GetSupply(ctx, s.contractID, s.ftClassID).IsZero())
+	minted := s.keeper.GetMinted(ctx, s.contractID, s.ftClassID)
+	s.Require().True(minted.IsPositive())
+
+	// import into a new chain
+	app := simapp.Setup(false)
+	newCtx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.CollectionKeeper.InitGenesis(newCtx, s.keeper.ExportGenesis(ctx))
+
+	// the minted amount of the class without supply is restored as well
+	s.Require().Equal(minted, app.CollectionKeeper.GetMinted(newCtx, s.contractID, s.ftClassID))
+}
+
+func (s *KeeperTestSuite) TestInitGenesisLegacyTokens() {
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal(s.keeper.GetParams(s.ctx), genesis.Params)
+
+	// import into a new chain
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.CollectionKeeper.InitGenesis(ctx, genesis)
+	s.Require().Equal(genesis.Params, app.CollectionKeeper.GetParams(ctx))
+
+	// the legacy tokens of the fungible token classes and the nfts are restored
+	store := ctx.KVStore(app.GetKey(collection.StoreKey))
+	tokenIDs := []string{collection.NewFTID(s.ftClassID)}
+	for i := 1; i <= s.numNFTs; i++ {
+		tokenIDs = append(tokenIDs, collection.NewNFTID(s.nftClassID, i))
+	}
+	for _, tokenID := range tokenIDs {
+		s.Require().True(hasLegacyToken(store, s.contractID, tokenID), tokenID)
+	}
+}
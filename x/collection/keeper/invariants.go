@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/collection"
+)
+
+const (
+	totalSupplyInvariant   = "total-supply"
+	ownershipInvariant     = "ownership"
+	compositionInvariant   = "composition"
+	authorizationInvariant = "authorizations"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for name, invariant := range map[string]func(k Keeper) sdk.Invariant{
+		totalSupplyInvariant:   TotalSupplyInvariant,
+		ownershipInvariant:     OwnershipInvariant,
+		compositionInvariant:   CompositionInvariant,
+		authorizationInvariant: AuthorizationInvariant,
+	} {
+		ir.RegisterRoute(collection.ModuleName, name, invariant(k))
+	}
+}
+
+// TotalSupplyInvariant checks that the supply of each token class equals to
+// the amount of minted tokens minus burnt ones. It also checks that the supply
+// equals to the sum of the balances for a fungible token class, and to the
+// number of the tokens for a non-fungible token class.
+func TotalSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// cache, we don't want to write changes
+		ctx, _ = ctx.CacheContext()
+
+		msg := ""
+		broken := false
+
+		k.IterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+			contractID := contract.Id
+
+			sums := map[string]sdk.Int{}
+			k.IterateContractBalances(ctx, contractID, func(_ sdk.AccAddress, balance collection.Coin) (stop bool) {
+				if err := collection.ValidateFTID(balance.TokenId); err == nil {
+					classID := collection.SplitTokenID(balance.TokenId)
+					if sum, ok := sums[classID]; ok {
+						sums[classID] = sum.Add(balance.Amount)
+					} else {
+						sums[classID] = balance.Amount
+					}
+				}
+				return false
+			})
+
+			counts := map[string]int64{}
+			k.IterateContractNFTs(ctx, contractID, func(nft collection.NFT) (stop bool) {
+				counts[collection.SplitTokenID(nft.TokenId)]++
+				return false
+			})
+
+			k.IterateContractClasses(ctx, contractID, func(class collection.TokenClass) (stop bool) {
+				classID := class.GetId()
+
+				supply := k.GetSupply(ctx, contractID, classID)
+				minted := k.GetMinted(ctx, contractID, classID)
+				burnt := k.GetBurnt(ctx, contractID, classID)
+				if expected := minted.Sub(burnt); !supply.Equal(expected) {
+					msg += fmt.Sprintf("supply of %s in %s; expected %s (minted %s - burnt %s), got %s\n", classID, contractID, expected, minted, burnt, supply)
+					broken = true
+				}
+
+				var real sdk.Int
+				switch class.(type) {
+				case *collection.FTClass:
+					real = sdk.ZeroInt()
+					if sum, ok := sums[classID]; ok {
+						real = sum
+					}
+				case *collection.NFTClass:
+					real = sdk.NewInt(counts[classID])
+				}
+				if !supply.Equal(real) {
+					msg += fmt.Sprintf("tokens of %s in %s; expected %s, got %s\n", classID, contractID, supply, real)
+					broken = true
+				}
+
+				return false
+			})
+
+			return false
+		})
+
+		return sdk.FormatInvariant(collection.ModuleName, totalSupplyInvariant, msg), broken
+	}
+}
+
+// OwnershipInvariant checks that every non-fungible token has either exactly
+// one owner or its parent, and that no one holds a non-existent token.
+func OwnershipInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// cache, we don't want to write changes
+		ctx, _ = ctx.CacheContext()
+
+		msg := ""
+		broken := false
+
+		store := ctx.KVStore(k.storeKey)
+		k.IterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+			contractID := contract.Id
+
+			holders := map[string][]sdk.AccAddress{}
+			k.IterateContractBalances(ctx, contractID, func(address sdk.AccAddress, balance collection.Coin) (stop bool) {
+				if err := collection.ValidateNFTID(balance.TokenId); err != nil {
+					return false
+				}
+
+				if err := k.hasNFT(ctx, contractID, balance.TokenId); err != nil {
+					msg += fmt.Sprintf("%s holds non-existent token %s in %s\n", address, balance.TokenId, contractID)
+					broken = true
+				}
+				if !balance.Amount.Equal(sdk.OneInt()) {
+					msg += fmt.Sprintf("%s holds %s of token %s in %s\n", address, balance.Amount, balance.TokenId, contractID)
+					broken = true
+				}
+
+				holders[balance.TokenId] = append(holders[balance.TokenId], address)
+				return false
+			})
+
+			k.IterateContractNFTs(ctx, contractID, func(nft collection.NFT) (stop bool) {
+				tokenID := nft.TokenId
+
+				_, err := k.GetParent(ctx, contractID, tokenID)
+				hasParent := err == nil
+
+				switch owners := holders[tokenID]; {
+				case hasParent && len(owners) != 0:
+					msg += fmt.Sprintf("token %s in %s has both its parent and %d owner(s)\n", tokenID, contractID, len(owners))
+					broken = true
+				case !hasParent && len(owners) != 1:
+					msg += fmt.Sprintf("token %s in %s has %d owner(s)\n", tokenID, contractID, len(owners))
+					broken = true
+				case !hasParent && !bytes.Equal(store.Get(ownerKey(contractID, tokenID)), owners[0]):
+					msg += fmt.Sprintf("owner index of token %s in %s differs from its holder %s\n", tokenID, contractID, owners[0])
+					broken = true
+				}
+
+				return false
+			})
+
+			return false
+		})
+
+		return sdk.FormatInvariant(collection.ModuleName, ownershipInvariant, msg), broken
+	}
+}
+
+// CompositionInvariant checks that the parent and the child indices are
+// consistent with each other, and that the compositions of the non-fungible
+// tokens have no cycles and their depths are within the limit.
+func CompositionInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// cache, we don't want to write changes
+		ctx, _ = ctx.CacheContext()
+
+		msg := ""
+		broken := false
+
+		store := ctx.KVStore(k.storeKey)
+		depthLimit := int(k.GetParams(ctx).DepthLimit)
+		k.IterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+			contractID := contract.Id
+
+			k.IterateContractParents(ctx, contractID, func(tokenID, parentID string) (stop bool) {
+				if !store.Has(childKey(contractID, parentID, tokenID)) {
+					msg += fmt.Sprintf("token %s in %s is not a child of its parent %s\n", tokenID, contractID, parentID)
+					broken = true
+				}
+				if err := k.hasNFT(ctx, contractID, parentID); err != nil {
+					msg += fmt.Sprintf("parent %s of token %s in %s does not exist\n", parentID, tokenID, contractID)
+					broken = true
+				}
+
+				// walk up to the root
+				visited := map[string]bool{tokenID: true}
+				depth := 0
+				for id := &parentID; id != nil; id, _ = k.GetParent(ctx, contractID, *id) {
+					if visited[*id] {
+						msg += fmt.Sprintf("token %s in %s is in a cycle\n", tokenID, contractID)
+						broken = true
+						break
+					}
+					visited[*id] = true
+
+					depth++
+					if depth > depthLimit {
+						msg += fmt.Sprintf("depth of token %s in %s exceeds its limit %d\n", tokenID, contractID, depthLimit)
+						broken = true
+						break
+					}
+				}
+
+				return false
+			})
+
+			k.iterateChildrenImpl(ctx, childKeyPrefixByContractID(contractID), func(_ string, tokenID, childID string) (stop bool) {
+				parent, err := k.GetParent(ctx, contractID, childID)
+				if err != nil || *parent != tokenID {
+					msg += fmt.Sprintf("token %s in %s is not a parent of its child %s\n", tokenID, contractID, childID)
+					broken = true
+				}
+
+				return false
+			})
+
+			return false
+		})
+
+		return sdk.FormatInvariant(collection.ModuleName, compositionInvariant, msg), broken
+	}
+}
+
+// AuthorizationInvariant checks that all the operator authorizations
+// reference existing contracts.
+func AuthorizationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// cache, we don't want to write changes
+		ctx, _ = ctx.CacheContext()
+
+		msg := ""
+		broken := false
+
+		k.iterateAuthorizationsImpl(ctx, AuthorizationKeyPrefix, func(contractID string, authorization collection.Authorization) (stop bool) {
+			if _, err := k.GetContract(ctx, contractID); err != nil {
+				msg += fmt.Sprintf("authorization of %s by %s on non-existent contract %s\n", authorization.Operator, authorization.Holder, contractID)
+				broken = true
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(collection.ModuleName, authorizationInvariant, msg), broken
+	}
+}
@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/collection/keeper"
+)
+
+func (s *KeeperTestSuite) TestTotalSupplyInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"supply differs from minted minus burnt": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Burnts: []collection.ContractStatistics{{
+						ContractId: s.contractID,
+						Statistics: []collection.ClassStatistics{{
+							ClassId: s.ftClassID,
+							Amount:  sdk.OneInt(),
+						}},
+					}},
+				})
+			},
+		},
+		"sum of the balances differs from the supply": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Balances: []collection.ContractBalances{{
+						ContractId: s.contractID,
+						Balances: []collection.Balance{{
+							Address: s.stranger.String(),
+							Amount:  collection.NewCoins(collection.NewFTCoin(s.ftClassID, sdk.OneInt())),
+						}},
+					}},
+				})
+			},
+		},
+		"number of the nfts differs from the supply": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Nfts: []collection.ContractNFTs{{
+						ContractId: s.contractID,
+						Nfts: []collection.NFT{{
+							TokenId: collection.NewNFTID(s.nftClassID, s.numNFTs*3+1),
+						}},
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.TotalSupplyInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOwnershipInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"nft without its owner nor parent": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Nfts: []collection.ContractNFTs{{
+						ContractId: s.contractID,
+						Nfts: []collection.NFT{{
+							TokenId: collection.NewNFTID(s.nftClassID, s.numNFTs*3+1),
+						}},
+					}},
+				})
+			},
+		},
+		"nft with two owners": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Balances: []collection.ContractBalances{{
+						ContractId: s.contractID,
+						Balances: []collection.Balance{{
+							Address: s.stranger.String(),
+							Amount:  collection.NewCoins(collection.NewCoin(collection.NewNFTID(s.nftClassID, 1), sdk.OneInt())),
+						}},
+					}},
+				})
+			},
+		},
+		"owner of a non-existent nft": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Balances: []collection.ContractBalances{{
+						ContractId: s.contractID,
+						Balances: []collection.Balance{{
+							Address: s.stranger.String(),
+							Amount:  collection.NewCoins(collection.NewCoin(collection.NewNFTID(s.nftClassID, s.numNFTs*3+1), sdk.OneInt())),
+						}},
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.OwnershipInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCompositionInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"cycle in the composition": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Parents: []collection.ContractTokenRelations{{
+						ContractId: s.contractID,
+						Relations: []collection.TokenRelation{{
+							Self:  collection.NewNFTID(s.nftClassID, 1),
+							Other: collection.NewNFTID(s.nftClassID, s.depthLimit),
+						}},
+					}},
+				})
+			},
+		},
+		"depth exceeds its limit": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.SetParams(ctx, collection.Params{
+					DepthLimit: uint32(s.depthLimit - 2),
+					WidthLimit: 4,
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.CompositionInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAuthorizationInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"authorization on a non-existent contract": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Authorizations: []collection.ContractAuthorizations{{
+						ContractId: "deadbeef",
+						Authorizations: []collection.Authorization{{
+							Holder:   s.customer.String(),
+							Operator: s.operator.String(),
+						}},
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.AuthorizationInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
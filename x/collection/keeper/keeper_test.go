@@ -18,6 +18,7 @@ type KeeperTestSuite struct {
 	suite.Suite
 	ctx         sdk.Context
 	goCtx       context.Context
+	storeKey    sdk.StoreKey
 	keeper      keeper.Keeper
 	queryServer collection.QueryServer
 	msgServer   collection.MsgServer
@@ -62,6 +63,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	app := simapp.Setup(checkTx)
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
+	s.storeKey = app.GetKey(collection.StoreKey)
 	s.keeper = app.CollectionKeeper
 
 	s.queryServer = keeper.NewQueryServer(s.keeper)
@@ -7,28 +7,28 @@ import (
 )
 
 var (
-	paramsKey = []byte{0x00}
+	ParamsKey = []byte{0x00}
 
-	contractKeyPrefix    = []byte{0x10}
-	classKeyPrefix       = []byte{0x11}
-	nextClassIDKeyPrefix = []byte{0x12}
-	nextTokenIDKeyPrefix = []byte{0x13}
+	ContractKeyPrefix    = []byte{0x10}
+	ClassKeyPrefix       = []byte{0x11}
+	NextClassIDKeyPrefix = []byte{0x12}
+	NextTokenIDKeyPrefix = []byte{0x13}
 
-	balanceKeyPrefix = []byte{0x20}
-	ownerKeyPrefix   = []byte{0x21}
-	nftKeyPrefix     = []byte{0x22}
-	parentKeyPrefix  = []byte{0x23}
-	childKeyPrefix   = []byte{0x24}
+	BalanceKeyPrefix = []byte{0x20}
+	OwnerKeyPrefix   = []byte{0x21}
+	NFTKeyPrefix     = []byte{0x22}
+	ParentKeyPrefix  = []byte{0x23}
+	ChildKeyPrefix   = []byte{0x24}
 
-	authorizationKeyPrefix = []byte{0x30}
-	grantKeyPrefix         = []byte{0x31}
+	AuthorizationKeyPrefix = []byte{0x30}
+	GrantKeyPrefix         = []byte{0x31}
 
-	supplyKeyPrefix = []byte{0x40}
-	mintedKeyPrefix = []byte{0x41}
-	burntKeyPrefix  = []byte{0x42}
+	SupplyKeyPrefix = []byte{0x40}
+	MintedKeyPrefix = []byte{0x41}
+	BurntKeyPrefix  = []byte{0x42}
 
-	legacyTokenKeyPrefix     = []byte{0xf0}
-	legacyTokenTypeKeyPrefix = []byte{0xf1}
+	LegacyTokenKeyPrefix     = []byte{0xf0}
+	LegacyTokenTypeKeyPrefix = []byte{0xf1}
 )
 
 func balanceKey(contractID string, address sdk.AccAddress, tokenID string) []byte {
@@ -58,12 +58,12 @@ func balanceKeyPrefixByAddress(contractID string, address sdk.AccAddress) []byte
 }
 
 func balanceKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(balanceKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(BalanceKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, balanceKeyPrefix)
+	copy(key, BalanceKeyPrefix)
 
-	begin += len(balanceKeyPrefix)
+	begin += len(BalanceKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -73,7 +73,7 @@ func balanceKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitBalanceKey(key []byte) (contractID string, address sdk.AccAddress, tokenID string) {
-	begin := len(balanceKeyPrefix) + 1
+	begin := len(BalanceKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -100,12 +100,12 @@ func ownerKey(contractID string, tokenID string) []byte {
 }
 
 func ownerKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(ownerKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(OwnerKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, ownerKeyPrefix)
+	copy(key, OwnerKeyPrefix)
 
-	begin += len(ownerKeyPrefix)
+	begin += len(OwnerKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -127,12 +127,12 @@ func nftKey(contractID string, tokenID string) []byte {
 }
 
 func nftKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(nftKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(NFTKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, nftKeyPrefix)
+	copy(key, NFTKeyPrefix)
 
-	begin += len(nftKeyPrefix)
+	begin += len(NFTKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -142,7 +142,7 @@ func nftKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitNFTKey(key []byte) (contractID string, tokenID string) {
-	begin := len(nftKeyPrefix) + 1
+	begin := len(NFTKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -165,12 +165,12 @@ func parentKey(contractID string, tokenID string) []byte {
 }
 
 func parentKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(parentKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(ParentKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, parentKeyPrefix)
+	copy(key, ParentKeyPrefix)
 
-	begin += len(parentKeyPrefix)
+	begin += len(ParentKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -180,7 +180,7 @@ func parentKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitParentKey(key []byte) (contractID string, tokenID string) {
-	begin := len(parentKeyPrefix) + 1
+	begin := len(ParentKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -219,12 +219,12 @@ func childKeyPrefixByTokenID(contractID string, tokenID string) []byte {
 }
 
 func childKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(childKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(ChildKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, childKeyPrefix)
+	copy(key, ChildKeyPrefix)
 
-	begin += len(childKeyPrefix)
+	begin += len(ChildKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -234,7 +234,7 @@ func childKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitChildKey(key []byte) (contractID string, tokenID, childID string) {
-	begin := len(childKeyPrefix) + 1
+	begin := len(ChildKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -250,10 +250,10 @@ func splitChildKey(key []byte) (contractID string, tokenID, childID string) {
 
 // ----------------------------------------------------------------------------
 func contractKey(contractID string) []byte {
-	key := make([]byte, len(contractKeyPrefix)+len(contractID))
+	key := make([]byte, len(ContractKeyPrefix)+len(contractID))
 
-	copy(key, contractKeyPrefix)
-	copy(key[len(contractKeyPrefix):], contractID)
+	copy(key, ContractKeyPrefix)
+	copy(key[len(ContractKeyPrefix):], contractID)
 
 	return key
 }
@@ -269,12 +269,12 @@ func classKey(contractID string, classID string) []byte {
 }
 
 func classKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(classKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(ClassKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, classKeyPrefix)
+	copy(key, ClassKeyPrefix)
 
-	begin += len(classKeyPrefix)
+	begin += len(ClassKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -294,12 +294,12 @@ func nextTokenIDKey(contractID string, classID string) []byte {
 }
 
 func nextTokenIDKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(nextTokenIDKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(NextTokenIDKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, nextTokenIDKeyPrefix)
+	copy(key, NextTokenIDKeyPrefix)
 
-	begin += len(nextTokenIDKeyPrefix)
+	begin += len(NextTokenIDKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -309,7 +309,7 @@ func nextTokenIDKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitNextTokenIDKey(key []byte) (contractID string, classID string) {
-	begin := len(nextTokenIDKeyPrefix) + 1
+	begin := len(NextTokenIDKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -320,10 +320,10 @@ func splitNextTokenIDKey(key []byte) (contractID string, classID string) {
 }
 
 func nextClassIDKey(contractID string) []byte {
-	key := make([]byte, len(nextClassIDKeyPrefix)+len(contractID))
+	key := make([]byte, len(NextClassIDKeyPrefix)+len(contractID))
 
-	copy(key, nextClassIDKeyPrefix)
-	copy(key[len(nextClassIDKeyPrefix):], contractID)
+	copy(key, NextClassIDKeyPrefix)
+	copy(key[len(NextClassIDKeyPrefix):], contractID)
 
 	return key
 }
@@ -356,12 +356,12 @@ func authorizationKeyPrefixByOperator(contractID string, operator sdk.AccAddress
 }
 
 func authorizationKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(authorizationKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(AuthorizationKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, authorizationKeyPrefix)
+	copy(key, AuthorizationKeyPrefix)
 
-	begin += len(authorizationKeyPrefix)
+	begin += len(AuthorizationKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -371,7 +371,7 @@ func authorizationKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitAuthorizationKey(key []byte) (contractID string, operator, holder sdk.AccAddress) {
-	begin := len(authorizationKeyPrefix) + 1
+	begin := len(AuthorizationKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -413,12 +413,12 @@ func grantKeyPrefixByGrantee(contractID string, grantee sdk.AccAddress) []byte {
 }
 
 func grantKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(grantKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(GrantKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, grantKeyPrefix)
+	copy(key, GrantKeyPrefix)
 
-	begin += len(grantKeyPrefix)
+	begin += len(GrantKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -428,7 +428,7 @@ func grantKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitGrantKey(key []byte) (contractID string, grantee sdk.AccAddress, permission collection.Permission) {
-	begin := len(grantKeyPrefix) + 1
+	begin := len(GrantKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -493,12 +493,12 @@ func legacyTokenKey(contractID string, tokenID string) []byte {
 }
 
 func legacyTokenKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(legacyTokenKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(LegacyTokenKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, legacyTokenKeyPrefix)
+	copy(key, LegacyTokenKeyPrefix)
 
-	begin += len(legacyTokenKeyPrefix)
+	begin += len(LegacyTokenKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -518,12 +518,12 @@ func legacyTokenTypeKey(contractID string, tokenType string) []byte {
 }
 
 func legacyTokenTypeKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(legacyTokenTypeKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(LegacyTokenTypeKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, legacyTokenTypeKeyPrefix)
+	copy(key, LegacyTokenTypeKeyPrefix)
 
-	begin += len(legacyTokenTypeKeyPrefix)
+	begin += len(LegacyTokenTypeKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/collection"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The version marks the fix of
+// MintFT, which adds the minted tokens to the balance of the recipient. The
+// balances overwritten before are not recovered.
+//
+// It also brings the legacy token entries in sync with the tokens, deleting the
+// entries of the burnt tokens and creating the missing ones.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+		m.keeper.syncLegacyTokens(ctx, contract.Id)
+		return false
+	})
+
+	return nil
+}
+
+// syncLegacyTokens brings the legacy token entries of the contract in sync
+// with its fungible token classes and non-fungible tokens.
+func (k Keeper) syncLegacyTokens(ctx sdk.Context, contractID string) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := legacyTokenKeyPrefixByContractID(contractID)
+
+	var stale []string
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	for ; iter.Valid(); iter.Next() {
+		tokenID := string(iter.Key()[len(prefix):])
+		if !k.legacyTokenExists(ctx, contractID, tokenID) {
+			stale = append(stale, tokenID)
+		}
+	}
+	iter.Close()
+
+	for _, tokenID := range stale {
+		k.deleteLegacyToken(ctx, contractID, tokenID)
+	}
+
+	k.IterateContractClasses(ctx, contractID, func(class collection.TokenClass) (stop bool) {
+		if ftClass, ok := class.(*collection.FTClass); ok {
+			k.setLegacyToken(ctx, contractID, collection.NewFTID(ftClass.Id))
+		}
+		return false
+	})
+	k.IterateContractNFTs(ctx, contractID, func(nft collection.NFT) (stop bool) {
+		k.setLegacyToken(ctx, contractID, nft.TokenId)
+		return false
+	})
+}
+
+// legacyTokenExists returns whether the token of the legacy token entry
+// exists.
+func (k Keeper) legacyTokenExists(ctx sdk.Context, contractID string, tokenID string) bool {
+	switch {
+	case collection.ValidateNFTID(tokenID) == nil:
+		return k.hasNFT(ctx, contractID, tokenID) == nil
+	case collection.ValidateFTID(tokenID) == nil:
+		_, err := k.GetTokenClass(ctx, contractID, collection.SplitTokenID(tokenID))
+		return err == nil
+	default:
+		return false
+	}
+}
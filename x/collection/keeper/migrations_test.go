@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/collection/keeper"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	store := s.ctx.KVStore(s.storeKey)
+	ftID := collection.NewFTID(s.ftClassID)
+	nftID := collection.NewNFTID(s.nftClassID, 1)
+	burntID := collection.NewNFTID(s.nftClassID, 3*s.numNFTs+1)
+
+	// entries missing for the existing tokens, and left behind for a burnt one
+	store.Delete(legacyTokenKey(s.contractID, ftID))
+	store.Delete(legacyTokenKey(s.contractID, nftID))
+	store.Set(legacyTokenKey(s.contractID, burntID), []byte{})
+
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate1to2(s.ctx))
+
+	s.Require().True(hasLegacyToken(store, s.contractID, ftID))
+	s.Require().True(hasLegacyToken(store, s.contractID, nftID))
+	s.Require().False(hasLegacyToken(store, s.contractID, burntID))
+}
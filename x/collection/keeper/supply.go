@@ -194,7 +194,7 @@ func (k Keeper) MintFT(ctx sdk.Context, contractID string, to sdk.AccAddress, am
 
 func (k Keeper) mintFT(ctx sdk.Context, contractID string, to sdk.AccAddress, classID string, amount sdk.Int) {
 	tokenID := collection.NewFTID(classID)
-	k.setBalance(ctx, contractID, to, tokenID, amount)
+	k.addCoins(ctx, contractID, to, collection.NewCoins(collection.NewCoin(tokenID, amount)))
 
 	// update statistics
 	supply := k.GetSupply(ctx, contractID, classID)
@@ -269,6 +269,9 @@ func (k Keeper) BurnCoins(ctx sdk.Context, contractID string, from sdk.AccAddres
 
 			for _, id := range pruned {
 				burntAmount = append(burntAmount, collection.NewCoin(id, sdk.OneInt()))
+
+				// legacy
+				k.deleteLegacyToken(ctx, contractID, id)
 			}
 
 			// legacy
@@ -507,25 +510,25 @@ func (k Keeper) setStatistic(ctx sdk.Context, keyPrefix []byte, contractID strin
 }
 
 func (k Keeper) GetSupply(ctx sdk.Context, contractID string, classID string) sdk.Int {
-	return k.getStatistic(ctx, supplyKeyPrefix, contractID, classID)
+	return k.getStatistic(ctx, SupplyKeyPrefix, contractID, classID)
 }
 
 func (k Keeper) GetMinted(ctx sdk.Context, contractID string, classID string) sdk.Int {
-	return k.getStatistic(ctx, mintedKeyPrefix, contractID, classID)
+	return k.getStatistic(ctx, MintedKeyPrefix, contractID, classID)
 }
 
 func (k Keeper) GetBurnt(ctx sdk.Context, contractID string, classID string) sdk.Int {
-	return k.getStatistic(ctx, burntKeyPrefix, contractID, classID)
+	return k.getStatistic(ctx, BurntKeyPrefix, contractID, classID)
 }
 
 func (k Keeper) setSupply(ctx sdk.Context, contractID string, classID string, amount sdk.Int) {
-	k.setStatistic(ctx, supplyKeyPrefix, contractID, classID, amount)
+	k.setStatistic(ctx, SupplyKeyPrefix, contractID, classID, amount)
 }
 
 func (k Keeper) setMinted(ctx sdk.Context, contractID string, classID string, amount sdk.Int) {
-	k.setStatistic(ctx, mintedKeyPrefix, contractID, classID, amount)
+	k.setStatistic(ctx, MintedKeyPrefix, contractID, classID, amount)
 }
 
 func (k Keeper) setBurnt(ctx sdk.Context, contractID string, classID string, amount sdk.Int) {
-	k.setStatistic(ctx, burntKeyPrefix, contractID, classID, amount)
+	k.setStatistic(ctx, BurntKeyPrefix, contractID, classID, amount)
 }
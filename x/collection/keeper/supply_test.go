@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/collection/keeper"
 )
 
 func (s *KeeperTestSuite) TestCreateContract() {
@@ -94,11 +95,16 @@ func (s *KeeperTestSuite) TestMintFT() {
 		s.Run(name, func() {
 			ctx, _ := s.ctx.CacheContext()
 
-			err := s.keeper.MintFT(ctx, tc.contractID, s.stranger, collection.NewCoins(tc.amount))
+			before := s.keeper.GetBalance(ctx, tc.contractID, s.customer, tc.amount.TokenId)
+
+			err := s.keeper.MintFT(ctx, tc.contractID, s.customer, collection.NewCoins(tc.amount))
 			s.Require().ErrorIs(err, tc.err)
 			if tc.err != nil {
 				return
 			}
+
+			after := s.keeper.GetBalance(ctx, tc.contractID, s.customer, tc.amount.TokenId)
+			s.Require().Equal(before.Add(tc.amount.Amount), after)
 		})
 	}
 }
@@ -168,6 +174,40 @@ func (s *KeeperTestSuite) TestBurnCoins() {
 	}
 }
 
+func (s *KeeperTestSuite) TestBurnCoinsLegacyTokens() {
+	ctx, _ := s.ctx.CacheContext()
+	store := ctx.KVStore(s.storeKey)
+
+	// the root of the chain of the customer
+	rootID := collection.NewNFTID(s.nftClassID, 1)
+	tokenIDs := append([]string{rootID}, s.keeper.GetChildren(ctx, s.contractID, rootID)...)
+	s.Require().Len(tokenIDs, 2)
+	tokenIDs = append(tokenIDs, s.keeper.GetChildren(ctx, s.contractID, tokenIDs[1])...)
+	for _, tokenID := range tokenIDs {
+		s.Require().True(hasLegacyToken(store, s.contractID, tokenID), tokenID)
+	}
+
+	_, err := s.keeper.BurnCoins(ctx, s.contractID, s.customer, collection.NewCoins(collection.NewNFTCoin(s.nftClassID, 1)))
+	s.Require().NoError(err)
+
+	// the legacy tokens of the descendants are deleted as well
+	for _, tokenID := range tokenIDs {
+		s.Require().False(hasLegacyToken(store, s.contractID, tokenID), tokenID)
+	}
+}
+
+// hasLegacyToken reports whether the legacy token entry of the token exists.
+func hasLegacyToken(store sdk.KVStore, contractID, tokenID string) bool {
+	return store.Has(legacyTokenKey(contractID, tokenID))
+}
+
+func legacyTokenKey(contractID, tokenID string) []byte {
+	key := append([]byte{}, keeper.LegacyTokenKeyPrefix...)
+	key = append(key, byte(len(contractID)))
+	key = append(key, contractID...)
+	return append(key, tokenID...)
+}
+
 func (s *KeeperTestSuite) TestModifyContract() {
 	contractDescriptions := map[string]string{
 		s.contractID: "valid",
@@ -0,0 +1,81 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/kv"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/collection/keeper"
+	"github.com/line/lbm-sdk/x/collection/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	params := collection.Params{DepthLimit: 1, WidthLimit: 4}
+	paramsBz, err := cdc.Marshal(&params)
+	require.NoError(t, err)
+
+	contract := collection.Contract{Id: "deadbeef", Name: "test"}
+	contractBz, err := cdc.Marshal(&contract)
+	require.NoError(t, err)
+
+	var class collection.TokenClass = &collection.NFTClass{Id: "10000001", Name: "test"}
+	classBz, err := cdc.MarshalInterface(class)
+	require.NoError(t, err)
+
+	nft := collection.NFT{TokenId: collection.NewNFTID("10000001", 1), Name: "test"}
+	nftBz, err := cdc.Marshal(&nft)
+	require.NoError(t, err)
+
+	amount := sdk.NewInt(100)
+	amountBz, err := amount.Marshal()
+	require.NoError(t, err)
+
+	owner := sdk.AccAddress("owner")
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: keeper.ParamsKey, Value: paramsBz},
+			{Key: keeper.ContractKeyPrefix, Value: contractBz},
+			{Key: keeper.ClassKeyPrefix, Value: classBz},
+			{Key: keeper.NFTKeyPrefix, Value: nftBz},
+			{Key: keeper.BalanceKeyPrefix, Value: amountBz},
+			{Key: keeper.OwnerKeyPrefix, Value: owner},
+			{Key: keeper.GrantKeyPrefix, Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"Contract", fmt.Sprintf("%v\n%v", contract, contract)},
+		{"Class", fmt.Sprintf("%v\n%v", class, class)},
+		{"NFT", fmt.Sprintf("%v\n%v", nft, nft)},
+		{"Balance", fmt.Sprintf("%v\n%v", amount, amount)},
+		{"Owner", fmt.Sprintf("%v\n%v", owner, owner)},
+		{"Grant", fmt.Sprintf("%v\n%v", []byte{}, []byte{})},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/line/lbm-sdk/types/module"
+	"github.com/line/lbm-sdk/x/collection"
+)
+
+// Simulation parameter constants
+const (
+	depthLimit = "depth_limit"
+	widthLimit = "width_limit"
+)
+
+// genDepthLimit returns a random depth limit of the composition of the non-fungible tokens.
+func genDepthLimit(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(4))
+}
+
+// genWidthLimit returns a random width limit of the composition of the non-fungible tokens.
+func genWidthLimit(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(8))
+}
+
+// RandomizedGenState generates a random GenesisState for collection.
+// It starts with no contracts, which would be created by the operations,
+// because the contract ids are shared with x/token, which owns their genesis.
+func RandomizedGenState(simState *module.SimulationState) {
+	var depth uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, depthLimit, &depth, simState.Rand,
+		func(r *rand.Rand) { depth = genDepthLimit(r) },
+	)
+
+	var width uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, widthLimit, &width, simState.Rand,
+		func(r *rand.Rand) { width = genWidthLimit(r) },
+	)
+
+	collectionGenesis := collection.GenesisState{
+		Params: collection.Params{
+			DepthLimit: depth,
+			WidthLimit: width,
+		},
+	}
+	bz, err := simState.Cdc.MarshalJSON(&collectionGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	simState.GenState[collection.ModuleName] = bz
+}
@@ -0,0 +1,41 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/types/module"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/collection/simulation"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	app := simapp.Setup(false)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          app.AppCodec(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+	var collectionGenesis collection.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[collection.ModuleName], &collectionGenesis)
+
+	require.NoError(t, collection.ValidateGenesis(collectionGenesis))
+	require.GreaterOrEqual(t, collectionGenesis.Params.DepthLimit, uint32(1))
+	require.GreaterOrEqual(t, collectionGenesis.Params.WidthLimit, uint32(1))
+	require.Empty(t, collectionGenesis.Contracts)
+}
@@ -0,0 +1,1193 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/codec"
+	simappparams "github.com/line/lbm-sdk/simapp/params"
+	sdk "github.com/line/lbm-sdk/types"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/collection/keeper"
+	"github.com/line/lbm-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgCreateContract    = "op_weight_msg_collection_create_contract"
+	OpWeightMsgIssueFT           = "op_weight_msg_collection_issue_ft"
+	OpWeightMsgIssueNFT          = "op_weight_msg_collection_issue_nft"
+	OpWeightMsgMintFT            = "op_weight_msg_collection_mint_ft"
+	OpWeightMsgMintNFT           = "op_weight_msg_collection_mint_nft"
+	OpWeightMsgSendFT            = "op_weight_msg_collection_send_ft"
+	OpWeightMsgOperatorSendFT    = "op_weight_msg_collection_operator_send_ft"
+	OpWeightMsgSendNFT           = "op_weight_msg_collection_send_nft"
+	OpWeightMsgOperatorSendNFT   = "op_weight_msg_collection_operator_send_nft"
+	OpWeightMsgAuthorizeOperator = "op_weight_msg_collection_authorize_operator"
+	OpWeightMsgRevokeOperator    = "op_weight_msg_collection_revoke_operator"
+	OpWeightMsgBurnFT            = "op_weight_msg_collection_burn_ft"
+	OpWeightMsgOperatorBurnFT    = "op_weight_msg_collection_operator_burn_ft"
+	OpWeightMsgBurnNFT           = "op_weight_msg_collection_burn_nft"
+	OpWeightMsgOperatorBurnNFT   = "op_weight_msg_collection_operator_burn_nft"
+	OpWeightMsgModify            = "op_weight_msg_collection_modify"
+	OpWeightMsgGrantPermission   = "op_weight_msg_collection_grant_permission"
+	OpWeightMsgRevokePermission  = "op_weight_msg_collection_revoke_permission"
+	OpWeightMsgAttach            = "op_weight_msg_collection_attach"
+	OpWeightMsgDetach            = "op_weight_msg_collection_detach"
+	OpWeightMsgOperatorAttach    = "op_weight_msg_collection_operator_attach"
+	OpWeightMsgOperatorDetach    = "op_weight_msg_collection_operator_detach"
+)
+
+var (
+	TypeMsgCreateContract    = sdk.MsgTypeURL(&collection.MsgCreateContract{})
+	TypeMsgIssueFT           = sdk.MsgTypeURL(&collection.MsgIssueFT{})
+	TypeMsgIssueNFT          = sdk.MsgTypeURL(&collection.MsgIssueNFT{})
+	TypeMsgMintFT            = sdk.MsgTypeURL(&collection.MsgMintFT{})
+	TypeMsgMintNFT           = sdk.MsgTypeURL(&collection.MsgMintNFT{})
+	TypeMsgSendFT            = sdk.MsgTypeURL(&collection.MsgSendFT{})
+	TypeMsgOperatorSendFT    = sdk.MsgTypeURL(&collection.MsgOperatorSendFT{})
+	TypeMsgSendNFT           = sdk.MsgTypeURL(&collection.MsgSendNFT{})
+	TypeMsgOperatorSendNFT   = sdk.MsgTypeURL(&collection.MsgOperatorSendNFT{})
+	TypeMsgAuthorizeOperator = sdk.MsgTypeURL(&collection.MsgAuthorizeOperator{})
+	TypeMsgRevokeOperator    = sdk.MsgTypeURL(&collection.MsgRevokeOperator{})
+	TypeMsgBurnFT            = sdk.MsgTypeURL(&collection.MsgBurnFT{})
+	TypeMsgOperatorBurnFT    = sdk.MsgTypeURL(&collection.MsgOperatorBurnFT{})
+	TypeMsgBurnNFT           = sdk.MsgTypeURL(&collection.MsgBurnNFT{})
+	TypeMsgOperatorBurnNFT   = sdk.MsgTypeURL(&collection.MsgOperatorBurnNFT{})
+	TypeMsgModify            = sdk.MsgTypeURL(&collection.MsgModify{})
+	TypeMsgGrantPermission   = sdk.MsgTypeURL(&collection.MsgGrantPermission{})
+	TypeMsgRevokePermission  = sdk.MsgTypeURL(&collection.MsgRevokePermission{})
+	TypeMsgAttach            = sdk.MsgTypeURL(&collection.MsgAttach{})
+	TypeMsgDetach            = sdk.MsgTypeURL(&collection.MsgDetach{})
+	TypeMsgOperatorAttach    = sdk.MsgTypeURL(&collection.MsgOperatorAttach{})
+	TypeMsgOperatorDetach    = sdk.MsgTypeURL(&collection.MsgOperatorDetach{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateContract    int
+		weightMsgIssueFT           int
+		weightMsgIssueNFT          int
+		weightMsgMintFT            int
+		weightMsgMintNFT           int
+		weightMsgSendFT            int
+		weightMsgOperatorSendFT    int
+		weightMsgSendNFT           int
+		weightMsgOperatorSendNFT   int
+		weightMsgAuthorizeOperator int
+		weightMsgRevokeOperator    int
+		weightMsgBurnFT            int
+		weightMsgOperatorBurnFT    int
+		weightMsgBurnNFT           int
+		weightMsgOperatorBurnNFT   int
+		weightMsgModify            int
+		weightMsgGrantPermission   int
+		weightMsgRevokePermission  int
+		weightMsgAttach            int
+		weightMsgDetach            int
+		weightMsgOperatorAttach    int
+		weightMsgOperatorDetach    int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateContract, &weightMsgCreateContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateContract = simappparams.DefaultWeightMsgCollectionCreateContract
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgIssueFT, &weightMsgIssueFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgIssueFT = simappparams.DefaultWeightMsgCollectionIssueFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgIssueNFT, &weightMsgIssueNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgIssueNFT = simappparams.DefaultWeightMsgCollectionIssueNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintFT, &weightMsgMintFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintFT = simappparams.DefaultWeightMsgCollectionMintFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintNFT, &weightMsgMintNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintNFT = simappparams.DefaultWeightMsgCollectionMintNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendFT, &weightMsgSendFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendFT = simappparams.DefaultWeightMsgCollectionSendFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorSendFT, &weightMsgOperatorSendFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorSendFT = simappparams.DefaultWeightMsgCollectionOperatorSendFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendNFT, &weightMsgSendNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendNFT = simappparams.DefaultWeightMsgCollectionSendNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorSendNFT, &weightMsgOperatorSendNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorSendNFT = simappparams.DefaultWeightMsgCollectionOperatorSendNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAuthorizeOperator, &weightMsgAuthorizeOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgAuthorizeOperator = simappparams.DefaultWeightMsgCollectionAuthorizeOperator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeOperator, &weightMsgRevokeOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeOperator = simappparams.DefaultWeightMsgCollectionRevokeOperator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnFT, &weightMsgBurnFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnFT = simappparams.DefaultWeightMsgCollectionBurnFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorBurnFT, &weightMsgOperatorBurnFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorBurnFT = simappparams.DefaultWeightMsgCollectionOperatorBurnFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnNFT, &weightMsgBurnNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnNFT = simappparams.DefaultWeightMsgCollectionBurnNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorBurnNFT, &weightMsgOperatorBurnNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorBurnNFT = simappparams.DefaultWeightMsgCollectionOperatorBurnNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgModify, &weightMsgModify, nil,
+		func(_ *rand.Rand) {
+			weightMsgModify = simappparams.DefaultWeightMsgCollectionModify
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantPermission, &weightMsgGrantPermission, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantPermission = simappparams.DefaultWeightMsgCollectionGrantPermission
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokePermission, &weightMsgRevokePermission, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokePermission = simappparams.DefaultWeightMsgCollectionRevokePermission
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAttach, &weightMsgAttach, nil,
+		func(_ *rand.Rand) {
+			weightMsgAttach = simappparams.DefaultWeightMsgCollectionAttach
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDetach, &weightMsgDetach, nil,
+		func(_ *rand.Rand) {
+			weightMsgDetach = simappparams.DefaultWeightMsgCollectionDetach
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorAttach, &weightMsgOperatorAttach, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorAttach = simappparams.DefaultWeightMsgCollectionOperatorAttach
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorDetach, &weightMsgOperatorDetach, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorDetach = simappparams.DefaultWeightMsgCollectionOperatorDetach
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateContract,
+			SimulateMsgCreateContract(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgIssueFT,
+			SimulateMsgIssueFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgIssueNFT,
+			SimulateMsgIssueNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintFT,
+			SimulateMsgMintFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintNFT,
+			SimulateMsgMintNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSendFT,
+			SimulateMsgSendFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorSendFT,
+			SimulateMsgOperatorSendFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSendNFT,
+			SimulateMsgSendNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorSendNFT,
+			SimulateMsgOperatorSendNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAuthorizeOperator,
+			SimulateMsgAuthorizeOperator(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeOperator,
+			SimulateMsgRevokeOperator(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurnFT,
+			SimulateMsgBurnFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorBurnFT,
+			SimulateMsgOperatorBurnFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurnNFT,
+			SimulateMsgBurnNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorBurnNFT,
+			SimulateMsgOperatorBurnNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgModify,
+			SimulateMsgModify(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgGrantPermission,
+			SimulateMsgGrantPermission(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokePermission,
+			SimulateMsgRevokePermission(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAttach,
+			SimulateMsgAttach(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDetach,
+			SimulateMsgDetach(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorAttach,
+			SimulateMsgOperatorAttach(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorDetach,
+			SimulateMsgOperatorDetach(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateContract generates a MsgCreateContract with random values.
+func SimulateMsgCreateContract(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgCreateContract{
+			Owner: owner.Address.String(),
+			Name:  randomName(r),
+			Uri:   simtypes.RandStringOfLength(r, r.Intn(100)),
+			Meta:  simtypes.RandStringOfLength(r, r.Intn(100)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg, TypeMsgCreateContract)
+	}
+}
+
+// SimulateMsgIssueFT generates a MsgIssueFT with random values.
+func SimulateMsgIssueFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueFT, "no contracts"), nil, nil
+		}
+
+		owner, ok := randomGrantee(r, ctx, k, contract.Id, collection.PermissionIssue, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueFT, "no grants"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgIssueFT{
+			ContractId: contract.Id,
+			Name:       randomName(r),
+			Meta:       simtypes.RandStringOfLength(r, r.Intn(100)),
+			Decimals:   int32(r.Intn(19)),
+			Mintable:   r.Intn(2) == 0,
+			Owner:      owner.Address.String(),
+			To:         to.Address.String(),
+			Amount:     sdk.NewInt(r.Int63n(1000000)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg, TypeMsgIssueFT)
+	}
+}
+
+// SimulateMsgIssueNFT generates a MsgIssueNFT with random values.
+func SimulateMsgIssueNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueNFT, "no contracts"), nil, nil
+		}
+
+		owner, ok := randomGrantee(r, ctx, k, contract.Id, collection.PermissionIssue, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueNFT, "no grants"), nil, nil
+		}
+
+		msg := &collection.MsgIssueNFT{
+			ContractId: contract.Id,
+			Name:       randomName(r),
+			Meta:       simtypes.RandStringOfLength(r, r.Intn(100)),
+			Owner:      owner.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg, TypeMsgIssueNFT)
+	}
+}
+
+// SimulateMsgMintFT generates a MsgMintFT with random values.
+func SimulateMsgMintFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintFT, "no contracts"), nil, nil
+		}
+
+		class, ok := randomClass(r, ctx, k, contract.Id, func(class collection.TokenClass) bool {
+			ftClass, ok := class.(*collection.FTClass)
+			return ok && ftClass.Mintable
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintFT, "no mintable classes"), nil, nil
+		}
+
+		from, ok := randomGrantee(r, ctx, k, contract.Id, collection.PermissionMint, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintFT, "no grants"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgMintFT{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     collection.NewCoins(collection.NewFTCoin(class.GetId(), sdk.NewInt(1+r.Int63n(1000000)))),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgMintFT)
+	}
+}
+
+// SimulateMsgMintNFT generates a MsgMintNFT with random values.
+func SimulateMsgMintNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintNFT, "no contracts"), nil, nil
+		}
+
+		class, ok := randomClass(r, ctx, k, contract.Id, func(class collection.TokenClass) bool {
+			_, ok := class.(*collection.NFTClass)
+			return ok
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintNFT, "no nft classes"), nil, nil
+		}
+
+		from, ok := randomGrantee(r, ctx, k, contract.Id, collection.PermissionMint, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintNFT, "no grants"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		params := make([]collection.MintNFTParam, 1+r.Intn(3))
+		for i := range params {
+			params[i] = collection.MintNFTParam{
+				TokenType: class.GetId(),
+				Name:      randomName(r),
+				Meta:      simtypes.RandStringOfLength(r, r.Intn(100)),
+			}
+		}
+
+		msg := &collection.MsgMintNFT{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Params:     params,
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgMintNFT)
+	}
+}
+
+// SimulateMsgSendFT generates a MsgSendFT with random values.
+func SimulateMsgSendFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendFT, "no contracts"), nil, nil
+		}
+
+		from, coin, ok := randomHolding(r, ctx, k, contract.Id, accs, isFT)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendFT, "no holders"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgSendFT{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     collection.NewCoins(collection.NewCoin(coin.TokenId, randomAmount(r, coin.Amount))),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgSendFT)
+	}
+}
+
+// SimulateMsgOperatorSendFT generates a MsgOperatorSendFT with random values.
+func SimulateMsgOperatorSendFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendFT, "no contracts"), nil, nil
+		}
+
+		from, coin, ok := randomHolding(r, ctx, k, contract.Id, accs, isFT)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendFT, "no holders"), nil, nil
+		}
+		operator, ok := randomOperator(r, ctx, k, contract.Id, from.Address, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendFT, "no authorizations"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgOperatorSendFT{
+			ContractId: contract.Id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     collection.NewCoins(collection.NewCoin(coin.TokenId, randomAmount(r, coin.Amount))),
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, TypeMsgOperatorSendFT)
+	}
+}
+
+// SimulateMsgSendNFT generates a MsgSendNFT with random values.
+func SimulateMsgSendNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendNFT, "no contracts"), nil, nil
+		}
+
+		from, coin, ok := randomHolding(r, ctx, k, contract.Id, accs, isNFT)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendNFT, "no holders"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgSendNFT{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			TokenIds:   []string{coin.TokenId},
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgSendNFT)
+	}
+}
+
+// SimulateMsgOperatorSendNFT generates a MsgOperatorSendNFT with random values.
+func SimulateMsgOperatorSendNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendNFT, "no contracts"), nil, nil
+		}
+
+		from, coin, ok := randomHolding(r, ctx, k, contract.Id, accs, isNFT)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendNFT, "no holders"), nil, nil
+		}
+		operator, ok := randomOperator(r, ctx, k, contract.Id, from.Address, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendNFT, "no authorizations"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgOperatorSendNFT{
+			ContractId: contract.Id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			TokenIds:   []string{coin.TokenId},
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, TypeMsgOperatorSendNFT)
+	}
+}
+
+// SimulateMsgAuthorizeOperator generates a MsgAuthorizeOperator with random values.
+func SimulateMsgAuthorizeOperator(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAuthorizeOperator, "no contracts"), nil, nil
+		}
+
+		holder, _ := simtypes.RandomAcc(r, accs)
+		operator, _ := simtypes.RandomAcc(r, accs)
+		if holder.Address.Equals(operator.Address) {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAuthorizeOperator, "holder and operator cannot be same"), nil, nil
+		}
+		if _, err := k.GetAuthorization(ctx, contract.Id, holder.Address, operator.Address); err == nil {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAuthorizeOperator, "authorization exists"), nil, nil
+		}
+
+		msg := &collection.MsgAuthorizeOperator{
+			ContractId: contract.Id,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, holder, msg, TypeMsgAuthorizeOperator)
+	}
+}
+
+// SimulateMsgRevokeOperator generates a MsgRevokeOperator with random values.
+func SimulateMsgRevokeOperator(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokeOperator, "no contracts"), nil, nil
+		}
+
+		var holders, operators []simtypes.Account
+		k.IterateContractAuthorizations(ctx, contract.Id, func(authorization collection.Authorization) (stop bool) {
+			holder, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authorization.Holder))
+			if !found {
+				return false
+			}
+			operator, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authorization.Operator))
+			if !found {
+				return false
+			}
+			holders = append(holders, holder)
+			operators = append(operators, operator)
+			return false
+		})
+		if len(holders) == 0 {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokeOperator, "no authorizations"), nil, nil
+		}
+		i := r.Intn(len(holders))
+
+		msg := &collection.MsgRevokeOperator{
+			ContractId: contract.Id,
+			Holder:     holders[i].Address.String(),
+			Operator:   operators[i].Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, holders[i], msg, TypeMsgRevokeOperator)
+	}
+}
+
+// SimulateMsgBurnFT generates a MsgBurnFT with random values.
+func SimulateMsgBurnFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnFT, "no contracts"), nil, nil
+		}
+
+		from, coin, ok := randomHolding(r, ctx, k, contract.Id, accs, func(holder sdk.AccAddress, coin collection.Coin) bool {
+			_, err := k.GetGrant(ctx, contract.Id, holder, collection.PermissionBurn)
+			return err == nil && isFT(holder, coin)
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnFT, "no holders"), nil, nil
+		}
+
+		msg := &collection.MsgBurnFT{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			Amount:     collection.NewCoins(collection.NewCoin(coin.TokenId, randomAmount(r, coin.Amount))),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgBurnFT)
+	}
+}
+
+// SimulateMsgOperatorBurnFT generates a MsgOperatorBurnFT with random values.
+func SimulateMsgOperatorBurnFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnFT, "no contracts"), nil, nil
+		}
+
+		from, coin, ok := randomHolding(r, ctx, k, contract.Id, accs, isFT)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnFT, "no holders"), nil, nil
+		}
+		operator, ok := randomOperator(r, ctx, k, contract.Id, from.Address, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnFT, "no authorizations"), nil, nil
+		}
+
+		msg := &collection.MsgOperatorBurnFT{
+			ContractId: contract.Id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			Amount:     collection.NewCoins(collection.NewCoin(coin.TokenId, randomAmount(r, coin.Amount))),
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, TypeMsgOperatorBurnFT)
+	}
+}
+
+// SimulateMsgBurnNFT generates a MsgBurnNFT with random values.
+func SimulateMsgBurnNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnNFT, "no contracts"), nil, nil
+		}
+
+		from, coin, ok := randomHolding(r, ctx, k, contract.Id, accs, func(holder sdk.AccAddress, coin collection.Coin) bool {
+			_, err := k.GetGrant(ctx, contract.Id, holder, collection.PermissionBurn)
+			return err == nil && isNFT(holder, coin)
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnNFT, "no holders"), nil, nil
+		}
+
+		msg := &collection.MsgBurnNFT{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			TokenIds:   []string{coin.TokenId},
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgBurnNFT)
+	}
+}
+
+// SimulateMsgOperatorBurnNFT generates a MsgOperatorBurnNFT with random values.
+func SimulateMsgOperatorBurnNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnNFT, "no contracts"), nil, nil
+		}
+
+		from, coin, ok := randomHolding(r, ctx, k, contract.Id, accs, isNFT)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnNFT, "no holders"), nil, nil
+		}
+		operator, ok := randomOperator(r, ctx, k, contract.Id, from.Address, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnNFT, "no authorizations"), nil, nil
+		}
+
+		msg := &collection.MsgOperatorBurnNFT{
+			ContractId: contract.Id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			TokenIds:   []string{coin.TokenId},
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, TypeMsgOperatorBurnNFT)
+	}
+}
+
+// SimulateMsgModify generates a MsgModify with random values.
+// It modifies one of the contract, a token class or a non-fungible token.
+func SimulateMsgModify(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgModify, "no contracts"), nil, nil
+		}
+
+		owner, ok := randomGrantee(r, ctx, k, contract.Id, collection.PermissionModify, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgModify, "no grants"), nil, nil
+		}
+
+		msg := &collection.MsgModify{
+			ContractId: contract.Id,
+			Owner:      owner.Address.String(),
+		}
+		candidates := []collection.Attribute{
+			{Key: collection.AttributeKeyName.String(), Value: randomName(r)},
+			{Key: collection.AttributeKeyMeta.String(), Value: simtypes.RandStringOfLength(r, r.Intn(100))},
+		}
+
+		switch r.Intn(3) {
+		case 0:
+			candidates = append(candidates, collection.Attribute{
+				Key:   collection.AttributeKeyURI.String(),
+				Value: simtypes.RandStringOfLength(r, r.Intn(100)),
+			})
+		case 1:
+			class, ok := randomClass(r, ctx, k, contract.Id, func(collection.TokenClass) bool { return true })
+			if !ok {
+				return simtypes.NoOpMsg(collection.ModuleName, TypeMsgModify, "no classes"), nil, nil
+			}
+			msg.TokenType = class.GetId()
+			if _, ok := class.(*collection.FTClass); ok {
+				msg.TokenIndex = collection.NewFTID(class.GetId())[len(class.GetId()):]
+			}
+		default:
+			var tokenIDs []string
+			k.IterateContractNFTs(ctx, contract.Id, func(nft collection.NFT) (stop bool) {
+				tokenIDs = append(tokenIDs, nft.TokenId)
+				return false
+			})
+			if len(tokenIDs) == 0 {
+				return simtypes.NoOpMsg(collection.ModuleName, TypeMsgModify, "no nfts"), nil, nil
+			}
+			tokenID := tokenIDs[r.Intn(len(tokenIDs))]
+			msg.TokenType = collection.SplitTokenID(tokenID)
+			msg.TokenIndex = tokenID[len(msg.TokenType):]
+		}
+
+		for _, i := range r.Perm(len(candidates))[:1+r.Intn(len(candidates))] {
+			msg.Changes = append(msg.Changes, candidates[i])
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg, TypeMsgModify)
+	}
+}
+
+// SimulateMsgGrantPermission generates a MsgGrantPermission with random values.
+func SimulateMsgGrantPermission(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgGrantPermission, "no contracts"), nil, nil
+		}
+
+		permission := randomPermission(r)
+		granter, ok := randomGrantee(r, ctx, k, contract.Id, permission, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgGrantPermission, "no grants"), nil, nil
+		}
+		grantee, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgGrantPermission{
+			ContractId: contract.Id,
+			From:       granter.Address.String(),
+			To:         grantee.Address.String(),
+			Permission: collection.LegacyPermission(permission).String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, granter, msg, TypeMsgGrantPermission)
+	}
+}
+
+// SimulateMsgRevokePermission generates a MsgRevokePermission with random values.
+func SimulateMsgRevokePermission(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokePermission, "no contracts"), nil, nil
+		}
+
+		permission := randomPermission(r)
+		grantee, ok := randomGrantee(r, ctx, k, contract.Id, permission, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokePermission, "no grants"), nil, nil
+		}
+
+		msg := &collection.MsgRevokePermission{
+			ContractId: contract.Id,
+			From:       grantee.Address.String(),
+			Permission: collection.LegacyPermission(permission).String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, TypeMsgRevokePermission)
+	}
+}
+
+// SimulateMsgAttach generates a MsgAttach with random values.
+func SimulateMsgAttach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAttach, "no contracts"), nil, nil
+		}
+
+		from, subject, target, ok := randomComposition(r, ctx, k, contract.Id, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAttach, "no nfts to attach"), nil, nil
+		}
+
+		msg := &collection.MsgAttach{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			TokenId:    subject,
+			ToTokenId:  target,
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgAttach)
+	}
+}
+
+// SimulateMsgDetach generates a MsgDetach with random values.
+func SimulateMsgDetach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgDetach, "no contracts"), nil, nil
+		}
+
+		from, subject, ok := randomChild(r, ctx, k, contract.Id, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgDetach, "no nfts to detach"), nil, nil
+		}
+
+		msg := &collection.MsgDetach{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			TokenId:    subject,
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgDetach)
+	}
+}
+
+// SimulateMsgOperatorAttach generates a MsgOperatorAttach with random values.
+func SimulateMsgOperatorAttach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorAttach, "no contracts"), nil, nil
+		}
+
+		from, subject, target, ok := randomComposition(r, ctx, k, contract.Id, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorAttach, "no nfts to attach"), nil, nil
+		}
+		operator, ok := randomOperator(r, ctx, k, contract.Id, from.Address, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorAttach, "no authorizations"), nil, nil
+		}
+
+		msg := &collection.MsgOperatorAttach{
+			ContractId: contract.Id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			TokenId:    subject,
+			ToTokenId:  target,
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, TypeMsgOperatorAttach)
+	}
+}
+
+// SimulateMsgOperatorDetach generates a MsgOperatorDetach with random values.
+func SimulateMsgOperatorDetach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorDetach, "no contracts"), nil, nil
+		}
+
+		from, subject, ok := randomChild(r, ctx, k, contract.Id, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorDetach, "no nfts to detach"), nil, nil
+		}
+		operator, ok := randomOperator(r, ctx, k, contract.Id, from.Address, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorDetach, "no authorizations"), nil, nil
+		}
+
+		msg := &collection.MsgOperatorDetach{
+			ContractId: contract.Id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			TokenId:    subject,
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, TypeMsgOperatorDetach)
+	}
+}
+
+// deliver generates and delivers a tx of the msg. The msg is run on a cached
+// context first, so the operation would be a no-op if the msg fails.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak collection.AccountKeeper, bk collection.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return simtypes.NoOpMsg(collection.ModuleName, msgType, err.Error()), nil, nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if _, err := app.MsgServiceRouter().Handler(msg)(cacheCtx, msg); err != nil {
+		return simtypes.NoOpMsg(collection.ModuleName, msgType, err.Error()), nil, nil
+	}
+
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      collection.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+func randomContract(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (*collection.Contract, bool) {
+	var contracts []collection.Contract
+	k.IterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+		contracts = append(contracts, contract)
+		return false
+	})
+	if len(contracts) == 0 {
+		return nil, false
+	}
+
+	return &contracts[r.Intn(len(contracts))], true
+}
+
+// randomClass returns a random token class of the contract which satisfies the filter.
+func randomClass(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, filter func(class collection.TokenClass) bool) (collection.TokenClass, bool) {
+	var classes []collection.TokenClass
+	k.IterateContractClasses(ctx, contractID, func(class collection.TokenClass) (stop bool) {
+		if filter(class) {
+			classes = append(classes, class)
+		}
+		return false
+	})
+	if len(classes) == 0 {
+		return nil, false
+	}
+
+	return classes[r.Intn(len(classes))], true
+}
+
+// randomGrantee returns a random simulation account which has the permission on the contract.
+func randomGrantee(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, permission collection.Permission, accs []simtypes.Account) (simtypes.Account, bool) {
+	var grantees []simtypes.Account
+	k.IterateContractGrants(ctx, contractID, func(grant collection.Grant) (stop bool) {
+		if grant.Permission != permission {
+			return false
+		}
+		if acc, ok := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(grant.Grantee)); ok {
+			grantees = append(grantees, acc)
+		}
+		return false
+	})
+	if len(grantees) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	return grantees[r.Intn(len(grantees))], true
+}
+
+// randomHolding returns a random balance of the contract held by a simulation
+// account, which satisfies the filter.
+func randomHolding(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, accs []simtypes.Account, filter func(holder sdk.AccAddress, coin collection.Coin) bool) (simtypes.Account, collection.Coin, bool) {
+	var holders []simtypes.Account
+	var coins []collection.Coin
+	k.IterateContractBalances(ctx, contractID, func(address sdk.AccAddress, balance collection.Coin) (stop bool) {
+		if !filter(address, balance) {
+			return false
+		}
+		if acc, ok := simtypes.FindAccount(accs, address); ok {
+			holders = append(holders, acc)
+			coins = append(coins, balance)
+		}
+		return false
+	})
+	if len(holders) == 0 {
+		return simtypes.Account{}, collection.Coin{}, false
+	}
+
+	i := r.Intn(len(holders))
+	return holders[i], coins[i], true
+}
+
+// randomOperator returns a random simulation account authorized by the holder.
+func randomOperator(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, holder sdk.AccAddress, accs []simtypes.Account) (simtypes.Account, bool) {
+	var operators []simtypes.Account
+	k.IterateContractAuthorizations(ctx, contractID, func(authorization collection.Authorization) (stop bool) {
+		if authorization.Holder != holder.String() {
+			return false
+		}
+		if acc, ok := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authorization.Operator)); ok {
+			operators = append(operators, acc)
+		}
+		return false
+	})
+	if len(operators) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	return operators[r.Intn(len(operators))], true
+}
+
+// randomComposition returns two random root non-fungible tokens of the same
+// holder, the subject and the target of an attach.
+func randomComposition(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, accs []simtypes.Account) (simtypes.Account, string, string, bool) {
+	holder, subject, ok := randomHolding(r, ctx, k, contractID, accs, isNFT)
+	if !ok {
+		return simtypes.Account{}, "", "", false
+	}
+
+	_, target, ok := randomHolding(r, ctx, k, contractID, accs, func(address sdk.AccAddress, coin collection.Coin) bool {
+		return address.Equals(holder.Address) && coin.TokenId != subject.TokenId && isNFT(address, coin)
+	})
+	if !ok {
+		return simtypes.Account{}, "", "", false
+	}
+
+	return holder, subject.TokenId, target.TokenId, true
+}
+
+// randomChild returns a random non-fungible token which has its parent, and
+// the simulation account owning it.
+func randomChild(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, accs []simtypes.Account) (simtypes.Account, string, bool) {
+	var owners []simtypes.Account
+	var children []string
+	k.IterateContractParents(ctx, contractID, func(tokenID, _ string) (stop bool) {
+		if acc, ok := simtypes.FindAccount(accs, k.GetRootOwner(ctx, contractID, tokenID)); ok {
+			owners = append(owners, acc)
+			children = append(children, tokenID)
+		}
+		return false
+	})
+	if len(children) == 0 {
+		return simtypes.Account{}, "", false
+	}
+
+	i := r.Intn(len(children))
+	return owners[i], children[i], true
+}
+
+func isFT(_ sdk.AccAddress, coin collection.Coin) bool {
+	return collection.ValidateFTID(coin.TokenId) == nil
+}
+
+func isNFT(_ sdk.AccAddress, coin collection.Coin) bool {
+	return collection.ValidateNFTID(coin.TokenId) == nil
+}
+
+func randomPermission(r *rand.Rand) collection.Permission {
+	permissions := []collection.Permission{
+		collection.PermissionIssue,
+		collection.PermissionModify,
+		collection.PermissionMint,
+		collection.PermissionBurn,
+	}
+	return permissions[r.Intn(len(permissions))]
+}
+
+func randomName(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, 1+r.Intn(20))
+}
+
+// randomAmount returns a random amount in [1, max].
+func randomAmount(r *rand.Rand, max sdk.Int) sdk.Int {
+	return sdk.OneInt().Add(simtypes.RandomAmount(r, max.Sub(sdk.OneInt())))
+}
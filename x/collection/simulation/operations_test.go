@@ -0,0 +1,259 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	ocabci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lbm-sdk/simapp"
+	simappparams "github.com/line/lbm-sdk/simapp/params"
+	sdk "github.com/line/lbm-sdk/types"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/collection/simulation"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{
+		Time: time.Now(),
+	})
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		err := simapp.FundAccount(suite.app, suite.ctx, account.Address, initCoins)
+		suite.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(
+		appParams, cdc, app.AccountKeeper,
+		app.BankKeeper, app.CollectionKeeper,
+	)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{
+			simappparams.DefaultWeightMsgCollectionCreateContract,
+			collection.ModuleName,
+			simulation.TypeMsgCreateContract,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionIssueFT,
+			collection.ModuleName,
+			simulation.TypeMsgIssueFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionIssueNFT,
+			collection.ModuleName,
+			simulation.TypeMsgIssueNFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionMintFT,
+			collection.ModuleName,
+			simulation.TypeMsgMintFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionMintNFT,
+			collection.ModuleName,
+			simulation.TypeMsgMintNFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionSendFT,
+			collection.ModuleName,
+			simulation.TypeMsgSendFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionOperatorSendFT,
+			collection.ModuleName,
+			simulation.TypeMsgOperatorSendFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionSendNFT,
+			collection.ModuleName,
+			simulation.TypeMsgSendNFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionOperatorSendNFT,
+			collection.ModuleName,
+			simulation.TypeMsgOperatorSendNFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionAuthorizeOperator,
+			collection.ModuleName,
+			simulation.TypeMsgAuthorizeOperator,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionRevokeOperator,
+			collection.ModuleName,
+			simulation.TypeMsgRevokeOperator,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionBurnFT,
+			collection.ModuleName,
+			simulation.TypeMsgBurnFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionOperatorBurnFT,
+			collection.ModuleName,
+			simulation.TypeMsgOperatorBurnFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionBurnNFT,
+			collection.ModuleName,
+			simulation.TypeMsgBurnNFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionOperatorBurnNFT,
+			collection.ModuleName,
+			simulation.TypeMsgOperatorBurnNFT,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionModify,
+			collection.ModuleName,
+			simulation.TypeMsgModify,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionGrantPermission,
+			collection.ModuleName,
+			simulation.TypeMsgGrantPermission,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionRevokePermission,
+			collection.ModuleName,
+			simulation.TypeMsgRevokePermission,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionAttach,
+			collection.ModuleName,
+			simulation.TypeMsgAttach,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionDetach,
+			collection.ModuleName,
+			simulation.TypeMsgDetach,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionOperatorAttach,
+			collection.ModuleName,
+			simulation.TypeMsgOperatorAttach,
+		},
+		{
+			simappparams.DefaultWeightMsgCollectionOperatorDetach,
+			collection.ModuleName,
+			simulation.TypeMsgOperatorDetach,
+		},
+	}
+
+	require.Len(weightedOps, len(expected))
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		require.Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) TestSimulateMsgCreateContract() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgCreateContract(app.AccountKeeper, app.BankKeeper, app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	var msg collection.MsgCreateContract
+	require.NoError(collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg))
+
+	require.True(operationMsg.OK, operationMsg.Comment)
+	require.NoError(msg.ValidateBasic())
+	require.Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgAttach() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	holder := accounts[0]
+	contractID := app.CollectionKeeper.CreateContract(ctx, holder.Address, collection.Contract{Name: "test"})
+	classID, err := app.CollectionKeeper.CreateTokenClass(ctx, contractID, &collection.NFTClass{Name: "test"})
+	require.NoError(err)
+	_, err = app.CollectionKeeper.MintNFT(ctx, contractID, holder.Address, []collection.MintNFTParam{
+		{TokenType: *classID, Name: "one"},
+		{TokenType: *classID, Name: "two"},
+	})
+	require.NoError(err)
+
+	// execute operation
+	op := simulation.SimulateMsgAttach(app.AccountKeeper, app.BankKeeper, app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	var msg collection.MsgAttach
+	require.NoError(collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg))
+
+	require.True(operationMsg.OK, operationMsg.Comment)
+	require.Equal(contractID, msg.ContractId)
+	require.Equal(holder.Address.String(), msg.From)
+	require.NotEqual(msg.TokenId, msg.ToTokenId)
+	require.Len(futureOperations, 0)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -13,9 +13,20 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	if k.AsyncInvariantCheckEnabled() {
+		// kept for the check of this height in the following blocks
+		defer k.TrackBlockHeader(ctx)
+	}
+
 	if k.InvCheckPeriod() == 0 || ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
 		// skip running the invariant check
 		return
 	}
+
+	if k.AsyncInvariantCheckEnabled() {
+		// the state of the current block has not been committed yet
+		k.CheckInvariantsAsync(ctx, ctx.BlockHeight()-1)
+		return
+	}
 	k.AssertInvariants(ctx)
 }
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/x/crisis/types"
+)
+
+// NewQueryCmd returns a root CLI command handler for all x/crisis query commands.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(NewQueryLastInvariantCheckCmd())
+
+	return queryCmd
+}
+
+// NewQueryLastInvariantCheckCmd returns a CLI command handler for querying the
+// results of the last asynchronous invariant check.
+func NewQueryLastInvariantCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-invariant-check",
+		Short: "Query the results of the last asynchronous invariant check run by the node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastInvariantCheck(cmd.Context(), &types.QueryLastInvariantCheckRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
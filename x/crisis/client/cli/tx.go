@@ -2,6 +2,7 @@ package cli
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -21,7 +22,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand(NewMsgVerifyInvariantTxCmd())
+	txCmd.AddCommand(
+		NewMsgVerifyInvariantTxCmd(),
+		NewMsgReportBrokenInvariantTxCmd(),
+	)
 
 	return txCmd
 }
@@ -58,3 +62,47 @@ func NewMsgVerifyInvariantTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewMsgReportBrokenInvariantTxCmd returns a CLI command handler for creating a
+// MsgReportBrokenInvariant transaction.
+func NewMsgReportBrokenInvariantTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-broken-invariant [module-name] [invariant-route] [height] [message]",
+		Short: "Report an invariant broken at a past height without halting the chain",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			moduleName, route := args[0], args[1]
+			if moduleName == "" {
+				return errors.New("invalid module name")
+			}
+			if route == "" {
+				return errors.New("invalid invariant route")
+			}
+			height, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			var message string
+			if len(args) > 3 {
+				message = args[3]
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgReportBrokenInvariant(senderAddr, moduleName, route, height, message)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
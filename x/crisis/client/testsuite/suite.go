@@ -6,11 +6,14 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
+	ostcli "github.com/line/ostracon/libs/cli"
+
 	"github.com/line/lbm-sdk/client/flags"
 	clitestutil "github.com/line/lbm-sdk/testutil/cli"
 	"github.com/line/lbm-sdk/testutil/network"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/crisis/client/cli"
+	"github.com/line/lbm-sdk/x/crisis/types"
 )
 
 type IntegrationTestSuite struct {
@@ -102,3 +105,71 @@ func (s *IntegrationTestSuite) TestNewMsgVerifyInvariantTxCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewMsgReportBrokenInvariantTxCmd() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"missing invariant route",
+			append([]string{"bank", "", "1"}, commonArgs...),
+			true, 0, nil,
+		},
+		{
+			"invalid height",
+			append([]string{"bank", "total-supply", "one"}, commonArgs...),
+			true, 0, nil,
+		},
+		{
+			"valid transaction",
+			append([]string{"bank", "total-supply", "1", "whoops"}, commonArgs...),
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewMsgReportBrokenInvariantTxCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, txResp.RawLog)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryLastInvariantCheckCmd() {
+	val := s.network.Validators[0]
+
+	cmd := cli.NewQueryLastInvariantCheckCmd()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{fmt.Sprintf("--%s=json", ostcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res types.QueryLastInvariantCheckResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+
+	// the network does not check the invariants asynchronously
+	s.Require().Nil(res.Check)
+}
@@ -18,6 +18,10 @@ func NewHandler(k types.MsgServer) sdk.Handler {
 			res, err := k.VerifyInvariant(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgReportBrokenInvariant:
+			res, err := k.ReportBrokenInvariant(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized crisis message type: %T", msg)
 		}
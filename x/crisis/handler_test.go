@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	"github.com/line/lbm-sdk/simapp"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
@@ -114,3 +115,52 @@ func TestHandleMsgVerifyInvariantWithInvariantBrokenAndNotEnoughPoolCoins(t *tes
 		res, _ = h(ctx, msg)
 	}, fmt.Sprintf("%v", res))
 }
+
+func TestHandleMsgReportBrokenInvariant(t *testing.T) {
+	app, ctx, addrs := createTestApp()
+	sender := addrs[0]
+	ctx = ctx.WithBlockHeight(10)
+	poor := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	cases := []struct {
+		name  string
+		msg   sdk.Msg
+		valid bool
+	}{
+		{"insufficient fee", types.NewMsgReportBrokenInvariant(poor, testModuleName, dummyRouteWhichFails.Route, 9, "whoops"), false},
+		{"bad invariant route", types.NewMsgReportBrokenInvariant(sender, testModuleName, "route-that-doesnt-exist", 9, "whoops"), false},
+		{"future height", types.NewMsgReportBrokenInvariant(sender, testModuleName, dummyRouteWhichFails.Route, 10, "whoops"), false},
+		{"valid report", types.NewMsgReportBrokenInvariant(sender, testModuleName, dummyRouteWhichFails.Route, 9, "whoops"), true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			h := crisis.NewHandler(app.CrisisKeeper)
+			ctx, _ := ctx.CacheContext()
+			balance := app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+			// a report never halts the chain
+			res, err := h(ctx, tc.msg)
+			if !tc.valid {
+				require.Error(t, err)
+				require.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			// the constant fee is charged
+			constantFee := app.CrisisKeeper.GetConstantFee(ctx)
+			require.Equal(t, balance.Sub(constantFee), app.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+
+			found := false
+			for _, event := range res.Events {
+				if event.Type == types.EventTypeBrokenInvariant {
+					found = true
+				}
+			}
+			require.True(t, found)
+		})
+	}
+}
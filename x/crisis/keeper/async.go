@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/line/lbm-sdk/telemetry"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/crisis/types"
+)
+
+// maxTrackedHeaders is the number of the recent block headers kept for the
+// asynchronous invariant checks.
+const maxTrackedHeaders = 16
+
+// asyncChecker holds the state of the asynchronous invariant checks. It is
+// shared among the copies of the keeper.
+type asyncChecker struct {
+	// ms is the root multistore of the app, which provides the committed states.
+	ms sdk.CommitMultiStore
+
+	mtx     sync.Mutex
+	running bool
+	last    *types.InvariantCheck
+	headers map[int64]tmproto.Header // the recent block headers by height
+}
+
+// EnableAsyncInvariantCheck makes the keeper assert the invariants in the
+// background against the committed states of ms, instead of halting the chain
+// in EndBlock. ms must be the root multistore of the app.
+func (k *Keeper) EnableAsyncInvariantCheck(ms sdk.CommitMultiStore) {
+	k.checker = &asyncChecker{ms: ms, headers: map[int64]tmproto.Header{}}
+}
+
+// AsyncInvariantCheckEnabled returns whether the invariants are asserted
+// asynchronously.
+func (k Keeper) AsyncInvariantCheckEnabled() bool {
+	return k.checker != nil
+}
+
+// TrackBlockHeader keeps the header of the current block, so that the state of
+// its height can be checked against it later. Only the recent headers are kept.
+func (k Keeper) TrackBlockHeader(ctx sdk.Context) {
+	if k.checker == nil {
+		panic("asynchronous invariant check not enabled")
+	}
+
+	k.checker.mtx.Lock()
+	defer k.checker.mtx.Unlock()
+
+	header := ctx.BlockHeader()
+	k.checker.headers[header.Height] = header
+	for height := range k.checker.headers {
+		if height <= header.Height-maxTrackedHeaders {
+			delete(k.checker.headers, height)
+		}
+	}
+}
+
+// CheckInvariantsAsync starts asserting all registered invariants against the
+// committed state at the given height in a background goroutine. The broken
+// invariants are reported through the logs and the telemetry, and never halt
+// the chain. The header of the height must have been tracked by
+// TrackBlockHeader. It returns false if the check has not been started, e.g.
+// the previous check is still running.
+//
+// NOTE: The state must not be pruned during the check, so keep enough recent
+// states on the node.
+func (k Keeper) CheckInvariantsAsync(ctx sdk.Context, height int64) bool {
+	if k.checker == nil {
+		panic("asynchronous invariant check not enabled")
+	}
+
+	logger := k.Logger(ctx)
+	if height <= 0 {
+		return false
+	}
+
+	k.checker.mtx.Lock()
+	defer k.checker.mtx.Unlock()
+	if k.checker.running {
+		logger.Info("skipping invariant check; the previous check is still running", "height", height)
+		return false
+	}
+
+	// an uncommitted version would be loaded as an empty state
+	if latest := k.checker.ms.LastCommitID().Version; height > latest {
+		logger.Error("cannot check invariants against an uncommitted state", "height", height, "latest", latest)
+		return false
+	}
+
+	// the invariants may refer to the header, e.g. the block time
+	header, ok := k.checker.headers[height]
+	if !ok {
+		logger.Error("cannot check invariants without the header of the height", "height", height)
+		return false
+	}
+
+	// branch here, not to race with the commits
+	cms, err := k.checker.ms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		logger.Error("failed to load state to check invariants", "height", height, "err", err)
+		return false
+	}
+
+	checkCtx := sdk.NewContext(cms, header, true, logger)
+	routes := k.Routes()
+
+	k.checker.running = true
+	go func() {
+		check := checkInvariants(checkCtx, routes)
+
+		k.checker.mtx.Lock()
+		defer k.checker.mtx.Unlock()
+		k.checker.running = false
+		k.checker.last = check
+	}()
+
+	return true
+}
+
+// checkInvariants asserts the invariants against the state of ctx, which is
+// never written.
+func checkInvariants(ctx sdk.Context, routes []types.InvarRoute) *types.InvariantCheck {
+	logger := ctx.Logger()
+	check := &types.InvariantCheck{
+		Height:    ctx.BlockHeight(),
+		StartedAt: time.Now(),
+	}
+
+	logger.Info("checking invariants asynchronously", "height", check.Height)
+	numBroken := 0
+	for _, ir := range routes {
+		res, broken := runInvariant(ctx, ir)
+		check.Results = append(check.Results, types.InvariantResult{
+			ModuleName: ir.ModuleName,
+			Route:      ir.Route,
+			Broken:     broken,
+			Message:    res,
+		})
+		if !broken {
+			continue
+		}
+		numBroken++
+
+		logger.Error(fmt.Sprintf("invariant broken: %s\n"+
+			"\tCRITICAL please submit the following transaction:\n"+
+			"\t\t tx crisis report-broken-invariant %s %s %d", res, ir.ModuleName, ir.Route, check.Height),
+			"height", check.Height, "name", ir.FullRoute())
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "invariant", "broken"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("module", ir.ModuleName),
+				telemetry.NewLabel("route", ir.Route),
+			},
+		)
+	}
+	telemetry.ModuleSetGauge(types.ModuleName, float32(numBroken), "broken_invariants")
+	telemetry.ModuleSetGauge(types.ModuleName, float32(check.Height), "last_checked_height")
+	telemetry.ModuleMeasureSince(types.ModuleName, check.StartedAt, "async_invariant_check")
+
+	check.Duration = time.Since(check.StartedAt)
+	logger.Info("checked all invariants", "duration", check.Duration, "height", check.Height, "broken", numBroken)
+	return check
+}
+
+// runInvariant runs the invariant, treating a panic as a broken invariant.
+func runInvariant(ctx sdk.Context, ir types.InvarRoute) (res string, broken bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = fmt.Sprintf("%s invariant panicked: %v", ir.FullRoute(), r)
+			broken = true
+		}
+	}()
+
+	return ir.Invar(ctx)
+}
+
+// lastInvariantCheck returns the last completed asynchronous invariant check,
+// or nil if there is none.
+func (k Keeper) lastInvariantCheck() *types.InvariantCheck {
+	if k.checker == nil {
+		return nil
+	}
+
+	k.checker.mtx.Lock()
+	defer k.checker.mtx.Unlock()
+	return k.checker.last
+}
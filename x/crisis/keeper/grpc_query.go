@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// LastInvariantCheck implements the Query/LastInvariantCheck gRPC method
+func (k Keeper) LastInvariantCheck(_ context.Context, req *types.QueryLastInvariantCheckRequest) (*types.QueryLastInvariantCheckResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryLastInvariantCheckResponse{Check: k.lastInvariantCheck()}, nil
+}
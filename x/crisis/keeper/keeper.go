@@ -20,6 +20,8 @@ type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	checker *asyncChecker // nil unless the invariants are asserted asynchronously
 }
 
 // NewKeeper creates a new Keeper object
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/line/lbm-sdk/simapp"
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/crisis/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -45,3 +47,80 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestCheckInvariantsAsync(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+	height := app.LastBlockHeight()
+
+	app.CrisisKeeper.EnableAsyncInvariantCheck(app.CommitMultiStore())
+	require.True(t, app.CrisisKeeper.AsyncInvariantCheckEnabled())
+
+	release := make(chan struct{})
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) {
+		<-release
+		return "", false
+	})
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "whoops", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute3", func(sdk.Context) (string, bool) { panic("whoops") })
+	var checkedHeader tmproto.Header
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute4", func(ctx sdk.Context) (string, bool) {
+		checkedHeader = ctx.BlockHeader()
+		return "", false
+	})
+
+	ctx := app.NewContext(true, tmproto.Header{Height: height + 1, Time: time.Unix(2000, 0).UTC()})
+	lastCheck := func() *types.InvariantCheck {
+		res, err := app.CrisisKeeper.LastInvariantCheck(sdk.WrapSDKContext(ctx), &types.QueryLastInvariantCheckRequest{})
+		require.NoError(t, err)
+		return res.Check
+	}
+	require.Nil(t, lastCheck())
+
+	// the header of the height has not been tracked
+	require.False(t, app.CrisisKeeper.CheckInvariantsAsync(ctx, height))
+	checkedTime := time.Unix(1000, 0).UTC()
+	app.CrisisKeeper.TrackBlockHeader(ctx.WithBlockHeader(tmproto.Header{Height: height, Time: checkedTime}))
+
+	// never halts the chain
+	require.NotPanics(t, func() { require.True(t, app.CrisisKeeper.CheckInvariantsAsync(ctx, height)) })
+
+	// the previous check is still running
+	require.False(t, app.CrisisKeeper.CheckInvariantsAsync(ctx, height))
+
+	close(release)
+	require.Eventually(t, func() bool { return lastCheck() != nil }, time.Second*10, time.Millisecond*10)
+
+	check := lastCheck()
+	require.Equal(t, height, check.Height)
+	require.Len(t, check.Results, len(app.CrisisKeeper.Routes()))
+	// checked against the header of the height, not of the current block
+	require.Equal(t, height, checkedHeader.Height)
+	require.Equal(t, checkedTime, checkedHeader.Time)
+
+	broken := map[string]bool{}
+	for _, result := range check.Results {
+		if result.Broken {
+			broken[result.ModuleName+"/"+result.Route] = true
+		}
+	}
+	require.Equal(t, map[string]bool{"testModule/testRoute2": true, "testModule/testRoute3": true}, broken)
+
+	// state not committed
+	app.CrisisKeeper.TrackBlockHeader(ctx)
+	require.False(t, app.CrisisKeeper.CheckInvariantsAsync(ctx, height+1))
+}
+
+func TestTrackBlockHeader(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+	app.CrisisKeeper.EnableAsyncInvariantCheck(app.CommitMultiStore())
+	height := app.LastBlockHeight()
+
+	// the header of the height is dropped after 16 blocks
+	ctx := app.NewContext(true, tmproto.Header{})
+	for h := height; h <= height+16; h++ {
+		app.CrisisKeeper.TrackBlockHeader(ctx.WithBlockHeader(tmproto.Header{Height: h}))
+	}
+	require.False(t, app.CrisisKeeper.CheckInvariantsAsync(ctx, height))
+}
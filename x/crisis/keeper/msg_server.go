@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/crisis/types"
 )
 
@@ -64,3 +66,52 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 
 	return &types.MsgVerifyInvariantResponse{}, nil
 }
+
+func (k Keeper) ReportBrokenInvariant(goCtx context.Context, msg *types.MsgReportBrokenInvariant) (*types.MsgReportBrokenInvariantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	constantFee := sdk.NewCoins(k.GetConstantFee(ctx))
+
+	// the reports are charged the constant fee as well, not to be spammed
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.SendCoinsFromAccountToFeeCollector(ctx, sender, constantFee); err != nil {
+		return nil, err
+	}
+
+	found := false
+	msgFullRoute := msg.FullInvariantRoute()
+	for _, invarRoute := range k.Routes() {
+		if invarRoute.FullRoute() == msgFullRoute {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, types.ErrUnknownInvariant
+	}
+
+	if msg.Height >= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "height %d is not in the past", msg.Height)
+	}
+
+	// the report is only recorded, as the past state cannot be verified here
+	k.Logger(ctx).Error("broken invariant reported", "name", msgFullRoute, "height", msg.Height, "reporter", msg.Sender, "res", msg.Message)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBrokenInvariant,
+			sdk.NewAttribute(types.AttributeKeyRoute, msgFullRoute),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(msg.Height)),
+			sdk.NewAttribute(types.AttributeKeyMessage, msg.Message),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCrisis),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgReportBrokenInvariantResponse{}, nil
+}
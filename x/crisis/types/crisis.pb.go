@@ -0,0 +1,809 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/crisis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InvariantCheck defines the results of an asynchronous invariant check run by
+// the node against a committed state.
+type InvariantCheck struct {
+	// height is the height of the state the invariants were asserted against.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// started_at is the local time when the check started.
+	StartedAt time.Time `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at" yaml:"started_at"`
+	// duration is the time taken by the check.
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+	// results are the results of the registered invariants.
+	Results []InvariantResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results"`
+}
+
+func (m *InvariantCheck) Reset()         { *m = InvariantCheck{} }
+func (m *InvariantCheck) String() string { return proto.CompactTextString(m) }
+func (*InvariantCheck) ProtoMessage()    {}
+func (*InvariantCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4563994d65183ad5, []int{0}
+}
+func (m *InvariantCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantCheck.Merge(m, src)
+}
+func (m *InvariantCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantCheck proto.InternalMessageInfo
+
+func (m *InvariantCheck) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InvariantCheck) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
+	}
+	return time.Time{}
+}
+
+func (m *InvariantCheck) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *InvariantCheck) GetResults() []InvariantResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// InvariantResult defines the result of an invariant.
+type InvariantResult struct {
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty" yaml:"module_name"`
+	Route      string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// broken is whether the invariant has been broken.
+	Broken bool `protobuf:"varint,3,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message is the message reported by the invariant.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4563994d65183ad5, []int{1}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*InvariantCheck)(nil), "cosmos.crisis.v1beta1.InvariantCheck")
+	proto.RegisterType((*InvariantResult)(nil), "cosmos.crisis.v1beta1.InvariantResult")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/crisis/v1beta1/crisis.proto", fileDescriptor_4563994d65183ad5)
+}
+
+var fileDescriptor_4563994d65183ad5 = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xb5, 0x6c, 0xad, 0x2b, 0x81, 0xb0, 0xc6, 0x14, 0x2a, 0x91, 0x54, 0x39, 0x4c,
+	0xbd, 0x60, 0x6b, 0xe3, 0x80, 0xc4, 0x05, 0x08, 0x08, 0x89, 0x0b, 0x87, 0x88, 0x03, 0xe2, 0x32,
+	0x39, 0xed, 0x23, 0xb1, 0x1a, 0xc7, 0x95, 0xed, 0x4c, 0xec, 0x33, 0x70, 0x99, 0x38, 0xf1, 0x91,
+	0x76, 0xdc, 0x91, 0x53, 0x41, 0xed, 0x37, 0xd8, 0x27, 0x40, 0xb1, 0x9d, 0x81, 0xca, 0x6e, 0xf9,
+	0xbf, 0xf7, 0x7b, 0xff, 0x97, 0xf7, 0x97, 0x51, 0x32, 0x97, 0x5a, 0x48, 0x4d, 0xe7, 0x8a, 0x6b,
+	0xae, 0xe9, 0xf9, 0x49, 0x0e, 0x86, 0x9d, 0x78, 0x49, 0x56, 0x4a, 0x1a, 0x89, 0x1f, 0x39, 0x86,
+	0xf8, 0xa2, 0x67, 0x26, 0x87, 0x85, 0x2c, 0xa4, 0x25, 0x68, 0xfb, 0xe5, 0xe0, 0x49, 0x54, 0x48,
+	0x59, 0x54, 0x40, 0xad, 0xca, 0x9b, 0x2f, 0x74, 0xd1, 0x28, 0x66, 0xb8, 0xac, 0x7d, 0x3f, 0xde,
+	0xed, 0x1b, 0x2e, 0x40, 0x1b, 0x26, 0x56, 0x0e, 0x48, 0xbe, 0xed, 0xa1, 0xfb, 0xef, 0xeb, 0x73,
+	0xa6, 0x38, 0xab, 0xcd, 0x9b, 0x12, 0xe6, 0x4b, 0x7c, 0x84, 0xf6, 0x4b, 0xe0, 0x45, 0x69, 0xc2,
+	0x60, 0x1a, 0xcc, 0xfa, 0x99, 0x57, 0xf8, 0x13, 0x42, 0xda, 0x30, 0x65, 0x60, 0x71, 0xc6, 0x4c,
+	0xb8, 0x37, 0x0d, 0x66, 0xe3, 0xd3, 0x09, 0x71, 0x0b, 0x48, 0xb7, 0x80, 0x7c, 0xec, 0x16, 0xa4,
+	0x4f, 0xae, 0xd6, 0x71, 0xef, 0x66, 0x1d, 0x3f, 0xbc, 0x60, 0xa2, 0x7a, 0x91, 0xfc, 0x9d, 0x4d,
+	0x2e, 0x7f, 0xc5, 0x41, 0x36, 0xf2, 0x85, 0xd7, 0x06, 0xbf, 0x44, 0xc3, 0xee, 0xbf, 0xc3, 0xbe,
+	0xf5, 0x7d, 0xfc, 0x9f, 0xef, 0x5b, 0x0f, 0xa4, 0xc3, 0xd6, 0xf6, 0x47, 0xeb, 0x70, 0x3b, 0x84,
+	0xdf, 0xa1, 0x03, 0x05, 0xba, 0xa9, 0x8c, 0x0e, 0x07, 0xd3, 0xfe, 0x6c, 0x7c, 0x7a, 0x4c, 0xee,
+	0x4c, 0x91, 0xdc, 0x9e, 0x9a, 0x59, 0x3c, 0x1d, 0xb4, 0x66, 0x59, 0x37, 0x9c, 0x7c, 0x0f, 0xd0,
+	0x83, 0x1d, 0x04, 0x3f, 0x47, 0x63, 0x21, 0x17, 0x4d, 0x05, 0x67, 0x35, 0x13, 0x60, 0x33, 0x19,
+	0xa5, 0x47, 0x37, 0xeb, 0x18, 0xbb, 0xbb, 0xfe, 0x69, 0x26, 0x19, 0x72, 0xea, 0x03, 0x13, 0x80,
+	0x0f, 0xd1, 0x3d, 0x25, 0x1b, 0x03, 0x36, 0xaa, 0x51, 0xe6, 0x44, 0x9b, 0x6e, 0xae, 0xe4, 0x12,
+	0xdc, 0xa5, 0xc3, 0xcc, 0x2b, 0x1c, 0xa2, 0x03, 0x01, 0x5a, 0xb3, 0x02, 0xc2, 0x81, 0xe5, 0x3b,
+	0x99, 0xbe, 0xba, 0xda, 0x44, 0xc1, 0xf5, 0x26, 0x0a, 0x7e, 0x6f, 0xa2, 0xe0, 0x72, 0x1b, 0xf5,
+	0xae, 0xb7, 0x51, 0xef, 0xe7, 0x36, 0xea, 0x7d, 0x3e, 0x2e, 0xb8, 0x29, 0x9b, 0x9c, 0xcc, 0xa5,
+	0xa0, 0x15, 0xaf, 0x81, 0x56, 0xb9, 0x78, 0xaa, 0x17, 0x4b, 0xfa, 0xb5, 0x7b, 0x61, 0xe6, 0x62,
+	0x05, 0x3a, 0xdf, 0xb7, 0x29, 0x3e, 0xfb, 0x33, 0x00, 0x69, 0x98, 0x7a, 0x9e, 0x7f, 0x02, 0x00,
+	0x00,
+}
+
+func (m *InvariantCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrisis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCrisis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCrisis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrisis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrisis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InvariantCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovCrisis(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovCrisis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovCrisis(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovCrisis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	return n
+}
+
+func sovCrisis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrisis(x uint64) (n int) {
+	return sovCrisis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InvariantCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, InvariantResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrisis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrisis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCrisis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCrisis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCrisis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCrisis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCrisis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCrisis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCrisis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,8 +2,11 @@ package types
 
 // crisis module event types
 const (
-	EventTypeInvariant = "invariant"
+	EventTypeInvariant       = "invariant"
+	EventTypeBrokenInvariant = "broken_invariant"
 
 	AttributeValueCrisis = ModuleName
 	AttributeKeyRoute    = "route"
+	AttributeKeyHeight   = "height"
+	AttributeKeyMessage  = "message"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
 // ensure Msg interface compliance at compile time
@@ -45,3 +46,55 @@ func (msg MsgVerifyInvariant) ValidateBasic() error {
 func (msg MsgVerifyInvariant) FullInvariantRoute() string {
 	return msg.InvariantModuleName + "/" + msg.InvariantRoute
 }
+
+var _ sdk.Msg = &MsgReportBrokenInvariant{}
+
+// NewMsgReportBrokenInvariant creates a new MsgReportBrokenInvariant object
+//
+//nolint:interfacer
+func NewMsgReportBrokenInvariant(sender sdk.AccAddress, invModeName, invRoute string, height int64, message string) *MsgReportBrokenInvariant {
+	return &MsgReportBrokenInvariant{
+		Sender:              sender.String(),
+		InvariantModuleName: invModeName,
+		InvariantRoute:      invRoute,
+		Height:              height,
+		Message:             message,
+	}
+}
+
+func (msg MsgReportBrokenInvariant) Route() string { return ModuleName }
+func (msg MsgReportBrokenInvariant) Type() string  { return "report_broken_invariant" }
+
+// get the bytes for the message signer to sign on
+func (msg MsgReportBrokenInvariant) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgReportBrokenInvariant
+func (msg MsgReportBrokenInvariant) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgReportBrokenInvariant) ValidateBasic() error {
+	if msg.Sender == "" {
+		return ErrNoSender
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", msg.Sender)
+	}
+	if msg.InvariantModuleName == "" || msg.InvariantRoute == "" {
+		return sdkerrors.Wrap(ErrUnknownInvariant, "empty invariant route")
+	}
+	if msg.Height <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height: %d", msg.Height)
+	}
+	return nil
+}
+
+// FullInvariantRoute - get the messages full invariant route
+func (msg MsgReportBrokenInvariant) FullInvariantRoute() string {
+	return msg.InvariantModuleName + "/" + msg.InvariantRoute
+}
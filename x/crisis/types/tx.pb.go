@@ -105,36 +105,126 @@ func (m *MsgVerifyInvariantResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVerifyInvariantResponse proto.InternalMessageInfo
 
+// MsgReportBrokenInvariant represents a message to report an invariant broken
+// at a past height.
+type MsgReportBrokenInvariant struct {
+	Sender              string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	InvariantModuleName string `protobuf:"bytes,2,opt,name=invariant_module_name,json=invariantModuleName,proto3" json:"invariant_module_name,omitempty" yaml:"invariant_module_name"`
+	InvariantRoute      string `protobuf:"bytes,3,opt,name=invariant_route,json=invariantRoute,proto3" json:"invariant_route,omitempty" yaml:"invariant_route"`
+	// height is the height of the state the invariant was found broken.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// message is the message reported by the invariant.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *MsgReportBrokenInvariant) Reset()         { *m = MsgReportBrokenInvariant{} }
+func (m *MsgReportBrokenInvariant) String() string { return proto.CompactTextString(m) }
+func (*MsgReportBrokenInvariant) ProtoMessage()    {}
+func (*MsgReportBrokenInvariant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61276163172fe867, []int{2}
+}
+func (m *MsgReportBrokenInvariant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportBrokenInvariant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportBrokenInvariant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportBrokenInvariant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportBrokenInvariant.Merge(m, src)
+}
+func (m *MsgReportBrokenInvariant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportBrokenInvariant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportBrokenInvariant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportBrokenInvariant proto.InternalMessageInfo
+
+// MsgReportBrokenInvariantResponse defines the Msg/ReportBrokenInvariant
+// response type.
+type MsgReportBrokenInvariantResponse struct {
+}
+
+func (m *MsgReportBrokenInvariantResponse) Reset()         { *m = MsgReportBrokenInvariantResponse{} }
+func (m *MsgReportBrokenInvariantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportBrokenInvariantResponse) ProtoMessage()    {}
+func (*MsgReportBrokenInvariantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61276163172fe867, []int{3}
+}
+func (m *MsgReportBrokenInvariantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportBrokenInvariantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportBrokenInvariantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportBrokenInvariantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportBrokenInvariantResponse.Merge(m, src)
+}
+func (m *MsgReportBrokenInvariantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportBrokenInvariantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportBrokenInvariantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportBrokenInvariantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgVerifyInvariant)(nil), "cosmos.crisis.v1beta1.MsgVerifyInvariant")
 	proto.RegisterType((*MsgVerifyInvariantResponse)(nil), "cosmos.crisis.v1beta1.MsgVerifyInvariantResponse")
+	proto.RegisterType((*MsgReportBrokenInvariant)(nil), "cosmos.crisis.v1beta1.MsgReportBrokenInvariant")
+	proto.RegisterType((*MsgReportBrokenInvariantResponse)(nil), "cosmos.crisis.v1beta1.MsgReportBrokenInvariantResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/crisis/v1beta1/tx.proto", fileDescriptor_61276163172fe867) }
 
 var fileDescriptor_61276163172fe867 = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2c, 0xce, 0x2c, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x85, 0xc8, 0xeb, 0x41,
-	0xe4, 0xf5, 0xa0, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x15, 0xfa, 0x20, 0x16, 0x44,
-	0xb1, 0xd2, 0x45, 0x46, 0x2e, 0x21, 0xdf, 0xe2, 0xf4, 0xb0, 0xd4, 0xa2, 0xcc, 0xb4, 0x4a, 0xcf,
-	0xbc, 0xb2, 0xc4, 0xa2, 0xcc, 0xc4, 0xbc, 0x12, 0x21, 0x31, 0x2e, 0xb6, 0xe2, 0xd4, 0xbc, 0x94,
-	0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x28, 0x4f, 0x28, 0x84, 0x4b, 0x34, 0x13,
-	0xa6, 0x28, 0x3e, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0x35, 0x3e, 0x2f, 0x31, 0x37, 0x55, 0x82, 0x09,
-	0xa4, 0xcc, 0x49, 0xe1, 0xd3, 0x3d, 0x79, 0x99, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0xac, 0xca,
-	0x94, 0x82, 0x84, 0xe1, 0xe2, 0xbe, 0x60, 0x61, 0xbf, 0xc4, 0xdc, 0x54, 0x21, 0x67, 0x2e, 0x7e,
-	0x84, 0xf2, 0xa2, 0xfc, 0xd2, 0x92, 0x54, 0x09, 0x66, 0xb0, 0x79, 0x52, 0x9f, 0xee, 0xc9, 0x8b,
-	0xa1, 0x9b, 0x07, 0x56, 0xa0, 0x14, 0xc4, 0x07, 0x17, 0x09, 0x02, 0x09, 0x58, 0x71, 0x74, 0x2c,
-	0x90, 0x67, 0x78, 0xb1, 0x40, 0x9e, 0x41, 0x49, 0x86, 0x4b, 0x0a, 0xd3, 0x4b, 0x41, 0xa9, 0xc5,
-	0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0x65, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9, 0x42, 0xf9, 0x5c, 0xfc,
-	0xe8, 0x9e, 0xd6, 0xd4, 0xc3, 0x1a, 0x72, 0x7a, 0x98, 0x86, 0x49, 0x19, 0x12, 0xad, 0x14, 0x66,
-	0xaf, 0x93, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa5, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xe7, 0x64, 0xe6, 0xa5, 0xea, 0xe7, 0x24,
-	0xe5, 0xea, 0x16, 0xa7, 0x64, 0xeb, 0x57, 0xc0, 0xe2, 0xb8, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0x1c, 0x65, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x9e, 0xf9, 0x26, 0x01, 0x02,
-	0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0xe3, 0x2b, 0x1c, 0xe0, 0x81, 0x93, 0x0c, 0x3d, 0x45, 0xd1, 0x29, 0x89, 0x32, 0xa0,
+	0x63, 0x20, 0x56, 0x61, 0x40, 0xba, 0x09, 0x95, 0x89, 0x21, 0x0c, 0x11, 0x62, 0x60, 0xa9, 0x92,
+	0xf6, 0xe1, 0x5a, 0x8d, 0xe3, 0xc8, 0x76, 0xab, 0x76, 0x64, 0x83, 0x8d, 0x8f, 0xd0, 0x8f, 0xc3,
+	0x58, 0x36, 0xa6, 0x0a, 0xb5, 0x0b, 0x73, 0x47, 0x26, 0x94, 0xb4, 0x29, 0xa8, 0x4d, 0x25, 0x18,
+	0xd9, 0xf2, 0xfe, 0xef, 0xe7, 0x17, 0xbf, 0xbf, 0xdf, 0xc3, 0x6e, 0x5f, 0x6a, 0x21, 0x35, 0xed,
+	0x2b, 0xae, 0xb9, 0xa6, 0x93, 0x4e, 0x0a, 0x26, 0xe9, 0x50, 0x33, 0x0d, 0x0b, 0x25, 0x8d, 0x24,
+	0xed, 0x6d, 0x3e, 0xdc, 0xe6, 0xc3, 0x5d, 0xde, 0x79, 0xc8, 0x24, 0x93, 0x15, 0x41, 0xcb, 0xaf,
+	0x2d, 0x1c, 0x7c, 0x45, 0x98, 0x44, 0x9a, 0xbd, 0x05, 0xc5, 0xdf, 0xcf, 0x5e, 0xe5, 0x93, 0x44,
+	0xf1, 0x24, 0x37, 0xe4, 0x12, 0x9f, 0x6b, 0xc8, 0x07, 0xa0, 0x6c, 0xe4, 0xa3, 0xeb, 0x7b, 0xf1,
+	0x2e, 0x22, 0x6f, 0x70, 0x9b, 0xd7, 0x50, 0x4f, 0xc8, 0xc1, 0x38, 0x83, 0x5e, 0x9e, 0x08, 0xb0,
+	0xcf, 0x4a, 0xac, 0xeb, 0x6f, 0x96, 0xde, 0xd5, 0x2c, 0x11, 0xd9, 0x4d, 0xd0, 0x88, 0x05, 0xf1,
+	0x83, 0xbd, 0x1e, 0x55, 0xf2, 0xeb, 0x44, 0x00, 0x79, 0x89, 0x2f, 0x7e, 0xe3, 0x4a, 0x8e, 0x0d,
+	0xd8, 0xad, 0xaa, 0x9e, 0xb3, 0x59, 0x7a, 0x97, 0x87, 0xf5, 0x2a, 0x20, 0x88, 0xef, 0xef, 0x95,
+	0xb8, 0x14, 0x6e, 0xee, 0x7e, 0x9c, 0x7b, 0xd6, 0x8f, 0xb9, 0x67, 0x05, 0x57, 0xd8, 0x39, 0x6e,
+	0x29, 0x06, 0x5d, 0xc8, 0x5c, 0x43, 0xf0, 0xe9, 0x0c, 0xdb, 0x91, 0x66, 0x31, 0x14, 0x52, 0x99,
+	0xae, 0x92, 0x23, 0xc8, 0xff, 0xe7, 0xbe, 0xcb, 0x2b, 0x0f, 0x81, 0xb3, 0xa1, 0xb1, 0x6f, 0xf9,
+	0xe8, 0xba, 0x15, 0xef, 0x22, 0x62, 0xe3, 0x3b, 0x02, 0xb4, 0x4e, 0x18, 0xd8, 0xb7, 0xab, 0x5e,
+	0xea, 0xf0, 0x0f, 0xa7, 0x02, 0xec, 0x9f, 0xb2, 0xa2, 0xf6, 0xeb, 0xe9, 0x4f, 0x84, 0x5b, 0x91,
+	0x66, 0x44, 0xe2, 0x8b, 0xc3, 0x29, 0x79, 0x1c, 0x36, 0x8e, 0x5a, 0x78, 0xec, 0xbe, 0xd3, 0xf9,
+	0x6b, 0xb4, 0xfe, 0x31, 0xf9, 0x80, 0x70, 0xbb, 0xf9, 0x95, 0xe8, 0xe9, 0x62, 0x8d, 0x07, 0x9c,
+	0xe7, 0xff, 0x78, 0xa0, 0xbe, 0x43, 0xf7, 0xc5, 0x97, 0x95, 0x8b, 0x16, 0x2b, 0x17, 0x7d, 0x5f,
+	0xb9, 0xe8, 0xf3, 0xda, 0xb5, 0x16, 0x6b, 0xd7, 0xfa, 0xb6, 0x76, 0xad, 0x77, 0x8f, 0x18, 0x37,
+	0xc3, 0x71, 0x1a, 0xf6, 0xa5, 0xa0, 0x19, 0xcf, 0x81, 0x66, 0xa9, 0x78, 0xa2, 0x07, 0x23, 0x3a,
+	0xad, 0x17, 0xd3, 0xcc, 0x0a, 0xd0, 0xe9, 0x79, 0xb5, 0x67, 0xcf, 0x7e, 0x0d, 0x00, 0x76, 0xc4,
+	0x4a, 0x3e, 0xb6, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -151,6 +241,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// VerifyInvariant defines a method to verify a particular invariance.
 	VerifyInvariant(ctx context.Context, in *MsgVerifyInvariant, opts ...grpc.CallOption) (*MsgVerifyInvariantResponse, error)
+	// ReportBrokenInvariant defines a method to report an invariant found broken
+	// by an asynchronous invariant check, without halting the chain.
+	ReportBrokenInvariant(ctx context.Context, in *MsgReportBrokenInvariant, opts ...grpc.CallOption) (*MsgReportBrokenInvariantResponse, error)
 }
 
 type msgClient struct {
@@ -170,10 +263,22 @@ func (c *msgClient) VerifyInvariant(ctx context.Context, in *MsgVerifyInvariant,
 	return out, nil
 }
 
+func (c *msgClient) ReportBrokenInvariant(ctx context.Context, in *MsgReportBrokenInvariant, opts ...grpc.CallOption) (*MsgReportBrokenInvariantResponse, error) {
+	out := new(MsgReportBrokenInvariantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Msg/ReportBrokenInvariant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// VerifyInvariant defines a method to verify a particular invariance.
 	VerifyInvariant(context.Context, *MsgVerifyInvariant) (*MsgVerifyInvariantResponse, error)
+	// ReportBrokenInvariant defines a method to report an invariant found broken
+	// by an asynchronous invariant check, without halting the chain.
+	ReportBrokenInvariant(context.Context, *MsgReportBrokenInvariant) (*MsgReportBrokenInvariantResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -183,6 +288,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) VerifyInvariant(ctx context.Context, req *MsgVerifyInvariant) (*MsgVerifyInvariantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyInvariant not implemented")
 }
+func (*UnimplementedMsgServer) ReportBrokenInvariant(ctx context.Context, req *MsgReportBrokenInvariant) (*MsgReportBrokenInvariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportBrokenInvariant not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -206,6 +314,24 @@ func _Msg_VerifyInvariant_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReportBrokenInvariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportBrokenInvariant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportBrokenInvariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Msg/ReportBrokenInvariant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportBrokenInvariant(ctx, req.(*MsgReportBrokenInvariant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.crisis.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -214,6 +340,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyInvariant",
 			Handler:    _Msg_VerifyInvariant_Handler,
 		},
+		{
+			MethodName: "ReportBrokenInvariant",
+			Handler:    _Msg_ReportBrokenInvariant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/crisis/v1beta1/tx.proto",
@@ -286,6 +416,85 @@ func (m *MsgVerifyInvariantResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgReportBrokenInvariant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportBrokenInvariant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportBrokenInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.InvariantRoute) > 0 {
+		i -= len(m.InvariantRoute)
+		copy(dAtA[i:], m.InvariantRoute)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InvariantRoute)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InvariantModuleName) > 0 {
+		i -= len(m.InvariantModuleName)
+		copy(dAtA[i:], m.InvariantModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InvariantModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportBrokenInvariantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportBrokenInvariantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportBrokenInvariantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -327,6 +536,43 @@ func (m *MsgVerifyInvariantResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgReportBrokenInvariant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InvariantModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InvariantRoute)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportBrokenInvariantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -529,6 +775,253 @@ func (m *MsgVerifyInvariantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgReportBrokenInvariant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportBrokenInvariant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportBrokenInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantRoute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportBrokenInvariantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportBrokenInvariantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportBrokenInvariantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,4 +35,7 @@ func BeginBlocker(ctx sdk.Context, req ocabci.RequestBeginBlock, k keeper.Keeper
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// restake the rewards of the enrolled delegations
+	k.AutoRestake(ctx)
 }
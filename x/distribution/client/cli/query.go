@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryAutoRestakeEnrollments(),
 	)
 
 	return distQueryCmd
@@ -319,3 +320,59 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryAutoRestakeEnrollments implements the query auto restake enrollments command.
+func GetCmdQueryAutoRestakeEnrollments() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-restake-enrollments [delegator-addr]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the delegations enrolled in the auto restake",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all delegations enrolled in the auto restake, optionally restrict to the delegations of a single delegator.
+
+Example:
+$ %s query distribution auto-restake-enrollments
+$ %s query distribution auto-restake-enrollments %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var delegatorAddr string
+			if len(args) == 1 {
+				addr, err := sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				delegatorAddr = addr.String()
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AutoRestakeEnrollments(
+				cmd.Context(),
+				&types.QueryAutoRestakeEnrollmentsRequest{DelegatorAddress: delegatorAddr, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auto restake enrollments")
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -41,6 +42,7 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSetAutoRestakeCmd(),
 	)
 
 	return distTxCmd
@@ -265,6 +267,50 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+func NewSetAutoRestakeCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-restake [validator-addr] [true|false]",
+		Args:  cobra.ExactArgs(2),
+		Short: "enable or disable the auto restake of the rewards from a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the auto restake of the rewards from a delegation.
+While enabled, the rewards are periodically withdrawn and delegated to the same validator.
+The withdraw address of the delegator must be the delegator address itself.
+
+Example:
+$ %s tx distribution set-auto-restake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("%s is not a valid bool, please input true or false", args[1])
+			}
+
+			msg := types.NewMsgSetAutoRestake(delAddr, valAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
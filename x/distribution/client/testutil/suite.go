@@ -16,6 +16,7 @@ import (
 	"github.com/line/lbm-sdk/testutil/network"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/distribution/client/cli"
+	"github.com/line/lbm-sdk/x/distribution/types"
 	minttypes "github.com/line/lbm-sdk/x/mint/types"
 )
 
@@ -74,12 +75,14 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", ostcli.OutputFlag)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true,"auto_restake_interval":"17280","auto_restake_gas_budget":"10000000"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", ostcli.OutputFlag)},
-			`base_proposer_reward: "0.010000000000000000"
+			`auto_restake_gas_budget: "10000000"
+auto_restake_interval: "17280"
+base_proposer_reward: "0.010000000000000000"
 bonus_proposer_reward: "0.040000000000000000"
 community_tax: "0.020000000000000000"
 withdraw_addr_enabled: true`,
@@ -675,6 +678,117 @@ func (s *IntegrationTestSuite) TestNewFundCommunityPoolCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewSetAutoRestakeCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"invalid validator address",
+			[]string{
+				"foo", "true",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"invalid bool",
+			[]string{
+				val.ValAddress.String(), "foo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"valid transaction",
+			[]string{
+				val.ValAddress.String(), "true",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetAutoRestakeCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+
+	// query the enrollment
+	queryCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid delegator address",
+			[]string{"foo", fmt.Sprintf("--%s=json", ostcli.OutputFlag)},
+			true,
+		},
+		{
+			"all enrollments",
+			[]string{fmt.Sprintf("--%s=json", ostcli.OutputFlag)},
+			false,
+		},
+		{
+			"enrollments of a delegator",
+			[]string{val.Address.String(), fmt.Sprintf("--%s=json", ostcli.OutputFlag)},
+			false,
+		},
+	}
+
+	for _, tc := range queryCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryAutoRestakeEnrollments()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res types.QueryAutoRestakeEnrollmentsResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().Equal([]types.AutoRestakeEnrollment{
+				{DelegatorAddress: val.Address.String(), ValidatorAddress: val.ValAddress.String()},
+			}, res.Enrollments)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdSubmitProposal() {
 	val := s.network.Validators[0]
 	invalidProp := `{
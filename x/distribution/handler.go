@@ -38,7 +38,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 func NewCommunityPoolSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
-	return func(ctx sdk.Context, content govtypes.Content) error {
+	return func(ctx sdk.Context, _ uint64, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
@@ -76,6 +76,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, enrollment := range data.AutoRestakeEnrollments {
+		delegatorAddress := sdk.MustAccAddressFromBech32(enrollment.DelegatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(enrollment.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setAutoRestakeEnrollment(ctx, delegatorAddress, valAddr)
+	}
+	if cursor := data.AutoRestakeCursor; cursor != nil {
+		delegatorAddress := sdk.MustAccAddressFromBech32(cursor.DelegatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(cursor.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setAutoRestakeCursor(ctx, types.GetAutoRestakeEnrollmentKey(delegatorAddress, valAddr))
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -181,5 +197,25 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	enrollments := make([]types.AutoRestakeEnrollment, 0)
+	k.IterateAutoRestakeEnrollments(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			enrollments = append(enrollments, types.AutoRestakeEnrollment{
+				DelegatorAddress: del.String(),
+				ValidatorAddress: val.String(),
+			})
+			return false
+		},
+	)
+
+	var cursor *types.AutoRestakeEnrollment
+	if key := k.getAutoRestakeCursor(ctx); key != nil {
+		del, val := types.GetAutoRestakeEnrollmentAddresses(key)
+		cursor = &types.AutoRestakeEnrollment{
+			DelegatorAddress: del.String(),
+			ValidatorAddress: val.String(),
+		}
+	}
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, enrollments, cursor)
 }
@@ -246,3 +246,38 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// AutoRestakeEnrollments queries the delegations enrolled in the auto restake
+func (k Keeper) AutoRestakeEnrollments(c context.Context, req *types.QueryAutoRestakeEnrollmentsRequest) (*types.QueryAutoRestakeEnrollmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	keyPrefix := types.AutoRestakeEnrollmentPrefix
+	if req.DelegatorAddress != "" {
+		delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address")
+		}
+		keyPrefix = types.GetAutoRestakeEnrollmentsPrefix(delAddr)
+	}
+	enrollmentStore := prefix.NewStore(store, keyPrefix)
+
+	var enrollments []types.AutoRestakeEnrollment
+	pageRes, err := query.Paginate(enrollmentStore, req.Pagination, func(key []byte, _ []byte) error {
+		delAddr, valAddr := types.GetAutoRestakeEnrollmentAddresses(append(append([]byte{}, keyPrefix...), key...))
+		enrollments = append(enrollments, types.AutoRestakeEnrollment{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAutoRestakeEnrollmentsResponse{Enrollments: enrollments, Pagination: pageRes}, nil
+}
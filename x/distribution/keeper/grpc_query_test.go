@@ -652,6 +652,89 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCAutoRestakeEnrollments() {
+	app, ctx, queryClient, addrs, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+
+	for _, addr := range addrs {
+		suite.Require().NoError(app.DistrKeeper.SetAutoRestake(ctx, addr, valAddrs[0], true))
+	}
+
+	var allEnrollments []types.AutoRestakeEnrollment
+	app.DistrKeeper.IterateAutoRestakeEnrollments(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+		allEnrollments = append(allEnrollments, types.AutoRestakeEnrollment{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		return false
+	})
+	suite.Require().Len(allEnrollments, len(addrs))
+
+	var (
+		req            *types.QueryAutoRestakeEnrollmentsRequest
+		expEnrollments []types.AutoRestakeEnrollment
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid delegator address",
+			func() {
+				req = &types.QueryAutoRestakeEnrollmentsRequest{DelegatorAddress: "foo"}
+			},
+			false,
+		},
+		{
+			"all enrollments",
+			func() {
+				req = &types.QueryAutoRestakeEnrollmentsRequest{}
+				expEnrollments = allEnrollments
+			},
+			true,
+		},
+		{
+			"enrollments of a delegator",
+			func() {
+				req = &types.QueryAutoRestakeEnrollmentsRequest{DelegatorAddress: addrs[1].String()}
+				expEnrollments = []types.AutoRestakeEnrollment{
+					{DelegatorAddress: addrs[1].String(), ValidatorAddress: valAddrs[0].String()},
+				}
+			},
+			true,
+		},
+		{
+			"paginated request",
+			func() {
+				req = &types.QueryAutoRestakeEnrollmentsRequest{Pagination: &query.PageRequest{Limit: 1}}
+				expEnrollments = allEnrollments[:1]
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.AutoRestakeEnrollments(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expEnrollments, res.Enrollments)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -77,6 +77,12 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 	)
 
 	k.SetDelegatorWithdrawAddr(ctx, delegatorAddr, withdrawAddr)
+
+	// the rewards withdrawn to another address cannot be restaked
+	if !withdrawAddr.Equals(delegatorAddr) {
+		k.disableAutoRestakes(ctx, delegatorAddr)
+	}
+
 	return nil
 }
 
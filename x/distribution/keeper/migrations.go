@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/distribution/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -15,6 +16,10 @@ func NewMigrator(keeper Keeper) Migrator {
 }
 
 // Migrate1to2 migrates from version 1 to 2.
+// It sets the params of the auto restake to their defaults.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyAutoRestakeInterval, types.DefaultAutoRestakeInterval)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyAutoRestakeGasBudget, types.DefaultAutoRestakeGasBudget)
+
 	return nil
 }
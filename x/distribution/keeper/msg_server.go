@@ -143,3 +143,29 @@ func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCo
 
 	return &types.MsgFundCommunityPoolResponse{}, nil
 }
+
+func (k msgServer) SetAutoRestake(goCtx context.Context, msg *types.MsgSetAutoRestake) (*types.MsgSetAutoRestakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.SetAutoRestake(ctx, delegatorAddress, valAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgSetAutoRestakeResponse{}, nil
+}
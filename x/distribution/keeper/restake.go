@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/distribution/types"
+	stakingtypes "github.com/line/lbm-sdk/x/staking/types"
+)
+
+// SetAutoRestake enables or disables the auto restake of a delegation.
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	if enabled {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrEmptyDelegationDistInfo
+		}
+		if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+			return types.ErrAutoRestakeWithdrawAddr
+		}
+		k.setAutoRestakeEnrollment(ctx, delAddr, valAddr)
+	} else {
+		k.deleteAutoRestakeEnrollment(ctx, delAddr, valAddr)
+	}
+
+	emitSetAutoRestakeEvent(ctx, delAddr, valAddr, enabled)
+
+	return nil
+}
+
+// disableAutoRestakes disables the auto restake of all the delegations of the
+// delegator.
+func (k Keeper) disableAutoRestakes(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetAutoRestakeEnrollmentsPrefix(delAddr))
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		_, valAddr := types.GetAutoRestakeEnrollmentAddresses(key)
+		store.Delete(key)
+		emitSetAutoRestakeEvent(ctx, delAddr, valAddr, false)
+	}
+}
+
+func emitSetAutoRestakeEvent(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+}
+
+// IsAutoRestakeEnabled returns whether the auto restake of the delegation is enabled.
+func (k Keeper) IsAutoRestakeEnabled(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoRestakeEnrollmentKey(delAddr, valAddr))
+}
+
+func (k Keeper) setAutoRestakeEnrollment(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoRestakeEnrollmentKey(delAddr, valAddr), []byte{0x01})
+}
+
+func (k Keeper) deleteAutoRestakeEnrollment(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoRestakeEnrollmentKey(delAddr, valAddr))
+}
+
+// IterateAutoRestakeEnrollments iterates over the auto restake enrollments.
+func (k Keeper) IterateAutoRestakeEnrollments(ctx sdk.Context, handler func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoRestakeEnrollmentPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delAddr, valAddr := types.GetAutoRestakeEnrollmentAddresses(iter.Key())
+		if handler(delAddr, valAddr) {
+			break
+		}
+	}
+}
+
+// AutoRestake processes the auto restake enrollments. A round starts every
+// AutoRestakeInterval blocks, and each block processes the enrollments within
+// AutoRestakeGasBudget, resuming from where the previous block stopped.
+func (k Keeper) AutoRestake(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoRestakeInterval == 0 || params.AutoRestakeGasBudget == 0 {
+		return
+	}
+
+	cursor := k.getAutoRestakeCursor(ctx)
+	if cursor == nil {
+		if ctx.BlockHeight()%int64(params.AutoRestakeInterval) != 0 {
+			return
+		}
+		cursor = types.AutoRestakeEnrollmentPrefix
+	}
+
+	gasMeter := sdk.NewGasMeter(params.AutoRestakeGasBudget)
+	for {
+		key := k.nextAutoRestakeEnrollmentKey(ctx, cursor)
+		if key == nil {
+			// the round has finished
+			k.deleteAutoRestakeCursor(ctx)
+			return
+		}
+
+		delAddr, valAddr := types.GetAutoRestakeEnrollmentAddresses(key)
+		fullBudget := gasMeter.GasConsumed() == 0
+		if !k.tryAutoRestake(ctx, gasMeter, delAddr, valAddr) {
+			if fullBudget {
+				// it would never fit in the budget, so skip it
+				k.Logger(ctx).Error("auto restake exceeds the gas budget", "delegator", delAddr, "validator", valAddr)
+			} else {
+				// the budget has run out, so resume from this enrollment in the next block
+				k.setAutoRestakeCursor(ctx, key)
+				return
+			}
+		}
+
+		// the smallest key following the processed one
+		cursor = append(append([]byte{}, key...), 0x00)
+	}
+}
+
+// getAutoRestakeCursor returns the key of the enrollment the current round
+// resumes from, or nil if no round is in progress.
+func (k Keeper) getAutoRestakeCursor(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.AutoRestakeCursorKey)
+}
+
+func (k Keeper) setAutoRestakeCursor(ctx sdk.Context, key []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoRestakeCursorKey, key)
+}
+
+func (k Keeper) deleteAutoRestakeCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoRestakeCursorKey)
+}
+
+// nextAutoRestakeEnrollmentKey returns the first enrollment key from the given
+// key, or nil if there is none.
+func (k Keeper) nextAutoRestakeEnrollmentKey(ctx sdk.Context, start []byte) []byte {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoRestakeEnrollmentPrefix))
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+	return iter.Key()
+}
+
+// tryAutoRestake restakes the rewards of the delegation within the remaining
+// gas of the given meter. It returns false if the gas has run out, in which
+// case the state is left unchanged.
+func (k Keeper) tryAutoRestake(ctx sdk.Context, gasMeter sdk.GasMeter, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (ok bool) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasMeter.Limit() - gasMeter.GasConsumedToLimit()))
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(sdk.ErrorOutOfGas); !isOutOfGas {
+				panic(r)
+			}
+			// exhaust the budget of the block
+			gasMeter.ConsumeGas(gasMeter.Limit()-gasMeter.GasConsumedToLimit(), "auto restake")
+			ok = false
+		}
+	}()
+
+	amount, err := k.restake(cacheCtx, delAddr, valAddr)
+	gasMeter.ConsumeGas(cacheCtx.GasMeter().GasConsumed(), "auto restake")
+	if types.ErrAutoRestakeWithdrawAddr.Is(err) {
+		// the enrollment can never be processed, so drop it
+		k.deleteAutoRestakeEnrollment(ctx, delAddr, valAddr)
+		emitSetAutoRestakeEvent(ctx, delAddr, valAddr, false)
+		return true
+	}
+	if err != nil {
+		k.Logger(ctx).Error("failed to auto restake", "delegator", delAddr, "validator", valAddr, "err", err)
+		return true
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return true
+}
+
+// restake withdraws the rewards of the delegation and delegates the rewards
+// in the bond denom to the same validator.
+func (k Keeper) restake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	// the rewards must be withdrawn to the delegator, to be delegated
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return sdk.Coin{}, types.ErrAutoRestakeWithdrawAddr
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	if amount.IsZero() {
+		return amount, nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrNoValidatorExists, valAddr.String())
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(err, "delegate %s", amount)
+	}
+
+	return amount, nil
+}
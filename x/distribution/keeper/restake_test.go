@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/distribution/types"
+	"github.com/line/lbm-sdk/x/staking"
+	"github.com/line/lbm-sdk/x/staking/teststaking"
+	stakingtypes "github.com/line/lbm-sdk/x/staking/types"
+)
+
+func TestSetAutoRestake(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+	tstaking.Delegate(addrs[2], valAddrs[0], sdk.NewInt(100))
+
+	// no delegation
+	err := app.DistrKeeper.SetAutoRestake(ctx, addrs[1], valAddrs[1], true)
+	require.ErrorIs(t, err, types.ErrEmptyDelegationDistInfo)
+
+	// the rewards are withdrawn to another address
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[2], addrs[0]))
+	err = app.DistrKeeper.SetAutoRestake(ctx, addrs[2], valAddrs[0], true)
+	require.ErrorIs(t, err, types.ErrAutoRestakeWithdrawAddr)
+	require.False(t, app.DistrKeeper.IsAutoRestakeEnabled(ctx, addrs[2], valAddrs[0]))
+
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addrs[1], valAddrs[0], true))
+	require.True(t, app.DistrKeeper.IsAutoRestakeEnabled(ctx, addrs[1], valAddrs[0]))
+
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addrs[1], valAddrs[0], false))
+	require.False(t, app.DistrKeeper.IsAutoRestakeEnabled(ctx, addrs[1], valAddrs[0]))
+
+	// removing the delegation removes the enrollment
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addrs[1], valAddrs[0], true))
+	tstaking.Undelegate(addrs[1], valAddrs[0], sdk.NewInt(100), true)
+	require.False(t, app.DistrKeeper.IsAutoRestakeEnabled(ctx, addrs[1], valAddrs[0]))
+}
+
+func TestAutoRestake(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	delegators := addrs[1:]
+	for _, delAddr := range delegators {
+		tstaking.DelegateWithPower(delAddr, valAddrs[0], 100)
+		require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, delAddr, valAddrs[0], true))
+	}
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// fund the distribution module and allocate the rewards
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, distrAcc.GetName(), rewards))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(rewards...))
+
+	// measure the gas of a single restake
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	restaked, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, delegators[0], valAddrs[0])
+	require.NoError(t, err)
+	validator, found := app.StakingKeeper.GetValidator(cacheCtx, valAddrs[0])
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(cacheCtx, delegators[0], restaked.AmountOf(sdk.DefaultBondDenom), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	restakeGas := cacheCtx.GasMeter().GasConsumed()
+
+	// only a single restake fits in the budget of a block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoRestakeInterval = 10
+	params.AutoRestakeGasBudget = restakeGas * 3 / 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	shares := func(delAddr sdk.AccAddress) sdk.Dec {
+		return app.StakingKeeper.Delegation(ctx, delAddr, valAddrs[0]).GetShares()
+	}
+	initialShares := shares(delegators[0])
+
+	// not at the interval
+	ctx = ctx.WithBlockHeight(9)
+	app.DistrKeeper.AutoRestake(ctx)
+	for _, delAddr := range delegators {
+		require.Equal(t, initialShares, shares(delAddr))
+	}
+
+	// the round starts
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.AutoRestake(ctx)
+	require.True(t, shares(delegators[0]).GT(initialShares))
+	require.Equal(t, initialShares, shares(delegators[1]))
+
+	// the round resumes
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.AutoRestake(ctx)
+	for _, delAddr := range delegators {
+		require.True(t, shares(delAddr).GT(initialShares))
+	}
+
+	// the round has finished
+	afterShares := shares(delegators[0])
+	ctx = ctx.WithBlockHeight(12)
+	app.DistrKeeper.AutoRestake(ctx)
+	require.Equal(t, afterShares, shares(delegators[0]))
+}
+
+func TestSetWithdrawAddrDisablesAutoRestake(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+	for _, valAddr := range valAddrs[:2] {
+		tstaking.Delegate(addrs[2], valAddr, sdk.NewInt(100))
+		require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addrs[2], valAddr, true))
+	}
+
+	// resetting the withdraw address to the delegator keeps the enrollments
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[2], addrs[2]))
+	for _, valAddr := range valAddrs[:2] {
+		require.True(t, app.DistrKeeper.IsAutoRestakeEnabled(ctx, addrs[2], valAddr))
+	}
+
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[2], addrs[0]))
+	for _, valAddr := range valAddrs[:2] {
+		require.False(t, app.DistrKeeper.IsAutoRestakeEnabled(ctx, addrs[2], valAddr))
+	}
+}
+
+func TestAutoRestakeDropsStaleEnrollments(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addrs[1], valAddrs[0], true))
+
+	// the withdraw address changed without going through SetWithdrawAddr
+	app.DistrKeeper.SetDelegatorWithdrawAddr(ctx, addrs[1], addrs[0])
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoRestakeInterval = 1
+	params.AutoRestakeGasBudget = 1_000_000
+	app.DistrKeeper.SetParams(ctx, params)
+
+	app.DistrKeeper.AutoRestake(ctx.WithBlockHeight(1))
+	require.False(t, app.DistrKeeper.IsAutoRestakeEnabled(ctx, addrs[1], valAddrs[0]))
+}
+
+func TestAutoRestakeCursorGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	genState := app.DistrKeeper.ExportGenesis(ctx)
+	require.Nil(t, genState.AutoRestakeCursor)
+
+	genState.AutoRestakeCursor = &types.AutoRestakeEnrollment{
+		DelegatorAddress: addrs[0].String(),
+		ValidatorAddress: valAddrs[0].String(),
+	}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	newApp := simapp.Setup(false)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{})
+	newApp.DistrKeeper.InitGenesis(newCtx, *genState)
+	require.Equal(t, genState.AutoRestakeCursor, newApp.DistrKeeper.ExportGenesis(newCtx).AutoRestakeCursor)
+}
@@ -47,7 +47,7 @@ func TestProposalHandlerPassed(t *testing.T) {
 
 	tp := testProposal(recipient, amount)
 	hdlr := distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)
-	require.NoError(t, hdlr(ctx, tp))
+	require.NoError(t, hdlr(ctx, 1, tp))
 
 	balances = app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.Equal(t, balances, amount)
@@ -65,7 +65,7 @@ func TestProposalHandlerFailed(t *testing.T) {
 
 	tp := testProposal(recipient, amount)
 	hdlr := distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)
-	require.Error(t, hdlr(ctx, tp))
+	require.Error(t, hdlr(ctx, 1, tp))
 
 	balances := app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.True(t, balances.IsZero())
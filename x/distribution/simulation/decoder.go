@@ -63,6 +63,14 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.AutoRestakeEnrollmentPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoRestakeCursorKey):
+			delAddrA, valAddrA := types.GetAutoRestakeEnrollmentAddresses(kvA.Value)
+			delAddrB, valAddrB := types.GetAutoRestakeEnrollmentAddresses(kvB.Value)
+			return fmt.Sprintf("%v/%v\n%v/%v", delAddrA, valAddrA, delAddrB, valAddrB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
@@ -46,6 +46,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.GetAutoRestakeEnrollmentKey(delAddr1, valAddr1), Value: []byte{0x01}},
+			{Key: types.AutoRestakeCursorKey, Value: types.GetAutoRestakeEnrollmentKey(delAddr1, valAddr1)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -63,6 +65,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"AutoRestakeEnrollment", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"AutoRestakeCursor", fmt.Sprintf("%v/%v\n%v/%v", delAddr1, valAddr1, delAddr1, valAddr1)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -9,15 +9,18 @@ import (
 
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/module"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
 	"github.com/line/lbm-sdk/x/distribution/types"
 )
 
 // Simulation parameter constants
 const (
-	CommunityTax        = "community_tax"
-	BaseProposerReward  = "base_proposer_reward"
-	BonusProposerReward = "bonus_proposer_reward"
-	WithdrawEnabled     = "withdraw_enabled"
+	CommunityTax         = "community_tax"
+	BaseProposerReward   = "base_proposer_reward"
+	BonusProposerReward  = "bonus_proposer_reward"
+	WithdrawEnabled      = "withdraw_enabled"
+	AutoRestakeInterval  = "auto_restake_interval"
+	AutoRestakeGasBudget = "auto_restake_gas_budget"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +43,16 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoRestakeInterval returns a randomized AutoRestakeInterval parameter.
+func GenAutoRestakeInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 0, 100))
+}
+
+// GenAutoRestakeGasBudget returns a randomized AutoRestakeGasBudget parameter.
+func GenAutoRestakeGasBudget(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 100_000, 10_000_000))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,13 +79,27 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoRestakeInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoRestakeInterval, &autoRestakeInterval, simState.Rand,
+		func(r *rand.Rand) { autoRestakeInterval = GenAutoRestakeInterval(r) },
+	)
+
+	var autoRestakeGasBudget uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoRestakeGasBudget, &autoRestakeGasBudget, simState.Rand,
+		func(r *rand.Rand) { autoRestakeGasBudget = GenAutoRestakeGasBudget(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:         communityTax,
+			BaseProposerReward:   baseProposerReward,
+			BonusProposerReward:  bonusProposerReward,
+			WithdrawAddrEnabled:  withdrawEnabled,
+			AutoRestakeInterval:  autoRestakeInterval,
+			AutoRestakeGasBudget: autoRestakeGasBudget,
 		},
 	}
 
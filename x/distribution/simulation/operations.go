@@ -22,6 +22,7 @@ const (
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"    //nolint:gosec
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission" //nolint:gosec
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"           //nolint:gosec
+	OpWeightMsgSetAutoRestake              = "op_weight_msg_set_auto_restake"              //nolint:gosec
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -54,6 +55,13 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak ty
 		},
 	)
 
+	var weightMsgSetAutoRestake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAutoRestake, &weightMsgSetAutoRestake, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoRestake = simappparams.DefaultWeightMsgSetAutoRestake
+		},
+	)
+
 	stakeKeeper := sk.(stakingkeeper.Keeper)
 
 	return simulation.WeightedOperations{
@@ -73,6 +81,10 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak ty
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(ak, bk, k, stakeKeeper),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSetAutoRestake,
+			SimulateMsgSetAutoRestake(ak, bk, k, stakeKeeper),
+		),
 	}
 }
 
@@ -245,3 +257,45 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 		return simulation.GenAndDeliverTx(txCtx, fees)
 	}
 }
+
+// SimulateMsgSetAutoRestake generates a MsgSetAutoRestake with random values.
+func SimulateMsgSetAutoRestake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		delegations := sk.GetAllDelegatorDelegations(ctx, simAccount.Address)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoRestake, "number of delegators equal 0"), nil, nil
+		}
+
+		delegation := delegations[r.Intn(len(delegations))]
+
+		enabled := r.Intn(2) == 0
+		if enabled && !k.GetDelegatorWithdrawAddr(ctx, simAccount.Address).Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoRestake, "withdraw address is not the delegator"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgSetAutoRestake(simAccount.Address, delegation.GetValidatorAddr(), enabled)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -31,6 +31,13 @@ type Params struct {
 	BaseProposerReward  github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                              `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	// auto_restake_interval is the number of blocks between the auto restake
+	// rounds. Zero disables the auto restake.
+	AutoRestakeInterval uint64 `protobuf:"varint,5,opt,name=auto_restake_interval,json=autoRestakeInterval,proto3" json:"auto_restake_interval,omitempty" yaml:"auto_restake_interval"`
+	// auto_restake_gas_budget is the maximum gas consumed by the auto restake
+	// in a block. The remaining delegations are restaked in the following blocks.
+	// Zero disables the auto restake.
+	AutoRestakeGasBudget uint64 `protobuf:"varint,6,opt,name=auto_restake_gas_budget,json=autoRestakeGasBudget,proto3" json:"auto_restake_gas_budget,omitempty" yaml:"auto_restake_gas_budget"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -72,6 +79,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoRestakeInterval() uint64 {
+	if m != nil {
+		return m.AutoRestakeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoRestakeGasBudget() uint64 {
+	if m != nil {
+		return m.AutoRestakeGasBudget
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -606,6 +627,48 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoRestakeEnrollment represents a delegation whose rewards are restaked
+// automatically.
+type AutoRestakeEnrollment struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *AutoRestakeEnrollment) Reset()         { *m = AutoRestakeEnrollment{} }
+func (m *AutoRestakeEnrollment) String() string { return proto.CompactTextString(m) }
+func (*AutoRestakeEnrollment) ProtoMessage()    {}
+func (*AutoRestakeEnrollment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoRestakeEnrollment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRestakeEnrollment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRestakeEnrollment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRestakeEnrollment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRestakeEnrollment.Merge(m, src)
+}
+func (m *AutoRestakeEnrollment) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRestakeEnrollment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRestakeEnrollment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRestakeEnrollment proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -619,6 +682,7 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoRestakeEnrollment)(nil), "cosmos.distribution.v1beta1.AutoRestakeEnrollment")
 }
 
 func init() {
@@ -626,76 +690,83 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1099 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x1c, 0xf5, 0x26, 0x8e, 0x93, 0x4e, 0xf3, 0xaf, 0x13, 0x27, 0x71, 0x93, 0xe0, 0x0d, 0x83, 0x5a,
-	0xa5, 0xa2, 0xb5, 0x69, 0xb9, 0x40, 0x4e, 0xc4, 0x4e, 0x4a, 0xc3, 0xa5, 0xd1, 0xb6, 0x02, 0x89,
-	0x03, 0xd6, 0x78, 0x77, 0x62, 0x8f, 0xba, 0xbb, 0xb3, 0x9a, 0x19, 0x3b, 0x09, 0x12, 0x77, 0x84,
-	0x00, 0x71, 0xa3, 0xdc, 0x72, 0x40, 0x08, 0x21, 0xbe, 0x07, 0x3d, 0xf6, 0x84, 0x10, 0x87, 0xa5,
-	0x4a, 0x2e, 0x88, 0xa3, 0xc5, 0x07, 0x40, 0xbb, 0x33, 0xbb, 0x6b, 0xbb, 0x6e, 0x89, 0x11, 0xbd,
-	0x79, 0xdf, 0xfc, 0xe6, 0xf7, 0xe7, 0xcd, 0xf3, 0x9b, 0x01, 0x15, 0x9b, 0x09, 0x8f, 0x89, 0xaa,
-	0x43, 0x85, 0xe4, 0xb4, 0xd9, 0x91, 0x94, 0xf9, 0xd5, 0xee, 0xed, 0x26, 0x91, 0xf8, 0xf6, 0x00,
-	0x58, 0x09, 0x38, 0x93, 0x0c, 0xae, 0xab, 0xf8, 0xca, 0xc0, 0x92, 0x8e, 0x5f, 0x2b, 0xb6, 0x58,
-	0x8b, 0xc5, 0x71, 0xd5, 0xe8, 0x97, 0xda, 0xb2, 0x56, 0xd6, 0x25, 0x9a, 0x58, 0x90, 0x34, 0xb5,
-	0xcd, 0xa8, 0x4e, 0x89, 0x7e, 0x99, 0x04, 0x85, 0x03, 0xcc, 0xb1, 0x27, 0xe0, 0x21, 0x98, 0xb3,
-	0x99, 0xe7, 0x75, 0x7c, 0x2a, 0x4f, 0x1a, 0x12, 0x1f, 0x97, 0x8c, 0x4d, 0x63, 0xeb, 0x52, 0x6d,
-	0xe7, 0x49, 0x68, 0xe6, 0x7e, 0x0f, 0xcd, 0xd7, 0x5b, 0x54, 0xb6, 0x3b, 0xcd, 0x8a, 0xcd, 0xbc,
-	0xaa, 0x4b, 0x7d, 0x52, 0x75, 0x9b, 0xde, 0x2d, 0xe1, 0x3c, 0xaa, 0xca, 0x93, 0x80, 0x88, 0xca,
-	0x2e, 0xb1, 0x7b, 0xa1, 0x59, 0x3c, 0xc1, 0x9e, 0xbb, 0x8d, 0x06, 0xf2, 0x20, 0x6b, 0x36, 0xfd,
-	0x7e, 0x88, 0x8f, 0xe1, 0xa7, 0xa0, 0x18, 0x75, 0xd3, 0x08, 0x38, 0x0b, 0x98, 0x20, 0xbc, 0xc1,
-	0xc9, 0x11, 0xe6, 0x4e, 0x69, 0x22, 0x2e, 0x77, 0x6f, 0x9c, 0x72, 0xeb, 0xaa, 0xdc, 0xa8, 0x74,
-	0xc8, 0x82, 0x11, 0x7c, 0xa0, 0x51, 0x2b, 0x06, 0xe1, 0x67, 0x60, 0xb9, 0xc9, 0xfc, 0x8e, 0x78,
-	0xae, 0xf8, 0x64, 0x5c, 0x7c, 0x7f, 0x9c, 0xe2, 0x1b, 0xba, 0xf8, 0xa8, 0x7c, 0xc8, 0x5a, 0x8a,
-	0xf1, 0xa1, 0xf2, 0x0f, 0xc1, 0xf2, 0x11, 0x95, 0x6d, 0x87, 0xe3, 0xa3, 0x06, 0x76, 0x1c, 0xde,
-	0x20, 0x3e, 0x6e, 0xba, 0xc4, 0x29, 0xe5, 0x37, 0x8d, 0xad, 0x99, 0xda, 0x66, 0x96, 0x75, 0x64,
-	0x18, 0xb2, 0x96, 0x12, 0x7c, 0xc7, 0x71, 0xf8, 0x9e, 0x42, 0xb7, 0xf3, 0x8f, 0x4f, 0xcd, 0x1c,
-	0xfa, 0x62, 0x02, 0xac, 0x7d, 0x88, 0x5d, 0xea, 0x60, 0xc9, 0xf8, 0x3d, 0x2a, 0x24, 0xe3, 0xd4,
-	0xc6, 0xae, 0xaa, 0x2c, 0xe0, 0x0f, 0x06, 0x58, 0xb5, 0x3b, 0x5e, 0xc7, 0xc5, 0x92, 0x76, 0x89,
-	0x6e, 0xb3, 0xc1, 0xb1, 0xa4, 0xac, 0x64, 0x6c, 0x4e, 0x6e, 0x5d, 0xbe, 0xb3, 0xa1, 0xe5, 0x58,
-	0x89, 0x78, 0x4b, 0x64, 0x15, 0xcd, 0x5a, 0x67, 0xd4, 0xaf, 0x1d, 0x44, 0xd4, 0xf4, 0x42, 0xb3,
-	0xac, 0x4f, 0x78, 0x74, 0x2a, 0xf4, 0xd3, 0x1f, 0xe6, 0xf5, 0x7f, 0x25, 0x2f, 0x4a, 0x28, 0xac,
-	0xe5, 0x2c, 0x87, 0x6a, 0xd2, 0x8a, 0x32, 0xc0, 0x3a, 0x58, 0xe0, 0xe4, 0x90, 0x70, 0xe2, 0xdb,
-	0xa4, 0x61, 0xb3, 0x8e, 0x2f, 0x63, 0x65, 0xcc, 0xd5, 0xd6, 0x7a, 0xa1, 0xb9, 0xa2, 0xaa, 0x0f,
-	0x05, 0x20, 0x6b, 0x3e, 0x45, 0xea, 0x31, 0xf0, 0x9d, 0x01, 0x56, 0x53, 0x32, 0xea, 0x1d, 0xce,
-	0x89, 0x2f, 0x13, 0x26, 0x0e, 0xc1, 0xb4, 0x6a, 0x59, 0x5c, 0x68, 0xf0, 0x4a, 0x34, 0xf8, 0x18,
-	0x63, 0x25, 0xc9, 0xe1, 0x0a, 0x28, 0x04, 0x84, 0x53, 0xa6, 0x94, 0x9d, 0xb7, 0xf4, 0x17, 0xfa,
-	0xda, 0x00, 0xe5, 0xb4, 0xb7, 0x1d, 0x5b, 0xb3, 0x40, 0x9c, 0x3a, 0xf3, 0x3c, 0x2a, 0x04, 0x65,
-	0x3e, 0x74, 0x01, 0xb0, 0xd3, 0xaf, 0x57, 0xd2, 0x65, 0x5f, 0x7e, 0xf4, 0xad, 0x01, 0xd6, 0xd3,
-	0x86, 0xee, 0x77, 0xa4, 0x90, 0xd8, 0x77, 0xa8, 0xdf, 0x4a, 0x08, 0x3b, 0x1e, 0x8f, 0xb0, 0xf7,
-	0xb4, 0x52, 0xe6, 0x93, 0xb3, 0x8a, 0xb7, 0xa2, 0xff, 0x40, 0x21, 0xfa, 0xde, 0x00, 0x4b, 0x69,
-	0x67, 0x0f, 0x5c, 0x2c, 0xda, 0x7b, 0x5d, 0xe2, 0x4b, 0x78, 0x17, 0x2c, 0x76, 0x13, 0xb8, 0xa1,
-	0x49, 0x8e, 0xdc, 0x2a, 0x5f, 0x5b, 0xef, 0x85, 0xe6, 0xaa, 0x2a, 0x3c, 0x1c, 0x81, 0xac, 0x85,
-	0x14, 0x3a, 0x88, 0x11, 0xb8, 0x07, 0x66, 0x0e, 0x39, 0xb6, 0x23, 0x1f, 0xd5, 0xf6, 0x73, 0xe3,
-	0xc2, 0x0e, 0x60, 0xa5, 0x5b, 0xd1, 0xcf, 0x06, 0x28, 0x8e, 0x68, 0x53, 0xc0, 0xaf, 0x0c, 0xb0,
-	0x92, 0xb5, 0x21, 0xa2, 0x95, 0x06, 0x89, 0x97, 0x34, 0x93, 0x6f, 0x55, 0x5e, 0x62, 0xe9, 0x95,
-	0x11, 0x39, 0x6b, 0xd7, 0x34, 0xbb, 0xaf, 0x0d, 0x0f, 0xd9, 0x9f, 0x1d, 0x59, 0xc5, 0xee, 0x88,
-	0x7e, 0xb4, 0x53, 0x3c, 0x36, 0xc0, 0xf4, 0x5d, 0x42, 0x0e, 0x18, 0x73, 0xe1, 0x97, 0x06, 0x98,
-	0xcf, 0xdc, 0x3a, 0x60, 0xcc, 0xbd, 0xd0, 0x19, 0xbf, 0xaf, 0xbb, 0x58, 0x1e, 0xf6, 0xfb, 0x28,
-	0xc3, 0x38, 0x47, 0x9d, 0x5d, 0x39, 0x51, 0x3b, 0xe8, 0x99, 0x01, 0xd6, 0xea, 0xfd, 0xc8, 0x83,
-	0x80, 0xf8, 0x8e, 0x72, 0x51, 0xec, 0xc2, 0x22, 0x98, 0x92, 0x54, 0xba, 0x44, 0x5d, 0x4d, 0x96,
-	0xfa, 0x80, 0x9b, 0xe0, 0xb2, 0x43, 0x84, 0xcd, 0x69, 0x90, 0x1d, 0xa4, 0xd5, 0x0f, 0xc1, 0x0d,
-	0x70, 0x89, 0x13, 0x9b, 0x06, 0x94, 0xf8, 0x52, 0x59, 0xbd, 0x95, 0x01, 0xf0, 0x13, 0x50, 0xc0,
-	0x5e, 0x6c, 0x34, 0xf9, 0x78, 0xf4, 0xab, 0x23, 0x47, 0x8f, 0xe7, 0x7e, 0x53, 0xff, 0xcd, 0xde,
-	0x78, 0xf9, 0x78, 0x6a, 0x36, 0x9d, 0x75, 0x7b, 0xf6, 0xf3, 0x53, 0x33, 0x17, 0x31, 0xff, 0x67,
-	0xc4, 0xfe, 0xdf, 0x06, 0x58, 0xde, 0x25, 0x2e, 0x69, 0xc5, 0x87, 0x23, 0x31, 0x97, 0xd4, 0x6f,
-	0xed, 0xfb, 0x87, 0xb1, 0xf3, 0x05, 0x9c, 0x74, 0x29, 0x8b, 0xee, 0x93, 0x7e, 0x51, 0xf7, 0x39,
-	0xdf, 0x50, 0x00, 0xb2, 0xe6, 0x13, 0x44, 0x4b, 0xfa, 0x3e, 0x98, 0x12, 0x12, 0x3f, 0x22, 0x5a,
-	0xcf, 0xef, 0x8e, 0x73, 0xa3, 0xcd, 0xaa, 0x1a, 0xf1, 0x7e, 0x64, 0xa9, 0x3c, 0x70, 0x0f, 0x14,
-	0xda, 0x84, 0xb6, 0xda, 0x8a, 0xb8, 0x7c, 0xed, 0xd6, 0x5f, 0xa1, 0xb9, 0x60, 0x73, 0x12, 0x99,
-	0xb5, 0xdf, 0x50, 0x4b, 0x59, 0x7f, 0x43, 0x0b, 0xc8, 0xd2, 0x9b, 0xd1, 0xaf, 0x06, 0xb8, 0xaa,
-	0xc7, 0xa6, 0xcc, 0x4f, 0x09, 0xd0, 0x17, 0xe3, 0x3e, 0xb8, 0x92, 0x29, 0x39, 0xba, 0xf2, 0x88,
-	0x10, 0xfa, 0xfd, 0xb1, 0xd1, 0x0b, 0xcd, 0xd2, 0xb0, 0xd8, 0x75, 0x08, 0xb2, 0x32, 0x1f, 0xd8,
-	0x51, 0x10, 0x74, 0x40, 0x21, 0x7d, 0x50, 0xfc, 0xff, 0xbe, 0xa9, 0x73, 0x6f, 0xcf, 0xe8, 0x33,
-	0x35, 0xd0, 0xe9, 0x04, 0xb8, 0xf6, 0x62, 0xc9, 0x7e, 0x44, 0x65, 0x7b, 0x97, 0x04, 0x4c, 0x50,
-	0x09, 0xaf, 0x0f, 0xa8, 0xb7, 0xb6, 0x98, 0x31, 0x1e, 0xc3, 0x28, 0xd1, 0xf3, 0x3b, 0x23, 0xf4,
-	0x5c, 0x5b, 0xe9, 0x85, 0x26, 0x54, 0xd1, 0x7d, 0x8b, 0x68, 0x50, 0xe7, 0x77, 0x9e, 0xd3, 0x79,
-	0xad, 0xd8, 0x0b, 0xcd, 0xc5, 0xc4, 0x89, 0xf5, 0x12, 0xea, 0x57, 0xff, 0x8d, 0x3e, 0xf5, 0x47,
-	0x1b, 0xae, 0xf4, 0x42, 0x73, 0x4e, 0x6d, 0x50, 0x38, 0x4a, 0x84, 0x0c, 0x6f, 0x82, 0x69, 0x47,
-	0xcd, 0x52, 0x9a, 0x8a, 0x63, 0x61, 0x66, 0xf3, 0x7a, 0x01, 0x59, 0x49, 0x48, 0x46, 0x51, 0xed,
-	0x83, 0x1f, 0xcf, 0xca, 0xc6, 0x93, 0xb3, 0xb2, 0xf1, 0xf4, 0xac, 0x6c, 0x3c, 0x3b, 0x2b, 0x1b,
-	0xdf, 0x9c, 0x97, 0x73, 0x4f, 0xcf, 0xcb, 0xb9, 0xdf, 0xce, 0xcb, 0xb9, 0x8f, 0x6f, 0xbe, 0x88,
-	0xfa, 0xe3, 0xc1, 0x87, 0x71, 0x7c, 0x12, 0xcd, 0x42, 0xfc, 0x6e, 0x7d, 0xfb, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x9a, 0x5e, 0x31, 0x37, 0x3c, 0x0b, 0x00, 0x00,
+	// 1207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xa4, 0xae, 0xdb, 0x4e, 0xdb, 0x34, 0x9d, 0x38, 0x89, 0x9b, 0xe4, 0xe7, 0xcd, 0x6f,
+	0x50, 0xab, 0x54, 0xb4, 0x0e, 0x2d, 0x17, 0xc8, 0x89, 0x38, 0x4d, 0xdb, 0x70, 0x69, 0x34, 0xad,
+	0x40, 0x70, 0x60, 0x35, 0xde, 0x9d, 0x38, 0xa3, 0xae, 0x77, 0xac, 0x99, 0xb1, 0x9b, 0x22, 0x71,
+	0x47, 0x08, 0x10, 0x37, 0xca, 0xad, 0x07, 0x84, 0x10, 0xe2, 0xce, 0xbf, 0xd0, 0x63, 0x4f, 0x08,
+	0x71, 0x30, 0x55, 0x7a, 0x41, 0x1c, 0x2d, 0x0e, 0x1c, 0xd1, 0xce, 0xcc, 0x7e, 0xd8, 0x75, 0x4a,
+	0x52, 0xc1, 0xcd, 0xfb, 0xcc, 0x3b, 0xcf, 0xfb, 0xf5, 0xec, 0xfb, 0xae, 0x61, 0x3d, 0x10, 0xaa,
+	0x2d, 0xd4, 0x6a, 0xc8, 0x95, 0x96, 0xbc, 0xd9, 0xd5, 0x5c, 0xc4, 0xab, 0xbd, 0x6b, 0x4d, 0xa6,
+	0xe9, 0xb5, 0x21, 0xb0, 0xde, 0x91, 0x42, 0x0b, 0xb4, 0x68, 0xed, 0xeb, 0x43, 0x47, 0xce, 0x7e,
+	0xa1, 0xd2, 0x12, 0x2d, 0x61, 0xec, 0x56, 0x93, 0x5f, 0xf6, 0xca, 0x42, 0xcd, 0xb9, 0x68, 0x52,
+	0xc5, 0x32, 0xea, 0x40, 0x70, 0x47, 0x89, 0xff, 0x2a, 0xc1, 0xf2, 0x36, 0x95, 0xb4, 0xad, 0xd0,
+	0x0e, 0x3c, 0x1b, 0x88, 0x76, 0xbb, 0x1b, 0x73, 0xfd, 0xd0, 0xd7, 0x74, 0xaf, 0x0a, 0x96, 0xc1,
+	0xca, 0xa9, 0xc6, 0xfa, 0x93, 0xbe, 0x37, 0xf1, 0x6b, 0xdf, 0xfb, 0x7f, 0x8b, 0xeb, 0xdd, 0x6e,
+	0xb3, 0x1e, 0x88, 0xf6, 0x6a, 0xc4, 0x63, 0xb6, 0x1a, 0x35, 0xdb, 0x57, 0x55, 0x78, 0x7f, 0x55,
+	0x3f, 0xec, 0x30, 0x55, 0xbf, 0xc1, 0x82, 0x41, 0xdf, 0xab, 0x3c, 0xa4, 0xed, 0x68, 0x0d, 0x0f,
+	0xf1, 0x60, 0x72, 0x26, 0x7b, 0xbe, 0x47, 0xf7, 0xd0, 0xc7, 0xb0, 0x92, 0x44, 0xe3, 0x77, 0xa4,
+	0xe8, 0x08, 0xc5, 0xa4, 0x2f, 0xd9, 0x03, 0x2a, 0xc3, 0xea, 0xa4, 0x71, 0x77, 0xfb, 0x28, 0xee,
+	0x16, 0xad, 0xbb, 0x71, 0x74, 0x98, 0xa0, 0x04, 0xde, 0x76, 0x28, 0x31, 0x20, 0xfa, 0x04, 0xce,
+	0x36, 0x45, 0xdc, 0x55, 0x2f, 0x38, 0x3f, 0x66, 0x9c, 0x6f, 0x1d, 0xc5, 0xf9, 0x92, 0x73, 0x3e,
+	0x8e, 0x0f, 0x93, 0x19, 0x83, 0x8f, 0xb8, 0xbf, 0x07, 0x67, 0x1f, 0x70, 0xbd, 0x1b, 0x4a, 0xfa,
+	0xc0, 0xa7, 0x61, 0x28, 0x7d, 0x16, 0xd3, 0x66, 0xc4, 0xc2, 0x6a, 0x69, 0x19, 0xac, 0x9c, 0x6c,
+	0x2c, 0xe7, 0xac, 0x63, 0xcd, 0x30, 0x99, 0x49, 0xf1, 0xf5, 0x30, 0x94, 0x9b, 0x16, 0x4d, 0x58,
+	0x69, 0x57, 0x0b, 0x5f, 0x32, 0xa5, 0xe9, 0x7d, 0xe6, 0xf3, 0x58, 0x33, 0xd9, 0xa3, 0x51, 0xf5,
+	0xf8, 0x32, 0x58, 0x29, 0x15, 0x59, 0xc7, 0x9a, 0x61, 0x32, 0x93, 0xe0, 0xc4, 0xc2, 0x5b, 0x0e,
+	0x45, 0x1f, 0xc0, 0xf9, 0x21, 0xf3, 0x16, 0x55, 0x7e, 0xb3, 0x1b, 0xb6, 0x98, 0xae, 0x96, 0x0d,
+	0x2f, 0x1e, 0xf4, 0xbd, 0xda, 0x18, 0xde, 0xdc, 0x10, 0x93, 0x4a, 0x81, 0xf9, 0x16, 0x55, 0x0d,
+	0x03, 0xaf, 0x95, 0x1e, 0x3d, 0xf6, 0x26, 0xf0, 0x67, 0x93, 0x70, 0xe1, 0x3d, 0x1a, 0xf1, 0x90,
+	0x6a, 0x21, 0x6f, 0x73, 0xa5, 0x85, 0xe4, 0x01, 0x8d, 0x6c, 0xa9, 0x14, 0xfa, 0x0e, 0xc0, 0xf9,
+	0xa0, 0xdb, 0xee, 0x46, 0x54, 0xf3, 0x1e, 0x73, 0x75, 0xf5, 0x25, 0xd5, 0x5c, 0x54, 0xc1, 0xf2,
+	0xb1, 0x95, 0xd3, 0xd7, 0x97, 0xdc, 0xfb, 0x53, 0x4f, 0x1a, 0x9d, 0xbe, 0x07, 0x49, 0x73, 0x36,
+	0x04, 0x8f, 0x1b, 0xdb, 0x49, 0x2f, 0xf3, 0x10, 0x0f, 0xa0, 0xc2, 0x3f, 0xfc, 0xe6, 0x5d, 0xfa,
+	0xc7, 0x6e, 0x27, 0x84, 0x8a, 0xcc, 0xe6, 0x1c, 0x36, 0x48, 0x92, 0x30, 0xa0, 0x0d, 0x78, 0x4e,
+	0xb2, 0x1d, 0x26, 0x59, 0x1c, 0x30, 0x3f, 0x10, 0xdd, 0x58, 0x1b, 0x29, 0x9f, 0x6d, 0x2c, 0x0c,
+	0xfa, 0xde, 0x9c, 0xf5, 0x3e, 0x62, 0x80, 0xc9, 0x54, 0x86, 0x6c, 0x18, 0xe0, 0x1b, 0x00, 0xe7,
+	0xb3, 0x62, 0x6c, 0x74, 0xa5, 0x64, 0xb1, 0x4e, 0x2b, 0xb1, 0x03, 0x4f, 0xd8, 0x90, 0xd5, 0xa1,
+	0x12, 0xaf, 0x27, 0x89, 0x1f, 0x21, 0xad, 0x94, 0x1c, 0xcd, 0xc1, 0x72, 0x87, 0x49, 0x2e, 0xec,
+	0xab, 0x58, 0x22, 0xee, 0x09, 0x7f, 0x09, 0x60, 0x2d, 0x8b, 0x6d, 0x3d, 0x70, 0x55, 0x60, 0xe1,
+	0x86, 0x68, 0xb7, 0xb9, 0x52, 0x5c, 0xc4, 0x28, 0x82, 0x30, 0xc8, 0x9e, 0xfe, 0x93, 0x28, 0x0b,
+	0xfc, 0xf8, 0x6b, 0x00, 0x17, 0xb3, 0x80, 0xee, 0x74, 0xb5, 0xd2, 0x34, 0x0e, 0x79, 0xdc, 0x4a,
+	0x0b, 0xb6, 0x77, 0xb4, 0x82, 0xbd, 0xe3, 0x94, 0x32, 0x95, 0xf6, 0xca, 0x5c, 0xc5, 0xaf, 0x50,
+	0x42, 0xfc, 0x2d, 0x80, 0x33, 0x59, 0x64, 0x77, 0x23, 0xaa, 0x76, 0x37, 0x7b, 0x2c, 0xd6, 0xe8,
+	0x26, 0x9c, 0xee, 0xa5, 0xb0, 0xef, 0x8a, 0x0c, 0xcc, 0x5b, 0xb4, 0x38, 0xe8, 0x7b, 0xf3, 0xd6,
+	0xf1, 0xa8, 0x05, 0x26, 0xe7, 0x32, 0x68, 0xdb, 0x20, 0x68, 0x13, 0x9e, 0xdc, 0x91, 0x34, 0x48,
+	0x06, 0xbf, 0x9b, 0x97, 0x97, 0x0f, 0x3d, 0xb2, 0x48, 0x76, 0x15, 0xff, 0x08, 0x60, 0x65, 0x4c,
+	0x98, 0x0a, 0x7d, 0x01, 0xe0, 0x5c, 0x1e, 0x86, 0x4a, 0x4e, 0x7c, 0x66, 0x8e, 0x5c, 0x25, 0xdf,
+	0xa8, 0xbf, 0x64, 0x07, 0xd5, 0xc7, 0x70, 0x36, 0x2e, 0xba, 0xea, 0xfe, 0x6f, 0x34, 0xc9, 0x22,
+	0x3b, 0x26, 0x95, 0xde, 0x98, 0x78, 0xdc, 0xa4, 0x78, 0x04, 0xe0, 0x89, 0x9b, 0x8c, 0x6d, 0x0b,
+	0x11, 0xa1, 0xcf, 0x01, 0x9c, 0xca, 0xd7, 0x4b, 0x47, 0x88, 0xe8, 0x50, 0x3d, 0xbe, 0xe5, 0xa2,
+	0x98, 0x1d, 0x5d, 0x50, 0x09, 0xc3, 0x51, 0x5a, 0x9d, 0xef, 0xc8, 0x24, 0x1c, 0xfc, 0x0c, 0xc0,
+	0x85, 0x8d, 0x22, 0x72, 0xb7, 0xc3, 0xe2, 0xd0, 0x8e, 0x7d, 0x1a, 0xa1, 0x0a, 0x3c, 0xae, 0xb9,
+	0x8e, 0x98, 0xdd, 0xa5, 0xc4, 0x3e, 0xa0, 0x65, 0x78, 0x3a, 0x64, 0x2a, 0x90, 0xbc, 0x93, 0x37,
+	0x92, 0x14, 0x21, 0xb4, 0x04, 0x4f, 0x49, 0x16, 0xf0, 0x0e, 0x67, 0xb1, 0xb6, 0xbb, 0x89, 0xe4,
+	0x00, 0xfa, 0x08, 0x96, 0x69, 0xdb, 0x0c, 0x9a, 0x92, 0x49, 0xfd, 0xc2, 0xd8, 0xd4, 0x4d, 0xde,
+	0xaf, 0xbb, 0xd7, 0xec, 0xb5, 0x97, 0xa7, 0x67, 0x73, 0x73, 0xac, 0x6b, 0x67, 0x3e, 0x7d, 0xec,
+	0x4d, 0x24, 0x95, 0xff, 0x3d, 0xa9, 0xfe, 0x9f, 0x00, 0xce, 0xde, 0x60, 0x11, 0x6b, 0x99, 0xe6,
+	0x68, 0x2a, 0x35, 0x8f, 0x5b, 0x5b, 0xf1, 0x8e, 0x99, 0x7c, 0x1d, 0xc9, 0x7a, 0x5c, 0x24, 0x0b,
+	0xb0, 0x28, 0xea, 0xc2, 0xe4, 0x1b, 0x31, 0xc0, 0x64, 0x2a, 0x45, 0x9c, 0xa4, 0xef, 0xc0, 0xe3,
+	0x66, 0x3d, 0x38, 0x3d, 0xbf, 0x7d, 0x94, 0x15, 0x7c, 0xc6, 0xfa, 0x30, 0xf7, 0x31, 0xb1, 0x3c,
+	0x68, 0x13, 0x96, 0x77, 0x19, 0x6f, 0xed, 0xda, 0xc2, 0x95, 0x1a, 0x57, 0xff, 0xe8, 0x7b, 0xe7,
+	0x02, 0xc9, 0x68, 0x52, 0x59, 0xdf, 0x1e, 0xe5, 0xf1, 0x8d, 0x1c, 0x60, 0xe2, 0x2e, 0xe3, 0x9f,
+	0x01, 0xbc, 0xe0, 0xd2, 0xe6, 0x22, 0xce, 0x0a, 0xe0, 0x36, 0xf9, 0x16, 0x3c, 0x9f, 0x2b, 0x39,
+	0xd9, 0xd1, 0x4c, 0x29, 0xf7, 0xc1, 0xb4, 0x34, 0xe8, 0x7b, 0xd5, 0x51, 0xb1, 0x3b, 0x13, 0x4c,
+	0xf2, 0x39, 0xb0, 0x6e, 0x21, 0x14, 0xc2, 0x72, 0xf6, 0x05, 0xf4, 0xef, 0xcf, 0x4d, 0xc7, 0xbd,
+	0x76, 0xd2, 0xf5, 0x14, 0xe0, 0xc7, 0x93, 0xf0, 0xe2, 0xc1, 0x92, 0x7d, 0x9f, 0xeb, 0xdd, 0x1b,
+	0xac, 0x23, 0x14, 0xd7, 0xe8, 0xd2, 0x90, 0x7a, 0x1b, 0xd3, 0x79, 0xc5, 0x0d, 0x8c, 0x53, 0x3d,
+	0xbf, 0x35, 0x46, 0xcf, 0x8d, 0xb9, 0x41, 0xdf, 0x43, 0xd6, 0xba, 0x70, 0x88, 0x87, 0x75, 0x7e,
+	0xfd, 0x05, 0x9d, 0x37, 0x2a, 0x83, 0xbe, 0x37, 0x9d, 0x4e, 0x62, 0x77, 0x84, 0x8b, 0xea, 0xbf,
+	0x5c, 0x50, 0x7f, 0x72, 0xe1, 0xfc, 0xa0, 0xef, 0x9d, 0xb5, 0x17, 0x2c, 0x8e, 0x53, 0x21, 0xa3,
+	0x2b, 0xf0, 0x44, 0x68, 0x73, 0x31, 0xdf, 0x42, 0xa7, 0x1a, 0x28, 0x1f, 0xf3, 0xee, 0x00, 0x93,
+	0xd4, 0xa4, 0x50, 0xa2, 0x9f, 0x00, 0x9c, 0x5d, 0xcf, 0xbf, 0x5c, 0x36, 0x63, 0x29, 0xa2, 0xa8,
+	0x9d, 0x38, 0xdf, 0x82, 0xe7, 0xc3, 0x54, 0x0a, 0x07, 0xf7, 0xfd, 0x05, 0x13, 0x4c, 0xa6, 0x33,
+	0x2c, 0xed, 0xfb, 0x58, 0x09, 0x4d, 0xbe, 0x8a, 0x84, 0x6c, 0xe4, 0xc9, 0xcb, 0xda, 0x78, 0xf7,
+	0xfb, 0xfd, 0x1a, 0x78, 0xb2, 0x5f, 0x03, 0x4f, 0xf7, 0x6b, 0xe0, 0xd9, 0x7e, 0x0d, 0x7c, 0xf5,
+	0xbc, 0x36, 0xf1, 0xf4, 0x79, 0x6d, 0xe2, 0x97, 0xe7, 0xb5, 0x89, 0x0f, 0xaf, 0x1c, 0x24, 0x9a,
+	0xbd, 0xe1, 0xff, 0x20, 0x46, 0x43, 0xcd, 0xb2, 0xf9, 0x8b, 0xf0, 0xe6, 0xdf, 0x03, 0x00, 0x66,
+	0xc3, 0x11, 0x61, 0xa7, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -729,6 +800,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoRestakeInterval != that1.AutoRestakeInterval {
+		return false
+	}
+	if this.AutoRestakeGasBudget != that1.AutoRestakeGasBudget {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1056,6 +1133,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRestakeGasBudget != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoRestakeGasBudget))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoRestakeInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoRestakeInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1572,6 +1659,43 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoRestakeEnrollment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRestakeEnrollment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRestakeEnrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1598,6 +1722,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoRestakeInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoRestakeInterval))
+	}
+	if m.AutoRestakeGasBudget != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoRestakeGasBudget))
+	}
 	return n
 }
 
@@ -1803,6 +1933,23 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *AutoRestakeEnrollment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1960,6 +2107,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeInterval", wireType)
+			}
+			m.AutoRestakeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRestakeInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeGasBudget", wireType)
+			}
+			m.AutoRestakeGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRestakeGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3254,6 +3439,120 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoRestakeEnrollment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRestakeEnrollment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRestakeEnrollment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,4 +18,5 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrAutoRestakeWithdrawAddr = sdkerrors.Register(ModuleName, 14, "auto restake requires the withdraw address to be the delegator address")
 )
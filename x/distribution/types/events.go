@@ -8,9 +8,13 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoRestake     = "set_auto_restake"
+	EventTypeAutoRestake        = "auto_restake"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
 
 	AttributeValueCategory = ModuleName
 )
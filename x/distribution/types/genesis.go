@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/line/lbm-sdk/types"
 )
 
@@ -9,6 +11,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	enrollments []AutoRestakeEnrollment, cursor *AutoRestakeEnrollment,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -21,6 +24,8 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoRestakeEnrollments:          enrollments,
+		AutoRestakeCursor:               cursor,
 	}
 }
 
@@ -37,6 +42,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoRestakeEnrollments:          []AutoRestakeEnrollment{},
 	}
 }
 
@@ -45,5 +51,31 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seen := map[string]bool{}
+	for _, enrollment := range gs.AutoRestakeEnrollments {
+		if _, err := sdk.AccAddressFromBech32(enrollment.DelegatorAddress); err != nil {
+			return err
+		}
+		if _, err := sdk.ValAddressFromBech32(enrollment.ValidatorAddress); err != nil {
+			return err
+		}
+
+		id := enrollment.DelegatorAddress + "/" + enrollment.ValidatorAddress
+		if seen[id] {
+			return fmt.Errorf("duplicate auto restake enrollment: %s", id)
+		}
+		seen[id] = true
+	}
+
+	if cursor := gs.AutoRestakeCursor; cursor != nil {
+		if _, err := sdk.AccAddressFromBech32(cursor.DelegatorAddress); err != nil {
+			return err
+		}
+		if _, err := sdk.ValAddressFromBech32(cursor.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -346,6 +346,11 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// auto_restake_enrollments defines the delegations enrolled in the auto restake at genesis.
+	AutoRestakeEnrollments []AutoRestakeEnrollment `protobuf:"bytes,11,rep,name=auto_restake_enrollments,json=autoRestakeEnrollments,proto3" json:"auto_restake_enrollments" yaml:"auto_restake_enrollments"`
+	// auto_restake_cursor defines the enrollment the current auto restake round
+	// resumes from, or null if no round is in progress.
+	AutoRestakeCursor *AutoRestakeEnrollment `protobuf:"bytes,12,opt,name=auto_restake_cursor,json=autoRestakeCursor,proto3" json:"auto_restake_cursor,omitempty" yaml:"auto_restake_cursor"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -397,72 +402,76 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1029 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1c, 0xf5, 0x3a, 0x25, 0x49, 0xc7, 0x29, 0x0d, 0xdb, 0x7c, 0xb8, 0x4e, 0xea, 0x4d, 0xa7, 0x05,
-	0x82, 0x80, 0x75, 0x1b, 0x10, 0xa0, 0x20, 0x90, 0xb2, 0x29, 0x85, 0x22, 0x24, 0xa2, 0x89, 0x04,
-	0x88, 0x8b, 0xb5, 0xde, 0x1d, 0xdb, 0x23, 0xd6, 0x3b, 0xd6, 0xce, 0xd8, 0x21, 0xfc, 0x03, 0x70,
-	0x44, 0x42, 0x70, 0x81, 0x43, 0x8e, 0x08, 0x71, 0xec, 0x9d, 0x6b, 0x8f, 0x3d, 0x72, 0x40, 0x01,
-	0x39, 0x17, 0xce, 0x39, 0x70, 0xe0, 0x84, 0x76, 0x66, 0xf6, 0xcb, 0x5e, 0x1b, 0x27, 0x6d, 0x6e,
-	0xf6, 0xf8, 0xb7, 0xef, 0xbd, 0xdf, 0x9b, 0xdf, 0xc7, 0x1a, 0xbc, 0xe4, 0x50, 0xd6, 0xa1, 0xac,
-	0xe6, 0x12, 0xc6, 0x03, 0xd2, 0xe8, 0x71, 0x42, 0xfd, 0x5a, 0xff, 0x6e, 0x03, 0x73, 0xfb, 0x6e,
-	0xad, 0x85, 0x7d, 0xcc, 0x08, 0x33, 0xbb, 0x01, 0xe5, 0x54, 0x5f, 0x93, 0xa1, 0x66, 0x3a, 0xd4,
-	0x54, 0xa1, 0x95, 0xa5, 0x16, 0x6d, 0x51, 0x11, 0x57, 0x0b, 0x3f, 0xc9, 0x47, 0x2a, 0x55, 0x85,
-	0xde, 0xb0, 0x19, 0x8e, 0x51, 0x1d, 0x4a, 0x7c, 0xf5, 0xbb, 0x39, 0x89, 0x3d, 0xc3, 0x23, 0xe2,
-	0xe1, 0x43, 0x0d, 0x2c, 0xdf, 0xc3, 0x1e, 0x6e, 0xd9, 0x9c, 0x06, 0x9f, 0x12, 0xde, 0x76, 0x03,
-	0xfb, 0xe0, 0x81, 0xdf, 0xa4, 0xfa, 0x03, 0xf0, 0x9c, 0x1b, 0xfd, 0x50, 0xb7, 0x5d, 0x37, 0xc0,
-	0x8c, 0x95, 0xb5, 0x0d, 0x6d, 0xf3, 0xb2, 0xb5, 0x7e, 0x7a, 0x6c, 0x94, 0x0f, 0xed, 0x8e, 0xb7,
-	0x0d, 0x47, 0x42, 0x20, 0x5a, 0x8c, 0xcf, 0x76, 0xe4, 0x91, 0x7e, 0x1f, 0x2c, 0x1e, 0x28, 0xe8,
-	0x18, 0xa9, 0x28, 0x90, 0xd6, 0x4e, 0x8f, 0x8d, 0x55, 0x89, 0x34, 0x1c, 0x01, 0xd1, 0xd5, 0xe8,
-	0x48, 0xe1, 0x6c, 0xcf, 0x7f, 0x73, 0x64, 0x14, 0xfe, 0x3e, 0x32, 0x0a, 0xf0, 0x87, 0x22, 0xb8,
-	0xf9, 0x89, 0xed, 0x11, 0x37, 0xa4, 0xf9, 0xb8, 0xc7, 0x19, 0xb7, 0x7d, 0x97, 0xf8, 0x2d, 0x84,
-	0x0f, 0xec, 0xc0, 0x65, 0x08, 0x3b, 0x34, 0x70, 0xc3, 0x14, 0xfa, 0x51, 0xd0, 0xf8, 0x14, 0x46,
-	0x42, 0x20, 0x5a, 0x8c, 0xcf, 0xa2, 0x14, 0x7e, 0xd4, 0xc0, 0x35, 0x9a, 0xf0, 0xd4, 0x03, 0x49,
-	0x54, 0x2e, 0x6e, 0xcc, 0x6c, 0x96, 0xb6, 0xd6, 0x95, 0xed, 0x66, 0x78, 0x2d, 0xd1, 0x0d, 0x9a,
-	0xf7, 0xb0, 0xb3, 0x4b, 0x89, 0x6f, 0x7d, 0xf4, 0xe8, 0xd8, 0x28, 0x9c, 0x1e, 0x1b, 0x15, 0xc9,
-	0x97, 0x03, 0x03, 0x7f, 0xf9, 0xd3, 0x78, 0xa1, 0x45, 0x78, 0xbb, 0xd7, 0x30, 0x1d, 0xda, 0xa9,
-	0x79, 0xc4, 0xc7, 0x35, 0xaf, 0xd1, 0x79, 0x95, 0xb9, 0x5f, 0xd4, 0xf8, 0x61, 0x17, 0xb3, 0x08,
-	0x8c, 0x21, 0x9d, 0x8e, 0xa4, 0x9b, 0x32, 0xe6, 0x1f, 0x0d, 0xdc, 0x8e, 0x8d, 0xd9, 0x71, 0x9c,
-	0x5e, 0xa7, 0xe7, 0xd9, 0x1c, 0xbb, 0xbb, 0xb4, 0xd3, 0x21, 0x8c, 0x11, 0xea, 0x3f, 0x7d, 0x6f,
-	0x0e, 0x41, 0xc9, 0x4e, 0x98, 0xc4, 0xcd, 0x96, 0xb6, 0xde, 0x36, 0x27, 0x14, 0xb7, 0x39, 0x59,
-	0xa2, 0x55, 0x51, 0x8e, 0xe9, 0x52, 0x45, 0x0a, 0x1d, 0xa2, 0x34, 0x57, 0x2a, 0xf1, 0x7f, 0x35,
-	0xb0, 0x11, 0xa3, 0x7e, 0x40, 0x18, 0xa7, 0x01, 0x71, 0x6c, 0xef, 0xc2, 0x0a, 0x62, 0x05, 0xcc,
-	0x76, 0x71, 0x40, 0xa8, 0xcc, 0xf7, 0x12, 0x52, 0xdf, 0x74, 0x02, 0xe6, 0xa2, 0xda, 0x98, 0x11,
-	0x46, 0xbc, 0x39, 0x9d, 0x11, 0x23, 0x92, 0xad, 0x15, 0x65, 0xc2, 0xb3, 0x52, 0x55, 0x54, 0x2a,
-	0x28, 0xc2, 0x4f, 0x25, 0xff, 0x87, 0x06, 0x6e, 0xc4, 0x48, 0xbb, 0xbd, 0x20, 0xc0, 0x3e, 0xbf,
-	0xb0, 0xcc, 0x9b, 0x49, 0x86, 0xf2, 0xaa, 0x5f, 0x9f, 0x2e, 0xc3, 0xac, 0xae, 0xb3, 0xa4, 0xf7,
-	0xb0, 0x08, 0xd6, 0xe2, 0x21, 0xb5, 0xcf, 0xed, 0x80, 0x13, 0xbf, 0x15, 0x0e, 0xa9, 0x24, 0xb9,
-	0xa7, 0x35, 0xaa, 0x72, 0x7d, 0x2a, 0x9e, 0xcb, 0xa7, 0x1e, 0xb8, 0xc2, 0x94, 0xd6, 0x3a, 0xf1,
-	0x9b, 0x54, 0xd5, 0xc3, 0xd6, 0x44, 0xb7, 0x72, 0xd3, 0xb4, 0xd6, 0x95, 0x57, 0x4b, 0x92, 0x3e,
-	0x03, 0x0b, 0xd1, 0x02, 0x4b, 0xc5, 0xa6, 0x6c, 0xfb, 0xa9, 0x08, 0xae, 0xc7, 0xee, 0xef, 0x7b,
-	0x36, 0x6b, 0xbf, 0xd7, 0x17, 0x17, 0x70, 0x01, 0xbd, 0xd0, 0xc6, 0xa4, 0xd5, 0xe6, 0x51, 0x2f,
-	0xc8, 0x6f, 0xa9, 0x1e, 0x99, 0xc9, 0xf4, 0xc8, 0x57, 0x60, 0x39, 0xc1, 0x65, 0xa1, 0xb0, 0x3a,
-	0x0e, 0x95, 0x95, 0x2f, 0x09, 0x87, 0xee, 0x4c, 0x57, 0x4f, 0x49, 0x46, 0xd6, 0x92, 0xf2, 0x67,
-	0x41, 0x8a, 0x16, 0x60, 0x10, 0x5d, 0xeb, 0x8f, 0x86, 0xa6, 0xec, 0xf9, 0xba, 0x04, 0x16, 0xde,
-	0x97, 0xfb, 0x78, 0x9f, 0xdb, 0x1c, 0xeb, 0x08, 0xcc, 0x76, 0xed, 0xc0, 0xee, 0x48, 0x1b, 0x4a,
-	0x5b, 0xb7, 0x26, 0xea, 0xd8, 0x13, 0xa1, 0xd6, 0xb2, 0xa2, 0xbe, 0x22, 0xa9, 0x25, 0x00, 0x44,
-	0x0a, 0x49, 0xff, 0x0c, 0xcc, 0x37, 0x31, 0xae, 0x77, 0x29, 0xf5, 0x54, 0xb7, 0xdc, 0x9e, 0x88,
-	0x7a, 0x1f, 0xe3, 0x3d, 0x4a, 0x3d, 0x6b, 0x55, 0xc1, 0x5e, 0x95, 0xb0, 0x11, 0x06, 0x44, 0x73,
-	0x4d, 0x19, 0xa1, 0x7f, 0xaf, 0x81, 0x72, 0x52, 0xd2, 0xf1, 0xf6, 0x0c, 0x4b, 0x22, 0x1c, 0x3d,
-	0x33, 0xd3, 0x97, 0x5a, 0x7a, 0xed, 0x5b, 0x2f, 0x2a, 0x62, 0x63, 0xb8, 0x69, 0xb2, 0x0c, 0x10,
-	0xad, 0xb8, 0x79, 0xcf, 0x8b, 0x0e, 0xea, 0x06, 0xb8, 0x4f, 0x68, 0x8f, 0xd5, 0xbb, 0x01, 0xed,
-	0x52, 0x86, 0x03, 0x71, 0xb1, 0x99, 0xba, 0x1a, 0x09, 0x81, 0x68, 0x31, 0x3a, 0xdb, 0x53, 0x47,
-	0xfa, 0x77, 0x63, 0x96, 0xee, 0x33, 0x22, 0xbb, 0x77, 0xa7, 0x2b, 0x93, 0x71, 0x6f, 0x07, 0x16,
-	0xfc, 0xff, 0xb5, 0x9c, 0xb7, 0x6c, 0xf5, 0xdf, 0x34, 0x70, 0x33, 0xd5, 0x16, 0xc9, 0x36, 0xaa,
-	0x3b, 0xf1, 0x06, 0x63, 0xe5, 0x59, 0xa1, 0x71, 0xe7, 0x09, 0xb6, 0xa0, 0x92, 0x79, 0x47, 0xc9,
-	0xdc, 0x1c, 0x69, 0xc8, 0x7c, 0x66, 0x88, 0x8c, 0xfe, 0x44, 0x5c, 0xa6, 0xff, 0xaa, 0x81, 0xf5,
-	0x04, 0xa7, 0x1d, 0x6f, 0x9e, 0xd8, 0xe0, 0x39, 0x21, 0xfe, 0x9d, 0x73, 0x6e, 0x2e, 0x25, 0xfc,
-	0x65, 0x25, 0xfc, 0xd6, 0xb0, 0xf0, 0x51, 0x42, 0x88, 0x2a, 0xfd, 0xb1, 0x70, 0xfa, 0x91, 0x06,
-	0xae, 0x27, 0x4f, 0x3b, 0x72, 0x8d, 0xc4, 0x5a, 0xe7, 0x85, 0xd6, 0xed, 0xf3, 0xec, 0x20, 0x25,
-	0x74, 0x53, 0x09, 0xdd, 0x18, 0x16, 0x3a, 0x44, 0x05, 0xd1, 0x6a, 0x3f, 0x1f, 0x28, 0x7c, 0x3d,
-	0x4c, 0x35, 0x63, 0x66, 0x3e, 0xb3, 0xf2, 0x65, 0xa1, 0xf0, 0xad, 0xb3, 0xcf, 0x7d, 0xa5, 0x6f,
-	0x6c, 0x4b, 0x66, 0x79, 0xd2, 0x2d, 0x99, 0x46, 0x61, 0x61, 0x1f, 0xad, 0xe4, 0x0e, 0x5c, 0x56,
-	0x06, 0x42, 0xdb, 0x1b, 0x67, 0x9d, 0xb8, 0x4a, 0xd9, 0xf3, 0x4a, 0xd9, 0x8d, 0x61, 0xe7, 0xd2,
-	0x1c, 0x10, 0x2d, 0xe5, 0x0c, 0xe2, 0xd4, 0x7e, 0xb7, 0x3e, 0xfc, 0x79, 0x50, 0xd5, 0x1e, 0x0d,
-	0xaa, 0xda, 0xe3, 0x41, 0x55, 0xfb, 0x6b, 0x50, 0xd5, 0xbe, 0x3d, 0xa9, 0x16, 0x1e, 0x9f, 0x54,
-	0x0b, 0xbf, 0x9f, 0x54, 0x0b, 0x9f, 0xbf, 0x32, 0xee, 0xc5, 0xf8, 0xcb, 0xec, 0xbf, 0x1c, 0xf1,
-	0x9e, 0xdc, 0x98, 0x15, 0xff, 0x6b, 0x5e, 0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x26, 0xfd, 0x23,
-	0x4f, 0x87, 0x0d, 0x00, 0x00,
+	// 1097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
+	0x18, 0xf5, 0x3a, 0x25, 0x49, 0x27, 0x09, 0x4d, 0x37, 0x7f, 0x6e, 0x92, 0xda, 0xe9, 0xb4, 0x40,
+	0x10, 0x60, 0xb7, 0x01, 0x01, 0x0a, 0x02, 0x29, 0x9b, 0xb6, 0x50, 0x84, 0x44, 0x34, 0x91, 0x00,
+	0x71, 0xb1, 0xc6, 0xbb, 0x13, 0x7b, 0xd4, 0xf5, 0x8e, 0x35, 0x33, 0xeb, 0x10, 0x8e, 0x9c, 0x38,
+	0x22, 0x21, 0xb8, 0x94, 0x43, 0x0e, 0x1c, 0x10, 0xe2, 0xd8, 0x3b, 0xd7, 0x1e, 0x7b, 0xe4, 0x80,
+	0x02, 0x4a, 0x2e, 0x9c, 0x73, 0xe0, 0xc0, 0x09, 0x79, 0x66, 0xf6, 0xc7, 0xf6, 0xda, 0x38, 0xa1,
+	0xb9, 0xc5, 0xb3, 0xdf, 0xbc, 0xf7, 0xbe, 0xb7, 0xdf, 0x4f, 0x16, 0xbc, 0xec, 0x32, 0xd1, 0x64,
+	0xa2, 0xe2, 0x51, 0x21, 0x39, 0xad, 0x85, 0x92, 0xb2, 0xa0, 0xd2, 0xbe, 0x53, 0x23, 0x12, 0xdf,
+	0xa9, 0xd4, 0x49, 0x40, 0x04, 0x15, 0xe5, 0x16, 0x67, 0x92, 0xd9, 0x2b, 0x3a, 0xb4, 0x9c, 0x0e,
+	0x2d, 0x9b, 0xd0, 0xe5, 0xf9, 0x3a, 0xab, 0x33, 0x15, 0x57, 0xe9, 0xfc, 0xa5, 0xaf, 0x2c, 0x17,
+	0x0d, 0x7a, 0x0d, 0x0b, 0x12, 0xa3, 0xba, 0x8c, 0x06, 0xe6, 0x79, 0x79, 0x18, 0x7b, 0x17, 0x8f,
+	0x8a, 0x87, 0x8f, 0x2d, 0xb0, 0x70, 0x97, 0xf8, 0xa4, 0x8e, 0x25, 0xe3, 0x9f, 0x52, 0xd9, 0xf0,
+	0x38, 0xde, 0x7f, 0x10, 0xec, 0x31, 0xfb, 0x01, 0xb8, 0xea, 0x45, 0x0f, 0xaa, 0xd8, 0xf3, 0x38,
+	0x11, 0xa2, 0x60, 0xad, 0x59, 0xeb, 0x97, 0x9d, 0xd5, 0xd3, 0xa3, 0x52, 0xe1, 0x00, 0x37, 0xfd,
+	0x4d, 0xd8, 0x17, 0x02, 0xd1, 0x6c, 0x7c, 0xb6, 0xa5, 0x8f, 0xec, 0xfb, 0x60, 0x76, 0xdf, 0x40,
+	0xc7, 0x48, 0x79, 0x85, 0xb4, 0x72, 0x7a, 0x54, 0x5a, 0xd2, 0x48, 0xbd, 0x11, 0x10, 0x5d, 0x89,
+	0x8e, 0x0c, 0xce, 0xe6, 0xe4, 0xd7, 0x87, 0xa5, 0xdc, 0x5f, 0x87, 0xa5, 0x1c, 0xfc, 0x3e, 0x0f,
+	0x6e, 0x7c, 0x82, 0x7d, 0xea, 0x75, 0x68, 0x3e, 0x0e, 0xa5, 0x90, 0x38, 0xf0, 0x68, 0x50, 0x47,
+	0x64, 0x1f, 0x73, 0x4f, 0x20, 0xe2, 0x32, 0xee, 0x75, 0x52, 0x68, 0x47, 0x41, 0x83, 0x53, 0xe8,
+	0x0b, 0x81, 0x68, 0x36, 0x3e, 0x8b, 0x52, 0x78, 0x64, 0x81, 0x39, 0x96, 0xf0, 0x54, 0xb9, 0x26,
+	0x2a, 0xe4, 0xd7, 0xc6, 0xd6, 0xa7, 0x36, 0x56, 0x8d, 0xed, 0xe5, 0xce, 0x6b, 0x89, 0xde, 0x60,
+	0xf9, 0x2e, 0x71, 0xb7, 0x19, 0x0d, 0x9c, 0x8f, 0x9e, 0x1c, 0x95, 0x72, 0xa7, 0x47, 0xa5, 0x65,
+	0xcd, 0x97, 0x01, 0x03, 0x7f, 0xfe, 0xa3, 0xf4, 0x62, 0x9d, 0xca, 0x46, 0x58, 0x2b, 0xbb, 0xac,
+	0x59, 0xf1, 0x69, 0x40, 0x2a, 0x7e, 0xad, 0xf9, 0x9a, 0xf0, 0x1e, 0x56, 0xe4, 0x41, 0x8b, 0x88,
+	0x08, 0x4c, 0x20, 0x9b, 0xf5, 0xa5, 0x9b, 0x32, 0xe6, 0x6f, 0x0b, 0xdc, 0x8a, 0x8d, 0xd9, 0x72,
+	0xdd, 0xb0, 0x19, 0xfa, 0x58, 0x12, 0x6f, 0x9b, 0x35, 0x9b, 0x54, 0x08, 0xca, 0x82, 0x67, 0xef,
+	0xcd, 0x01, 0x98, 0xc2, 0x09, 0x93, 0x7a, 0xb3, 0x53, 0x1b, 0xef, 0x94, 0x87, 0x14, 0x77, 0x79,
+	0xb8, 0x44, 0x67, 0xd9, 0x38, 0x66, 0x6b, 0x15, 0x29, 0x74, 0x88, 0xd2, 0x5c, 0xa9, 0xc4, 0xff,
+	0xb1, 0xc0, 0x5a, 0x8c, 0xfa, 0x01, 0x15, 0x92, 0x71, 0xea, 0x62, 0xff, 0xc2, 0x0a, 0x62, 0x11,
+	0x8c, 0xb7, 0x08, 0xa7, 0x4c, 0xe7, 0x7b, 0x09, 0x99, 0x5f, 0x36, 0x05, 0x13, 0x51, 0x6d, 0x8c,
+	0x29, 0x23, 0xde, 0x1a, 0xcd, 0x88, 0x3e, 0xc9, 0xce, 0xa2, 0x31, 0xe1, 0x79, 0xad, 0x2a, 0x2a,
+	0x15, 0x14, 0xe1, 0xa7, 0x92, 0xff, 0xdd, 0x02, 0xd7, 0x63, 0xa4, 0xed, 0x90, 0x73, 0x12, 0xc8,
+	0x0b, 0xcb, 0x7c, 0x2f, 0xc9, 0x50, 0xbf, 0xea, 0x37, 0x46, 0xcb, 0xb0, 0x5b, 0xd7, 0x59, 0xd2,
+	0x7b, 0x9c, 0x07, 0x2b, 0xf1, 0x90, 0xda, 0x95, 0x98, 0x4b, 0x1a, 0xd4, 0x3b, 0x43, 0x2a, 0x49,
+	0xee, 0x59, 0x8d, 0xaa, 0x4c, 0x9f, 0xf2, 0xe7, 0xf2, 0x29, 0x04, 0x33, 0xc2, 0x68, 0xad, 0xd2,
+	0x60, 0x8f, 0x99, 0x7a, 0xd8, 0x18, 0xea, 0x56, 0x66, 0x9a, 0xce, 0xaa, 0xf1, 0x6a, 0x5e, 0xd3,
+	0x77, 0xc1, 0x42, 0x34, 0x2d, 0x52, 0xb1, 0x29, 0xdb, 0x7e, 0xc8, 0x83, 0x6b, 0xb1, 0xfb, 0xbb,
+	0x3e, 0x16, 0x8d, 0x7b, 0x6d, 0xf5, 0x02, 0x2e, 0xa0, 0x17, 0x1a, 0x84, 0xd6, 0x1b, 0x32, 0xea,
+	0x05, 0xfd, 0x2b, 0xd5, 0x23, 0x63, 0x5d, 0x3d, 0xf2, 0x25, 0x58, 0x48, 0x70, 0x45, 0x47, 0x58,
+	0x95, 0x74, 0x94, 0x15, 0x2e, 0x29, 0x87, 0x6e, 0x8f, 0x56, 0x4f, 0x49, 0x46, 0xce, 0xbc, 0xf1,
+	0x67, 0x5a, 0x8b, 0x56, 0x60, 0x10, 0xcd, 0xb5, 0xfb, 0x43, 0x53, 0xf6, 0xfc, 0x38, 0x03, 0xa6,
+	0xdf, 0xd7, 0xfb, 0x78, 0x57, 0x62, 0x49, 0x6c, 0x04, 0xc6, 0x5b, 0x98, 0xe3, 0xa6, 0xb6, 0x61,
+	0x6a, 0xe3, 0xe6, 0x50, 0x1d, 0x3b, 0x2a, 0xd4, 0x59, 0x30, 0xd4, 0x33, 0x9a, 0x5a, 0x03, 0x40,
+	0x64, 0x90, 0xec, 0xcf, 0xc0, 0xe4, 0x1e, 0x21, 0xd5, 0x16, 0x63, 0xbe, 0xe9, 0x96, 0x5b, 0x43,
+	0x51, 0xef, 0x13, 0xb2, 0xc3, 0x98, 0xef, 0x2c, 0x19, 0xd8, 0x2b, 0x1a, 0x36, 0xc2, 0x80, 0x68,
+	0x62, 0x4f, 0x47, 0xd8, 0xdf, 0x59, 0xa0, 0x90, 0x94, 0x74, 0xbc, 0x3d, 0x3b, 0x25, 0xd1, 0x19,
+	0x3d, 0x63, 0xa3, 0x97, 0x5a, 0x7a, 0xed, 0x3b, 0x2f, 0x19, 0xe2, 0x52, 0x6f, 0xd3, 0x74, 0x33,
+	0x40, 0xb4, 0xe8, 0x65, 0xdd, 0x57, 0x1d, 0xd4, 0xe2, 0xa4, 0x4d, 0x59, 0x28, 0xaa, 0x2d, 0xce,
+	0x5a, 0x4c, 0x10, 0x5e, 0xb8, 0xd4, 0x5b, 0x57, 0x7d, 0x21, 0x10, 0xcd, 0x46, 0x67, 0x3b, 0xe6,
+	0xc8, 0xfe, 0x76, 0xc0, 0xd2, 0x7d, 0x4e, 0x65, 0xf7, 0xde, 0x68, 0x65, 0x32, 0xe8, 0xbf, 0x03,
+	0x07, 0xfe, 0xf7, 0x5a, 0xce, 0x5a, 0xb6, 0xf6, 0xaf, 0x16, 0xb8, 0x91, 0x6a, 0x8b, 0x64, 0x1b,
+	0x55, 0xdd, 0x78, 0x83, 0x89, 0xc2, 0xb8, 0xd2, 0xb8, 0xf5, 0x3f, 0xb6, 0xa0, 0x91, 0x79, 0xdb,
+	0xc8, 0x5c, 0xef, 0x6b, 0xc8, 0x6c, 0x66, 0x88, 0x4a, 0xed, 0xa1, 0xb8, 0xc2, 0xfe, 0xc5, 0x02,
+	0xab, 0x09, 0x4e, 0x23, 0xde, 0x3c, 0xb1, 0xc1, 0x13, 0x4a, 0xfc, 0xbb, 0xe7, 0xdc, 0x5c, 0x46,
+	0xf8, 0x2b, 0x46, 0xf8, 0xcd, 0x5e, 0xe1, 0xfd, 0x84, 0x10, 0x2d, 0xb7, 0x07, 0xc2, 0xd9, 0x87,
+	0x16, 0xb8, 0x96, 0xdc, 0x76, 0xf5, 0x1a, 0x89, 0xb5, 0x4e, 0x2a, 0xad, 0x9b, 0xe7, 0xd9, 0x41,
+	0x46, 0xe8, 0xba, 0x11, 0xba, 0xd6, 0x2b, 0xb4, 0x87, 0x0a, 0xa2, 0xa5, 0x76, 0x36, 0x90, 0xfd,
+	0xa8, 0xab, 0x19, 0xbb, 0xe6, 0xb3, 0x28, 0x5c, 0x56, 0x0a, 0xdf, 0x3e, 0xfb, 0xdc, 0x37, 0xfa,
+	0x06, 0xb6, 0x64, 0x37, 0x4f, 0xba, 0x25, 0xd3, 0x28, 0xa2, 0xd3, 0x47, 0x8b, 0x99, 0x03, 0x57,
+	0x14, 0x80, 0xd2, 0xf6, 0xe6, 0x59, 0x27, 0xae, 0x51, 0xf6, 0x82, 0x51, 0x76, 0xbd, 0xd7, 0xb9,
+	0x34, 0x07, 0x44, 0xf3, 0x19, 0x83, 0x58, 0xa8, 0x01, 0x86, 0x43, 0xc9, 0xaa, 0x9c, 0x08, 0x89,
+	0x1f, 0x92, 0x2a, 0x09, 0x38, 0xf3, 0xfd, 0xa6, 0xd2, 0x35, 0x35, 0xc2, 0x00, 0xdb, 0x0a, 0x25,
+	0x43, 0xfa, 0xee, 0xbd, 0xf8, 0x6a, 0xaf, 0x5b, 0x83, 0x18, 0x20, 0x5a, 0xc4, 0x59, 0xf7, 0x85,
+	0xfd, 0x95, 0x05, 0xe6, 0xba, 0x6e, 0xb9, 0x21, 0x17, 0x8c, 0x17, 0xa6, 0xd7, 0xac, 0x73, 0x4a,
+	0x2a, 0x26, 0x53, 0x26, 0x03, 0x18, 0xa2, 0xab, 0x29, 0x25, 0xdb, 0xea, 0x2c, 0x59, 0x53, 0xce,
+	0x87, 0x3f, 0x1d, 0x17, 0xad, 0x27, 0xc7, 0x45, 0xeb, 0xe9, 0x71, 0xd1, 0xfa, 0xf3, 0xb8, 0x68,
+	0x7d, 0x73, 0x52, 0xcc, 0x3d, 0x3d, 0x29, 0xe6, 0x7e, 0x3b, 0x29, 0xe6, 0x3e, 0x7f, 0x75, 0xd0,
+	0x57, 0xc3, 0x17, 0xdd, 0x9f, 0x80, 0xea, 0x23, 0xa2, 0x36, 0xae, 0x3e, 0xfa, 0x5e, 0xff, 0x77,
+	0x00, 0x19, 0x71, 0xac, 0x0f, 0xa4, 0x0e, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -788,6 +797,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRestakeCursor != nil {
+		{
+			size, err := m.AutoRestakeCursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AutoRestakeEnrollments) > 0 {
+		for iNdEx := len(m.AutoRestakeEnrollments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRestakeEnrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1107,6 +1142,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoRestakeEnrollments) > 0 {
+		for _, e := range m.AutoRestakeEnrollments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AutoRestakeCursor != nil {
+		l = m.AutoRestakeCursor.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -2375,6 +2420,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeEnrollments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakeEnrollments = append(m.AutoRestakeEnrollments, AutoRestakeEnrollment{})
+			if err := m.AutoRestakeEnrollments[len(m.AutoRestakeEnrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeCursor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoRestakeCursor == nil {
+				m.AutoRestakeCursor = &AutoRestakeEnrollment{}
+			}
+			if err := m.AutoRestakeCursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
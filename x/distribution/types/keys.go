@@ -42,6 +42,10 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: AutoRestakeEnrollment
+//
+// - 0x0a: key of the next AutoRestakeEnrollment to process in the current round
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +57,9 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	AutoRestakeEnrollmentPrefix = []byte{0x09} // key for auto restake enrollment
+	AutoRestakeCursorKey        = []byte{0x0a} // key for the progress of the current auto restake round
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -152,6 +159,22 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetAutoRestakeEnrollmentAddresses creates the addresses from an auto restake enrollment key.
+func GetAutoRestakeEnrollmentAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x09<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -213,3 +236,13 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoRestakeEnrollmentsPrefix creates the prefix key for a delegator's auto restake enrollments.
+func GetAutoRestakeEnrollmentsPrefix(delAddr sdk.AccAddress) []byte {
+	return append(AutoRestakeEnrollmentPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoRestakeEnrollmentKey creates the key for an auto restake enrollment.
+func GetAutoRestakeEnrollmentKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetAutoRestakeEnrollmentsPrefix(delAddr), address.MustLengthPrefix(valAddr.Bytes())...)
+}
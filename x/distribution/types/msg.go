@@ -11,10 +11,11 @@ const (
 	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetAutoRestake              = "set_auto_restake"
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgSetAutoRestake{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -164,3 +165,54 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetAutoRestake returns a new MsgSetAutoRestake which enables or
+// disables the auto restake of the delegation.
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoRestake {
+	return &MsgSetAutoRestake{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+// Route returns the MsgSetAutoRestake message route.
+func (msg MsgSetAutoRestake) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoRestake message type.
+func (msg MsgSetAutoRestake) Type() string { return TypeMsgSetAutoRestake }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoRestake message that
+// the expected signer needs to sign.
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoRestake message validation.
+func (msg MsgSetAutoRestake) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address: %s", msg.DelegatorAddress)
+	}
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", msg.ValidatorAddress)
+	}
+
+	return nil
+}
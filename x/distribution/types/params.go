@@ -11,10 +11,23 @@ import (
 
 // Parameter keys
 var (
-	ParamStoreKeyCommunityTax        = []byte("communitytax")
-	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
-	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
-	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyCommunityTax         = []byte("communitytax")
+	ParamStoreKeyBaseProposerReward   = []byte("baseproposerreward")
+	ParamStoreKeyBonusProposerReward  = []byte("bonusproposerreward")
+	ParamStoreKeyWithdrawAddrEnabled  = []byte("withdrawaddrenabled")
+	ParamStoreKeyAutoRestakeInterval  = []byte("autorestakeinterval")
+	ParamStoreKeyAutoRestakeGasBudget = []byte("autorestakegasbudget")
+)
+
+// Default values of the auto restake parameters.
+const (
+	// the auto restake is disabled by default, and enabled by a param change
+	DefaultAutoRestakeInterval  uint64 = 0
+	DefaultAutoRestakeGasBudget uint64 = 0
+
+	// MaxAutoRestakeGasBudget is the upper bound of the gas consumed by the
+	// auto restake in a block.
+	MaxAutoRestakeGasBudget uint64 = 50_000_000
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -25,10 +38,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:         sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:   sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:  sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:  true,
+		AutoRestakeInterval:  DefaultAutoRestakeInterval,
+		AutoRestakeGasBudget: DefaultAutoRestakeGasBudget,
 	}
 }
 
@@ -44,6 +59,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoRestakeInterval, &p.AutoRestakeInterval, validateAutoRestakeInterval),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoRestakeGasBudget, &p.AutoRestakeGasBudget, validateAutoRestakeGasBudget),
 	}
 }
 
@@ -69,7 +86,11 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
-
+	if p.AutoRestakeGasBudget > MaxAutoRestakeGasBudget {
+		return fmt.Errorf(
+			"auto restake gas budget cannot be greater than %d: %d", MaxAutoRestakeGasBudget, p.AutoRestakeGasBudget,
+		)
+	}
 	return nil
 }
 
@@ -138,3 +159,25 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoRestakeInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAutoRestakeGasBudget(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxAutoRestakeGasBudget {
+		return fmt.Errorf("auto restake gas budget too large: %d > %d", v, MaxAutoRestakeGasBudget)
+	}
+
+	return nil
+}
@@ -13,29 +13,33 @@ func TestParams_ValidateBasic(t *testing.T) {
 	toDec := sdk.MustNewDecFromStr
 
 	type fields struct {
-		CommunityTax        sdk.Dec
-		BaseProposerReward  sdk.Dec
-		BonusProposerReward sdk.Dec
-		WithdrawAddrEnabled bool
+		CommunityTax         sdk.Dec
+		BaseProposerReward   sdk.Dec
+		BonusProposerReward  sdk.Dec
+		WithdrawAddrEnabled  bool
+		AutoRestakeGasBudget uint64
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false}, true},
-		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false}, true},
-		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false}, true},
-		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false}, true},
+		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 0}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false, 0}, true},
+		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false, 0}, true},
+		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false, 0}, true},
+		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, 0}, true},
+		{"max auto restake gas budget", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, types.MaxAutoRestakeGasBudget}, false},
+		{"auto restake gas budget too large", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, types.MaxAutoRestakeGasBudget + 1}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := types.Params{
-				CommunityTax:        tt.fields.CommunityTax,
-				BaseProposerReward:  tt.fields.BaseProposerReward,
-				BonusProposerReward: tt.fields.BonusProposerReward,
-				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+				CommunityTax:         tt.fields.CommunityTax,
+				BaseProposerReward:   tt.fields.BaseProposerReward,
+				BonusProposerReward:  tt.fields.BonusProposerReward,
+				WithdrawAddrEnabled:  tt.fields.WithdrawAddrEnabled,
+				AutoRestakeGasBudget: tt.fields.AutoRestakeGasBudget,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
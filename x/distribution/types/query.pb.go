@@ -838,6 +838,105 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_line_lbm_sdk_types.Dec
 	return nil
 }
 
+// QueryAutoRestakeEnrollmentsRequest is the request type for the
+// Query/AutoRestakeEnrollments RPC method.
+type QueryAutoRestakeEnrollmentsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	// All the enrollments are returned if it is empty.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoRestakeEnrollmentsRequest) Reset()         { *m = QueryAutoRestakeEnrollmentsRequest{} }
+func (m *QueryAutoRestakeEnrollmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoRestakeEnrollmentsRequest) ProtoMessage()    {}
+func (*QueryAutoRestakeEnrollmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryAutoRestakeEnrollmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoRestakeEnrollmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoRestakeEnrollmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoRestakeEnrollmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoRestakeEnrollmentsRequest.Merge(m, src)
+}
+func (m *QueryAutoRestakeEnrollmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoRestakeEnrollmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoRestakeEnrollmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoRestakeEnrollmentsRequest proto.InternalMessageInfo
+
+// QueryAutoRestakeEnrollmentsResponse is the response type for the
+// Query/AutoRestakeEnrollments RPC method.
+type QueryAutoRestakeEnrollmentsResponse struct {
+	// enrollments defines the delegations enrolled in the auto restake.
+	Enrollments []AutoRestakeEnrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoRestakeEnrollmentsResponse) Reset()         { *m = QueryAutoRestakeEnrollmentsResponse{} }
+func (m *QueryAutoRestakeEnrollmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoRestakeEnrollmentsResponse) ProtoMessage()    {}
+func (*QueryAutoRestakeEnrollmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryAutoRestakeEnrollmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoRestakeEnrollmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoRestakeEnrollmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoRestakeEnrollmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoRestakeEnrollmentsResponse.Merge(m, src)
+}
+func (m *QueryAutoRestakeEnrollmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoRestakeEnrollmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoRestakeEnrollmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoRestakeEnrollmentsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoRestakeEnrollmentsResponse) GetEnrollments() []AutoRestakeEnrollment {
+	if m != nil {
+		return m.Enrollments
+	}
+	return nil
+}
+
+func (m *QueryAutoRestakeEnrollmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -857,6 +956,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryAutoRestakeEnrollmentsRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoRestakeEnrollmentsRequest")
+	proto.RegisterType((*QueryAutoRestakeEnrollmentsResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoRestakeEnrollmentsResponse")
 }
 
 func init() {
@@ -864,76 +965,82 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0x3d, 0x6e, 0x9a, 0xfe, 0xfa, 0xf4, 0x57, 0x92, 0x4e, 0x2b, 0x64, 0x36, 0xc1, 0x8e,
-	0x36, 0xb4, 0x09, 0x84, 0x7a, 0xdb, 0x54, 0x2a, 0xd0, 0x82, 0x20, 0xaf, 0x54, 0xa2, 0x4a, 0x53,
-	0x53, 0x25, 0xe1, 0x4d, 0x66, 0xec, 0x1d, 0xd6, 0x2b, 0xd6, 0x3b, 0xee, 0xce, 0x38, 0x21, 0xaa,
-	0xca, 0x81, 0x82, 0xd4, 0x23, 0x12, 0x97, 0x1e, 0x73, 0xe6, 0xce, 0x85, 0xbf, 0xa0, 0xc7, 0x4a,
-	0x48, 0xa8, 0x27, 0x5e, 0x12, 0x0e, 0x95, 0x10, 0x67, 0xae, 0xc8, 0xb3, 0xb3, 0xf6, 0xae, 0xbd,
-	0x5e, 0xbf, 0xa9, 0x37, 0xeb, 0xd9, 0x79, 0xbe, 0xf3, 0x7c, 0x9e, 0x99, 0x67, 0x9e, 0x47, 0x86,
-	0xb9, 0x32, 0xe3, 0x55, 0xc6, 0x0d, 0xd3, 0xe6, 0xc2, 0xb3, 0x4b, 0x75, 0x61, 0x33, 0xd7, 0xd8,
-	0xbd, 0x5c, 0xa2, 0x82, 0x5c, 0x36, 0xee, 0xd6, 0xa9, 0xb7, 0x9f, 0xaf, 0x79, 0x4c, 0x30, 0x3c,
-	0xe5, 0x2f, 0xcc, 0x87, 0x17, 0xe6, 0xd5, 0x42, 0xed, 0x35, 0xa5, 0x52, 0x22, 0x9c, 0xfa, 0x5e,
-	0x4d, 0x8d, 0x1a, 0xb1, 0x6c, 0x97, 0xc8, 0xd5, 0x52, 0x48, 0x3b, 0x67, 0x31, 0x8b, 0xc9, 0x9f,
-	0x46, 0xe3, 0x97, 0xb2, 0x4e, 0x5b, 0x8c, 0x59, 0x0e, 0x35, 0x48, 0xcd, 0x36, 0x88, 0xeb, 0x32,
-	0x21, 0x5d, 0xb8, 0xfa, 0x9a, 0x0d, 0xeb, 0x07, 0xca, 0x65, 0x66, 0x07, 0x9a, 0xf9, 0x24, 0x8a,
-	0x48, 0xc4, 0x72, 0xbd, 0x7e, 0x0e, 0xf0, 0xed, 0x46, 0x94, 0x9b, 0xc4, 0x23, 0x55, 0x5e, 0xa0,
-	0x77, 0xeb, 0x94, 0x0b, 0x7d, 0x07, 0xce, 0x46, 0xac, 0xbc, 0xc6, 0x5c, 0x4e, 0xf1, 0x12, 0x8c,
-	0xd7, 0xa4, 0x25, 0x83, 0x66, 0xd0, 0xfc, 0xa9, 0xc5, 0xd9, 0x7c, 0x42, 0x2a, 0xf2, 0xbe, 0xf3,
-	0xf2, 0xd8, 0xe3, 0xdf, 0x72, 0xa9, 0x82, 0x72, 0xd4, 0xb7, 0x60, 0x4e, 0x2a, 0x6f, 0x11, 0xc7,
-	0x36, 0x89, 0x60, 0xde, 0xad, 0xba, 0xe0, 0x82, 0xb8, 0xa6, 0xed, 0x5a, 0x05, 0xba, 0x47, 0x3c,
-	0x33, 0x08, 0x02, 0x2f, 0xc0, 0x99, 0xdd, 0x60, 0x55, 0x91, 0x98, 0xa6, 0x47, 0xb9, 0xbf, 0xf1,
-	0xc9, 0xc2, 0x64, 0xf3, 0xc3, 0x92, 0x6f, 0xd7, 0xbf, 0x45, 0x30, 0xdf, 0x5b, 0x58, 0x71, 0xec,
-	0xc0, 0x09, 0xcf, 0x37, 0x29, 0x90, 0x37, 0x13, 0x41, 0x12, 0x24, 0x15, 0x5d, 0x20, 0xa7, 0x6f,
-	0x40, 0x2e, 0x1a, 0xc5, 0x0a, 0xab, 0x56, 0x6d, 0xce, 0x6d, 0xe6, 0x0e, 0x85, 0xf5, 0x1d, 0x82,
-	0x99, 0xee, 0x82, 0x0a, 0x87, 0x00, 0x94, 0x9b, 0x56, 0x45, 0x74, 0xbd, 0x3f, 0xa2, 0xa5, 0x72,
-	0xb9, 0x5e, 0xad, 0x3b, 0x44, 0x50, 0xb3, 0x25, 0xac, 0xa0, 0x42, 0xa2, 0xfa, 0xdf, 0x08, 0xa6,
-	0xa3, 0x71, 0x7c, 0xe8, 0x10, 0x5e, 0xa1, 0x43, 0x1d, 0x16, 0x9e, 0x83, 0x09, 0x2e, 0x88, 0x27,
-	0x6c, 0xd7, 0x2a, 0x56, 0xa8, 0x6d, 0x55, 0x44, 0x26, 0x3d, 0x83, 0xe6, 0xc7, 0x0a, 0x2f, 0x04,
-	0xe6, 0x1b, 0xd2, 0x8a, 0x67, 0xe1, 0x34, 0x95, 0xe9, 0x0e, 0x96, 0x1d, 0x93, 0xcb, 0xfe, 0xef,
-	0x1b, 0xd5, 0xa2, 0x75, 0x80, 0x56, 0x69, 0x65, 0xc6, 0x24, 0xfe, 0x85, 0x00, 0xbf, 0x51, 0x27,
-	0x79, 0xbf, 0x7a, 0x5b, 0xf7, 0xd2, 0xa2, 0x2a, 0xec, 0x42, 0xc8, 0xf3, 0xda, 0xff, 0x1e, 0x1e,
-	0xe4, 0x52, 0x8f, 0x0e, 0x72, 0x48, 0xff, 0x19, 0xc1, 0xcb, 0x5d, 0x68, 0x55, 0xca, 0x37, 0xe1,
-	0x04, 0xf7, 0x4d, 0x19, 0x34, 0x73, 0x6c, 0xfe, 0xd4, 0xe2, 0xa5, 0xfe, 0xf2, 0x2d, 0x75, 0xd6,
-	0x76, 0xa9, 0x2b, 0x82, 0x9b, 0xa3, 0x64, 0xf0, 0xfb, 0x11, 0x8a, 0xb4, 0xa4, 0x98, 0xeb, 0x49,
-	0xe1, 0x87, 0x13, 0xc6, 0xd0, 0x1f, 0x04, 0xc1, 0xaf, 0x52, 0x87, 0x5a, 0xd2, 0xd6, 0x59, 0x58,
-	0xa6, 0xff, 0xad, 0xf3, 0xac, 0x9a, 0x1f, 0x82, 0xb3, 0x8a, 0x3d, 0xd8, 0x74, 0xfc, 0xc1, 0xfa,
-	0x29, 0x7c, 0x76, 0x90, 0x4b, 0xe9, 0x0f, 0x11, 0x64, 0xbb, 0x45, 0xa1, 0x72, 0xf8, 0x45, 0xb8,
-	0x0a, 0x1b, 0x39, 0x9c, 0x8e, 0xe0, 0x06, 0xa0, 0xab, 0xb4, 0xbc, 0xc2, 0x6c, 0x77, 0x39, 0xdf,
-	0xc8, 0xd7, 0x8f, 0xbf, 0xe7, 0x2e, 0x58, 0xb6, 0xa8, 0xd4, 0x4b, 0xf9, 0x32, 0xab, 0x1a, 0x8e,
-	0xed, 0x52, 0xc3, 0x29, 0x55, 0x2f, 0x72, 0xf3, 0x4b, 0x43, 0xec, 0xd7, 0x28, 0x0f, 0x96, 0xf3,
-	0x56, 0x4d, 0x7e, 0x02, 0x7a, 0x5b, 0x24, 0x77, 0x98, 0x20, 0xce, 0x08, 0x49, 0x09, 0x71, 0xfe,
-	0x89, 0x60, 0x36, 0x51, 0x5d, 0xc1, 0x6e, 0xb5, 0xc3, 0x5e, 0x4d, 0xbc, 0x30, 0x2d, 0xb5, 0xd5,
-	0x60, 0x6f, 0x5f, 0xb1, 0xed, 0xc1, 0xc1, 0x25, 0x38, 0x2e, 0x1a, 0xfb, 0x65, 0xd2, 0xcf, 0x21,
-	0x85, 0xbe, 0xb4, 0xbe, 0xa3, 0x1e, 0xb5, 0x66, 0x28, 0xcd, 0xeb, 0x3c, 0x6a, 0xf6, 0x6e, 0xaa,
-	0xd7, 0x2d, 0x56, 0x59, 0x65, 0x2e, 0x0b, 0xd0, 0xbc, 0x67, 0x7e, 0xf2, 0x4e, 0x16, 0x42, 0x96,
-	0x90, 0xda, 0x67, 0xf0, 0x4a, 0x54, 0x6d, 0xdb, 0x16, 0x15, 0xd3, 0x23, 0x7b, 0x6a, 0xe3, 0x11,
-	0x83, 0xfd, 0x14, 0xce, 0xf7, 0x90, 0x57, 0x11, 0xbf, 0x0a, 0x93, 0x7b, 0xea, 0x53, 0x9b, 0xfc,
-	0xc4, 0x5e, 0xd4, 0x25, 0xa4, 0x3e, 0x05, 0x2f, 0x49, 0xf5, 0xc6, 0x33, 0x5c, 0x77, 0x6d, 0xb1,
-	0xbf, 0xc9, 0x98, 0x13, 0xf4, 0xe3, 0xaf, 0x41, 0x8b, 0xfb, 0xa8, 0xf6, 0xfb, 0x1c, 0xc6, 0x6a,
-	0x8c, 0x39, 0xcf, 0xa5, 0x8a, 0xa4, 0xf2, 0xe2, 0xd3, 0x09, 0x38, 0x2e, 0x03, 0xc0, 0x8f, 0x10,
-	0x8c, 0xfb, 0x8d, 0x1d, 0x1b, 0x89, 0x37, 0xb8, 0x73, 0xaa, 0xd0, 0x2e, 0xf5, 0xef, 0xe0, 0x93,
-	0xe9, 0x0b, 0xdf, 0xfc, 0xf2, 0xd7, 0x0f, 0xe9, 0xf3, 0x78, 0xd6, 0x48, 0x1a, 0x6b, 0xfc, 0xd1,
-	0x02, 0x3f, 0x48, 0xc3, 0x54, 0x42, 0xab, 0xc6, 0xab, 0xbd, 0xb7, 0xef, 0x3d, 0x95, 0x68, 0x6b,
-	0x23, 0xaa, 0x28, 0xb2, 0x6d, 0x49, 0x76, 0x1b, 0xdf, 0x4a, 0x24, 0x6b, 0x5d, 0x73, 0xe3, 0x5e,
-	0xc7, 0x2b, 0x7c, 0xdf, 0x60, 0x2d, 0xfd, 0x62, 0xf0, 0x20, 0x1c, 0x22, 0x38, 0x1b, 0x33, 0x2c,
-	0xe0, 0xb7, 0x07, 0x88, 0xbb, 0x63, 0x68, 0xd1, 0xde, 0x19, 0xd2, 0x5b, 0xd1, 0x6e, 0x48, 0xda,
-	0x1b, 0x78, 0x7d, 0x14, 0xda, 0xd6, 0x38, 0x82, 0x7f, 0x45, 0x30, 0xd9, 0xde, 0x9b, 0xf1, 0x5b,
-	0x03, 0xc4, 0x18, 0x9d, 0x5e, 0xb4, 0x6b, 0xc3, 0xb8, 0x2a, 0xb6, 0x0f, 0x24, 0xdb, 0x1a, 0x5e,
-	0x19, 0x85, 0x2d, 0x98, 0x02, 0xfe, 0x41, 0x70, 0xa6, 0xa3, 0x63, 0xe2, 0x3e, 0xc2, 0xeb, 0xd6,
-	0xec, 0xb5, 0xeb, 0x43, 0xf9, 0x2a, 0xb6, 0xa2, 0x64, 0xfb, 0x08, 0x6f, 0x27, 0xb2, 0x35, 0xdf,
-	0x4c, 0x6e, 0xdc, 0xeb, 0x78, 0x58, 0xef, 0x1b, 0xea, 0x66, 0xc6, 0x71, 0xe3, 0x67, 0x08, 0x5e,
-	0x8c, 0xef, 0x9c, 0xf8, 0xdd, 0x41, 0x02, 0x8f, 0xe9, 0xe8, 0xda, 0x7b, 0xc3, 0x0b, 0x0c, 0x74,
-	0xb4, 0xfd, 0xe1, 0xcb, 0xc2, 0x8c, 0xe9, 0x73, 0xfd, 0x14, 0x66, 0xf7, 0xc6, 0xdb, 0x4f, 0x61,
-	0x26, 0x34, 0xd7, 0x3e, 0x0b, 0xb3, 0x07, 0x61, 0xeb, 0x6e, 0xe3, 0x7f, 0x11, 0x64, 0xba, 0xf5,
-	0x47, 0xbc, 0x34, 0x40, 0xac, 0xf1, 0xad, 0x5b, 0x5b, 0x1e, 0x45, 0x42, 0x31, 0xdf, 0x91, 0xcc,
-	0x1b, 0xf8, 0xe6, 0x28, 0xcc, 0xed, 0x0d, 0x1e, 0xff, 0x84, 0xe0, 0x74, 0xa4, 0x3d, 0xe3, 0xab,
-	0xbd, 0x63, 0x8d, 0x6b, 0xf6, 0xda, 0x1b, 0x03, 0xfb, 0x29, 0xb0, 0x2b, 0x12, 0xec, 0x22, 0x5e,
-	0x48, 0x04, 0x2b, 0x07, 0xbe, 0xc5, 0x46, 0x6b, 0x5f, 0x5e, 0x7f, 0x7c, 0x98, 0x45, 0x4f, 0x0e,
-	0xb3, 0xe8, 0x8f, 0xc3, 0x2c, 0xfa, 0xfe, 0x28, 0x9b, 0x7a, 0x72, 0x94, 0x4d, 0x3d, 0x3d, 0xca,
-	0xa6, 0x3e, 0x7e, 0xbd, 0xdb, 0x88, 0xf0, 0x55, 0x54, 0x58, 0x4e, 0x0c, 0xa5, 0x71, 0xf9, 0x7f,
-	0xc2, 0x95, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xbc, 0xf6, 0x99, 0x47, 0x11, 0x00, 0x00,
+	// 1195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x6e, 0x9a, 0xd2, 0x17, 0x4a, 0x93, 0x69, 0x55, 0x99, 0x4d, 0xb0, 0xa3, 0x0d,
+	0x6d, 0x02, 0xa1, 0xde, 0x36, 0x15, 0x2d, 0xb4, 0x44, 0x34, 0x3f, 0xa9, 0x44, 0x95, 0xa6, 0xa6,
+	0x4a, 0x42, 0x01, 0x99, 0xb5, 0x77, 0x58, 0xaf, 0xba, 0xde, 0x71, 0x77, 0xc6, 0x09, 0x51, 0x55,
+	0x0e, 0x14, 0xa4, 0x1e, 0x91, 0xb8, 0xf4, 0x18, 0x89, 0x1b, 0xf7, 0x5e, 0xf8, 0x0b, 0x2a, 0x4e,
+	0x95, 0x90, 0x10, 0xe2, 0xc0, 0x8f, 0x84, 0x43, 0x25, 0xc4, 0x99, 0x2b, 0xf2, 0xec, 0xac, 0xbd,
+	0x6b, 0xaf, 0xd7, 0xbf, 0xe8, 0x2d, 0x7a, 0x3b, 0xef, 0x3b, 0xef, 0xf3, 0x66, 0xde, 0xf8, 0xab,
+	0xc0, 0x74, 0x91, 0xb2, 0x32, 0x65, 0x9a, 0x61, 0x31, 0xee, 0x5a, 0x85, 0x2a, 0xb7, 0xa8, 0xa3,
+	0x6d, 0x9f, 0x2f, 0x10, 0xae, 0x9f, 0xd7, 0xee, 0x56, 0x89, 0xbb, 0x9b, 0xad, 0xb8, 0x94, 0x53,
+	0x3c, 0xee, 0x2d, 0xcc, 0x06, 0x17, 0x66, 0xe5, 0x42, 0xe5, 0x75, 0xa9, 0x52, 0xd0, 0x19, 0xf1,
+	0xb2, 0xea, 0x1a, 0x15, 0xdd, 0xb4, 0x1c, 0x5d, 0xac, 0x16, 0x42, 0xca, 0x49, 0x93, 0x9a, 0x54,
+	0xfc, 0xa9, 0xd5, 0xfe, 0x92, 0xd1, 0x09, 0x93, 0x52, 0xd3, 0x26, 0x9a, 0x5e, 0xb1, 0x34, 0xdd,
+	0x71, 0x28, 0x17, 0x29, 0x4c, 0x7e, 0x4d, 0x07, 0xf5, 0x7d, 0xe5, 0x22, 0xb5, 0x7c, 0xcd, 0x6c,
+	0x1c, 0x45, 0xa8, 0x62, 0xb1, 0x5e, 0x3d, 0x09, 0xf8, 0x66, 0xad, 0xca, 0x75, 0xdd, 0xd5, 0xcb,
+	0x2c, 0x47, 0xee, 0x56, 0x09, 0xe3, 0xea, 0x16, 0x9c, 0x08, 0x45, 0x59, 0x85, 0x3a, 0x8c, 0xe0,
+	0x05, 0x18, 0xae, 0x88, 0x48, 0x0a, 0x4d, 0xa2, 0x99, 0x91, 0xb9, 0xa9, 0x6c, 0x4c, 0x2b, 0xb2,
+	0x5e, 0xf2, 0xe2, 0xd0, 0x93, 0xdf, 0x32, 0x89, 0x9c, 0x4c, 0x54, 0x37, 0x60, 0x5a, 0x28, 0x6f,
+	0xe8, 0xb6, 0x65, 0xe8, 0x9c, 0xba, 0x37, 0xaa, 0x9c, 0x71, 0xdd, 0x31, 0x2c, 0xc7, 0xcc, 0x91,
+	0x1d, 0xdd, 0x35, 0xfc, 0x22, 0xf0, 0x2c, 0x8c, 0x6d, 0xfb, 0xab, 0xf2, 0xba, 0x61, 0xb8, 0x84,
+	0x79, 0x1b, 0x1f, 0xcd, 0x8d, 0xd6, 0x3f, 0x2c, 0x78, 0x71, 0xf5, 0x2b, 0x04, 0x33, 0x9d, 0x85,
+	0x25, 0xc7, 0x16, 0x1c, 0x71, 0xbd, 0x90, 0x04, 0x79, 0x2b, 0x16, 0x24, 0x46, 0x52, 0xd2, 0xf9,
+	0x72, 0xea, 0x1a, 0x64, 0xc2, 0x55, 0x2c, 0xd1, 0x72, 0xd9, 0x62, 0xcc, 0xa2, 0x4e, 0x5f, 0x58,
+	0x5f, 0x23, 0x98, 0x6c, 0x2f, 0x28, 0x71, 0x74, 0x80, 0x62, 0x3d, 0x2a, 0x89, 0xae, 0x74, 0x47,
+	0xb4, 0x50, 0x2c, 0x56, 0xcb, 0x55, 0x5b, 0xe7, 0xc4, 0x68, 0x08, 0x4b, 0xa8, 0x80, 0xa8, 0xfa,
+	0x37, 0x82, 0x89, 0x70, 0x1d, 0x1f, 0xd8, 0x3a, 0x2b, 0x91, 0xbe, 0x0e, 0x0b, 0x4f, 0xc3, 0x71,
+	0xc6, 0x75, 0x97, 0x5b, 0x8e, 0x99, 0x2f, 0x11, 0xcb, 0x2c, 0xf1, 0x54, 0x72, 0x12, 0xcd, 0x0c,
+	0xe5, 0x5e, 0xf2, 0xc3, 0xd7, 0x44, 0x14, 0x4f, 0xc1, 0x31, 0xe2, 0x18, 0x81, 0x65, 0x87, 0xc4,
+	0xb2, 0x17, 0xbd, 0xa0, 0x5c, 0xb4, 0x0a, 0xd0, 0x18, 0xad, 0xd4, 0x90, 0xc0, 0x3f, 0xe3, 0xe3,
+	0xd7, 0xe6, 0x24, 0xeb, 0x4d, 0x6f, 0xe3, 0x5e, 0x9a, 0x44, 0x96, 0x9d, 0x0b, 0x64, 0x5e, 0x7e,
+	0xe1, 0xe1, 0x5e, 0x26, 0xf1, 0x68, 0x2f, 0x83, 0xd4, 0x1f, 0x10, 0xbc, 0xd2, 0x86, 0x56, 0xb6,
+	0x7c, 0x1d, 0x8e, 0x30, 0x2f, 0x94, 0x42, 0x93, 0x87, 0x66, 0x46, 0xe6, 0xce, 0x75, 0xd7, 0x6f,
+	0xa1, 0xb3, 0xb2, 0x4d, 0x1c, 0xee, 0xdf, 0x1c, 0x29, 0x83, 0xdf, 0x0b, 0x51, 0x24, 0x05, 0xc5,
+	0x74, 0x47, 0x0a, 0xaf, 0x9c, 0x20, 0x86, 0xfa, 0xc0, 0x2f, 0x7e, 0x99, 0xd8, 0xc4, 0x14, 0xb1,
+	0xd6, 0xc1, 0x32, 0xbc, 0x6f, 0xad, 0x67, 0x55, 0xff, 0xe0, 0x9f, 0x55, 0xe4, 0xc1, 0x26, 0xa3,
+	0x0f, 0xd6, 0x6b, 0xe1, 0xb3, 0xbd, 0x4c, 0x42, 0x7d, 0x88, 0x20, 0xdd, 0xae, 0x0a, 0xd9, 0xc3,
+	0xcf, 0x82, 0x53, 0x58, 0xeb, 0xe1, 0x44, 0x08, 0xd7, 0x07, 0x5d, 0x26, 0xc5, 0x25, 0x6a, 0x39,
+	0x8b, 0xd9, 0x5a, 0xbf, 0xbe, 0xff, 0x3d, 0x73, 0xc6, 0xb4, 0x78, 0xa9, 0x5a, 0xc8, 0x16, 0x69,
+	0x59, 0xb3, 0x2d, 0x87, 0x68, 0x76, 0xa1, 0x7c, 0x96, 0x19, 0x77, 0x34, 0xbe, 0x5b, 0x21, 0xcc,
+	0x5f, 0xce, 0x1a, 0x33, 0xf9, 0x11, 0xa8, 0x4d, 0x95, 0xdc, 0xa2, 0x5c, 0xb7, 0x07, 0x68, 0x4a,
+	0x80, 0xf3, 0x4f, 0x04, 0x53, 0xb1, 0xea, 0x12, 0x76, 0xa3, 0x19, 0xf6, 0x62, 0xec, 0x85, 0x69,
+	0xa8, 0x2d, 0xfb, 0x7b, 0x7b, 0x8a, 0x4d, 0x0f, 0x0e, 0x2e, 0xc0, 0x61, 0x5e, 0xdb, 0x2f, 0x95,
+	0x7c, 0x0e, 0x2d, 0xf4, 0xa4, 0xd5, 0x2d, 0xf9, 0xa8, 0xd5, 0x4b, 0xa9, 0x5f, 0xe7, 0x41, 0xbb,
+	0x77, 0x1d, 0x26, 0xdb, 0x2b, 0xcb, 0xce, 0xa5, 0x01, 0xea, 0xf7, 0xcc, 0x6b, 0xde, 0xd1, 0x5c,
+	0x20, 0x12, 0x50, 0xfb, 0x04, 0x5e, 0x0d, 0xab, 0x6d, 0x5a, 0xbc, 0x64, 0xb8, 0xfa, 0x8e, 0xdc,
+	0x78, 0xc0, 0x62, 0x3f, 0x86, 0xd3, 0x1d, 0xe4, 0x65, 0xc5, 0xaf, 0xc1, 0xe8, 0x8e, 0xfc, 0xd4,
+	0x24, 0x7f, 0x7c, 0x27, 0x9c, 0x12, 0x50, 0x1f, 0x87, 0x97, 0x85, 0x7a, 0xed, 0x19, 0xae, 0x3a,
+	0x16, 0xdf, 0x5d, 0xa7, 0xd4, 0xf6, 0x7f, 0x8f, 0xbf, 0x00, 0x25, 0xea, 0xa3, 0xdc, 0xef, 0x53,
+	0x18, 0xaa, 0x50, 0x6a, 0x3f, 0x97, 0x29, 0x12, 0xca, 0xea, 0x77, 0x48, 0xce, 0xd0, 0x42, 0x95,
+	0xd3, 0x1c, 0x61, 0x5c, 0xbf, 0x43, 0x56, 0x1c, 0x97, 0xda, 0x76, 0x99, 0x38, 0xbc, 0xbf, 0x87,
+	0x65, 0x35, 0xe2, 0xc1, 0xeb, 0xfb, 0xd9, 0x16, 0x2d, 0xfc, 0xd1, 0x9f, 0xc5, 0x76, 0x55, 0xca,
+	0x7e, 0xdd, 0x86, 0x11, 0xd2, 0x08, 0xcb, 0xb6, 0xcd, 0xc5, 0xce, 0x63, 0xa4, 0xa2, 0x9c, 0xc5,
+	0xa0, 0xd8, 0xff, 0xf6, 0x8c, 0xcf, 0x3d, 0x1e, 0x83, 0xc3, 0x02, 0x06, 0x3f, 0x42, 0x30, 0xec,
+	0x79, 0x29, 0xac, 0xc5, 0x16, 0xd9, 0x6a, 0xe4, 0x94, 0x73, 0xdd, 0x27, 0x78, 0x35, 0xa8, 0xb3,
+	0x5f, 0xfe, 0xf4, 0xd7, 0xb7, 0xc9, 0xd3, 0x78, 0x4a, 0x8b, 0x73, 0x92, 0x9e, 0x9b, 0xc3, 0x0f,
+	0x92, 0x30, 0x1e, 0xe3, 0x8e, 0xf0, 0x72, 0xe7, 0xed, 0x3b, 0x1b, 0x41, 0x65, 0x65, 0x40, 0x15,
+	0x49, 0xb6, 0x29, 0xc8, 0x6e, 0xe2, 0x1b, 0xb1, 0x64, 0x8d, 0x97, 0x45, 0xbb, 0xd7, 0xf2, 0xc3,
+	0x77, 0x5f, 0xa3, 0x0d, 0xfd, 0xbc, 0xff, 0x06, 0xef, 0x23, 0x38, 0x11, 0xe1, 0xcf, 0xf0, 0x3b,
+	0x3d, 0xd4, 0xdd, 0xe2, 0x13, 0x95, 0xf9, 0x3e, 0xb3, 0x25, 0xed, 0x9a, 0xa0, 0xbd, 0x86, 0x57,
+	0x07, 0xa1, 0x6d, 0x38, 0x40, 0xfc, 0x33, 0x82, 0xd1, 0x66, 0x3b, 0x84, 0xdf, 0xee, 0xa1, 0xc6,
+	0xb0, 0x61, 0x54, 0x2e, 0xf7, 0x93, 0x2a, 0xd9, 0xde, 0x17, 0x6c, 0x2b, 0x78, 0x69, 0x10, 0x36,
+	0xdf, 0x78, 0xfd, 0x83, 0x60, 0xac, 0xc5, 0xa4, 0xe0, 0x2e, 0xca, 0x6b, 0xe7, 0xaf, 0x94, 0x2b,
+	0x7d, 0xe5, 0x4a, 0xb6, 0xbc, 0x60, 0xfb, 0x10, 0x6f, 0xc6, 0xb2, 0xd5, 0x5f, 0x53, 0xa6, 0xdd,
+	0x6b, 0x79, 0x72, 0xef, 0x6b, 0xf2, 0x66, 0x46, 0x71, 0xe3, 0x67, 0x08, 0x4e, 0x45, 0x9b, 0x15,
+	0xfc, 0x6e, 0x2f, 0x85, 0x47, 0x98, 0x28, 0xe5, 0x6a, 0xff, 0x02, 0x3d, 0x1d, 0x6d, 0x77, 0xf8,
+	0x62, 0x30, 0x23, 0xac, 0x45, 0x37, 0x83, 0xd9, 0xde, 0xeb, 0x28, 0xf3, 0x7d, 0x66, 0xf7, 0x34,
+	0x98, 0x1d, 0x08, 0x1b, 0x77, 0x1b, 0xff, 0x8b, 0x20, 0xd5, 0xce, 0x92, 0xe0, 0x85, 0x1e, 0x6a,
+	0x8d, 0x76, 0x4b, 0xca, 0xe2, 0x20, 0x12, 0x92, 0xf9, 0x96, 0x60, 0x5e, 0xc3, 0xd7, 0x07, 0x61,
+	0x6e, 0xf6, 0x54, 0xf8, 0x31, 0x82, 0x63, 0x21, 0x47, 0x84, 0x2f, 0x76, 0xae, 0x35, 0xca, 0x5f,
+	0x29, 0x97, 0x7a, 0xce, 0x93, 0x60, 0x17, 0x04, 0xd8, 0x59, 0x3c, 0x1b, 0x0b, 0x56, 0xf4, 0x73,
+	0xf3, 0x35, 0x37, 0x85, 0x7f, 0x45, 0x70, 0x2a, 0xda, 0xa2, 0x74, 0x33, 0x81, 0xb1, 0x16, 0x4c,
+	0xb9, 0xda, 0xbf, 0x80, 0x44, 0x9a, 0x17, 0x48, 0x97, 0xf0, 0x9b, 0xb1, 0x48, 0x7a, 0x95, 0xd3,
+	0xbc, 0xeb, 0xa9, 0xe4, 0x03, 0x06, 0x68, 0x71, 0xf5, 0xc9, 0x7e, 0x1a, 0x3d, 0xdd, 0x4f, 0xa3,
+	0x3f, 0xf6, 0xd3, 0xe8, 0x9b, 0x83, 0x74, 0xe2, 0xe9, 0x41, 0x3a, 0xf1, 0xcb, 0x41, 0x3a, 0x71,
+	0xfb, 0x8d, 0x76, 0x96, 0xf3, 0xf3, 0xf0, 0x16, 0xc2, 0x81, 0x16, 0x86, 0xc5, 0xff, 0xa7, 0x2e,
+	0xfc, 0x37, 0x00, 0x2b, 0xd2, 0x30, 0x98, 0x97, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -967,6 +1074,8 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoRestakeEnrollments queries the delegations enrolled in the auto restake.
+	AutoRestakeEnrollments(ctx context.Context, in *QueryAutoRestakeEnrollmentsRequest, opts ...grpc.CallOption) (*QueryAutoRestakeEnrollmentsResponse, error)
 }
 
 type queryClient struct {
@@ -1058,6 +1167,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoRestakeEnrollments(ctx context.Context, in *QueryAutoRestakeEnrollmentsRequest, opts ...grpc.CallOption) (*QueryAutoRestakeEnrollmentsResponse, error) {
+	out := new(QueryAutoRestakeEnrollmentsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoRestakeEnrollments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1079,6 +1197,8 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoRestakeEnrollments queries the delegations enrolled in the auto restake.
+	AutoRestakeEnrollments(context.Context, *QueryAutoRestakeEnrollmentsRequest) (*QueryAutoRestakeEnrollmentsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1112,6 +1232,9 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) AutoRestakeEnrollments(ctx context.Context, req *QueryAutoRestakeEnrollmentsRequest) (*QueryAutoRestakeEnrollmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoRestakeEnrollments not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1279,6 +1402,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoRestakeEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoRestakeEnrollmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoRestakeEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoRestakeEnrollments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoRestakeEnrollments(ctx, req.(*QueryAutoRestakeEnrollmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1319,6 +1460,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoRestakeEnrollments",
+			Handler:    _Query_AutoRestakeEnrollments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -1944,6 +2089,97 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAutoRestakeEnrollmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoRestakeEnrollmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoRestakeEnrollmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoRestakeEnrollmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoRestakeEnrollmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoRestakeEnrollmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Enrollments) > 0 {
+		for iNdEx := len(m.Enrollments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Enrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2209,6 +2445,42 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoRestakeEnrollmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoRestakeEnrollmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Enrollments) > 0 {
+		for _, e := range m.Enrollments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3814,6 +4086,244 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoRestakeEnrollmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoRestakeEnrollmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoRestakeEnrollmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoRestakeEnrollmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoRestakeEnrollmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoRestakeEnrollmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrollments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enrollments = append(m.Enrollments, AutoRestakeEnrollment{})
+			if err := m.Enrollments[len(m.Enrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
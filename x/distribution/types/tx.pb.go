@@ -339,6 +339,83 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgSetAutoRestake enables or disables the auto restake of a delegation.
+type MsgSetAutoRestake struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	Enabled          bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoRestake) Reset()         { *m = MsgSetAutoRestake{} }
+func (m *MsgSetAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestake) ProtoMessage()    {}
+func (*MsgSetAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{8}
+}
+func (m *MsgSetAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestake.Merge(m, src)
+}
+func (m *MsgSetAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRestake proto.InternalMessageInfo
+
+// MsgSetAutoRestakeResponse defines the Msg/SetAutoRestake response type.
+type MsgSetAutoRestakeResponse struct {
+}
+
+func (m *MsgSetAutoRestakeResponse) Reset()         { *m = MsgSetAutoRestakeResponse{} }
+func (m *MsgSetAutoRestakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestakeResponse) ProtoMessage()    {}
+func (*MsgSetAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{9}
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRestakeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -348,6 +425,8 @@ func init() {
 	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgSetAutoRestake)(nil), "cosmos.distribution.v1beta1.MsgSetAutoRestake")
+	proto.RegisterType((*MsgSetAutoRestakeResponse)(nil), "cosmos.distribution.v1beta1.MsgSetAutoRestakeResponse")
 }
 
 func init() {
@@ -355,42 +434,46 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x6b, 0x13, 0x41,
-	0x1c, 0xc5, 0x77, 0x5a, 0x29, 0x76, 0x3c, 0x98, 0x2c, 0x95, 0xc6, 0x4d, 0x9c, 0x2d, 0x6b, 0x91,
-	0x80, 0xba, 0x4b, 0xe2, 0x41, 0x8c, 0x07, 0x31, 0x91, 0x42, 0x85, 0x80, 0xac, 0xa0, 0xe0, 0x41,
-	0xd9, 0xcd, 0x0c, 0xdb, 0xc1, 0xdd, 0x9d, 0xb0, 0x33, 0xdb, 0x34, 0x47, 0xc1, 0x83, 0x47, 0xc1,
-	0xbb, 0xf6, 0x28, 0x9e, 0x3d, 0xfa, 0x07, 0xe4, 0xd8, 0xa3, 0xa7, 0x28, 0xc9, 0xc5, 0x73, 0xff,
-	0x02, 0x49, 0xf6, 0x87, 0x49, 0x77, 0x93, 0x5a, 0xeb, 0x2d, 0x7c, 0xe7, 0xbd, 0xcf, 0xbc, 0x2f,
-	0x79, 0x93, 0xc0, 0xed, 0x0e, 0xe3, 0x1e, 0xe3, 0x06, 0xa6, 0x5c, 0x04, 0xd4, 0x0e, 0x05, 0x65,
-	0xbe, 0xb1, 0x5f, 0xb3, 0x89, 0xb0, 0x6a, 0x86, 0x38, 0xd0, 0xbb, 0x01, 0x13, 0x4c, 0x2e, 0x47,
-	0x2a, 0x7d, 0x56, 0xa5, 0xc7, 0x2a, 0x65, 0xc3, 0x61, 0x0e, 0x9b, 0xea, 0x8c, 0xc9, 0xa7, 0xc8,
-	0xa2, 0xa0, 0x18, 0x6c, 0x5b, 0x9c, 0xa4, 0xc0, 0x0e, 0xa3, 0x7e, 0x74, 0xae, 0x7d, 0x05, 0xf0,
-	0x4a, 0x9b, 0x3b, 0x4f, 0x89, 0x78, 0x4e, 0xc5, 0x1e, 0x0e, 0xac, 0xde, 0x43, 0x8c, 0x03, 0xc2,
-	0xb9, 0xbc, 0x0b, 0x8b, 0x98, 0xb8, 0xc4, 0xb1, 0x04, 0x0b, 0x5e, 0x59, 0xd1, 0xb0, 0x04, 0xb6,
-	0x40, 0x75, 0xbd, 0x59, 0x39, 0x1e, 0xaa, 0xa5, 0xbe, 0xe5, 0xb9, 0x0d, 0x2d, 0x23, 0xd1, 0xcc,
-	0x42, 0x3a, 0x4b, 0x50, 0x3b, 0xb0, 0xd0, 0x8b, 0xe9, 0x29, 0x69, 0x65, 0x4a, 0x2a, 0x1f, 0x0f,
-	0xd5, 0xcd, 0x88, 0x74, 0x52, 0xa1, 0x99, 0x97, 0x7b, 0xf3, 0x91, 0x1a, 0x17, 0xdf, 0x1d, 0xaa,
-	0xd2, 0xaf, 0x43, 0x55, 0xd2, 0x54, 0x78, 0x2d, 0x37, 0xb5, 0x49, 0x78, 0x97, 0xf9, 0x9c, 0x68,
-	0xdf, 0x00, 0x54, 0xda, 0xdc, 0x49, 0x8e, 0x1f, 0x25, 0x91, 0x4c, 0xd2, 0xb3, 0x02, 0xfc, 0x3f,
-	0x97, 0xdb, 0x85, 0xc5, 0x7d, 0xcb, 0xa5, 0x78, 0x0e, 0xb5, 0x72, 0x12, 0x95, 0x91, 0x68, 0x66,
-	0x21, 0x9d, 0x65, 0xf7, 0xdb, 0x86, 0xda, 0xe2, 0xf4, 0xe9, 0x92, 0x21, 0x44, 0x33, 0xaa, 0x67,
-	0x09, 0xae, 0xc5, 0x3c, 0x8f, 0x72, 0x4e, 0x99, 0x9f, 0x1f, 0x0e, 0x9c, 0x33, 0x5c, 0x15, 0xde,
-	0x58, 0x7e, 0x6d, 0x1a, 0xf0, 0x23, 0x80, 0x1b, 0x6d, 0xee, 0xec, 0x84, 0x3e, 0x9e, 0x9c, 0x86,
-	0x3e, 0x15, 0xfd, 0x27, 0x8c, 0xb9, 0xf2, 0x4b, 0xb8, 0x66, 0x79, 0x2c, 0xf4, 0x45, 0x09, 0x6c,
-	0xad, 0x56, 0x2f, 0xd5, 0xaf, 0xea, 0x71, 0xb5, 0x27, 0x3d, 0x4d, 0x2a, 0xad, 0xb7, 0x18, 0xf5,
-	0x9b, 0x37, 0x07, 0x43, 0x55, 0xfa, 0xf2, 0x43, 0xbd, 0xee, 0x50, 0xb1, 0x17, 0xda, 0x7a, 0x87,
-	0x79, 0x86, 0x4b, 0x7d, 0x62, 0xb8, 0xb6, 0x77, 0x9b, 0xe3, 0xd7, 0x86, 0xe8, 0x77, 0x09, 0x9f,
-	0x6a, 0xb9, 0x19, 0x53, 0xe5, 0x0a, 0x5c, 0xc7, 0xa4, 0xcb, 0x38, 0x15, 0x2c, 0x88, 0xbe, 0x0c,
-	0xf3, 0xcf, 0x60, 0x66, 0x15, 0x04, 0x2b, 0x79, 0xf9, 0x92, 0x05, 0xea, 0x83, 0x0b, 0x70, 0xb5,
-	0xcd, 0x1d, 0xf9, 0x2d, 0x80, 0x72, 0xce, 0x1b, 0xa9, 0xeb, 0x4b, 0x5e, 0xa4, 0x9e, 0xdb, 0x50,
-	0xa5, 0x71, 0x76, 0x4f, 0x12, 0x47, 0xfe, 0x00, 0xe0, 0xe6, 0xa2, 0x4a, 0xdf, 0x3d, 0x8d, 0xbb,
-	0xc0, 0xa8, 0x3c, 0xf8, 0x47, 0x63, 0x9a, 0xea, 0x13, 0x80, 0xe5, 0x65, 0x25, 0xbc, 0xff, 0xb7,
-	0x17, 0xe4, 0x98, 0x95, 0xd6, 0x39, 0xcc, 0x69, 0xc2, 0x37, 0x00, 0x16, 0xb3, 0x25, 0xac, 0x9d,
-	0x86, 0xce, 0x58, 0x94, 0x7b, 0x67, 0xb6, 0x24, 0x19, 0x9a, 0x8f, 0x3f, 0x8f, 0x10, 0x18, 0x8c,
-	0x10, 0x38, 0x1a, 0x21, 0xf0, 0x73, 0x84, 0xc0, 0xfb, 0x31, 0x92, 0x8e, 0xc6, 0x48, 0xfa, 0x3e,
-	0x46, 0xd2, 0x8b, 0x5b, 0x8b, 0xda, 0x7d, 0x30, 0xff, 0x9f, 0x30, 0x2d, 0xbb, 0xbd, 0x36, 0xfd,
-	0xf1, 0xbe, 0xf3, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x63, 0xac, 0x1c, 0x6d, 0x37, 0x06, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xc1, 0x6b, 0xd3, 0x50,
+	0x1c, 0xce, 0x5b, 0x71, 0x6e, 0x4f, 0xd0, 0x36, 0x4c, 0xd6, 0xa5, 0x35, 0x29, 0x71, 0x48, 0x41,
+	0x4d, 0x68, 0x05, 0xc5, 0x7a, 0x90, 0xb5, 0x32, 0x98, 0x50, 0x90, 0x08, 0x0a, 0x1e, 0x94, 0xa4,
+	0x79, 0x64, 0x8f, 0x25, 0x79, 0x25, 0xef, 0x65, 0x6d, 0x8f, 0x82, 0x07, 0x8f, 0x82, 0x77, 0xdd,
+	0x51, 0x3c, 0x7b, 0xf4, 0xe4, 0x69, 0x17, 0x61, 0x47, 0x4f, 0x55, 0xda, 0x8b, 0xe7, 0xfd, 0x05,
+	0xd2, 0xa6, 0x89, 0x6d, 0x93, 0x76, 0xab, 0xf3, 0xb0, 0x5b, 0xfb, 0x7b, 0xdf, 0xf7, 0xbd, 0xef,
+	0x23, 0xbf, 0xdf, 0xef, 0xc1, 0xcd, 0x06, 0xa1, 0x0e, 0xa1, 0xaa, 0x89, 0x29, 0xf3, 0xb0, 0xe1,
+	0x33, 0x4c, 0x5c, 0x75, 0xbf, 0x64, 0x20, 0xa6, 0x97, 0x54, 0xd6, 0x56, 0x9a, 0x1e, 0x61, 0x84,
+	0xcf, 0x05, 0x28, 0x65, 0x1c, 0xa5, 0x8c, 0x50, 0xc2, 0x9a, 0x45, 0x2c, 0x32, 0xc4, 0xa9, 0x83,
+	0x5f, 0x01, 0x45, 0x10, 0x47, 0xc2, 0x86, 0x4e, 0x51, 0x24, 0xd8, 0x20, 0xd8, 0x0d, 0xce, 0xe5,
+	0x2f, 0x00, 0x5e, 0xad, 0x53, 0xeb, 0x29, 0x62, 0xcf, 0x31, 0xdb, 0x35, 0x3d, 0xbd, 0xb5, 0x65,
+	0x9a, 0x1e, 0xa2, 0x94, 0xdf, 0x81, 0x19, 0x13, 0xd9, 0xc8, 0xd2, 0x19, 0xf1, 0x5e, 0xe9, 0x41,
+	0x31, 0x0b, 0x0a, 0xa0, 0xb8, 0x5a, 0xcd, 0x1f, 0x77, 0xa5, 0x6c, 0x47, 0x77, 0xec, 0x8a, 0x1c,
+	0x83, 0xc8, 0x5a, 0x3a, 0xaa, 0x85, 0x52, 0xdb, 0x30, 0xdd, 0x1a, 0xa9, 0x47, 0x4a, 0x4b, 0x43,
+	0xa5, 0xdc, 0x71, 0x57, 0x5a, 0x0f, 0x94, 0xa6, 0x11, 0xb2, 0x76, 0xa5, 0x35, 0x69, 0xa9, 0xb2,
+	0xf2, 0xf6, 0x40, 0xe2, 0x7e, 0x1f, 0x48, 0x9c, 0x2c, 0xc1, 0x6b, 0x89, 0xae, 0x35, 0x44, 0x9b,
+	0xc4, 0xa5, 0x48, 0xfe, 0x0a, 0xa0, 0x50, 0xa7, 0x56, 0x78, 0xfc, 0x28, 0xb4, 0xa4, 0xa1, 0x96,
+	0xee, 0x99, 0xff, 0x33, 0xdc, 0x0e, 0xcc, 0xec, 0xeb, 0x36, 0x36, 0x27, 0xa4, 0x96, 0xa6, 0xa5,
+	0x62, 0x10, 0x59, 0x4b, 0x47, 0xb5, 0x78, 0xbe, 0x4d, 0x28, 0xcf, 0x76, 0x1f, 0x85, 0xf4, 0xa1,
+	0x38, 0x86, 0x7a, 0x16, 0xca, 0xd5, 0x88, 0xe3, 0x60, 0x4a, 0x31, 0x71, 0x93, 0xcd, 0x81, 0x33,
+	0x9a, 0x2b, 0xc2, 0x1b, 0xf3, 0xaf, 0x8d, 0x0c, 0x7e, 0x00, 0x70, 0xad, 0x4e, 0xad, 0x6d, 0xdf,
+	0x35, 0x07, 0xa7, 0xbe, 0x8b, 0x59, 0xe7, 0x09, 0x21, 0x36, 0xff, 0x12, 0x2e, 0xeb, 0x0e, 0xf1,
+	0x5d, 0x96, 0x05, 0x85, 0x54, 0xf1, 0x52, 0x79, 0x43, 0x19, 0xb5, 0xf6, 0xa0, 0x4f, 0xc3, 0x96,
+	0x56, 0x6a, 0x04, 0xbb, 0xd5, 0x9b, 0x87, 0x5d, 0x89, 0xfb, 0xfc, 0x53, 0xba, 0x6e, 0x61, 0xb6,
+	0xeb, 0x1b, 0x4a, 0x83, 0x38, 0xaa, 0x8d, 0x5d, 0xa4, 0xda, 0x86, 0x73, 0x9b, 0x9a, 0x7b, 0x2a,
+	0xeb, 0x34, 0x11, 0x1d, 0x62, 0xa9, 0x36, 0x52, 0xe5, 0xf3, 0x70, 0xd5, 0x44, 0x4d, 0x42, 0x31,
+	0x23, 0x5e, 0xf0, 0x31, 0xb4, 0xbf, 0x85, 0xb1, 0x28, 0x22, 0xcc, 0x27, 0xf9, 0x8b, 0x02, 0x7c,
+	0x07, 0x30, 0x13, 0x34, 0xda, 0x96, 0xcf, 0x88, 0x86, 0x28, 0xd3, 0xf7, 0xd0, 0xf9, 0xec, 0x1e,
+	0x3e, 0x0b, 0x2f, 0x22, 0x57, 0x37, 0x6c, 0x64, 0x66, 0x53, 0x05, 0x50, 0x5c, 0xd1, 0xc2, 0xbf,
+	0x63, 0x79, 0x73, 0x70, 0x23, 0x16, 0x27, 0x0c, 0x5b, 0xfe, 0x76, 0x01, 0xa6, 0xea, 0xd4, 0xe2,
+	0xdf, 0x00, 0xc8, 0x27, 0x2c, 0x84, 0xb2, 0x32, 0x67, 0xfd, 0x28, 0x89, 0xe3, 0x28, 0x54, 0x16,
+	0xe7, 0x84, 0x76, 0xf8, 0xf7, 0x00, 0xae, 0xcf, 0x9a, 0xdf, 0x7b, 0x27, 0xe9, 0xce, 0x20, 0x0a,
+	0x0f, 0xff, 0x91, 0x18, 0xb9, 0xfa, 0x08, 0x60, 0x6e, 0xde, 0xc4, 0x3d, 0x38, 0xed, 0x05, 0x09,
+	0x64, 0xa1, 0x76, 0x06, 0x72, 0xe4, 0xf0, 0x35, 0x80, 0x99, 0xf8, 0xc4, 0x95, 0x4e, 0x92, 0x8e,
+	0x51, 0x84, 0xfb, 0x0b, 0x53, 0x22, 0x0f, 0x6d, 0x78, 0x79, 0x6a, 0x66, 0x94, 0x53, 0x74, 0xc2,
+	0x18, 0x5e, 0xb8, 0xbb, 0x18, 0x3e, 0xbc, 0xb9, 0xfa, 0xf8, 0x53, 0x4f, 0x04, 0x87, 0x3d, 0x11,
+	0x1c, 0xf5, 0x44, 0xf0, 0xab, 0x27, 0x82, 0x77, 0x7d, 0x91, 0x3b, 0xea, 0x8b, 0xdc, 0x8f, 0xbe,
+	0xc8, 0xbd, 0xb8, 0x35, 0x6b, 0x89, 0xb4, 0x27, 0x9f, 0xde, 0xe1, 0x4e, 0x31, 0x96, 0x87, 0x6f,
+	0xe4, 0x9d, 0x3f, 0x03, 0x00, 0xd7, 0xa2, 0x6a, 0x84, 0x9e, 0x07, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -477,6 +560,27 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetAutoRestakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoRestakeResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoRestakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -502,6 +606,10 @@ type MsgClient interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// SetAutoRestake defines a method to enroll a delegation in (or withdraw it
+	// from) the auto restake, which withdraws the rewards and delegates them to
+	// the same validator periodically.
+	SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error)
 }
 
 type msgClient struct {
@@ -548,6 +656,15 @@ func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityP
 	return out, nil
 }
 
+func (c *msgClient) SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error) {
+	out := new(MsgSetAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetAutoRestake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -562,6 +679,10 @@ type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// SetAutoRestake defines a method to enroll a delegation in (or withdraw it
+	// from) the auto restake, which withdraws the rewards and delegates them to
+	// the same validator periodically.
+	SetAutoRestake(context.Context, *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -580,6 +701,9 @@ func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context,
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoRestake(ctx context.Context, req *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRestake not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -657,6 +781,24 @@ func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRestake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetAutoRestake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRestake(ctx, req.(*MsgSetAutoRestake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -677,6 +819,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
+		{
+			MethodName: "SetAutoRestake",
+			Handler:    _Msg_SetAutoRestake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -922,6 +1068,76 @@ func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoRestakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRestakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRestakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1035,6 +1251,35 @@ func (m *MsgFundCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoRestakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1667,6 +1912,190 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoRestake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoRestakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,8 +27,10 @@ func GetQueryCmd() *cobra.Command {
 Example:
 $ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
 $ %s query %s --page=2 --limit=50
+$ %s query %s by-type %s
 `,
 				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, types.TypeFoundationMisbehavior,
 			),
 		),
 		Args:                       cobra.MaximumNArgs(1),
@@ -40,6 +42,47 @@ $ %s query %s --page=2 --limit=50
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "evidence")
 
+	cmd.AddCommand(QueryEvidenceByTypeCmd())
+
+	return cmd
+}
+
+// QueryEvidenceByTypeCmd returns the command to query all (paginated) evidence
+// of the given type.
+func QueryEvidenceByTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-type [evidence-type]",
+		Short: "Query for all (paginated) submitted evidence of the given type",
+		Example: fmt.Sprintf(`$ %s query %s by-type %s --page=2 --limit=50`,
+			version.AppName, types.ModuleName, types.TypeEquivocation),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EvidenceByType(cmd.Context(), &types.QueryEvidenceByTypeRequest{
+				EvidenceType: args[0],
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "evidence by type")
+
 	return cmd
 }
 
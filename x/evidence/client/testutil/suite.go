@@ -53,6 +53,16 @@ func (s *IntegrationTestSuite) TestGetQueryCmd() {
 			"evidence: []\npagination:\n  next_key: null\n  total: \"0\"",
 			false,
 		},
+		"evidence by type": {
+			[]string{"by-type", "equivocation"},
+			"evidence: []\npagination:\n  next_key: null\n  total: \"0\"",
+			false,
+		},
+		"evidence by type without the type": {
+			[]string{"by-type"},
+			"accepts 1 arg(s), received 0",
+			true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -37,6 +37,15 @@ type ValidatorEvidence interface {
 	GetTotalPower() int64
 }
 
+// ReportedEvidence extends Evidence interface to define contract for evidence
+// which records its reporter. Such evidence must be submitted by the reporter.
+type ReportedEvidence interface {
+	Evidence
+
+	// The address of the account reporting the misbehavior
+	GetReporter() sdk.AccAddress
+}
+
 // MsgSubmitEvidenceI defines the specific interface a concrete message must
 // implement in order to process submitted evidence. The concrete MsgSubmitEvidence
 // must be defined at the application-level.
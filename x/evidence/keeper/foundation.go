@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/evidence/exported"
+	"github.com/line/lbm-sdk/x/evidence/types"
+)
+
+// NewFoundationMisbehaviorHandler returns an Evidence Handler for the
+// FoundationMisbehavior type, which freezes the misbehaving member on x/foundation.
+// It is expected to be registered on the Router with RouteFoundationMisbehavior.
+//
+// The evidence is only accepted from the x/foundation authority or the given
+// reporters, e.g. the operators of the chain. It is considered invalid if:
+// - the reporter is neither the authority nor one of the reporters
+// - the infraction height is in the future
+// - the proposal does not exist
+// - the member has not voted on the proposal
+// - the member is not a foundation member
+func NewFoundationMisbehaviorHandler(fk types.FoundationKeeper, reporters ...sdk.AccAddress) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.FoundationMisbehavior)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("unexpected evidence type: %T", e)
+		}
+
+		if !isFoundationReporter(fk, evidence.GetReporter(), reporters) {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to report foundation misbehavior", evidence.Reporter)
+		}
+
+		if evidence.GetHeight() > ctx.BlockHeight() {
+			return fmt.Errorf("infraction height %d is in the future; current height %d", evidence.GetHeight(), ctx.BlockHeight())
+		}
+
+		if _, err := fk.GetProposal(ctx, evidence.ProposalId); err != nil {
+			return err
+		}
+		if _, err := fk.GetVote(ctx, evidence.ProposalId, evidence.GetMember()); err != nil {
+			return err
+		}
+
+		return fk.FreezeMember(ctx, evidence.GetMember())
+	}
+}
+
+func isFoundationReporter(fk types.FoundationKeeper, reporter sdk.AccAddress, reporters []sdk.AccAddress) bool {
+	if reporter.String() == fk.GetAuthority() {
+		return true
+	}
+
+	for _, r := range reporters {
+		if reporter.Equals(r) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/evidence/keeper"
+	"github.com/line/lbm-sdk/x/evidence/types"
+	"github.com/line/lbm-sdk/x/foundation"
+	foundationkeeper "github.com/line/lbm-sdk/x/foundation/keeper"
+)
+
+func (suite *KeeperTestSuite) addFoundationMember(ctx sdk.Context) sdk.AccAddress {
+	member := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	gs := suite.app.FoundationKeeper.ExportGenesis(ctx)
+	gs.Members = append(gs.Members, foundation.Member{Address: member.String()})
+	gs.Foundation.TotalWeight = gs.Foundation.TotalWeight.Add(sdk.OneDec())
+	err := gs.Foundation.SetDecisionPolicy(&foundation.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.FoundationKeeper.InitGenesis(ctx, gs))
+
+	return member
+}
+
+// submitFoundationProposal submits a foundation proposal by the member, which
+// votes on it.
+func (suite *KeeperTestSuite) submitFoundationProposal(ctx sdk.Context, member sdk.AccAddress) uint64 {
+	msgServer := foundationkeeper.NewMsgServer(suite.app.FoundationKeeper)
+
+	req := &foundation.MsgSubmitProposal{
+		Proposers: []string{member.String()},
+	}
+	err := req.SetMsgs([]sdk.Msg{&foundation.MsgWithdrawFromTreasury{
+		Authority: suite.app.FoundationKeeper.GetAuthority(),
+		To:        member.String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)),
+	}})
+	suite.Require().NoError(err)
+
+	res, err := msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+
+	_, err = msgServer.Vote(sdk.WrapSDKContext(ctx), &foundation.MsgVote{
+		ProposalId: res.ProposalId,
+		Voter:      member.String(),
+		Option:     foundation.VOTE_OPTION_NO,
+	})
+	suite.Require().NoError(err)
+
+	return res.ProposalId
+}
+
+func (suite *KeeperTestSuite) TestHandleFoundationMisbehavior() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	member := suite.addFoundationMember(ctx)
+	proposalID := suite.submitFoundationProposal(ctx, member)
+	nonVoter := suite.addFoundationMember(ctx)
+	authority := sdk.MustAccAddressFromBech32(suite.app.FoundationKeeper.GetAuthority())
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		reporter   sdk.AccAddress
+		member     sdk.AccAddress
+		proposalID uint64
+		height     int64
+		valid      bool
+	}{
+		"valid evidence by the authority": {
+			reporter:   authority,
+			member:     member,
+			proposalID: proposalID,
+			height:     10,
+			valid:      true,
+		},
+		"valid evidence by an operator": {
+			reporter:   operator,
+			member:     member,
+			proposalID: proposalID,
+			height:     10,
+			valid:      true,
+		},
+		"not allowed reporter": {
+			reporter:   sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+			member:     member,
+			proposalID: proposalID,
+			height:     10,
+		},
+		"not a member": {
+			reporter:   authority,
+			member:     sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+			proposalID: proposalID,
+			height:     10,
+		},
+		"no such proposal": {
+			reporter:   authority,
+			member:     member,
+			proposalID: proposalID + 1,
+			height:     10,
+		},
+		"not voted": {
+			reporter:   authority,
+			member:     nonVoter,
+			proposalID: proposalID,
+			height:     10,
+		},
+		"future infraction": {
+			reporter:   authority,
+			member:     member,
+			proposalID: proposalID,
+			height:     11,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			handler := keeper.NewFoundationMisbehaviorHandler(suite.app.FoundationKeeper, operator)
+			e := &types.FoundationMisbehavior{
+				Height:      tc.height,
+				Time:        time.Now().UTC(),
+				Member:      tc.member.String(),
+				ProposalId:  tc.proposalID,
+				Description: "censoring the proposal",
+				Reporter:    tc.reporter.String(),
+			}
+			err := handler(ctx, e)
+			if !tc.valid {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			res, err := foundationkeeper.NewQueryServer(suite.app.FoundationKeeper).Member(sdk.WrapSDKContext(ctx), &foundation.QueryMemberRequest{
+				Address: tc.member.String(),
+			})
+			suite.Require().NoError(err)
+			suite.Require().True(res.Member.Frozen)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitFoundationMisbehavior() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	member := suite.addFoundationMember(ctx)
+	proposalID := suite.submitFoundationProposal(ctx, member)
+	authority := sdk.MustAccAddressFromBech32(suite.app.FoundationKeeper.GetAuthority())
+
+	testCases := map[string]struct {
+		submitter sdk.AccAddress
+		valid     bool
+	}{
+		"submitted by the reporter": {
+			submitter: authority,
+			valid:     true,
+		},
+		"submitted by another account": {
+			submitter: member,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			e := &types.FoundationMisbehavior{
+				Height:     10,
+				Time:       time.Now().UTC(),
+				Member:     member.String(),
+				ProposalId: proposalID,
+				Reporter:   authority.String(),
+			}
+			msg, err := types.NewMsgSubmitEvidence(tc.submitter, e)
+			suite.Require().NoError(err)
+
+			_, err = keeper.NewMsgServerImpl(suite.app.EvidenceKeeper).SubmitEvidence(sdk.WrapSDKContext(ctx), msg)
+			if !tc.valid {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			_, ok := suite.app.EvidenceKeeper.GetEvidence(ctx, e.Hash())
+			suite.Require().True(ok)
+		})
+	}
+}
@@ -85,3 +85,46 @@ func (k Keeper) AllEvidence(c context.Context, req *types.QueryAllEvidenceReques
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidence, Pagination: pageRes}, nil
 }
+
+// EvidenceByType implements the Query/EvidenceByType gRPC method
+func (k Keeper) EvidenceByType(c context.Context, req *types.QueryEvidenceByTypeRequest) (*types.QueryEvidenceByTypeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.EvidenceType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid evidence type")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var evidence []*codectypes.Any
+	store := ctx.KVStore(k.storeKey)
+	evidenceStore := prefix.NewStore(store, types.KeyPrefixEvidence)
+
+	pageRes, err := query.FilteredPaginate(evidenceStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		result, err := k.UnmarshalEvidence(value)
+		if err != nil {
+			return false, err
+		}
+
+		if result.Type() != req.EvidenceType {
+			return false, nil
+		}
+
+		if accumulate {
+			evidenceAny, err := codectypes.NewAnyWithValue(result)
+			if err != nil {
+				return false, err
+			}
+			evidence = append(evidence, evidenceAny)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEvidenceByTypeResponse{Evidence: evidence, Pagination: pageRes}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/query"
@@ -139,3 +140,71 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryEvidenceByType() {
+	suite.SetupTest()
+	ctx := suite.ctx.WithBlockHeight(10)
+
+	_ = suite.populateEvidence(ctx, 10)
+	member := suite.addFoundationMember(ctx)
+	misbehavior := &types.FoundationMisbehavior{
+		Height:     10,
+		Time:       time.Now().UTC(),
+		Member:     member.String(),
+		ProposalId: suite.submitFoundationProposal(ctx, member),
+		Reporter:   suite.app.FoundationKeeper.GetAuthority(),
+	}
+	suite.Require().NoError(suite.app.EvidenceKeeper.SubmitEvidence(ctx, misbehavior))
+
+	testCases := map[string]struct {
+		req      *types.QueryEvidenceByTypeRequest
+		expected int
+		expPass  bool
+	}{
+		"empty request": {
+			req: &types.QueryEvidenceByTypeRequest{},
+		},
+		"equivocation": {
+			req:      &types.QueryEvidenceByTypeRequest{EvidenceType: types.TypeEquivocation},
+			expected: 10,
+			expPass:  true,
+		},
+		"equivocation (paginated)": {
+			req: &types.QueryEvidenceByTypeRequest{
+				EvidenceType: types.TypeEquivocation,
+				Pagination:   &query.PageRequest{Limit: 3},
+			},
+			expected: 3,
+			expPass:  true,
+		},
+		"foundation misbehavior": {
+			req:      &types.QueryEvidenceByTypeRequest{EvidenceType: types.TypeFoundationMisbehavior},
+			expected: 1,
+			expPass:  true,
+		},
+		"unknown type": {
+			req:     &types.QueryEvidenceByTypeRequest{EvidenceType: "unknown"},
+			expPass: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			res, err := suite.queryClient.EvidenceByType(sdk.WrapSDKContext(ctx), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Evidence, tc.expected)
+
+			for _, evidenceAny := range res.Evidence {
+				var evidence exported.Evidence
+				err := suite.app.InterfaceRegistry().UnpackAny(evidenceAny, &evidence)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.req.EvidenceType, evidence.Type())
+			}
+		})
+	}
+}
@@ -104,7 +104,7 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
-	k.slashingKeeper.Slash(
+	tokensBurned := k.slashingKeeper.Slash(
 		ctx,
 		consAddr,
 		k.slashingKeeper.SlashFractionDoubleSign(ctx),
@@ -119,5 +119,6 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
+	k.slashingKeeper.RecordDoubleSign(ctx, consAddr, infractionHeight, infractionTime, tokensBurned)
 	k.SetEvidence(ctx, evidence)
 }
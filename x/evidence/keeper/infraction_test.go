@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/evidence/types"
+	slashingtypes "github.com/line/lbm-sdk/x/slashing/types"
 	"github.com/line/lbm-sdk/x/staking"
 	"github.com/line/lbm-sdk/x/staking/teststaking"
 )
@@ -49,11 +50,29 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
 	suite.True(newTokens.LT(oldTokens))
 
+	// the infraction should have been recorded
+	expInfractions := []slashingtypes.Infraction{
+		slashingtypes.NewInfraction(
+			sdk.ConsAddress(val.Address()), slashingtypes.InfractionDoubleSign, evidence.Height, evidence.Time.UTC(),
+			suite.app.SlashingKeeper.SlashFractionDoubleSign(ctx), oldTokens.Sub(newTokens), types.DoubleSignJailEndTime.UTC(),
+		),
+	}
+	getInfractions := func() []slashingtypes.Infraction {
+		var infractions []slashingtypes.Infraction
+		suite.app.SlashingKeeper.IterateInfractions(ctx, sdk.ConsAddress(val.Address()), func(infraction slashingtypes.Infraction) bool {
+			infractions = append(infractions, infraction)
+			return false
+		})
+		return infractions
+	}
+	suite.Equal(expInfractions, getInfractions())
+
 	// submit duplicate evidence
 	suite.app.EvidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
 
 	// tokens should be the same (capped slash)
 	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+	suite.Equal(expInfractions, getInfractions())
 
 	// jump to past the unbonding period
 	ctx = ctx.WithBlockTime(time.Unix(1, 0).Add(stakingParams.UnbondingTime))
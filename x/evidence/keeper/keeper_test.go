@@ -89,6 +89,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	)
 	router := types.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(*evidenceKeeper))
+	router = router.AddRoute(types.RouteFoundationMisbehavior, keeper.NewFoundationMisbehaviorHandler(app.FoundationKeeper))
 	evidenceKeeper.SetRouter(router)
 
 	app.EvidenceKeeper = *evidenceKeeper
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/evidence/exported"
 	"github.com/line/lbm-sdk/x/evidence/types"
 )
 
@@ -24,6 +26,12 @@ func (ms msgServer) SubmitEvidence(goCtx context.Context, msg *types.MsgSubmitEv
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	evidence := msg.GetEvidence()
+	if reported, ok := evidence.(exported.ReportedEvidence); ok {
+		if submitter := msg.GetSubmitter(); !reported.GetReporter().Equals(submitter) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("evidence reported by %s cannot be submitted by %s", reported.GetReporter(), submitter)
+		}
+	}
+
 	if err := ms.Keeper.SubmitEvidence(ctx, evidence); err != nil {
 		return nil, err
 	}
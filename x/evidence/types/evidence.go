@@ -17,9 +17,16 @@ import (
 const (
 	RouteEquivocation = "equivocation"
 	TypeEquivocation  = "equivocation"
+
+	RouteFoundationMisbehavior = "foundation"
+	TypeFoundationMisbehavior  = "foundation_misbehavior"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence         = &Equivocation{}
+	_ exported.Evidence         = &FoundationMisbehavior{}
+	_ exported.ReportedEvidence = &FoundationMisbehavior{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -101,3 +108,64 @@ func FromABCIEvidence(e abci.Evidence) exported.Evidence {
 		Time:             e.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a FoundationMisbehavior type.
+func (e *FoundationMisbehavior) Route() string { return RouteFoundationMisbehavior }
+
+// Type returns the Evidence Handler type for a FoundationMisbehavior type.
+func (e *FoundationMisbehavior) Type() string { return TypeFoundationMisbehavior }
+
+func (e *FoundationMisbehavior) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a FoundationMisbehavior object.
+func (e *FoundationMisbehavior) Hash() ostbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a FoundationMisbehavior object.
+func (e *FoundationMisbehavior) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid foundation misbehavior time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid foundation misbehavior height: %d", e.Height)
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Member); err != nil {
+		return fmt.Errorf("invalid foundation misbehavior member address: %w", err)
+	}
+	if e.ProposalId == 0 {
+		return fmt.Errorf("invalid foundation misbehavior proposal id: %d", e.ProposalId)
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Reporter); err != nil {
+		return fmt.Errorf("invalid foundation misbehavior reporter address: %w", err)
+	}
+
+	return nil
+}
+
+// GetMember returns the address of the misbehaving foundation member.
+func (e FoundationMisbehavior) GetMember() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(e.Member)
+}
+
+// GetReporter returns the address of the account reporting the misbehavior.
+func (e FoundationMisbehavior) GetReporter() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(e.Reporter)
+}
+
+// GetHeight returns the height at time of the FoundationMisbehavior infraction.
+func (e FoundationMisbehavior) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the FoundationMisbehavior infraction.
+func (e FoundationMisbehavior) GetTime() time.Time {
+	return e.Time
+}
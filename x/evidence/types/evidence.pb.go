@@ -68,8 +68,55 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// FoundationMisbehavior implements the Evidence interface and defines evidence
+// of a foundation member misbehaving on foundation proposals (e.g. censoring or
+// double-voting). Handling it freezes the member in x/foundation.
+type FoundationMisbehavior struct {
+	Height      int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time        time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	Member      string    `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	ProposalId  uint64    `protobuf:"varint,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Description string    `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// reporter is the address of the account reporting the misbehavior, which
+	// must be the submitter of the evidence.
+	Reporter string `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *FoundationMisbehavior) Reset()      { *m = FoundationMisbehavior{} }
+func (*FoundationMisbehavior) ProtoMessage() {}
+func (*FoundationMisbehavior) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *FoundationMisbehavior) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FoundationMisbehavior) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FoundationMisbehavior.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FoundationMisbehavior) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FoundationMisbehavior.Merge(m, src)
+}
+func (m *FoundationMisbehavior) XXX_Size() int {
+	return m.Size()
+}
+func (m *FoundationMisbehavior) XXX_DiscardUnknown() {
+	xxx_messageInfo_FoundationMisbehavior.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FoundationMisbehavior proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*FoundationMisbehavior)(nil), "cosmos.evidence.v1beta1.FoundationMisbehavior")
 }
 
 func init() {
@@ -77,28 +124,34 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x3f, 0x4f, 0xfa, 0x40,
-	0x1c, 0xc6, 0xef, 0x7e, 0xf0, 0x23, 0x5a, 0x19, 0xb4, 0x21, 0xda, 0x10, 0x73, 0x47, 0x18, 0x4c,
-	0x17, 0x7b, 0x41, 0x17, 0xc3, 0x26, 0x89, 0x26, 0xae, 0xc4, 0xc9, 0xc5, 0xf4, 0xcf, 0x59, 0x2e,
-	0xb6, 0xfd, 0x56, 0xee, 0x8a, 0xf2, 0x0e, 0x1c, 0x19, 0x1d, 0x19, 0x7d, 0x29, 0x6c, 0x32, 0x3a,
-	0xa1, 0x29, 0x8b, 0xb3, 0xaf, 0xc0, 0xd0, 0x83, 0x3a, 0xb8, 0xdd, 0xf3, 0xe4, 0xf3, 0x7c, 0x92,
-	0xfb, 0x1a, 0x47, 0x3e, 0xc8, 0x18, 0x24, 0xe3, 0x23, 0x11, 0xf0, 0xc4, 0xe7, 0x6c, 0xd4, 0xf1,
-	0xb8, 0x72, 0x3b, 0x65, 0xe1, 0xa4, 0x43, 0x50, 0x60, 0x1e, 0x68, 0xce, 0x29, 0xeb, 0x35, 0xd7,
-	0x6c, 0x84, 0x10, 0x42, 0xc1, 0xb0, 0xd5, 0x4b, 0xe3, 0x4d, 0x1a, 0x02, 0x84, 0x11, 0x67, 0x45,
-	0xf2, 0xb2, 0x3b, 0xa6, 0x44, 0xcc, 0xa5, 0x72, 0xe3, 0x54, 0x03, 0xed, 0x37, 0x6c, 0xd4, 0x2f,
-	0x1e, 0x32, 0x31, 0x02, 0xdf, 0x55, 0x02, 0x12, 0x73, 0xdf, 0xa8, 0x0d, 0xb8, 0x08, 0x07, 0xca,
-	0xc2, 0x2d, 0x6c, 0x57, 0xfa, 0xeb, 0x64, 0x9e, 0x19, 0xd5, 0xd5, 0xd6, 0xfa, 0xd7, 0xc2, 0xf6,
-	0xce, 0x49, 0xd3, 0xd1, 0x62, 0x67, 0x23, 0x76, 0xae, 0x37, 0xe2, 0xde, 0xd6, 0x6c, 0x41, 0xd1,
-	0xe4, 0x83, 0xe2, 0x7e, 0xb1, 0x30, 0x1b, 0xc6, 0xff, 0x14, 0x1e, 0xf9, 0xd0, 0xaa, 0x14, 0x42,
-	0x1d, 0xcc, 0x2b, 0x63, 0xcf, 0x87, 0x44, 0xf2, 0x44, 0x66, 0xf2, 0xd6, 0x0d, 0x82, 0x21, 0x97,
-	0xd2, 0xaa, 0xb6, 0xb0, 0xbd, 0xdd, 0x3b, 0xfc, 0x5e, 0x50, 0x6b, 0xec, 0xc6, 0x51, 0xb7, 0xfd,
-	0x07, 0x69, 0xf7, 0x77, 0xcb, 0xee, 0x5c, 0x57, 0xdd, 0xfa, 0xf3, 0x94, 0xa2, 0x97, 0x29, 0x45,
-	0x5f, 0x53, 0x8a, 0x7a, 0x97, 0xaf, 0x39, 0xc1, 0xb3, 0x9c, 0xe0, 0x79, 0x4e, 0xf0, 0x67, 0x4e,
-	0xf0, 0x64, 0x49, 0xd0, 0x7c, 0x49, 0xd0, 0xfb, 0x92, 0xa0, 0x1b, 0x3b, 0x14, 0x6a, 0x90, 0x79,
-	0x8e, 0x0f, 0x31, 0x8b, 0x44, 0xc2, 0x59, 0xe4, 0xc5, 0xc7, 0x32, 0xb8, 0x67, 0x4f, 0xbf, 0xa7,
-	0x57, 0xe3, 0x94, 0x4b, 0xaf, 0x56, 0x7c, 0xed, 0xf4, 0x27, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x1f,
-	0xd0, 0xf8, 0x9a, 0x01, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0x7d, 0x6d, 0x1a, 0xb5, 0x97, 0x0e, 0x70, 0x2a, 0xc1, 0x8a, 0x90, 0x1d, 0x79, 0x40,
+	0x59, 0xb0, 0x55, 0x18, 0x40, 0xdd, 0x88, 0x44, 0xa5, 0x0e, 0x2c, 0x16, 0x13, 0x4b, 0x65, 0xfb,
+	0x1e, 0xce, 0x09, 0x9f, 0xdf, 0x71, 0x77, 0x0e, 0xf4, 0x1f, 0x40, 0x8c, 0x1d, 0x19, 0x33, 0xf2,
+	0xa7, 0x74, 0xa3, 0x23, 0x53, 0x40, 0xc9, 0xc2, 0xdc, 0xbf, 0x00, 0xe5, 0x9c, 0x84, 0x22, 0xe6,
+	0x6e, 0xfe, 0xbe, 0xf7, 0x7b, 0x9f, 0xfc, 0x3d, 0x1d, 0x7d, 0x5c, 0xa0, 0x91, 0x68, 0x12, 0x98,
+	0x0a, 0x0e, 0x75, 0x01, 0xc9, 0xf4, 0x38, 0x07, 0x9b, 0x1d, 0x6f, 0x8d, 0x58, 0x69, 0xb4, 0xc8,
+	0x1e, 0xb6, 0x5c, 0xbc, 0xb5, 0xd7, 0xdc, 0xe0, 0xa8, 0xc4, 0x12, 0x1d, 0x93, 0xac, 0xbe, 0x5a,
+	0x7c, 0x10, 0x96, 0x88, 0x65, 0x05, 0x89, 0x53, 0x79, 0xf3, 0x2e, 0xb1, 0x42, 0x82, 0xb1, 0x99,
+	0x54, 0x2d, 0x10, 0x7d, 0x27, 0xf4, 0xf0, 0xd5, 0x87, 0x46, 0x4c, 0xb1, 0xc8, 0xac, 0xc0, 0x9a,
+	0xf5, 0x69, 0x77, 0x02, 0xa2, 0x9c, 0x58, 0x9f, 0x0c, 0xc9, 0x68, 0x37, 0x5d, 0x2b, 0xf6, 0x82,
+	0x76, 0x56, 0xbb, 0xfe, 0xce, 0x90, 0x8c, 0x7a, 0x4f, 0x07, 0x71, 0x1b, 0x1c, 0x6f, 0x82, 0xe3,
+	0x37, 0x9b, 0xe0, 0xf1, 0xfe, 0xd5, 0x3c, 0xf4, 0x2e, 0x7f, 0x86, 0x24, 0x75, 0x1b, 0xec, 0x88,
+	0xee, 0x29, 0xfc, 0x08, 0xda, 0xdf, 0x75, 0x81, 0xad, 0x60, 0x67, 0xf4, 0x7e, 0x81, 0xb5, 0x81,
+	0xda, 0x34, 0xe6, 0x3c, 0xe3, 0x5c, 0x83, 0x31, 0x7e, 0x67, 0x48, 0x46, 0x07, 0xe3, 0x47, 0x37,
+	0xf3, 0xd0, 0xbf, 0xc8, 0x64, 0x75, 0x12, 0xfd, 0x87, 0x44, 0xe9, 0xbd, 0xad, 0xf7, 0xb2, 0xb5,
+	0x4e, 0x0e, 0xbf, 0xcc, 0x42, 0xef, 0xeb, 0x2c, 0xf4, 0x7e, 0xcf, 0x42, 0x2f, 0xfa, 0xbc, 0x43,
+	0x1f, 0x9c, 0x62, 0x53, 0x73, 0xd7, 0xe7, 0xb5, 0x30, 0x39, 0x4c, 0xb2, 0xa9, 0x40, 0x7d, 0x07,
+	0xd5, 0xfa, 0xb4, 0x2b, 0x41, 0xe6, 0xeb, 0x6e, 0x07, 0xe9, 0x5a, 0xb1, 0xe7, 0xb4, 0xa7, 0x34,
+	0x2a, 0x34, 0x59, 0x75, 0x2e, 0xb8, 0xab, 0xd5, 0x19, 0xf7, 0x6f, 0xe6, 0x21, 0x6b, 0x6b, 0xdd,
+	0x1a, 0x46, 0x29, 0xdd, 0xa8, 0x33, 0xce, 0x86, 0xb4, 0xc7, 0xc1, 0x14, 0x5a, 0xa8, 0xd5, 0xcf,
+	0xfb, 0x7b, 0x2e, 0xf5, 0xb6, 0xc5, 0x06, 0x74, 0x5f, 0x83, 0x42, 0x6d, 0x41, 0xfb, 0x5d, 0x37,
+	0xde, 0xea, 0x7f, 0x0f, 0x31, 0x3e, 0xfd, 0xb6, 0x08, 0xc8, 0xd5, 0x22, 0x20, 0xd7, 0x8b, 0x80,
+	0xfc, 0x5a, 0x04, 0xe4, 0x72, 0x19, 0x78, 0xd7, 0xcb, 0xc0, 0xfb, 0xb1, 0x0c, 0xbc, 0xb7, 0xa3,
+	0x52, 0xd8, 0x49, 0x93, 0xc7, 0x05, 0xca, 0xa4, 0x12, 0x35, 0x24, 0x55, 0x2e, 0x9f, 0x18, 0xfe,
+	0x3e, 0xf9, 0xf4, 0xf7, 0x0d, 0xda, 0x0b, 0x05, 0x26, 0xef, 0xba, 0x43, 0x3c, 0xfb, 0x33, 0x00,
+	0x5b, 0x33, 0x83, 0x89, 0xa3, 0x02, 0x00, 0x00,
 }
 
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
@@ -149,6 +202,68 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FoundationMisbehavior) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FoundationMisbehavior) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FoundationMisbehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -181,6 +296,35 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *FoundationMisbehavior) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovEvidence(uint64(m.ProposalId))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -340,6 +484,223 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FoundationMisbehavior) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FoundationMisbehavior: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FoundationMisbehavior: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
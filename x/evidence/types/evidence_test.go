@@ -77,3 +77,31 @@ func TestEvidenceAddressConversion(t *testing.T) {
 	require.Equal(t, tmEvidence.Validator.Address, consAddr.Bytes())
 	sdk.GetConfig().SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
 }
+
+func TestFoundationMisbehaviorValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr := sdk.AccAddress("foo_________________")
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.FoundationMisbehavior
+		expectErr bool
+	}{
+		{"valid", types.FoundationMisbehavior{100, n, addr.String(), 1, "censorship", addr.String()}, false},
+		{"invalid time", types.FoundationMisbehavior{100, zeroTime, addr.String(), 1, "", addr.String()}, true},
+		{"invalid height", types.FoundationMisbehavior{0, n, addr.String(), 1, "", addr.String()}, true},
+		{"invalid address", types.FoundationMisbehavior{100, n, "", 1, "", addr.String()}, true},
+		{"invalid proposal id", types.FoundationMisbehavior{100, n, addr.String(), 0, "", addr.String()}, true},
+		{"invalid reporter", types.FoundationMisbehavior{100, n, addr.String(), 1, "", ""}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+			require.Equal(t, types.TypeFoundationMisbehavior, tc.e.Type())
+			require.Equal(t, types.RouteFoundationMisbehavior, tc.e.Route())
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/foundation"
 	stakingtypes "github.com/line/lbm-sdk/x/staking/types"
 )
 
@@ -22,9 +23,19 @@ type (
 		IsTombstoned(sdk.Context, sdk.ConsAddress) bool
 		HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
 		Tombstone(sdk.Context, sdk.ConsAddress)
-		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
+		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64) sdk.Int
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+		RecordDoubleSign(sdk.Context, sdk.ConsAddress, int64, time.Time, sdk.Int)
+	}
+
+	// FoundationKeeper defines the foundation module interface contract needed by
+	// the evidence module.
+	FoundationKeeper interface {
+		GetAuthority() string
+		GetProposal(sdk.Context, uint64) (*foundation.Proposal, error)
+		GetVote(sdk.Context, uint64, sdk.AccAddress) (*foundation.Vote, error)
+		FreezeMember(sdk.Context, sdk.AccAddress) error
 	}
 )
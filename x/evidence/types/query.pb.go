@@ -227,11 +227,125 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEvidenceByTypeRequest is the request type for the Query/EvidenceByType
+// RPC method.
+type QueryEvidenceByTypeRequest struct {
+	// evidence_type defines the type of the requested evidence (e.g. equivocation).
+	EvidenceType string `protobuf:"bytes,1,opt,name=evidence_type,json=evidenceType,proto3" json:"evidence_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEvidenceByTypeRequest) Reset()         { *m = QueryEvidenceByTypeRequest{} }
+func (m *QueryEvidenceByTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceByTypeRequest) ProtoMessage()    {}
+func (*QueryEvidenceByTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryEvidenceByTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceByTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceByTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceByTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceByTypeRequest.Merge(m, src)
+}
+func (m *QueryEvidenceByTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceByTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceByTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceByTypeRequest proto.InternalMessageInfo
+
+func (m *QueryEvidenceByTypeRequest) GetEvidenceType() string {
+	if m != nil {
+		return m.EvidenceType
+	}
+	return ""
+}
+
+func (m *QueryEvidenceByTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEvidenceByTypeResponse is the response type for the Query/EvidenceByType
+// RPC method.
+type QueryEvidenceByTypeResponse struct {
+	// evidence returns all evidences of the type.
+	Evidence []*types.Any `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEvidenceByTypeResponse) Reset()         { *m = QueryEvidenceByTypeResponse{} }
+func (m *QueryEvidenceByTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceByTypeResponse) ProtoMessage()    {}
+func (*QueryEvidenceByTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryEvidenceByTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceByTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceByTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceByTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceByTypeResponse.Merge(m, src)
+}
+func (m *QueryEvidenceByTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceByTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceByTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceByTypeResponse proto.InternalMessageInfo
+
+func (m *QueryEvidenceByTypeResponse) GetEvidence() []*types.Any {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *QueryEvidenceByTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryEvidenceByTypeRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceByTypeRequest")
+	proto.RegisterType((*QueryEvidenceByTypeResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceByTypeResponse")
 }
 
 func init() {
@@ -239,37 +353,41 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xd4, 0x30,
-	0x14, 0xc7, 0xcf, 0x87, 0x40, 0x95, 0x5b, 0x16, 0xeb, 0x50, 0x4b, 0x84, 0x02, 0xa4, 0x12, 0x14,
-	0x44, 0xed, 0x4b, 0x3b, 0x31, 0x36, 0x12, 0x50, 0x36, 0x88, 0xc4, 0xc2, 0x82, 0xec, 0xd4, 0x24,
-	0x11, 0x89, 0x9d, 0x9e, 0x9d, 0xaa, 0x11, 0x62, 0xe1, 0x13, 0x20, 0x21, 0x46, 0x36, 0x3e, 0x0c,
-	0x13, 0xaa, 0xc4, 0xc2, 0x84, 0xd0, 0x1d, 0x9f, 0x82, 0x09, 0xc5, 0x76, 0xae, 0xd7, 0x6b, 0xcb,
-	0xd1, 0xcd, 0xb1, 0xdf, 0xff, 0xff, 0xff, 0xe5, 0xbd, 0x07, 0xd7, 0x13, 0xa9, 0x4a, 0xa9, 0x08,
-	0x3f, 0xc8, 0xf7, 0xb8, 0x48, 0x38, 0x39, 0x08, 0x19, 0xd7, 0x34, 0x24, 0xfb, 0x35, 0x1f, 0x35,
-	0xb8, 0x1a, 0x49, 0x2d, 0xd1, 0xaa, 0x2d, 0xc2, 0x5d, 0x11, 0x76, 0x45, 0xde, 0x7d, 0xa7, 0x66,
-	0x54, 0x71, 0xab, 0x98, 0xea, 0x2b, 0x9a, 0xe6, 0x82, 0xea, 0x5c, 0x0a, 0x6b, 0xe2, 0x0d, 0x52,
-	0x99, 0x4a, 0x73, 0x24, 0xed, 0xc9, 0xdd, 0x5e, 0x4f, 0xa5, 0x4c, 0x0b, 0x4e, 0xcc, 0x17, 0xab,
-	0x5f, 0x13, 0x2a, 0x5c, 0xaa, 0x77, 0xc3, 0x3d, 0xd1, 0x2a, 0x27, 0x54, 0x08, 0xa9, 0x8d, 0x9b,
-	0xb2, 0xaf, 0x41, 0x09, 0x07, 0xcf, 0xdb, 0xc0, 0x47, 0x8e, 0x29, 0xe6, 0xfb, 0x35, 0x57, 0x1a,
-	0xbd, 0x80, 0x57, 0x3b, 0xcc, 0x57, 0x19, 0x55, 0xd9, 0x1a, 0xb8, 0x05, 0x36, 0x56, 0xa2, 0xe1,
-	0x9f, 0x9f, 0x37, 0x1f, 0xa4, 0xb9, 0xce, 0x6a, 0x86, 0x13, 0x59, 0x92, 0x22, 0x17, 0x9c, 0x48,
-	0xa5, 0x47, 0x34, 0x91, 0x82, 0x14, 0x39, 0x53, 0x84, 0x35, 0x9a, 0x2b, 0xbc, 0xcb, 0x0f, 0xa3,
-	0xf6, 0x10, 0xaf, 0x74, 0x36, 0xbb, 0x54, 0x65, 0xc1, 0x53, 0x78, 0x6d, 0x2e, 0x4e, 0x55, 0x52,
-	0x28, 0x8e, 0x86, 0x70, 0xa9, 0x2b, 0x34, 0x51, 0xcb, 0x5b, 0x03, 0x6c, 0xc1, 0x71, 0xf7, 0x4f,
-	0x78, 0x47, 0x34, 0xf1, 0xb4, 0x2a, 0xa0, 0x70, 0xd5, 0x58, 0xed, 0x14, 0xc5, 0x3c, 0xfc, 0x63,
-	0x08, 0x8f, 0xfb, 0xe6, 0xec, 0xee, 0x60, 0xd7, 0xfd, 0xb6, 0xc9, 0xd8, 0x8e, 0xc5, 0x35, 0x19,
-	0x3f, 0xa3, 0x69, 0xa7, 0x8d, 0x67, 0x94, 0xc1, 0x27, 0x00, 0xd7, 0x4e, 0x67, 0x9c, 0x49, 0x7c,
-	0x69, 0x31, 0x31, 0x7a, 0x72, 0x02, 0xab, 0x6f, 0xb0, 0xee, 0x2e, 0xc4, 0xb2, 0x71, 0xb3, 0x5c,
-	0x5b, 0xdf, 0xfa, 0xf0, 0xb2, 0xe1, 0x42, 0x5f, 0x00, 0x5c, 0xea, 0xc8, 0xd0, 0x26, 0x3e, 0x67,
-	0xc1, 0xf0, 0x59, 0x23, 0xf6, 0xf0, 0xff, 0x96, 0x5b, 0x82, 0xe0, 0xe1, 0xfb, 0xef, 0xbf, 0x3f,
-	0xf6, 0xb7, 0x51, 0x48, 0xce, 0x5b, 0xf6, 0xe9, 0xc5, 0xdb, 0x13, 0xbb, 0xf3, 0x0e, 0x7d, 0x06,
-	0x70, 0x79, 0xa6, 0x87, 0x68, 0xf8, 0xef, 0xe8, 0xd3, 0x23, 0xf5, 0xc2, 0x0b, 0x28, 0x1c, 0xef,
-	0x3d, 0xc3, 0xbb, 0x8e, 0x6e, 0x2f, 0xe4, 0x8d, 0xa2, 0xaf, 0x63, 0x1f, 0x1c, 0x8d, 0x7d, 0xf0,
-	0x6b, 0xec, 0x83, 0x0f, 0x13, 0xbf, 0x77, 0x34, 0xf1, 0x7b, 0x3f, 0x26, 0x7e, 0xef, 0xe5, 0xc6,
-	0xfc, 0xb2, 0x17, 0xac, 0xdc, 0x54, 0x7b, 0x6f, 0xc8, 0xe1, 0xb1, 0x9d, 0x6e, 0x2a, 0xae, 0xd8,
-	0x15, 0x33, 0xf5, 0xed, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x8e, 0xeb, 0xdd, 0x0b, 0x04,
-	0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xbf, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xeb, 0xf2, 0x43, 0xc5, 0x2d, 0x0c, 0x56, 0x50, 0xcb, 0x81, 0x0e, 0xb8, 0x48, 0x50,
+	0x10, 0xb5, 0x93, 0x86, 0x85, 0x8d, 0x9e, 0x44, 0x09, 0x1b, 0x44, 0xb0, 0xb0, 0x20, 0x5f, 0x6a,
+	0x2e, 0x27, 0x2e, 0xf6, 0x35, 0x76, 0xaa, 0x9e, 0xaa, 0x2e, 0x6c, 0x6c, 0x48, 0x08, 0xc4, 0xc2,
+	0xc6, 0xbf, 0x82, 0xc4, 0x58, 0x89, 0x85, 0x09, 0xa1, 0x84, 0xbf, 0x82, 0x09, 0x9d, 0xed, 0xbb,
+	0xe4, 0xd2, 0x94, 0xb4, 0x2c, 0x6c, 0xbe, 0xf3, 0x7b, 0xef, 0xfb, 0xf1, 0xf7, 0x3d, 0x1b, 0x56,
+	0xdb, 0x42, 0x76, 0x85, 0x24, 0x6c, 0x27, 0xda, 0x62, 0xbc, 0xcd, 0xc8, 0x4e, 0x3d, 0x60, 0x8a,
+	0xd6, 0xc9, 0x76, 0x9f, 0xf5, 0x52, 0x9c, 0xf4, 0x84, 0x12, 0x68, 0xd9, 0x04, 0xe1, 0x3c, 0x08,
+	0xdb, 0x20, 0xe7, 0xb6, 0xcd, 0x0e, 0xa8, 0x64, 0x26, 0xa3, 0xc8, 0x4f, 0x68, 0x18, 0x71, 0xaa,
+	0x22, 0xc1, 0x4d, 0x11, 0xa7, 0x12, 0x8a, 0x50, 0xe8, 0x25, 0xc9, 0x56, 0xf6, 0xef, 0xa5, 0x50,
+	0x88, 0x30, 0x66, 0x44, 0x7f, 0x05, 0xfd, 0x97, 0x84, 0x72, 0xab, 0xea, 0x5c, 0xb1, 0x5b, 0x34,
+	0x89, 0x08, 0xe5, 0x5c, 0x28, 0x5d, 0x4d, 0x9a, 0x5d, 0xaf, 0x0b, 0x2b, 0x4f, 0x32, 0xc1, 0x07,
+	0x96, 0xa9, 0xc5, 0xb6, 0xfb, 0x4c, 0x2a, 0xf4, 0x0c, 0x9e, 0xcf, 0x31, 0x5f, 0x74, 0xa8, 0xec,
+	0xac, 0x80, 0x6b, 0x60, 0x75, 0xc9, 0xaf, 0xfd, 0xfe, 0x71, 0xf5, 0x4e, 0x18, 0xa9, 0x4e, 0x3f,
+	0xc0, 0x6d, 0xd1, 0x25, 0x71, 0xc4, 0x19, 0x11, 0x52, 0xf5, 0x68, 0x5b, 0x70, 0x12, 0x47, 0x81,
+	0x24, 0x41, 0xaa, 0x98, 0xc4, 0x4d, 0xb6, 0xeb, 0x67, 0x8b, 0xd6, 0x52, 0x5e, 0xa6, 0x49, 0x65,
+	0xc7, 0x7b, 0x04, 0x2f, 0x4e, 0xc8, 0xc9, 0x44, 0x70, 0xc9, 0x50, 0x0d, 0x2e, 0xe4, 0x81, 0x5a,
+	0x6a, 0x71, 0xbd, 0x82, 0x0d, 0x38, 0xce, 0xcf, 0x84, 0x37, 0x78, 0xda, 0x2a, 0xa2, 0x3c, 0x0a,
+	0x97, 0x75, 0xa9, 0x8d, 0x38, 0x9e, 0x84, 0xdf, 0x84, 0x70, 0xe4, 0x9b, 0x2d, 0x77, 0x03, 0x5b,
+	0xf7, 0x33, 0x93, 0xb1, 0x69, 0x8b, 0x35, 0x19, 0x3f, 0xa6, 0x61, 0x9e, 0xdb, 0x1a, 0xcb, 0xf4,
+	0xde, 0x03, 0xb8, 0x72, 0x58, 0x63, 0x2a, 0xf1, 0xa9, 0xd9, 0xc4, 0xe8, 0x61, 0x09, 0x6b, 0x5e,
+	0x63, 0xdd, 0x9c, 0x89, 0x65, 0xe4, 0x4a, 0x5c, 0x6f, 0x00, 0x74, 0x4a, 0x36, 0xfa, 0xe9, 0xd3,
+	0x34, 0x29, 0x8e, 0x5f, 0x1d, 0xeb, 0x9d, 0x4a, 0x13, 0x63, 0xe8, 0xb9, 0x51, 0x27, 0xb2, 0x58,
+	0xb4, 0x39, 0x05, 0xe6, 0x5f, 0x3c, 0xfa, 0x08, 0xe0, 0xe5, 0xa9, 0x2c, 0xff, 0xdd, 0xa6, 0xf5,
+	0x0f, 0xa7, 0xe1, 0x19, 0x8d, 0x86, 0x3e, 0x03, 0xb8, 0x90, 0xf3, 0xa1, 0x35, 0x7c, 0xc4, 0x3d,
+	0xc4, 0xd3, 0x6e, 0x82, 0x83, 0x8f, 0x1b, 0x6e, 0x08, 0xbc, 0x7b, 0xaf, 0xbf, 0xfd, 0x7a, 0x37,
+	0xdf, 0x40, 0x75, 0x72, 0xd4, 0x9b, 0x50, 0xfc, 0xd8, 0x2b, 0x5d, 0xb1, 0x7d, 0xf4, 0x09, 0xc0,
+	0xc5, 0xb1, 0x51, 0x43, 0xb5, 0xbf, 0x4b, 0x1f, 0x9e, 0x7c, 0xa7, 0x7e, 0x82, 0x0c, 0xcb, 0x7b,
+	0x4b, 0xf3, 0x56, 0xd1, 0xf5, 0x99, 0xbc, 0xe8, 0x0b, 0x80, 0x17, 0xca, 0x6d, 0x46, 0x8d, 0xe3,
+	0xb9, 0x53, 0x1a, 0x50, 0xe7, 0xee, 0xc9, 0x92, 0x2c, 0x68, 0x53, 0x83, 0xfa, 0xe8, 0xfe, 0x4c,
+	0x50, 0x3d, 0xf5, 0x92, 0xec, 0x95, 0xbe, 0xf7, 0x8b, 0x6d, 0xdf, 0xff, 0x3a, 0x70, 0xc1, 0xc1,
+	0xc0, 0x05, 0x3f, 0x07, 0x2e, 0x78, 0x3b, 0x74, 0xe7, 0x0e, 0x86, 0xee, 0xdc, 0xf7, 0xa1, 0x3b,
+	0xf7, 0x7c, 0x75, 0xf2, 0x6d, 0x8b, 0x83, 0xee, 0x9a, 0xdc, 0x7a, 0x45, 0x76, 0x47, 0x6a, 0xba,
+	0x78, 0x70, 0x56, 0x4f, 0x6f, 0xe3, 0xcf, 0x00, 0x01, 0x93, 0x2b, 0x76, 0xfa, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +406,8 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EvidenceByType queries all evidence of the given evidence type.
+	EvidenceByType(ctx context.Context, in *QueryEvidenceByTypeRequest, opts ...grpc.CallOption) (*QueryEvidenceByTypeResponse, error)
 }
 
 type queryClient struct {
@@ -316,12 +436,23 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EvidenceByType(ctx context.Context, in *QueryEvidenceByTypeRequest, opts ...grpc.CallOption) (*QueryEvidenceByTypeResponse, error) {
+	out := new(QueryEvidenceByTypeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/EvidenceByType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EvidenceByType queries all evidence of the given evidence type.
+	EvidenceByType(context.Context, *QueryEvidenceByTypeRequest) (*QueryEvidenceByTypeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -334,6 +465,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) EvidenceByType(ctx context.Context, req *QueryEvidenceByTypeRequest) (*QueryEvidenceByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceByType not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -375,6 +509,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvidenceByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/EvidenceByType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceByType(ctx, req.(*QueryEvidenceByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -387,6 +539,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EvidenceByType",
+			Handler:    _Query_EvidenceByType_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -541,6 +697,97 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEvidenceByTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceByTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceByTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvidenceType) > 0 {
+		i -= len(m.EvidenceType)
+		copy(dAtA[i:], m.EvidenceType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvidenceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceByTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceByTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceByTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -610,6 +857,42 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEvidenceByTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvidenceType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvidenceByTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -992,6 +1275,244 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEvidenceByTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceByTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceByTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceByTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceByTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceByTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, &types.Any{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
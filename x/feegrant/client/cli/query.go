@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrantsByGrantee(),
 		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQueryAllowanceUsage(),
 	)
 
 	return feegrantQueryCmd
@@ -176,3 +177,52 @@ $ %s query feegrant grants-by-granter [granter]
 
 	return cmd
 }
+
+// GetCmdQueryAllowanceUsage returns cmd to query for the usage of the grant between granter and grantee.
+func GetCmdQueryAllowanceUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the usage of the grant between granter and grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the usage of the grant.
+You can find how much fee the granter has paid for the grantee through the
+current grant. The usage is removed along with the grant.
+
+Example:
+$ %s query feegrant usage [granter] [grantee]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowanceUsage(
+				cmd.Context(),
+				&feegrant.QueryAllowanceUsageRequest{
+					Granter: granterAddr.String(),
+					Grantee: granteeAddr.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Usage)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
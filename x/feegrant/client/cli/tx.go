@@ -22,6 +22,7 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagContracts   = "allowed-contracts"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -57,8 +58,10 @@ Examples:
 %s tx %s grant link1skjw... link1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant link1skjw... link1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant link1skjw... link1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant link1skjw... link1skjw... --spend-limit 100stake --allowed-contracts "9be17165,678c146a"
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -163,6 +166,18 @@ Examples:
 				}
 			}
 
+			allowedContracts, err := cmd.Flags().GetStringSlice(FlagContracts)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedContracts) > 0 {
+				grant, err = feegrant.NewContractAllowance(grant, allowedContracts)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
@@ -174,6 +189,7 @@ Examples:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().StringSlice(FlagContracts, []string{}, "Set of x/token and x/collection contract ids allowed for fee allowance")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
@@ -0,0 +1,174 @@
+package feegrant
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/token/class"
+)
+
+var (
+	_ FeeAllowanceI                 = (*ContractAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*ContractAllowance)(nil)
+)
+
+// ContractMsg defines the messages which are bound to a contract, e.g. the
+// messages of x/collection. The messages of x/token are adapted by
+// contractIDOf, as they have no getters.
+type ContractMsg interface {
+	sdk.Msg
+
+	GetContractId() string
+}
+
+// contractIDOf returns the id of the contract which the message is bound to.
+func contractIDOf(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case ContractMsg:
+		return msg.GetContractId(), true
+	case *token.MsgSend:
+		return msg.ContractId, true
+	case *token.MsgOperatorSend:
+		return msg.ContractId, true
+	case *token.MsgRevokeOperator:
+		return msg.ContractId, true
+	case *token.MsgAuthorizeOperator:
+		return msg.ContractId, true
+	case *token.MsgGrantPermission:
+		return msg.ContractId, true
+	case *token.MsgRevokePermission:
+		return msg.ContractId, true
+	case *token.MsgMint:
+		return msg.ContractId, true
+	case *token.MsgBurn:
+		return msg.ContractId, true
+	case *token.MsgOperatorBurn:
+		return msg.ContractId, true
+	case *token.MsgModify:
+		return msg.ContractId, true
+	default:
+		return "", false
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *ContractAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewContractAllowance creates new contract fee allowance.
+func NewContractAllowance(allowance FeeAllowanceI, allowedContracts []string) (*ContractAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractAllowance{
+		Allowance:        any,
+		AllowedContracts: allowedContracts,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *ContractAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *ContractAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	protoAllowance, ok := allowance.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+	a.Allowance, err = types.NewAnyWithValue(protoAllowance)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", protoAllowance)
+	}
+	return nil
+}
+
+// Accept method checks that all the messages belong to the allowed contracts
+func (a *ContractAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if !a.allContractsAllowed(ctx, msgs) {
+		return false, sdkerrors.Wrap(ErrContractNotAllowed, "message does not belong to allowed contracts")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+func (a *ContractAllowance) allowedContractsToMap(ctx sdk.Context) map[string]bool {
+	contractsMap := make(map[string]bool, len(a.AllowedContracts))
+	for _, contractID := range a.AllowedContracts {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check contract")
+		contractsMap[contractID] = true
+	}
+
+	return contractsMap
+}
+
+func (a *ContractAllowance) allContractsAllowed(ctx sdk.Context, msgs []sdk.Msg) bool {
+	contractsMap := a.allowedContractsToMap(ctx)
+
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check contract")
+		contractID, ok := contractIDOf(msg)
+		if !ok || !contractsMap[contractID] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *ContractAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedContracts) == 0 {
+		return sdkerrors.Wrap(ErrNoContracts, "allowed contracts shouldn't be empty")
+	}
+
+	seen := map[string]bool{}
+	for _, contractID := range a.AllowedContracts {
+		if err := class.ValidateID(contractID); err != nil {
+			return err
+		}
+		if seen[contractID] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate contract id: %s", contractID)
+		}
+		seen[contractID] = true
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
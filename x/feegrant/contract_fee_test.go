@@ -0,0 +1,112 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/feegrant"
+	"github.com/line/lbm-sdk/x/token"
+)
+
+func TestContractFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(false)
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+
+	allowedContract := "deadbeef"
+	otherContract := "fee1dead"
+
+	cases := map[string]struct {
+		msgs    []sdk.Msg
+		accept  bool
+		remains sdk.Coins
+	}{
+		"token msg of allowed contract": {
+			msgs:    []sdk.Msg{&token.MsgSend{ContractId: allowedContract}},
+			accept:  true,
+			remains: leftAtom,
+		},
+		"other token msg of allowed contract": {
+			msgs:    []sdk.Msg{&token.MsgModify{ContractId: allowedContract}},
+			accept:  true,
+			remains: leftAtom,
+		},
+		"collection msg of allowed contract": {
+			msgs:    []sdk.Msg{&collection.MsgSendFT{ContractId: allowedContract}},
+			accept:  true,
+			remains: leftAtom,
+		},
+		"msg of other contract": {
+			msgs: []sdk.Msg{
+				&token.MsgSend{ContractId: allowedContract},
+				&token.MsgSend{ContractId: otherContract},
+			},
+		},
+		"msg without contract": {
+			msgs: []sdk.Msg{&banktypes.MsgSend{}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			allowance, err := feegrant.NewContractAllowance(&feegrant.BasicAllowance{SpendLimit: atom}, []string{allowedContract})
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(ctx, smallAtom, tc.msgs)
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrContractNotAllowed)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, removed)
+
+			basic, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tc.remains, basic.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func TestContractFeeValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		contracts []string
+		valid     bool
+	}{
+		"valid": {
+			contracts: []string{"deadbeef", "fee1dead"},
+			valid:     true,
+		},
+		"empty contracts": {},
+		"invalid contract id": {
+			contracts: []string{"invalid"},
+		},
+		"duplicate contract ids": {
+			contracts: []string{"deadbeef", "deadbeef"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewContractAllowance(&feegrant.BasicAllowance{}, tc.contracts)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
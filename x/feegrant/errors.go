@@ -22,4 +22,8 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrNoContracts error if there is no contract
+	ErrNoContracts = sdkerrors.Register(DefaultCodespace, 8, "allowed contracts are empty")
+	// ErrContractNotAllowed error if contract is not allowed
+	ErrContractNotAllowed = sdkerrors.Register(DefaultCodespace, 9, "contract not allowed")
 )
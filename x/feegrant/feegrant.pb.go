@@ -218,6 +218,48 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// ContractAllowance creates allowance only for the messages of the specified
+// x/token and x/collection contracts.
+type ContractAllowance struct {
+	// allowance can be any of basic and filtered fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_contracts are the ids of the contracts for which the grantee has the access.
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+}
+
+func (m *ContractAllowance) Reset()         { *m = ContractAllowance{} }
+func (m *ContractAllowance) String() string { return proto.CompactTextString(m) }
+func (*ContractAllowance) ProtoMessage()    {}
+func (*ContractAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *ContractAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAllowance.Merge(m, src)
+}
+func (m *ContractAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAllowance proto.InternalMessageInfo
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -232,7 +274,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,11 +324,96 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+// AllowanceUsage records the usage of the allowance granted by the granter to
+// the grantee. It is removed along with the allowance.
+type AllowanceUsage struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter_address"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee_address"`
+	// spent is the total amount of fees paid by the allowances.
+	Spent github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"spent"`
+	// count is the number of the transactions whose fees were paid by the allowances.
+	Count uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// last_used specifies the block time at which the allowance was used last.
+	LastUsed time.Time `protobuf:"bytes,5,opt,name=last_used,json=lastUsed,proto3,stdtime" json:"last_used"`
+}
+
+func (m *AllowanceUsage) Reset()         { *m = AllowanceUsage{} }
+func (m *AllowanceUsage) String() string { return proto.CompactTextString(m) }
+func (*AllowanceUsage) ProtoMessage()    {}
+func (*AllowanceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *AllowanceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowanceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowanceUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowanceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowanceUsage.Merge(m, src)
+}
+func (m *AllowanceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowanceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowanceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowanceUsage proto.InternalMessageInfo
+
+func (m *AllowanceUsage) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *AllowanceUsage) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *AllowanceUsage) GetSpent() github_com_line_lbm_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *AllowanceUsage) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *AllowanceUsage) GetLastUsed() time.Time {
+	if m != nil {
+		return m.LastUsed
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*ContractAllowance)(nil), "cosmos.feegrant.v1beta1.ContractAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*AllowanceUsage)(nil), "cosmos.feegrant.v1beta1.AllowanceUsage")
 }
 
 func init() {
@@ -294,44 +421,49 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa4, 0x90, 0x0b, 0x94, 0xc6, 0x14, 0x70, 0x32, 0xd8, 0x51, 0x90, 0x20, 0x08,
-	0xd5, 0x56, 0x0b, 0x53, 0x60, 0xa0, 0x0e, 0x50, 0x21, 0x51, 0x09, 0x19, 0x26, 0x16, 0xeb, 0x6c,
-	0xbf, 0x1a, 0x0b, 0xdb, 0x67, 0xf9, 0x1c, 0x68, 0xbe, 0x01, 0x63, 0x47, 0x26, 0xc4, 0xcc, 0xcc,
-	0xc4, 0x27, 0xa8, 0x18, 0x50, 0xc5, 0x04, 0x4b, 0x8b, 0x92, 0x6f, 0xc0, 0x27, 0x40, 0xbe, 0x3b,
-	0x27, 0x21, 0x69, 0x85, 0x84, 0xba, 0xf9, 0xde, 0x7b, 0xbf, 0x3f, 0xef, 0x8f, 0x8c, 0x6e, 0xb8,
-	0x84, 0x46, 0x84, 0x1a, 0xbb, 0x00, 0x7e, 0x8a, 0xe3, 0xcc, 0x78, 0xb3, 0xe1, 0x40, 0x86, 0x37,
-	0x26, 0x01, 0x3d, 0x49, 0x49, 0x46, 0xe4, 0x6b, 0xbc, 0x4e, 0x9f, 0x84, 0x45, 0x5d, 0x6b, 0xcd,
-	0x27, 0x3e, 0x61, 0x35, 0x46, 0xfe, 0xc5, 0xcb, 0x5b, 0x4d, 0x9f, 0x10, 0x3f, 0x04, 0x83, 0xbd,
-	0x9c, 0xc1, 0xae, 0x81, 0xe3, 0x61, 0x91, 0xe2, 0x4c, 0x36, 0xc7, 0x08, 0x5a, 0x9e, 0x52, 0x85,
-	0x19, 0x07, 0x53, 0x98, 0x18, 0x71, 0x49, 0x10, 0x8b, 0xbc, 0x36, 0xcf, 0x9a, 0x05, 0x11, 0xd0,
-	0x0c, 0x47, 0x49, 0x41, 0x30, 0x5f, 0xe0, 0x0d, 0x52, 0x9c, 0x05, 0x44, 0x10, 0x74, 0xbe, 0x49,
-	0x68, 0xc5, 0xc4, 0x34, 0x70, 0xb7, 0xc2, 0x90, 0xbc, 0xc5, 0xb1, 0x0b, 0xb2, 0x8f, 0xea, 0x34,
-	0x81, 0xd8, 0xb3, 0xc3, 0x20, 0x0a, 0x32, 0x45, 0x6a, 0x97, 0xbb, 0xf5, 0xcd, 0xa6, 0x2e, 0x7c,
-	0xe5, 0x4e, 0x8a, 0x56, 0xf5, 0x3e, 0x09, 0x62, 0xf3, 0xf6, 0xc1, 0x91, 0x56, 0xfa, 0x74, 0xac,
-	0x5d, 0xf7, 0x83, 0xec, 0xd5, 0xc0, 0xd1, 0x5d, 0x12, 0x19, 0x61, 0x10, 0x83, 0x11, 0x3a, 0xd1,
-	0x3a, 0xf5, 0x5e, 0x1b, 0xd9, 0x30, 0x01, 0xca, 0x6a, 0xa9, 0x85, 0x18, 0xf5, 0xd3, 0x9c, 0x59,
-	0x7e, 0x80, 0x10, 0xec, 0x25, 0x01, 0xf7, 0xa3, 0x2c, 0xb5, 0xa5, 0x6e, 0x7d, 0xb3, 0xa5, 0x73,
-	0xc3, 0x7a, 0x61, 0x58, 0x7f, 0x51, 0x74, 0x64, 0x56, 0xf6, 0x8f, 0x35, 0xc9, 0x9a, 0xc1, 0xf4,
-	0x1a, 0xdf, 0x3f, 0xaf, 0x5f, 0x7c, 0x0c, 0x30, 0x31, 0xff, 0xa4, 0xf3, 0xb3, 0x8c, 0x1a, 0xcf,
-	0x20, 0x0d, 0x88, 0x37, 0xdb, 0x53, 0x1f, 0x55, 0x9d, 0xbc, 0x4b, 0x45, 0x62, 0x2a, 0x37, 0xf5,
-	0x53, 0x96, 0xa7, 0xff, 0x3d, 0x0b, 0xb3, 0x92, 0xf7, 0x66, 0x71, 0xac, 0x7c, 0x0f, 0x2d, 0x27,
-	0x8c, 0x59, 0x78, 0x6d, 0x2e, 0x78, 0x7d, 0x28, 0x86, 0x6b, 0x9e, 0xcf, 0x71, 0xef, 0x73, 0xbb,
-	0x02, 0x22, 0x67, 0x48, 0xe6, 0x5f, 0xf6, 0xec, 0x70, 0xcb, 0x67, 0x3a, 0xdc, 0x55, 0xae, 0xf0,
-	0x7c, 0x3a, 0xe2, 0x04, 0x89, 0x98, 0xed, 0xe2, 0x98, 0x2b, 0x2b, 0x95, 0x33, 0xd5, 0x5c, 0xe1,
-	0xfc, 0x7d, 0x1c, 0x33, 0x59, 0x79, 0x1b, 0x5d, 0x10, 0x8a, 0x29, 0x50, 0xc8, 0x94, 0xea, 0x3f,
-	0xd7, 0xca, 0x66, 0xc5, 0x56, 0x5b, 0xe7, 0x48, 0x2b, 0x07, 0x9e, 0xb4, 0xdb, 0x0f, 0x12, 0xba,
-	0xcc, 0x9e, 0xe0, 0xed, 0x50, 0x7f, 0xba, 0xdd, 0x47, 0xa8, 0x86, 0x8b, 0x87, 0xd8, 0xf0, 0xda,
-	0x82, 0xe0, 0x56, 0x3c, 0x34, 0x1b, 0x5f, 0xe7, 0x39, 0xad, 0x29, 0x52, 0xbe, 0x85, 0x56, 0x31,
-	0x67, 0xb7, 0x23, 0xa0, 0x14, 0xfb, 0x40, 0x95, 0xa5, 0x76, 0xb9, 0x5b, 0xb3, 0x2e, 0x89, 0xf8,
-	0x8e, 0x08, 0xf7, 0xae, 0xbc, 0xfb, 0xa8, 0x95, 0x16, 0x0d, 0x7e, 0x91, 0x50, 0x75, 0x3b, 0xbf,
-	0x27, 0xf9, 0x2e, 0x3a, 0xc7, 0x0e, 0x0b, 0x52, 0x66, 0xa8, 0x66, 0xb6, 0x7e, 0x1f, 0x69, 0x57,
-	0x87, 0x38, 0x0a, 0x7b, 0x1d, 0x91, 0xb0, 0xb1, 0xe7, 0xa5, 0x40, 0x69, 0xc7, 0x2a, 0x4a, 0xa7,
-	0x28, 0x60, 0x27, 0x76, 0x02, 0x0a, 0x16, 0x50, 0x73, 0xed, 0x97, 0xff, 0xb7, 0x7d, 0xf3, 0xfe,
-	0xc1, 0x48, 0x95, 0x0e, 0x47, 0xaa, 0xf4, 0x6b, 0xa4, 0x4a, 0xfb, 0x63, 0xb5, 0x74, 0x38, 0x56,
-	0x4b, 0x3f, 0xc6, 0x6a, 0xe9, 0x65, 0xe7, 0xb4, 0x43, 0xd8, 0x9b, 0xfc, 0x14, 0x9d, 0x65, 0xa6,
-	0x74, 0xe7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x86, 0x4e, 0x4f, 0x3f, 0x05, 0x00, 0x00,
+	// 671 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0xf3, 0xa7, 0x34, 0x17, 0x28, 0x8d, 0x29, 0xe0, 0x66, 0x48, 0xa2, 0x20, 0x41, 0x50,
+	0x55, 0x5b, 0x2d, 0x4c, 0x85, 0x81, 0x3a, 0x40, 0x85, 0x44, 0x25, 0x64, 0xe8, 0x82, 0x90, 0xac,
+	0xb3, 0xfd, 0x6a, 0x2c, 0x6c, 0x9f, 0xe5, 0xbb, 0x40, 0xf3, 0x0d, 0x18, 0x3b, 0x32, 0x21, 0x66,
+	0x56, 0x98, 0xf8, 0x04, 0x15, 0x03, 0xaa, 0x98, 0x60, 0x69, 0x51, 0xfb, 0x0d, 0xf8, 0x04, 0xc8,
+	0x77, 0xe7, 0xa4, 0xa4, 0xad, 0x10, 0x28, 0x62, 0xf3, 0xbd, 0xf7, 0x7e, 0x7f, 0xde, 0x7b, 0x77,
+	0x32, 0xba, 0xea, 0x12, 0x1a, 0x11, 0x6a, 0x6c, 0x02, 0xf8, 0x29, 0x8e, 0x99, 0xf1, 0x72, 0xc9,
+	0x01, 0x86, 0x97, 0x86, 0x01, 0x3d, 0x49, 0x09, 0x23, 0xea, 0x65, 0x51, 0xa7, 0x0f, 0xc3, 0xb2,
+	0xae, 0x31, 0xe7, 0x13, 0x9f, 0xf0, 0x1a, 0x23, 0xfb, 0x12, 0xe5, 0x8d, 0x79, 0x9f, 0x10, 0x3f,
+	0x04, 0x83, 0x9f, 0x9c, 0xfe, 0xa6, 0x81, 0xe3, 0x41, 0x9e, 0x12, 0x4c, 0xb6, 0xc0, 0x48, 0x5a,
+	0x91, 0x6a, 0x4a, 0x33, 0x0e, 0xa6, 0x30, 0x34, 0xe2, 0x92, 0x20, 0x96, 0xf9, 0xd6, 0x38, 0x2b,
+	0x0b, 0x22, 0xa0, 0x0c, 0x47, 0x49, 0x4e, 0x30, 0x5e, 0xe0, 0xf5, 0x53, 0xcc, 0x02, 0x22, 0x09,
+	0x3a, 0x5f, 0x14, 0x34, 0x63, 0x62, 0x1a, 0xb8, 0xab, 0x61, 0x48, 0x5e, 0xe1, 0xd8, 0x05, 0xd5,
+	0x47, 0x35, 0x9a, 0x40, 0xec, 0xd9, 0x61, 0x10, 0x05, 0x4c, 0x53, 0xda, 0xa5, 0x6e, 0x6d, 0x79,
+	0x5e, 0x97, 0xbe, 0x32, 0x27, 0x79, 0xab, 0x7a, 0x8f, 0x04, 0xb1, 0xb9, 0xb0, 0xb3, 0xd7, 0x2a,
+	0xbc, 0xdf, 0x6f, 0x5d, 0xf1, 0x03, 0xf6, 0xbc, 0xef, 0xe8, 0x2e, 0x89, 0x8c, 0x30, 0x88, 0xc1,
+	0x08, 0x9d, 0x68, 0x91, 0x7a, 0x2f, 0x0c, 0x36, 0x48, 0x80, 0xf2, 0x5a, 0x6a, 0x21, 0x4e, 0xfd,
+	0x30, 0x63, 0x56, 0xef, 0x20, 0x04, 0x5b, 0x49, 0x20, 0xfc, 0x68, 0xc5, 0xb6, 0xd2, 0xad, 0x2d,
+	0x37, 0x74, 0x61, 0x58, 0xcf, 0x0d, 0xeb, 0x4f, 0xf2, 0x8e, 0xcc, 0xf2, 0xf6, 0x7e, 0x4b, 0xb1,
+	0x8e, 0x60, 0x56, 0xea, 0x5f, 0x3f, 0x2e, 0x9e, 0xbb, 0x0f, 0x30, 0x34, 0xff, 0xa0, 0xf3, 0xbd,
+	0x84, 0xea, 0x8f, 0x20, 0x0d, 0x88, 0x77, 0xb4, 0xa7, 0x1e, 0xaa, 0x38, 0x59, 0x97, 0x9a, 0xc2,
+	0x55, 0xae, 0xe9, 0xa7, 0x2c, 0x4f, 0xff, 0x7d, 0x16, 0x66, 0x39, 0xeb, 0xcd, 0x12, 0x58, 0xf5,
+	0x16, 0x9a, 0x4a, 0x38, 0xb3, 0xf4, 0x3a, 0x7f, 0xcc, 0xeb, 0x5d, 0x39, 0x5c, 0x73, 0x3a, 0xc3,
+	0xbd, 0xc9, 0xec, 0x4a, 0x88, 0xca, 0x90, 0x2a, 0xbe, 0xec, 0xa3, 0xc3, 0x2d, 0x4d, 0x74, 0xb8,
+	0xb3, 0x42, 0xe1, 0xf1, 0x68, 0xc4, 0x09, 0x92, 0x31, 0xdb, 0xc5, 0xb1, 0x50, 0xd6, 0xca, 0x13,
+	0xd5, 0x9c, 0x11, 0xfc, 0x3d, 0x1c, 0x73, 0x59, 0x75, 0x0d, 0x9d, 0x95, 0x8a, 0x29, 0x50, 0x60,
+	0x5a, 0xe5, 0x8f, 0x6b, 0xe5, 0xb3, 0xe2, 0xab, 0xad, 0x09, 0xa4, 0x95, 0x01, 0x4f, 0xda, 0xed,
+	0x5b, 0x05, 0x5d, 0xe0, 0x47, 0xf0, 0xd6, 0xa9, 0x3f, 0xda, 0xee, 0x3d, 0x54, 0xc5, 0xf9, 0x41,
+	0x6e, 0x78, 0xee, 0x98, 0xe0, 0x6a, 0x3c, 0x30, 0xeb, 0x9f, 0xc7, 0x39, 0xad, 0x11, 0x52, 0xbd,
+	0x8e, 0x66, 0xb1, 0x60, 0xb7, 0x23, 0xa0, 0x14, 0xfb, 0x40, 0xb5, 0x62, 0xbb, 0xd4, 0xad, 0x5a,
+	0xe7, 0x65, 0x7c, 0x5d, 0x86, 0x57, 0x2e, 0xbe, 0x7e, 0xd7, 0x2a, 0x9c, 0x68, 0xb0, 0xde, 0x23,
+	0x31, 0x4b, 0xb1, 0xcb, 0x26, 0x6e, 0x6f, 0x01, 0xd5, 0x73, 0x7b, 0xae, 0xd4, 0xc8, 0xfd, 0xe5,
+	0xbe, 0x73, 0xed, 0x53, 0x0d, 0x7e, 0x52, 0x50, 0x65, 0x2d, 0xbb, 0xf0, 0xea, 0x4d, 0x74, 0x86,
+	0xdf, 0x7c, 0x48, 0xb9, 0xa5, 0xaa, 0xd9, 0xf8, 0xb9, 0xd7, 0xba, 0x34, 0xc0, 0x51, 0xb8, 0xd2,
+	0x91, 0x09, 0x1b, 0x7b, 0x5e, 0x0a, 0x94, 0x76, 0xac, 0xbc, 0x74, 0x84, 0x02, 0xad, 0x78, 0x32,
+	0x0a, 0x8e, 0xa1, 0xc6, 0x06, 0x50, 0xfa, 0xd7, 0x01, 0x74, 0x3e, 0x14, 0xd1, 0xcc, 0x30, 0xb3,
+	0x91, 0x2d, 0xe2, 0xbf, 0x76, 0xf1, 0x0c, 0x55, 0xb2, 0x07, 0x34, 0xe9, 0x47, 0x2b, 0x48, 0xd5,
+	0x39, 0x54, 0x71, 0x49, 0x3f, 0x66, 0x5a, 0xb9, 0xad, 0x74, 0xcb, 0x96, 0x38, 0xa8, 0xab, 0xa8,
+	0x1a, 0x62, 0xca, 0xec, 0x3e, 0x05, 0xef, 0xaf, 0x9e, 0xd2, 0x74, 0x06, 0xdb, 0xa0, 0xe0, 0x99,
+	0xb7, 0x77, 0x0e, 0x9a, 0xca, 0xee, 0x41, 0x53, 0xf9, 0x71, 0xd0, 0x54, 0xb6, 0x0f, 0x9b, 0x85,
+	0xdd, 0xc3, 0x66, 0xe1, 0xdb, 0x61, 0xb3, 0xf0, 0xb4, 0x73, 0x9a, 0xbd, 0xad, 0xe1, 0xbf, 0xce,
+	0x99, 0xe2, 0x2a, 0x37, 0x7e, 0x0d, 0x00, 0x8c, 0x9b, 0xbf, 0xac, 0x16, 0x07, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -502,6 +634,50 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -551,6 +727,70 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowanceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowanceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowanceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUsed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUsed):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintFeegrant(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	if m.Count != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeegrant(v)
 	base := offset
@@ -627,6 +867,25 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *ContractAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -648,6 +907,34 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *AllowanceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovFeegrant(uint64(m.Count))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUsed)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
 func sovFeegrant(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1109,6 +1396,124 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1259,6 +1664,206 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AllowanceUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowanceUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowanceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFeegrant(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package feegrant
 
 import (
 	"github.com/line/lbm-sdk/codec/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -15,7 +16,10 @@ func NewGenesisState(entries []Grant) *GenesisState {
 
 // ValidateGenesis ensures all grants in the genesis state are valid
 func ValidateGenesis(data GenesisState) error {
+	allowances := map[string]bool{}
 	for _, f := range data.Allowances {
+		allowances[f.Granter+f.Grantee] = true
+
 		grant, err := f.GetGrant()
 		if err != nil {
 			return err
@@ -25,6 +29,23 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	seenUsages := map[string]bool{}
+	for _, usage := range data.Usages {
+		if err := usage.ValidateBasic(); err != nil {
+			return err
+		}
+
+		pair := usage.Granter + usage.Grantee
+		if !allowances[pair] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("usage of %s to %s without allowance", usage.Granter, usage.Grantee)
+		}
+		if seenUsages[pair] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate usage of %s to %s", usage.Granter, usage.Grantee)
+		}
+		seenUsages[pair] = true
+	}
+
 	return nil
 }
 
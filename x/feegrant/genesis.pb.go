@@ -26,6 +26,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState contains a set of fee allowances, persisted from the store
 type GenesisState struct {
 	Allowances []Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+	// usages are the usages of the allowances.
+	Usages []AllowanceUsage `protobuf:"bytes,2,rep,name=usages,proto3" json:"usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +70,13 @@ func (m *GenesisState) GetAllowances() []Grant {
 	return nil
 }
 
+func (m *GenesisState) GetUsages() []AllowanceUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.feegrant.v1beta1.GenesisState")
 }
@@ -77,20 +86,22 @@ func init() {
 }
 
 var fileDescriptor_ac719d2d0954d1bf = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
+	// 232 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
 	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
 	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x99, 0x0a, 0xd7, 0x0f, 0x56,
-	0xa7, 0x14, 0xc2, 0xc5, 0xe3, 0x0e, 0xb1, 0x27, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x85, 0x8b,
-	0x2b, 0x31, 0x27, 0x27, 0xbf, 0x3c, 0x31, 0x2f, 0x39, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83,
-	0xdb, 0x48, 0x4e, 0x0f, 0x87, 0xdd, 0x7a, 0xee, 0x20, 0x9e, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c,
-	0x41, 0x48, 0xfa, 0x9c, 0x6c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x29, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x27, 0x33, 0x2f, 0x55,
-	0x3f, 0x27, 0x29, 0x57, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0x02, 0xee, 0xb2, 0x24, 0x36, 0xb0, 0xd3,
-	0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x39, 0x8f, 0xb2, 0x65, 0x1a, 0x01, 0x00, 0x00,
+	0xa7, 0x34, 0x9b, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x51, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x0b,
+	0x17, 0x57, 0x62, 0x4e, 0x4e, 0x7e, 0x79, 0x62, 0x5e, 0x72, 0x6a, 0xb1, 0x04, 0xa3, 0x02, 0xb3,
+	0x06, 0xb7, 0x91, 0x9c, 0x1e, 0x0e, 0xcb, 0xf5, 0xdc, 0x41, 0x3c, 0x27, 0x96, 0x13, 0xf7, 0xe4,
+	0x19, 0x82, 0x90, 0xf4, 0x09, 0xb9, 0x72, 0xb1, 0x95, 0x16, 0x27, 0xa6, 0xa7, 0x16, 0x4b, 0x30,
+	0x81, 0x4d, 0x50, 0xc7, 0x69, 0x82, 0x23, 0x4c, 0x53, 0x28, 0x48, 0x3d, 0xd4, 0x28, 0xa8, 0x66,
+	0x27, 0x9b, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
+	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4a, 0xcf, 0x2c,
+	0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0xc9, 0xcc, 0x4b, 0xd5, 0xcf, 0x49, 0xca,
+	0xd5, 0x2d, 0x4e, 0xc9, 0xd6, 0xaf, 0x80, 0xfb, 0x30, 0x89, 0x0d, 0xec, 0x45, 0x63, 0xc0, 0x00,
+	0xb0, 0x70, 0x27, 0xc9, 0x62, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +124,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Allowances) > 0 {
 		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -153,6 +178,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -225,6 +256,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, AllowanceUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
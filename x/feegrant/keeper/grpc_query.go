@@ -133,3 +133,25 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowanceUsage returns the usage of the allowance granted to the grantee by the granter.
+func (q Keeper) AllowanceUsage(c context.Context, req *feegrant.QueryAllowanceUsageRequest) (*feegrant.QueryAllowanceUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	usage := q.GetAllowanceUsage(ctx, granterAddr, granteeAddr)
+
+	return &feegrant.QueryAllowanceUsageResponse{Usage: usage}, nil
+}
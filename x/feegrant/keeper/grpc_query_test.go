@@ -213,6 +213,137 @@ func (suite *KeeperTestSuite) TestFeeAllowancesByGranter() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAllowanceUsage() {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	testCases := []struct {
+		name      string
+		req       *feegrant.QueryAllowanceUsageRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *feegrant.QueryAllowanceUsageResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			func() {},
+			func(*feegrant.QueryAllowanceUsageResponse) {},
+		},
+		{
+			"fail: invalid granter",
+			&feegrant.QueryAllowanceUsageRequest{
+				Granter: "invalid_granter",
+				Grantee: suite.addrs[0].String(),
+			},
+			true,
+			func() {},
+			func(*feegrant.QueryAllowanceUsageResponse) {},
+		},
+		{
+			"fail: invalid grantee",
+			&feegrant.QueryAllowanceUsageRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: "invalid_grantee",
+			},
+			true,
+			func() {},
+			func(*feegrant.QueryAllowanceUsageResponse) {},
+		},
+		{
+			"no usage",
+			&feegrant.QueryAllowanceUsageRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {},
+			func(resp *feegrant.QueryAllowanceUsageResponse) {
+				suite.Require().True(resp.Usage.Spent.IsZero())
+				suite.Require().Zero(resp.Usage.Count)
+			},
+		},
+		{
+			"valid query: expect accumulated usage",
+			&feegrant.QueryAllowanceUsageRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {
+				suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+				for i := 0; i < 2; i++ {
+					err := suite.keeper.UseGrantedFees(suite.sdkCtx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{})
+					suite.Require().NoError(err)
+				}
+			},
+			func(resp *feegrant.QueryAllowanceUsageResponse) {
+				suite.Require().Equal(suite.addrs[0].String(), resp.Usage.Granter)
+				suite.Require().Equal(suite.addrs[1].String(), resp.Usage.Grantee)
+				suite.Require().Equal(fee.Add(fee...), resp.Usage.Spent)
+				suite.Require().Equal(uint64(2), resp.Usage.Count)
+				suite.Require().Equal(suite.sdkCtx.BlockTime(), resp.Usage.LastUsed)
+			},
+		},
+		{
+			"valid query: usage removed with the revoked allowance",
+			&feegrant.QueryAllowanceUsageRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {
+				_, err := suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{
+					Granter: suite.addrs[0].String(),
+					Grantee: suite.addrs[1].String(),
+				})
+				suite.Require().NoError(err)
+			},
+			func(resp *feegrant.QueryAllowanceUsageResponse) {
+				suite.Require().True(resp.Usage.Spent.IsZero())
+				suite.Require().Zero(resp.Usage.Count)
+			},
+		},
+		{
+			"valid query: usage removed with the exhausted allowance",
+			&feegrant.QueryAllowanceUsageRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{
+					SpendLimit: fee.Add(fee...),
+				})
+				suite.Require().NoError(err)
+				for i := 0; i < 2; i++ {
+					err := suite.keeper.UseGrantedFees(suite.sdkCtx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{})
+					suite.Require().NoError(err)
+				}
+				_, err = suite.keeper.GetAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1])
+				suite.Require().Error(err)
+			},
+			func(resp *feegrant.QueryAllowanceUsageResponse) {
+				suite.Require().True(resp.Usage.Spent.IsZero())
+				suite.Require().Zero(resp.Usage.Count)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.keeper.AllowanceUsage(suite.ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress) {
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
 	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.sdkCtx, granter, grantee, &feegrant.BasicAllowance{
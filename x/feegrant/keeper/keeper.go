@@ -70,7 +70,7 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	return nil
 }
 
-// revokeAllowance removes an existing grant
+// revokeAllowance removes an existing grant along with its usage
 func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
 	_, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
@@ -80,6 +80,7 @@ func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 	store := ctx.KVStore(k.storeKey)
 	key := feegrant.FeeAllowanceKey(granter, grantee)
 	store.Delete(key)
+	store.Delete(feegrant.AllowanceUsageKey(granter, grantee))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -172,12 +173,62 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 		return err
 	}
 
+	k.addAllowanceUsage(ctx, granter, grantee, fee)
 	emitUseGrantEvent(ctx, granter.String(), grantee.String())
 
 	// if fee allowance is accepted, store the updated state of the allowance
 	return k.GrantAllowance(ctx, granter, grantee, grant)
 }
 
+// GetAllowanceUsage returns the usage of the allowance granted by the granter
+// to the grantee. It returns an empty usage if the allowance has never been
+// used, or has been removed.
+func (k Keeper) GetAllowanceUsage(ctx sdk.Context, granter, grantee sdk.AccAddress) feegrant.AllowanceUsage {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feegrant.AllowanceUsageKey(granter, grantee))
+	if bz == nil {
+		return feegrant.NewAllowanceUsage(granter, grantee)
+	}
+
+	var usage feegrant.AllowanceUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+
+	return usage
+}
+
+func (k Keeper) setAllowanceUsage(ctx sdk.Context, granter, grantee sdk.AccAddress, usage feegrant.AllowanceUsage) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&usage)
+	store.Set(feegrant.AllowanceUsageKey(granter, grantee), bz)
+}
+
+// addAllowanceUsage records the fee paid by the allowance into its usage.
+func (k Keeper) addAllowanceUsage(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) {
+	usage := k.GetAllowanceUsage(ctx, granter, grantee)
+	usage.Add(fee, ctx.BlockTime())
+	k.setAllowanceUsage(ctx, granter, grantee, usage)
+}
+
+// IterateAllAllowanceUsages iterates over all the allowance usages in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+func (k Keeper) IterateAllAllowanceUsages(ctx sdk.Context, cb func(usage feegrant.AllowanceUsage) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.AllowanceUsageKeyPrefix)
+	defer iter.Close()
+
+	stop := false
+	for ; iter.Valid() && !stop; iter.Next() {
+		var usage feegrant.AllowanceUsage
+		if err := k.cdc.Unmarshal(iter.Value(), &usage); err != nil {
+			return err
+		}
+
+		stop = cb(usage)
+	}
+
+	return nil
+}
+
 func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -210,6 +261,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error
 			return err
 		}
 	}
+
+	for _, usage := range data.Usages {
+		granter, err := sdk.AccAddressFromBech32(usage.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := sdk.AccAddressFromBech32(usage.Grantee)
+		if err != nil {
+			return err
+		}
+
+		k.setAllowanceUsage(ctx, granter, grantee, usage)
+	}
+
 	return nil
 }
 
@@ -221,8 +286,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*feegrant.GenesisState, error) {
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []feegrant.AllowanceUsage
+	err = k.IterateAllAllowanceUsages(ctx, func(usage feegrant.AllowanceUsage) bool {
+		usages = append(usages, usage)
+		return false
+	})
 
 	return &feegrant.GenesisState{
 		Allowances: grants,
+		Usages:     usages,
 	}, err
 }
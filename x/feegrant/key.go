@@ -20,8 +20,13 @@ const (
 	QuerierRoute = ModuleName
 )
 
-// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
-var FeeAllowanceKeyPrefix = []byte{0x00}
+var (
+	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
+	FeeAllowanceKeyPrefix = []byte{0x00}
+
+	// AllowanceUsageKeyPrefix is the set of the kvstore for allowance usage data
+	AllowanceUsageKeyPrefix = []byte{0x01}
+)
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
 // We store by grantee first to allow searching by everyone who granted to you
@@ -47,3 +52,10 @@ func ParseAddressesFromFeeAllowanceKey(key []byte) (granter, grantee sdk.AccAddr
 
 	return granter, grantee
 }
+
+// AllowanceUsageKey is the canonical key to store the usage of the allowance
+// granted by the granter to the grantee.
+func AllowanceUsageKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	key := append(AllowanceUsageKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
+	return append(key, address.MustLengthPrefix(granter.Bytes())...)
+}
@@ -349,6 +349,107 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAllowanceUsageRequest is the request type for the Query/AllowanceUsage RPC method.
+type QueryAllowanceUsageRequest struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty" yaml:"granter_address"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty" yaml:"grantee_address"`
+}
+
+func (m *QueryAllowanceUsageRequest) Reset()         { *m = QueryAllowanceUsageRequest{} }
+func (m *QueryAllowanceUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceUsageRequest) ProtoMessage()    {}
+func (*QueryAllowanceUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QueryAllowanceUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceUsageRequest.Merge(m, src)
+}
+func (m *QueryAllowanceUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceUsageRequest proto.InternalMessageInfo
+
+func (m *QueryAllowanceUsageRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryAllowanceUsageRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+// QueryAllowanceUsageResponse is the response type for the Query/AllowanceUsage RPC method.
+type QueryAllowanceUsageResponse struct {
+	// usage is the usage of the allowance granted for grantee by granter.
+	Usage AllowanceUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryAllowanceUsageResponse) Reset()         { *m = QueryAllowanceUsageResponse{} }
+func (m *QueryAllowanceUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceUsageResponse) ProtoMessage()    {}
+func (*QueryAllowanceUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QueryAllowanceUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceUsageResponse.Merge(m, src)
+}
+func (m *QueryAllowanceUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceUsageResponse proto.InternalMessageInfo
+
+func (m *QueryAllowanceUsageResponse) GetUsage() AllowanceUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return AllowanceUsage{}
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -356,6 +457,8 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QueryAllowanceUsageRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceUsageRequest")
+	proto.RegisterType((*QueryAllowanceUsageResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceUsageResponse")
 }
 
 func init() {
@@ -363,41 +466,46 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4d, 0x8b, 0xd3, 0x40,
-	0x1c, 0xc6, 0x3b, 0xeb, 0x1b, 0xfd, 0xef, 0x6d, 0xd4, 0xdd, 0x12, 0x24, 0x5b, 0x22, 0xac, 0x6f,
-	0x6c, 0xc6, 0xd6, 0x17, 0x56, 0x59, 0x16, 0xec, 0xc1, 0x5e, 0xb5, 0x07, 0x0f, 0x5e, 0x64, 0xd2,
-	0xfe, 0x8d, 0xc1, 0x34, 0xd3, 0xcd, 0xa4, 0x6a, 0x91, 0xbd, 0xb8, 0x5f, 0x40, 0x10, 0xbc, 0x0a,
-	0x1e, 0xbc, 0xe8, 0x57, 0xf0, 0xee, 0x71, 0xc1, 0x8b, 0x27, 0x91, 0xd6, 0x4f, 0xe0, 0x27, 0x90,
-	0x66, 0x32, 0x49, 0x4d, 0x13, 0x36, 0x88, 0x07, 0x6f, 0x69, 0xe7, 0x79, 0x66, 0x7e, 0xcf, 0x33,
-	0xff, 0x04, 0xce, 0xf7, 0x85, 0x1c, 0x0a, 0xc9, 0x1e, 0x23, 0xba, 0x21, 0x0f, 0x22, 0xf6, 0xac,
-	0xe5, 0x60, 0xc4, 0x5b, 0x6c, 0x6f, 0x8c, 0xe1, 0xc4, 0x1e, 0x85, 0x22, 0x12, 0x74, 0x5d, 0x89,
-	0x6c, 0x2d, 0xb2, 0x13, 0x91, 0x71, 0xc6, 0x15, 0xae, 0x88, 0x35, 0x6c, 0xfe, 0xa4, 0xe4, 0xc6,
-	0x66, 0xd9, 0x9e, 0xa9, 0x5f, 0xe9, 0x2e, 0x27, 0x3a, 0x87, 0x4b, 0x54, 0xe7, 0xa5, 0xca, 0x11,
-	0x77, 0xbd, 0x80, 0x47, 0x9e, 0x08, 0x12, 0xed, 0x39, 0x57, 0x08, 0xd7, 0x47, 0xc6, 0x47, 0x1e,
-	0xe3, 0x41, 0x20, 0xa2, 0x78, 0x51, 0xaa, 0x55, 0xeb, 0x80, 0xc0, 0xd9, 0xfb, 0xf3, 0x0d, 0xee,
-	0xf8, 0xbe, 0x78, 0xce, 0x83, 0x3e, 0xf6, 0x70, 0x6f, 0x8c, 0x32, 0xa2, 0xd7, 0xe1, 0x54, 0x7c,
-	0x24, 0x86, 0x0d, 0xd2, 0x24, 0x17, 0xeb, 0x1d, 0xe3, 0xd7, 0xf7, 0x8d, 0xb5, 0x09, 0x1f, 0xfa,
-	0xb7, 0xad, 0x64, 0xe1, 0x11, 0x1f, 0x0c, 0x42, 0x94, 0xd2, 0xea, 0x69, 0x69, 0xe6, 0xc2, 0xc6,
-	0x4a, 0xb1, 0x0b, 0x97, 0x5c, 0x68, 0x3d, 0x80, 0xb5, 0x3c, 0x84, 0x1c, 0x89, 0x40, 0x22, 0xdd,
-	0x81, 0x3a, 0xd7, 0x7f, 0xc6, 0x1c, 0xab, 0x6d, 0xd3, 0x2e, 0x29, 0xd5, 0xee, 0xce, 0x7f, 0xf5,
-	0x32, 0x83, 0xf5, 0x96, 0xe4, 0x37, 0x96, 0x4b, 0xf1, 0xb0, 0x2c, 0x5e, 0x01, 0x28, 0xbd, 0x0b,
-	0x90, 0x15, 0x1c, 0x27, 0x5c, 0x6d, 0x6f, 0x6a, 0x9e, 0xf9, 0x6d, 0xd8, 0xea, 0xf6, 0x35, 0xd1,
-	0x3d, 0xee, 0xea, 0x42, 0x7b, 0x0b, 0x4e, 0xeb, 0x3d, 0x81, 0xf5, 0x25, 0xb0, 0x24, 0xf2, 0x2e,
-	0x40, 0x9a, 0x40, 0x36, 0x48, 0xf3, 0x58, 0x85, 0xcc, 0x0b, 0x0e, 0xda, 0x2d, 0x60, 0xbc, 0x70,
-	0x24, 0xa3, 0x3a, 0xfc, 0x0f, 0xc8, 0x03, 0x02, 0x1b, 0x39, 0xc8, 0xce, 0xa4, 0xab, 0x2e, 0x5a,
-	0xd7, 0xd8, 0xc8, 0x4d, 0x49, 0x36, 0x09, 0xff, 0xaa, 0xaa, 0x8f, 0x04, 0x9a, 0xe5, 0x14, 0xff,
-	0x59, 0x67, 0xed, 0x77, 0xc7, 0xe1, 0x44, 0x4c, 0x4b, 0x3f, 0x11, 0xa8, 0xa7, 0xc8, 0xd4, 0x2e,
-	0x85, 0x29, 0x7c, 0xfb, 0x0c, 0x56, 0x59, 0xaf, 0x20, 0xac, 0xdd, 0x57, 0x5f, 0x7f, 0xbe, 0x59,
-	0xd9, 0xa6, 0x37, 0x59, 0xd9, 0x37, 0x24, 0x8d, 0xcb, 0x5e, 0x26, 0x77, 0xb4, 0xaf, 0x9f, 0x70,
-	0x9f, 0x7e, 0x20, 0x00, 0x59, 0xc3, 0xb4, 0xea, 0xf9, 0xfa, 0x7d, 0x32, 0xae, 0x56, 0x37, 0x24,
-	0xc4, 0x37, 0x62, 0x62, 0x46, 0xb7, 0x8e, 0x26, 0x96, 0x0b, 0xa0, 0x9f, 0x09, 0x9c, 0x2e, 0x18,
-	0x05, 0xba, 0x5d, 0x15, 0x20, 0x3f, 0xc3, 0xc6, 0xad, 0xbf, 0x70, 0x26, 0x19, 0x5a, 0x71, 0x86,
-	0x2b, 0xf4, 0x52, 0x69, 0x06, 0x4f, 0xca, 0x31, 0x0e, 0xb2, 0xca, 0x3b, 0x3b, 0x5f, 0xa6, 0x26,
-	0x39, 0x9c, 0x9a, 0xe4, 0xc7, 0xd4, 0x24, 0xaf, 0x67, 0x66, 0xed, 0x70, 0x66, 0xd6, 0xbe, 0xcd,
-	0xcc, 0xda, 0x43, 0xcb, 0xf5, 0xa2, 0x27, 0x63, 0xc7, 0xee, 0x8b, 0x21, 0xf3, 0xbd, 0x00, 0x99,
-	0xef, 0x0c, 0xb7, 0xe4, 0xe0, 0x29, 0x7b, 0x91, 0x6e, 0xeb, 0x9c, 0x8c, 0x3f, 0xdb, 0xd7, 0x7e,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x2e, 0xb7, 0xcd, 0x7e, 0x06, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x3b, 0xab, 0x55, 0xfa, 0x2c, 0x78, 0x18, 0x75, 0x37, 0x44, 0x49, 0x4b, 0x84, 0xf5,
+	0x8d, 0xcd, 0xd8, 0xee, 0x2a, 0xab, 0x94, 0x82, 0x55, 0xec, 0x55, 0x0b, 0x7a, 0xf0, 0xb2, 0x4c,
+	0xda, 0x31, 0x06, 0xd3, 0x4c, 0xb7, 0x93, 0xaa, 0x45, 0xf6, 0xe2, 0x5e, 0x3c, 0x0a, 0x82, 0x1f,
+	0xc0, 0x83, 0x17, 0xfd, 0x0a, 0x1e, 0xbc, 0xed, 0x71, 0xc1, 0x8b, 0xa7, 0x45, 0x5a, 0x3f, 0x81,
+	0x9f, 0x40, 0x3a, 0x79, 0xeb, 0x4b, 0x42, 0xa3, 0x88, 0xec, 0x2d, 0x4d, 0xfe, 0xff, 0x79, 0x7e,
+	0xcf, 0x7f, 0x9e, 0x99, 0xc2, 0x85, 0x16, 0x17, 0x1d, 0x2e, 0xc8, 0x13, 0xc6, 0xac, 0x1e, 0x75,
+	0x3d, 0xf2, 0xbc, 0x6c, 0x32, 0x8f, 0x96, 0xc9, 0x4e, 0x9f, 0xf5, 0x06, 0x46, 0xb7, 0xc7, 0x3d,
+	0x8e, 0x57, 0x7d, 0x91, 0x11, 0x8a, 0x8c, 0x40, 0xa4, 0x9e, 0xb1, 0xb8, 0xc5, 0xa5, 0x86, 0x8c,
+	0x9f, 0x7c, 0xb9, 0xba, 0x96, 0xb6, 0x66, 0xe4, 0xf7, 0x75, 0x57, 0x02, 0x9d, 0x49, 0x05, 0xf3,
+	0xeb, 0x45, 0xca, 0x2e, 0xb5, 0x6c, 0x97, 0x7a, 0x36, 0x77, 0x03, 0xed, 0x79, 0x8b, 0x73, 0xcb,
+	0x61, 0x84, 0x76, 0x6d, 0x42, 0x5d, 0x97, 0x7b, 0xf2, 0xa3, 0xf0, 0xbf, 0xea, 0x7b, 0x08, 0xce,
+	0x3e, 0x18, 0x2f, 0x70, 0xdb, 0x71, 0xf8, 0x0b, 0xea, 0xb6, 0x58, 0x93, 0xed, 0xf4, 0x99, 0xf0,
+	0xf0, 0x26, 0x9c, 0x94, 0x25, 0x59, 0x4f, 0x41, 0x25, 0x74, 0xa9, 0x50, 0x57, 0x7f, 0x1d, 0x16,
+	0x57, 0x06, 0xb4, 0xe3, 0xdc, 0xd2, 0x83, 0x0f, 0xdb, 0xb4, 0xdd, 0xee, 0x31, 0x21, 0xf4, 0x66,
+	0x28, 0x8d, 0x5d, 0x4c, 0x59, 0x4a, 0x76, 0xb1, 0x39, 0x17, 0xd3, 0x1f, 0xc1, 0xca, 0x2c, 0x84,
+	0xe8, 0x72, 0x57, 0x30, 0x5c, 0x85, 0x02, 0x0d, 0x5f, 0x4a, 0x8e, 0xe5, 0x8a, 0x66, 0xa4, 0x84,
+	0x6a, 0x34, 0xc6, 0xbf, 0x9a, 0xb1, 0x41, 0x7f, 0x8f, 0x66, 0x17, 0x16, 0x73, 0xed, 0x31, 0x05,
+	0x65, 0x06, 0xc5, 0xf7, 0x00, 0xe2, 0x80, 0x65, 0x87, 0xcb, 0x95, 0xb5, 0x90, 0x67, 0xbc, 0x1b,
+	0x86, 0xbf, 0xfb, 0x21, 0xd1, 0x7d, 0x6a, 0x85, 0x81, 0x36, 0x27, 0x9c, 0xfa, 0x07, 0x04, 0xab,
+	0x73, 0x60, 0x41, 0xcb, 0x35, 0x80, 0xa8, 0x03, 0xa1, 0xa0, 0xd2, 0xb1, 0x0c, 0x3d, 0x4f, 0x38,
+	0x70, 0x23, 0x81, 0xf1, 0xe2, 0x42, 0x46, 0xbf, 0xf8, 0x14, 0xe4, 0x1e, 0x82, 0xe2, 0x0c, 0x64,
+	0x7d, 0xd0, 0xf0, 0x37, 0x3a, 0x8c, 0x51, 0x99, 0x99, 0x92, 0x78, 0x12, 0xfe, 0x55, 0x54, 0x9f,
+	0x10, 0x94, 0xd2, 0x29, 0x8e, 0x5a, 0x66, 0x6f, 0x10, 0xa8, 0xd3, 0xb4, 0x0f, 0x45, 0xdc, 0xd8,
+	0x7f, 0x3d, 0x54, 0x26, 0x9c, 0x4b, 0x24, 0x09, 0x22, 0xbb, 0x03, 0xf9, 0xfe, 0xf8, 0x85, 0x82,
+	0xa6, 0xbb, 0x9d, 0x4b, 0x6b, 0xda, 0x5f, 0x3f, 0xbe, 0x7f, 0x58, 0xcc, 0x35, 0x7d, 0x6f, 0x65,
+	0x94, 0x87, 0xbc, 0x2c, 0x82, 0x3f, 0x23, 0x28, 0x44, 0x4a, 0x6c, 0xa4, 0xae, 0x96, 0x78, 0xd9,
+	0xa8, 0x24, 0xb3, 0xde, 0xa7, 0xd7, 0x6b, 0xaf, 0xbf, 0xfd, 0x7c, 0xb7, 0xb4, 0x85, 0x6f, 0x90,
+	0xb4, 0x2b, 0x33, 0xda, 0x5d, 0xf2, 0x2a, 0xc8, 0x71, 0x37, 0x7c, 0x62, 0xbb, 0xf8, 0x23, 0x02,
+	0x88, 0x07, 0x0a, 0x67, 0xad, 0x1f, 0x5e, 0x1f, 0xea, 0xb5, 0xec, 0x86, 0x80, 0xf8, 0xba, 0x24,
+	0x26, 0x78, 0x7d, 0x31, 0xb1, 0x98, 0x00, 0xfd, 0x82, 0xe0, 0x74, 0xc2, 0xe4, 0xe3, 0xad, 0xac,
+	0x00, 0xb3, 0x47, 0x56, 0xbd, 0xf9, 0x17, 0xce, 0xa0, 0x87, 0xb2, 0xec, 0xe1, 0x2a, 0xbe, 0x9c,
+	0xda, 0x83, 0x2d, 0x44, 0x9f, 0xb5, 0xe3, 0xc8, 0xf1, 0x57, 0x04, 0xa7, 0xa6, 0x27, 0x08, 0x6f,
+	0x64, 0x04, 0x98, 0x3c, 0x39, 0xea, 0xe6, 0x9f, 0x99, 0x02, 0xe0, 0xbb, 0x12, 0xb8, 0x86, 0xab,
+	0x8b, 0x43, 0xdf, 0x96, 0x13, 0x9d, 0x34, 0x2c, 0xf5, 0xea, 0xfe, 0x50, 0x43, 0x07, 0x43, 0x0d,
+	0xfd, 0x18, 0x6a, 0xe8, 0xed, 0x48, 0xcb, 0x1d, 0x8c, 0xb4, 0xdc, 0xf7, 0x91, 0x96, 0x7b, 0xac,
+	0x5b, 0xb6, 0xf7, 0xb4, 0x6f, 0x1a, 0x2d, 0xde, 0x21, 0x8e, 0xed, 0x32, 0xe2, 0x98, 0x9d, 0x75,
+	0xd1, 0x7e, 0x46, 0x5e, 0x46, 0x95, 0xcc, 0x13, 0xf2, 0x9f, 0x76, 0xe3, 0xf7, 0x00, 0x11, 0x4e,
+	0x15, 0x12, 0x31, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -419,6 +527,8 @@ type QueryClient interface {
 	// AllowancesByGranter returns all the grants given by an address
 	// Since v0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// AllowanceUsage returns the usage of the allowance granted to the grantee by the granter.
+	AllowanceUsage(ctx context.Context, in *QueryAllowanceUsageRequest, opts ...grpc.CallOption) (*QueryAllowanceUsageResponse, error)
 }
 
 type queryClient struct {
@@ -456,6 +566,15 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) AllowanceUsage(ctx context.Context, in *QueryAllowanceUsageRequest, opts ...grpc.CallOption) (*QueryAllowanceUsageResponse, error) {
+	out := new(QueryAllowanceUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/AllowanceUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns fee granted to the grantee by the granter.
@@ -465,6 +584,8 @@ type QueryServer interface {
 	// AllowancesByGranter returns all the grants given by an address
 	// Since v0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// AllowanceUsage returns the usage of the allowance granted to the grantee by the granter.
+	AllowanceUsage(context.Context, *QueryAllowanceUsageRequest) (*QueryAllowanceUsageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -480,6 +601,9 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) AllowanceUsage(ctx context.Context, req *QueryAllowanceUsageRequest) (*QueryAllowanceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowanceUsage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -539,6 +663,24 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllowanceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowanceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/AllowanceUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowanceUsage(ctx, req.(*QueryAllowanceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -555,6 +697,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "AllowanceUsage",
+			Handler:    _Query_AllowanceUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -814,6 +960,76 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllowanceUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -927,6 +1143,34 @@ func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllowanceUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1609,6 +1853,203 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllowanceUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,6 +19,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &grantA)
 			cdc.MustUnmarshal(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], feegrant.AllowanceUsageKeyPrefix):
+			var usageA, usageB feegrant.AllowanceUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
 		default:
 			panic(fmt.Sprintf("invalid feegrant key %X", kvA.Key))
 		}
@@ -0,0 +1,41 @@
+package feegrant
+
+import (
+	"time"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// NewAllowanceUsage creates an empty AllowanceUsage.
+//
+//nolint:interfacer
+func NewAllowanceUsage(granter, grantee sdk.AccAddress) AllowanceUsage {
+	return AllowanceUsage{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+		Spent:   sdk.NewCoins(),
+	}
+}
+
+// Add records a use of the allowance which paid the given fee at the given time.
+func (u *AllowanceUsage) Add(fee sdk.Coins, usedAt time.Time) {
+	u.Spent = u.Spent.Add(fee...)
+	u.Count++
+	u.LastUsed = usedAt
+}
+
+// ValidateBasic performs basic validation on AllowanceUsage
+func (u AllowanceUsage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Granter); err != nil {
+		return sdkerrors.Wrapf(err, "invalid granter address: %s", u.Granter)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Grantee); err != nil {
+		return sdkerrors.Wrapf(err, "invalid grantee address: %s", u.Grantee)
+	}
+	if !u.Spent.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, u.Spent.String())
+	}
+
+	return nil
+}
@@ -508,6 +508,52 @@ func (m *EventLeaveFoundation) GetAddress() string {
 	return ""
 }
 
+// EventFreezeMember is an event emitted when a foundation member gets frozen.
+type EventFreezeMember struct {
+	// address is the account address of the foundation member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventFreezeMember) Reset()         { *m = EventFreezeMember{} }
+func (m *EventFreezeMember) String() string { return proto.CompactTextString(m) }
+func (*EventFreezeMember) ProtoMessage()    {}
+func (*EventFreezeMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{10}
+}
+func (m *EventFreezeMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFreezeMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFreezeMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFreezeMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFreezeMember.Merge(m, src)
+}
+func (m *EventFreezeMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFreezeMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFreezeMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFreezeMember proto.InternalMessageInfo
+
+func (m *EventFreezeMember) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // EventUpdateCensorship is emitted when a censorship information updated.
 type EventUpdateCensorship struct {
 	Censorship Censorship `protobuf:"bytes,1,opt,name=censorship,proto3" json:"censorship"`
@@ -517,7 +563,7 @@ func (m *EventUpdateCensorship) Reset()         { *m = EventUpdateCensorship{} }
 func (m *EventUpdateCensorship) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateCensorship) ProtoMessage()    {}
 func (*EventUpdateCensorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{10}
+	return fileDescriptor_2b66c645bbb34fbc, []int{11}
 }
 func (m *EventUpdateCensorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +611,7 @@ func (m *EventGrant) Reset()         { *m = EventGrant{} }
 func (m *EventGrant) String() string { return proto.CompactTextString(m) }
 func (*EventGrant) ProtoMessage()    {}
 func (*EventGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{11}
+	return fileDescriptor_2b66c645bbb34fbc, []int{12}
 }
 func (m *EventGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +666,7 @@ func (m *EventRevoke) Reset()         { *m = EventRevoke{} }
 func (m *EventRevoke) String() string { return proto.CompactTextString(m) }
 func (*EventRevoke) ProtoMessage()    {}
 func (*EventRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{12}
+	return fileDescriptor_2b66c645bbb34fbc, []int{13}
 }
 func (m *EventRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,6 +720,7 @@ func init() {
 	proto.RegisterType((*EventVote)(nil), "lbm.foundation.v1.EventVote")
 	proto.RegisterType((*EventExec)(nil), "lbm.foundation.v1.EventExec")
 	proto.RegisterType((*EventLeaveFoundation)(nil), "lbm.foundation.v1.EventLeaveFoundation")
+	proto.RegisterType((*EventFreezeMember)(nil), "lbm.foundation.v1.EventFreezeMember")
 	proto.RegisterType((*EventUpdateCensorship)(nil), "lbm.foundation.v1.EventUpdateCensorship")
 	proto.RegisterType((*EventGrant)(nil), "lbm.foundation.v1.EventGrant")
 	proto.RegisterType((*EventRevoke)(nil), "lbm.foundation.v1.EventRevoke")
@@ -682,54 +729,54 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/event.proto", fileDescriptor_2b66c645bbb34fbc) }
 
 var fileDescriptor_2b66c645bbb34fbc = []byte{
-	// 740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xc1, 0x4f, 0x13, 0x4d,
-	0x14, 0xef, 0x42, 0xd3, 0xef, 0x63, 0xf8, 0xe8, 0x17, 0x46, 0x8c, 0x05, 0x43, 0xdb, 0xac, 0x1e,
-	0x30, 0xa6, 0xbb, 0x16, 0x0f, 0x1a, 0x13, 0x49, 0x28, 0x82, 0x21, 0x81, 0x04, 0x57, 0xd0, 0xc4,
-	0x18, 0x9b, 0xd9, 0xdd, 0x61, 0xbb, 0x61, 0x67, 0x67, 0x9d, 0x99, 0x5d, 0x29, 0x57, 0x0f, 0x9a,
-	0x78, 0xf1, 0xe2, 0xdd, 0xb3, 0x67, 0xfe, 0x08, 0xc2, 0x89, 0xa3, 0x27, 0x35, 0xf0, 0x8f, 0x98,
-	0x99, 0x9d, 0x96, 0x36, 0x14, 0x3c, 0x79, 0x7b, 0x6f, 0xe6, 0xfd, 0x7e, 0xef, 0xf7, 0x7e, 0xf3,
-	0x06, 0xcc, 0x47, 0x2e, 0xb1, 0x77, 0x69, 0x1a, 0xfb, 0x48, 0x84, 0x34, 0xb6, 0xb3, 0xa6, 0x8d,
-	0x33, 0x1c, 0x0b, 0x2b, 0x61, 0x54, 0x50, 0x38, 0x1d, 0xb9, 0xc4, 0x3a, 0xbf, 0xb6, 0xb2, 0xe6,
-	0xdc, 0x4c, 0x40, 0x03, 0xaa, 0x6e, 0x6d, 0x19, 0xe5, 0x85, 0x73, 0xb3, 0x01, 0xa5, 0x41, 0x84,
-	0x6d, 0x95, 0xb9, 0xe9, 0xae, 0x8d, 0xe2, 0x6e, 0xef, 0xca, 0xa3, 0x9c, 0x50, 0xde, 0xce, 0x31,
-	0x79, 0xa2, 0xaf, 0xaa, 0x79, 0x66, 0xbb, 0x88, 0x63, 0x3b, 0x6b, 0xba, 0x58, 0xa0, 0xa6, 0xed,
-	0xd1, 0x30, 0xd6, 0xf7, 0xe6, 0x45, 0x75, 0x03, 0x62, 0x54, 0x8d, 0xb9, 0x01, 0xa6, 0x57, 0xa5,
-	0xe2, 0x9d, 0xc4, 0x47, 0x02, 0x6f, 0x21, 0x86, 0x08, 0x87, 0x0f, 0x40, 0x29, 0x51, 0x51, 0xc5,
-	0xa8, 0x1b, 0x0b, 0x93, 0x8b, 0xb3, 0xd6, 0x85, 0x41, 0xac, 0xbc, 0xb4, 0x55, 0x3c, 0xfa, 0x51,
-	0x2b, 0x38, 0xba, 0xdc, 0xfc, 0x60, 0x68, 0xba, 0xb5, 0x34, 0xf6, 0xb7, 0x19, 0x46, 0x3c, 0x65,
-	0x5d, 0x08, 0x41, 0x71, 0x97, 0x51, 0xa2, 0xc8, 0x26, 0x1c, 0x15, 0xc3, 0x37, 0xa0, 0x84, 0x08,
-	0x4d, 0x63, 0x51, 0x19, 0xab, 0x8f, 0xab, 0x16, 0x7a, 0x34, 0x39, 0x8c, 0xa5, 0x87, 0xb1, 0x56,
-	0x68, 0x18, 0xb7, 0xee, 0xca, 0x16, 0xdf, 0x7e, 0xd6, 0x6e, 0x05, 0xa1, 0xe8, 0xa4, 0xae, 0xe5,
-	0x51, 0x62, 0x47, 0x61, 0x8c, 0xed, 0xc8, 0x25, 0x0d, 0xee, 0xef, 0xd9, 0xa2, 0x9b, 0x60, 0xae,
-	0x6a, 0xb9, 0xa3, 0x59, 0xcd, 0x4f, 0x06, 0x98, 0x55, 0x4a, 0x5e, 0x86, 0xa2, 0xe3, 0x33, 0xf4,
-	0x6e, 0x8d, 0x51, 0xd2, 0x57, 0x54, 0x06, 0x63, 0x82, 0x6a, 0x3d, 0x63, 0x82, 0xfe, 0x75, 0x35,
-	0x1e, 0x80, 0x03, 0x2e, 0x6f, 0x62, 0xe2, 0x62, 0xc6, 0xe1, 0x26, 0x28, 0x13, 0x15, 0xb6, 0x53,
-	0x75, 0x2e, 0xed, 0x96, 0xdd, 0xeb, 0x23, 0xec, 0xce, 0x31, 0x0e, 0x7e, 0x9b, 0x62, 0x2e, 0xb4,
-	0xeb, 0x53, 0x39, 0x3a, 0x27, 0xe5, 0xa6, 0xd0, 0x13, 0xe7, 0xf9, 0x13, 0xec, 0x85, 0x3c, 0xa4,
-	0xf1, 0x16, 0x8d, 0x42, 0xaf, 0x0b, 0x9f, 0x81, 0xff, 0x7d, 0x7d, 0xd2, 0x4e, 0xd4, 0x91, 0x7e,
-	0xdb, 0x19, 0x2b, 0xdf, 0x3d, 0xab, 0xb7, 0x7b, 0xd6, 0x72, 0xdc, 0x6d, 0xc1, 0xe3, 0xc3, 0x46,
-	0x79, 0x98, 0xc2, 0x29, 0xfb, 0x43, 0xf9, 0xa3, 0xe2, 0xc7, 0xaf, 0xb5, 0x82, 0xb9, 0x0d, 0xae,
-	0xa9, 0xae, 0xcf, 0x53, 0x97, 0x84, 0x62, 0x8b, 0xd1, 0x84, 0x72, 0x14, 0xc1, 0xc7, 0xe0, 0xdf,
-	0x44, 0xc7, 0xba, 0xd1, 0xcd, 0x51, 0x4b, 0xa4, 0x4b, 0xf4, 0x40, 0x7d, 0x88, 0xf9, 0x10, 0x5c,
-	0x1f, 0x7a, 0xbd, 0x3e, 0x6f, 0x0d, 0x4c, 0xf6, 0x8a, 0xda, 0xa1, 0xaf, 0xa8, 0x8b, 0x0e, 0xe8,
-	0x1d, 0xad, 0xfb, 0xe6, 0x12, 0x98, 0x50, 0xc8, 0x17, 0x54, 0x60, 0xd8, 0x04, 0xc5, 0x8c, 0x0a,
-	0xac, 0x15, 0xdc, 0x18, 0xa1, 0x40, 0x96, 0xe9, 0xee, 0xaa, 0xd4, 0x7c, 0x6f, 0x68, 0x82, 0xd5,
-	0x7d, 0xec, 0xfd, 0xb1, 0x1d, 0x5c, 0x06, 0x25, 0x86, 0x79, 0x1a, 0xc9, 0xcd, 0x31, 0x16, 0xca,
-	0x8b, 0x77, 0xae, 0x98, 0x52, 0x32, 0xa6, 0x82, 0x32, 0x47, 0x01, 0x1c, 0x0d, 0x94, 0xdf, 0x23,
-	0xa2, 0x01, 0xaf, 0x8c, 0xe7, 0xdf, 0x43, 0xc6, 0xe6, 0x3d, 0x30, 0xa3, 0x44, 0x6c, 0x60, 0x94,
-	0xe1, 0xb5, 0x3e, 0x1b, 0xac, 0x80, 0x7f, 0x90, 0xef, 0x33, 0xcc, 0xb9, 0xde, 0xde, 0x5e, 0x6a,
-	0xbe, 0xd6, 0x8e, 0xe5, 0xaf, 0xbf, 0x82, 0x63, 0x4e, 0x19, 0xef, 0x84, 0x09, 0x5c, 0x01, 0xc0,
-	0xeb, 0x67, 0xda, 0x89, 0xf9, 0x11, 0x2a, 0xcf, 0x21, 0xda, 0x8f, 0x01, 0x98, 0xf9, 0xc5, 0x00,
-	0x40, 0xd1, 0x3f, 0x65, 0x28, 0x16, 0x52, 0x46, 0x20, 0x03, 0x8c, 0x7b, 0x32, 0x74, 0x0a, 0x09,
-	0x98, 0x42, 0xa9, 0xe8, 0x50, 0x16, 0x1e, 0x28, 0x66, 0x65, 0xcb, 0x65, 0x5b, 0xd6, 0x3c, 0x3e,
-	0x6c, 0x34, 0x2e, 0xfb, 0x47, 0xfb, 0xb6, 0x24, 0x3a, 0xb0, 0x96, 0x07, 0xe9, 0x9c, 0x61, 0x76,
-	0x73, 0x1d, 0x4c, 0x2a, 0x59, 0x0e, 0xce, 0xe8, 0x1e, 0xbe, 0x42, 0x57, 0x1d, 0xfc, 0x47, 0x78,
-	0xd0, 0x96, 0x9f, 0xb3, 0x9d, 0xb2, 0x48, 0xc9, 0x9a, 0x70, 0x00, 0xe1, 0xc1, 0x76, 0x37, 0xc1,
-	0x3b, 0x2c, 0x6a, 0x2d, 0x1d, 0x9d, 0x56, 0x8d, 0x93, 0xd3, 0xaa, 0xf1, 0xeb, 0xb4, 0x6a, 0x7c,
-	0x3e, 0xab, 0x16, 0x4e, 0xce, 0xaa, 0x85, 0xef, 0x67, 0xd5, 0xc2, 0xab, 0xdb, 0x97, 0x4b, 0x3c,
-	0xb7, 0xd0, 0x2d, 0xa9, 0xd1, 0xee, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x50, 0xe7, 0x22,
-	0x14, 0x06, 0x00, 0x00,
+	// 749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x43, 0x94, 0x5d, 0x86, 0x25, 0x2b, 0x66, 0x41, 0x1b, 0x58, 0x91, 0x44, 0xde, 0x3d,
+	0xb0, 0xaa, 0x62, 0x37, 0xf4, 0xd0, 0xaa, 0x52, 0x91, 0x08, 0x85, 0x0a, 0x09, 0x24, 0xea, 0x42,
+	0x2b, 0x55, 0x55, 0xa3, 0xb1, 0x3d, 0x38, 0x16, 0x1e, 0x8f, 0x3b, 0x33, 0x4e, 0x09, 0xd7, 0x1e,
+	0x5a, 0xa9, 0x97, 0x5e, 0x7a, 0xef, 0xb9, 0x67, 0xfe, 0x08, 0xc4, 0x89, 0x63, 0x4f, 0x6d, 0x05,
+	0xff, 0x48, 0x35, 0xe3, 0xc9, 0x2f, 0x11, 0xd2, 0x53, 0x6f, 0xef, 0xcd, 0xfb, 0xde, 0xf7, 0xbe,
+	0xf7, 0xe6, 0x3d, 0xb0, 0x1c, 0xb9, 0xc4, 0x3e, 0xa4, 0x69, 0xec, 0x23, 0x11, 0xd2, 0xd8, 0xee,
+	0x34, 0x6c, 0xdc, 0xc1, 0xb1, 0xb0, 0x12, 0x46, 0x05, 0x85, 0x73, 0x91, 0x4b, 0xac, 0x41, 0xd8,
+	0xea, 0x34, 0x96, 0xe6, 0x03, 0x1a, 0x50, 0x15, 0xb5, 0xa5, 0x95, 0x01, 0x97, 0x16, 0x03, 0x4a,
+	0x83, 0x08, 0xdb, 0xca, 0x73, 0xd3, 0x43, 0x1b, 0xc5, 0xdd, 0x5e, 0xc8, 0xa3, 0x9c, 0x50, 0xde,
+	0xca, 0x72, 0x32, 0x47, 0x87, 0x2a, 0x99, 0x67, 0xbb, 0x88, 0x63, 0xbb, 0xd3, 0x70, 0xb1, 0x40,
+	0x0d, 0xdb, 0xa3, 0x61, 0xac, 0xe3, 0xe6, 0x75, 0x75, 0x03, 0x2f, 0xc3, 0x98, 0x3b, 0x60, 0x6e,
+	0x53, 0x2a, 0x3e, 0x48, 0x7c, 0x24, 0xf0, 0x1e, 0x62, 0x88, 0x70, 0x78, 0x17, 0x14, 0x13, 0x65,
+	0x95, 0x8d, 0x9a, 0xb1, 0x32, 0xb3, 0xba, 0x68, 0x5d, 0x6b, 0xc4, 0xca, 0xa0, 0xcd, 0xc2, 0xd9,
+	0xd7, 0x6a, 0xce, 0xd1, 0x70, 0xf3, 0xad, 0xa1, 0xe9, 0xb6, 0xd2, 0xd8, 0xdf, 0x67, 0x18, 0xf1,
+	0x94, 0x75, 0x21, 0x04, 0x85, 0x43, 0x46, 0x89, 0x22, 0x9b, 0x76, 0x94, 0x0d, 0x5f, 0x82, 0x22,
+	0x22, 0x34, 0x8d, 0x45, 0x39, 0x5f, 0x9b, 0x52, 0x25, 0x74, 0x6b, 0xb2, 0x19, 0x4b, 0x37, 0x63,
+	0x6d, 0xd0, 0x30, 0x6e, 0xde, 0x92, 0x25, 0x3e, 0x7f, 0xab, 0xfe, 0x1b, 0x84, 0xa2, 0x9d, 0xba,
+	0x96, 0x47, 0x89, 0x1d, 0x85, 0x31, 0xb6, 0x23, 0x97, 0xd4, 0xb9, 0x7f, 0x64, 0x8b, 0x6e, 0x82,
+	0xb9, 0xc2, 0x72, 0x47, 0xb3, 0x9a, 0xef, 0x0d, 0xb0, 0xa8, 0x94, 0x3c, 0x0b, 0x45, 0xdb, 0x67,
+	0xe8, 0xf5, 0x16, 0xa3, 0xa4, 0xaf, 0xa8, 0x04, 0xf2, 0x82, 0x6a, 0x3d, 0x79, 0x41, 0x7f, 0xb9,
+	0x1a, 0x0f, 0xc0, 0xa1, 0x29, 0xef, 0x62, 0xe2, 0x62, 0xc6, 0xe1, 0x2e, 0x28, 0x11, 0x65, 0xb6,
+	0x52, 0xf5, 0x2e, 0xc7, 0x2d, 0xab, 0xd7, 0xc6, 0x8c, 0x3b, 0xcb, 0x71, 0xf0, 0xab, 0x14, 0x73,
+	0xa1, 0xa7, 0x3e, 0x9b, 0x65, 0x67, 0xa4, 0xdc, 0x14, 0xba, 0xe3, 0xcc, 0x7f, 0x88, 0xbd, 0x90,
+	0x87, 0x34, 0xde, 0xa3, 0x51, 0xe8, 0x75, 0xe1, 0x63, 0xf0, 0xa7, 0xaf, 0x5f, 0x5a, 0x89, 0x7a,
+	0xd2, 0x7f, 0x3b, 0x6f, 0x65, 0xbb, 0x67, 0xf5, 0x76, 0xcf, 0x5a, 0x8f, 0xbb, 0x4d, 0x78, 0x7e,
+	0x5a, 0x2f, 0x8d, 0x52, 0x38, 0x25, 0x7f, 0xc4, 0xbf, 0x5f, 0x78, 0xf7, 0xa9, 0x9a, 0x33, 0xf7,
+	0xc1, 0x5f, 0xaa, 0xea, 0x93, 0xd4, 0x25, 0xa1, 0xd8, 0x63, 0x34, 0xa1, 0x1c, 0x45, 0xf0, 0x01,
+	0xf8, 0x3d, 0xd1, 0xb6, 0x2e, 0xf4, 0xcf, 0xb8, 0x25, 0xd2, 0x10, 0xdd, 0x50, 0x3f, 0xc5, 0xbc,
+	0x07, 0x16, 0x46, 0x7e, 0xaf, 0xcf, 0x5b, 0x05, 0x33, 0x3d, 0x50, 0x2b, 0xf4, 0x15, 0x75, 0xc1,
+	0x01, 0xbd, 0xa7, 0x6d, 0xdf, 0x5c, 0x03, 0xd3, 0x2a, 0xf3, 0x29, 0x15, 0x18, 0x36, 0x40, 0xa1,
+	0x43, 0x05, 0xd6, 0x0a, 0xfe, 0x1e, 0xa3, 0x40, 0xc2, 0x74, 0x75, 0x05, 0x35, 0xdf, 0x18, 0x9a,
+	0x60, 0xf3, 0x18, 0x7b, 0x3f, 0x2d, 0x07, 0xd7, 0x41, 0x91, 0x61, 0x9e, 0x46, 0x72, 0x73, 0x8c,
+	0x95, 0xd2, 0xea, 0xff, 0x13, 0xba, 0x94, 0x8c, 0xa9, 0xa0, 0xcc, 0x51, 0x09, 0x8e, 0x4e, 0x94,
+	0xe7, 0x11, 0xd1, 0x80, 0x97, 0xa7, 0xb2, 0xf3, 0x90, 0xb6, 0x79, 0x1b, 0xcc, 0x2b, 0x11, 0x3b,
+	0x18, 0x75, 0xf0, 0x56, 0x9f, 0x0d, 0x96, 0xc1, 0x6f, 0xc8, 0xf7, 0x19, 0xe6, 0x5c, 0x6f, 0x6f,
+	0xcf, 0x35, 0xeb, 0xbd, 0xcb, 0x63, 0x18, 0x9f, 0xe8, 0x15, 0x9b, 0x00, 0x7f, 0x01, 0x16, 0x86,
+	0x96, 0x65, 0x03, 0xc7, 0x9c, 0x32, 0xde, 0x0e, 0x13, 0xb8, 0x01, 0x80, 0xd7, 0xf7, 0xf4, 0xe0,
+	0x96, 0xc7, 0x34, 0x35, 0x48, 0xd1, 0xe3, 0x1b, 0x4a, 0x33, 0x3f, 0x1a, 0x00, 0x28, 0xfa, 0x47,
+	0x0c, 0xc5, 0x42, 0xca, 0x08, 0xa4, 0x81, 0x71, 0x4f, 0x86, 0x76, 0x21, 0x01, 0xb3, 0x28, 0x15,
+	0x6d, 0xca, 0xc2, 0x13, 0xc5, 0x5c, 0xce, 0x4f, 0x58, 0xca, 0xc6, 0xf9, 0x69, 0xbd, 0x7e, 0xd3,
+	0xd9, 0x1d, 0xdb, 0x92, 0xe8, 0xc4, 0x5a, 0x1f, 0xa6, 0x73, 0x46, 0xd9, 0xcd, 0x6d, 0x30, 0xa3,
+	0x64, 0x39, 0xb8, 0x43, 0x8f, 0xf0, 0x04, 0x5d, 0x35, 0xf0, 0x07, 0xe1, 0x41, 0x4b, 0xde, 0x72,
+	0x2b, 0x65, 0x91, 0x92, 0x35, 0xed, 0x00, 0xc2, 0x83, 0xfd, 0x6e, 0x82, 0x0f, 0x58, 0xd4, 0x5c,
+	0x3b, 0xbb, 0xac, 0x18, 0x17, 0x97, 0x15, 0xe3, 0xfb, 0x65, 0xc5, 0xf8, 0x70, 0x55, 0xc9, 0x5d,
+	0x5c, 0x55, 0x72, 0x5f, 0xae, 0x2a, 0xb9, 0xe7, 0xff, 0xdd, 0x2c, 0x71, 0x30, 0x42, 0xb7, 0xa8,
+	0x5a, 0xbb, 0xf3, 0x63, 0x00, 0xf5, 0xd3, 0x18, 0x25, 0x43, 0x06, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -1089,6 +1136,36 @@ func (m *EventLeaveFoundation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFreezeMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFreezeMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFreezeMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventUpdateCensorship) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1355,6 +1432,19 @@ func (m *EventLeaveFoundation) Size() (n int) {
 	return n
 }
 
+func (m *EventFreezeMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func (m *EventUpdateCensorship) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2328,6 +2418,88 @@ func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFreezeMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFreezeMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFreezeMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -285,6 +285,9 @@ type Member struct {
 	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// added_at is a timestamp specifying when a member was added.
 	AddedAt time.Time `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// frozen is the flag which tells whether the member has been frozen due to its misbehavior.
+	// A frozen member can neither submit proposals, vote nor execute them.
+	Frozen bool `protobuf:"varint,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
@@ -341,6 +344,13 @@ func (m *Member) GetAddedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *Member) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 // MemberRequest represents a foundation member to be used in Msg server requests.
 // Contrary to `Member`, it doesn't have any `added_at` field
 // since this field cannot be set as part of requests.
@@ -409,10 +419,10 @@ func (m *MemberRequest) GetMetadata() string {
 
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
-// 1. The sum of all `YES` voters' weights is greater or equal than the defined
-//    `threshold`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum sum of yes votes that must be met or exceeded for a proposal to succeed.
 	Threshold github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,1,opt,name=threshold,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"threshold"`
@@ -462,10 +472,10 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
-// 1. The percentage of all `YES` voters' weights out of the total group weight
-//    is greater or equal than the given `percentage`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The percentage of all `YES` voters' weights out of the total group weight
+//     is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type PercentageDecisionPolicy struct {
 	// percentage is the minimum percentage the sum of yes votes must meet for a proposal to succeed.
 	Percentage github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,1,opt,name=percentage,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"percentage"`
@@ -1000,101 +1010,102 @@ func init() {
 }
 
 var fileDescriptor_1980496a233f02f4 = []byte{
-	// 1501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0xd5,
-	0x16, 0xcf, 0xd8, 0x4e, 0x62, 0x1f, 0x27, 0x8e, 0x7b, 0x9b, 0xd7, 0x3a, 0x6e, 0x6b, 0xbb, 0x56,
-	0x55, 0xa5, 0x95, 0x6a, 0xbf, 0xe4, 0xe9, 0x2d, 0xe8, 0x06, 0xf9, 0x63, 0xd2, 0xb8, 0x4a, 0x3d,
-	0xee, 0x78, 0x9c, 0x50, 0x24, 0x34, 0x8c, 0x3d, 0x37, 0xf6, 0x88, 0x99, 0xb9, 0x66, 0xee, 0xb5,
-	0x13, 0xaf, 0x90, 0x58, 0x55, 0xdd, 0xd0, 0x25, 0x9b, 0x4a, 0x48, 0x6c, 0x10, 0x6b, 0x24, 0x50,
-	0x25, 0xf6, 0x85, 0x05, 0x2a, 0x6c, 0x40, 0x2c, 0x5a, 0x94, 0xfe, 0x0d, 0xec, 0xd1, 0x7c, 0xf9,
-	0x2b, 0x4e, 0xa8, 0x91, 0xd8, 0xe5, 0xdc, 0x73, 0x7e, 0xbf, 0xfb, 0x3b, 0xe7, 0x9e, 0x73, 0x3c,
-	0x81, 0xac, 0xde, 0x34, 0xf2, 0x87, 0xa4, 0x67, 0xaa, 0x0a, 0xd3, 0x88, 0x99, 0xef, 0x6f, 0x8d,
-	0x59, 0xb9, 0xae, 0x45, 0x18, 0x41, 0x17, 0xf4, 0xa6, 0x91, 0x1b, 0x3b, 0xed, 0x6f, 0x25, 0xd7,
-	0xdb, 0xa4, 0x4d, 0x1c, 0x6f, 0xde, 0xfe, 0xcb, 0x0d, 0x4c, 0xa6, 0xda, 0x84, 0xb4, 0x75, 0x9c,
-	0x77, 0xac, 0x66, 0xef, 0x30, 0xaf, 0xf6, 0xac, 0x31, 0xa2, 0x64, 0x7a, 0xda, 0xcf, 0x34, 0x03,
-	0x53, 0xa6, 0x18, 0x5d, 0x2f, 0x60, 0x63, 0x3a, 0x40, 0x31, 0x07, 0x3e, 0x77, 0x8b, 0x50, 0x83,
-	0xd0, 0x7c, 0x53, 0xa1, 0x38, 0xdf, 0xdf, 0x6a, 0x62, 0xa6, 0x6c, 0xe5, 0x5b, 0x44, 0xf3, 0xb9,
-	0x37, 0x5c, 0xbf, 0xec, 0x8a, 0x72, 0x0d, 0xd7, 0x95, 0xfd, 0x10, 0x96, 0x6a, 0x8a, 0xa5, 0x18,
-	0x14, 0xd5, 0x20, 0x36, 0xca, 0x43, 0x66, 0xca, 0x71, 0x82, 0xcb, 0x70, 0x9b, 0x91, 0xe2, 0xad,
-	0x17, 0xaf, 0xd2, 0x0b, 0xbf, 0xbf, 0x4a, 0x5f, 0x6f, 0x6b, 0xac, 0xd3, 0x6b, 0xe6, 0x5a, 0xc4,
-	0xc8, 0xeb, 0x9a, 0x89, 0xf3, 0x7a, 0xd3, 0xb8, 0x43, 0xd5, 0x8f, 0xf2, 0x6c, 0xd0, 0xc5, 0x34,
-	0x57, 0xc6, 0x2d, 0x71, 0x75, 0x44, 0x20, 0x29, 0xc7, 0xf7, 0x43, 0xe1, 0x40, 0x3c, 0x98, 0x65,
-	0x00, 0x25, 0x6c, 0x52, 0x62, 0xd1, 0x8e, 0xd6, 0x45, 0x19, 0x58, 0x31, 0x68, 0x5b, 0xb6, 0x31,
-	0x72, 0xcf, 0xd2, 0xdd, 0x3b, 0x44, 0x30, 0x68, 0x5b, 0x1a, 0x74, 0x71, 0xc3, 0xd2, 0x51, 0x19,
-	0x22, 0x4a, 0x8f, 0x75, 0x88, 0xa5, 0xb1, 0x41, 0x22, 0x90, 0xe1, 0x36, 0x63, 0xdb, 0x37, 0x73,
-	0xa7, 0xaa, 0x9c, 0x1b, 0x71, 0x16, 0xfc, 0x68, 0x71, 0x04, 0xcc, 0x7e, 0x02, 0x4b, 0x0f, 0xb0,
-	0xd1, 0xc4, 0x16, 0x4a, 0xc0, 0xb2, 0xa2, 0xaa, 0x16, 0xa6, 0xd4, 0xbb, 0xcc, 0x37, 0x51, 0x12,
-	0xc2, 0x06, 0x66, 0x8a, 0xaa, 0x30, 0xc5, 0xb9, 0x28, 0x22, 0x0e, 0x6d, 0xf4, 0x2e, 0x84, 0x15,
-	0x55, 0xc5, 0xaa, 0xac, 0xb0, 0x44, 0x28, 0xc3, 0x6d, 0x46, 0xb7, 0x93, 0x39, 0xf7, 0x01, 0x72,
-	0xfe, 0x03, 0xe4, 0x24, 0xff, 0x85, 0x8a, 0x61, 0xbb, 0x46, 0x4f, 0x5f, 0xa7, 0x39, 0x87, 0x1c,
-	0xab, 0x05, 0x96, 0xfd, 0x00, 0x56, 0x5d, 0x01, 0x22, 0xfe, 0xb8, 0x87, 0x29, 0x3b, 0x47, 0xc7,
-	0x25, 0x58, 0xb2, 0xb0, 0x41, 0xfa, 0xd8, 0x51, 0x11, 0x16, 0x3d, 0x6b, 0x42, 0x5f, 0x70, 0x52,
-	0x5f, 0xf6, 0x39, 0x07, 0x97, 0xa5, 0x8e, 0x85, 0x69, 0x87, 0xe8, 0x6a, 0x19, 0xb7, 0x34, 0xaa,
-	0x11, 0xb3, 0x46, 0x74, 0xad, 0x35, 0x40, 0xf7, 0x20, 0xc2, 0x7c, 0xd7, 0xfc, 0x8f, 0x38, 0xc2,
-	0xa2, 0x22, 0x2c, 0x1f, 0x69, 0xa6, 0x4a, 0x8e, 0xa8, 0xa3, 0x2c, 0xba, 0xbd, 0x39, 0xe3, 0x21,
-	0x26, 0x2f, 0x3f, 0x70, 0xe3, 0x45, 0x1f, 0x78, 0x17, 0xfd, 0xf2, 0xcd, 0x9d, 0xd8, 0x64, 0x4c,
-	0xf6, 0x7b, 0x0e, 0x12, 0x35, 0x6c, 0xb5, 0xb0, 0xc9, 0x94, 0x36, 0x9e, 0x52, 0x5f, 0x01, 0xe8,
-	0x0e, 0x7d, 0xf3, 0xcb, 0x1f, 0x03, 0xff, 0x6b, 0xfa, 0xbf, 0xe3, 0xe0, 0x3f, 0x33, 0x61, 0x68,
-	0x17, 0x56, 0xfb, 0x84, 0x69, 0x66, 0x5b, 0xee, 0x62, 0x4b, 0x23, 0x6e, 0xf9, 0xa3, 0xdb, 0x1b,
-	0xa7, 0x7a, 0xa7, 0xec, 0x4d, 0xbf, 0xdb, 0x3a, 0x9f, 0xdb, 0xad, 0xb3, 0xe2, 0x22, 0x6b, 0x0e,
-	0x10, 0x35, 0x60, 0xdd, 0xd0, 0x4c, 0x19, 0x1f, 0xe3, 0x56, 0xcf, 0x99, 0x48, 0x8f, 0x30, 0xf0,
-	0xf6, 0x84, 0xc8, 0xd0, 0x4c, 0xde, 0xc7, 0xbb, 0xb4, 0xd9, 0x87, 0xb0, 0x21, 0xf4, 0x18, 0x25,
-	0x3d, 0xab, 0xa5, 0x99, 0xed, 0xa9, 0xd2, 0x67, 0x20, 0xaa, 0x62, 0xda, 0xb2, 0xb4, 0xae, 0x8d,
-	0xf0, 0xda, 0x74, 0xfc, 0x68, 0x66, 0x35, 0x7e, 0xe6, 0x20, 0xb6, 0x33, 0x2c, 0x69, 0xc5, 0x3c,
-	0x24, 0x76, 0xaf, 0xf7, 0xb1, 0x45, 0x7d, 0x92, 0x90, 0xe8, 0x9b, 0x68, 0x0f, 0x56, 0x18, 0x61,
-	0x8a, 0x2e, 0x1f, 0x61, 0xad, 0xdd, 0x61, 0xee, 0xdc, 0xcd, 0xf3, 0xbe, 0x51, 0x07, 0x7e, 0xe0,
-	0xa0, 0xd1, 0x43, 0x58, 0x53, 0x3d, 0x31, 0x72, 0xd7, 0x51, 0xe3, 0x0c, 0x4a, 0x74, 0x7b, 0xfd,
-	0x54, 0x7d, 0x0a, 0xe6, 0xa0, 0x88, 0x7e, 0x3c, 0xa5, 0x5e, 0x8c, 0xa9, 0x13, 0xf6, 0xdd, 0xd0,
-	0xe3, 0x2f, 0xd2, 0x0b, 0xd9, 0x6f, 0x43, 0x10, 0xae, 0x59, 0xa4, 0x4b, 0xa8, 0xa2, 0xa3, 0x18,
-	0x04, 0x34, 0xd5, 0x4b, 0x24, 0xa0, 0xa9, 0xe7, 0xee, 0x8d, 0xab, 0x10, 0xe9, 0x3a, 0x38, 0x6c,
-	0xd1, 0x44, 0x30, 0x13, 0xdc, 0x8c, 0x88, 0xa3, 0x03, 0xc4, 0x43, 0x94, 0xf6, 0x9a, 0x86, 0xc6,
-	0x64, 0x7b, 0xbb, 0xcf, 0xb5, 0x58, 0xc0, 0x05, 0xda, 0x2e, 0x74, 0x07, 0xd0, 0xd8, 0xaa, 0xf6,
-	0x2b, 0xbd, 0xe8, 0x08, 0xbc, 0x30, 0xf2, 0xec, 0x7b, 0x35, 0x7f, 0x07, 0x96, 0x28, 0x53, 0x58,
-	0x8f, 0x26, 0x96, 0x9c, 0x75, 0x7a, 0x7d, 0xc6, 0x14, 0xf8, 0xc9, 0xd6, 0x9d, 0x40, 0xd1, 0x03,
-	0x20, 0x11, 0xd0, 0xa1, 0x66, 0x2a, 0xba, 0xcc, 0x14, 0x5d, 0x1f, 0xc8, 0x16, 0xa6, 0x3d, 0x9d,
-	0x25, 0x96, 0x1d, 0xdd, 0xa9, 0x19, 0x34, 0x92, 0x1d, 0x26, 0x3a, 0x51, 0xc5, 0x90, 0xad, 0x5d,
-	0x8c, 0x3b, 0xf8, 0xb1, 0x73, 0x54, 0x83, 0x0b, 0x13, 0x33, 0x22, 0x63, 0x53, 0x4d, 0x84, 0xe7,
-	0x28, 0xc5, 0xda, 0xf8, 0xa0, 0xf0, 0xa6, 0x8a, 0x44, 0x58, 0x73, 0xe7, 0x84, 0x58, 0xbe, 0xc4,
-	0x88, 0x93, 0xe9, 0xad, 0x73, 0x32, 0xe5, 0x3d, 0x84, 0xab, 0x4a, 0x8c, 0xe1, 0x09, 0x1b, 0xfd,
-	0xd7, 0x7e, 0x64, 0x4a, 0x95, 0x36, 0xa6, 0x09, 0xc8, 0x04, 0xcf, 0xea, 0x29, 0x71, 0x18, 0xe5,
-	0x75, 0xce, 0x0f, 0x01, 0x88, 0x8e, 0x67, 0xbb, 0x03, 0x91, 0x01, 0xa6, 0x72, 0x8b, 0xf4, 0x4c,
-	0x36, 0xff, 0x36, 0x0b, 0x0f, 0x30, 0x2d, 0xd9, 0x50, 0x54, 0x85, 0x55, 0xa5, 0x49, 0x99, 0xa2,
-	0x99, 0x1e, 0xd7, 0xdc, 0x93, 0xb3, 0xe2, 0xe1, 0x5d, 0xbe, 0x32, 0x84, 0x4d, 0xe2, 0x51, 0x05,
-	0xe7, 0xa5, 0x5a, 0x36, 0x89, 0xcb, 0xb2, 0x0f, 0xc8, 0x24, 0xf2, 0x91, 0xc6, 0x3a, 0x72, 0x1f,
-	0x33, 0x9f, 0x2f, 0x34, 0x2f, 0xdf, 0x9a, 0x49, 0x0e, 0x34, 0xd6, 0xd9, 0xc7, 0xcc, 0xe5, 0xf5,
-	0x6a, 0xf9, 0x2b, 0x07, 0xa1, 0x7d, 0xc2, 0x30, 0x4a, 0x43, 0xb4, 0xeb, 0x3d, 0x9b, 0x3c, 0x1c,
-	0x45, 0xf0, 0x8f, 0x2a, 0x2a, 0x5a, 0x87, 0xc5, 0x3e, 0x61, 0xd8, 0xf2, 0xe6, 0xd1, 0x35, 0xd0,
-	0xff, 0x61, 0x89, 0xb8, 0xab, 0x2c, 0xe8, 0xb4, 0xc3, 0xb5, 0x19, 0xed, 0x60, 0xf3, 0x0b, 0x4e,
-	0x90, 0xe8, 0x05, 0x4f, 0xcc, 0x77, 0x68, 0x6a, 0xbe, 0xa7, 0x26, 0x78, 0xf1, 0x9f, 0x4d, 0x70,
-	0xb6, 0x0b, 0xa1, 0x1a, 0x21, 0x3a, 0xea, 0x40, 0x98, 0x59, 0x58, 0xa1, 0x3d, 0x6b, 0x90, 0xe0,
-	0x9c, 0x2e, 0xbb, 0x9a, 0xf3, 0xbe, 0xcf, 0xec, 0x8f, 0xb9, 0x9c, 0xf7, 0x31, 0x67, 0x17, 0xa9,
-	0x44, 0x34, 0xb3, 0x98, 0xb3, 0xd9, 0xbe, 0x7e, 0x9d, 0xbe, 0xf9, 0xb7, 0x35, 0xb5, 0xc3, 0xa9,
-	0x38, 0x64, 0xcf, 0x7e, 0xca, 0xc1, 0xa5, 0xd1, 0x96, 0xb6, 0x9b, 0x7f, 0xb8, 0xdf, 0xd6, 0x61,
-	0x91, 0x69, 0x4c, 0xf7, 0x7e, 0x6c, 0x45, 0xd7, 0x98, 0xfe, 0x31, 0x08, 0x9c, 0xfa, 0x31, 0x98,
-	0x18, 0x91, 0xe0, 0xdb, 0x8c, 0xc8, 0xed, 0x3f, 0x39, 0xb8, 0x38, 0xe3, 0xc3, 0x0d, 0xed, 0x42,
-	0xa6, 0xc4, 0x57, 0xeb, 0x82, 0x58, 0xdf, 0xad, 0xd4, 0xe4, 0x42, 0x43, 0xda, 0x15, 0xc4, 0x8a,
-	0xf4, 0x48, 0x6e, 0x54, 0xeb, 0x35, 0xbe, 0x54, 0xd9, 0xa9, 0xf0, 0xe5, 0xf8, 0x42, 0x32, 0xfb,
-	0xe4, 0x59, 0x26, 0x35, 0x03, 0xde, 0x30, 0x69, 0x17, 0xb7, 0xb4, 0x43, 0x0d, 0xab, 0x68, 0x07,
-	0xd2, 0x33, 0x99, 0xee, 0x09, 0xfb, 0xbc, 0x58, 0x2d, 0x54, 0x4b, 0x7c, 0x9c, 0x4b, 0x5e, 0x7f,
-	0xf2, 0x2c, 0x73, 0x6d, 0x06, 0xd1, 0x3d, 0xd2, 0xc7, 0x96, 0xa9, 0x98, 0x2d, 0x7c, 0x26, 0xcf,
-	0x8e, 0xd0, 0xa8, 0x96, 0x0b, 0x52, 0x45, 0xa8, 0xc6, 0x03, 0x67, 0xf2, 0x8c, 0xea, 0x9c, 0x0c,
-	0x3d, 0xfe, 0x32, 0xb5, 0x70, 0xfb, 0x33, 0x0e, 0x60, 0xd4, 0x68, 0xe8, 0x0a, 0x5c, 0xde, 0x17,
-	0x24, 0x5e, 0x16, 0x6a, 0x36, 0xd1, 0x64, 0x96, 0xe8, 0x22, 0xac, 0x8d, 0x3b, 0x1f, 0xf1, 0xf5,
-	0x38, 0x87, 0x2e, 0xc3, 0xc5, 0xf1, 0xc3, 0x42, 0xb1, 0x2e, 0x15, 0x2a, 0xd5, 0x78, 0x00, 0x21,
-	0x88, 0x8d, 0x3b, 0xaa, 0x42, 0x3c, 0x88, 0xae, 0x42, 0x62, 0xf2, 0x4c, 0x3e, 0xa8, 0x48, 0xbb,
-	0xf2, 0x3e, 0x2f, 0x09, 0xf1, 0x90, 0xa7, 0xe8, 0x27, 0x0e, 0x62, 0x93, 0x3b, 0x1f, 0xa5, 0xe1,
-	0x4a, 0x4d, 0x14, 0x6a, 0x42, 0xbd, 0xb0, 0x27, 0xd7, 0xa5, 0x82, 0xd4, 0xa8, 0x4f, 0x29, 0xbb,
-	0x06, 0x1b, 0xd3, 0x01, 0xf5, 0x46, 0xf1, 0x41, 0x45, 0x92, 0xf8, 0x72, 0x9c, 0xb3, 0xaf, 0x9d,
-	0x76, 0x17, 0x4a, 0x25, 0xbe, 0x66, 0x7b, 0x03, 0xb3, 0xbc, 0x22, 0x7f, 0x9f, 0x2f, 0xd9, 0xde,
-	0xa0, 0x5d, 0x91, 0x53, 0xd8, 0xa2, 0x20, 0xda, 0xce, 0xd0, 0xac, 0x7b, 0xed, 0x84, 0xca, 0x62,
-	0xe1, 0xa0, 0x1a, 0x5f, 0xf4, 0x12, 0x7a, 0xce, 0xc1, 0xa5, 0xd9, 0xab, 0x1d, 0x6d, 0xc2, 0x8d,
-	0x21, 0x9e, 0x7f, 0x8f, 0x2f, 0x35, 0x24, 0x41, 0x94, 0x45, 0xbe, 0xde, 0xd8, 0x93, 0xa6, 0x32,
-	0xbc, 0x01, 0x99, 0x33, 0x23, 0xab, 0x82, 0x24, 0x8b, 0x8d, 0x6a, 0x9c, 0x3b, 0x37, 0xaa, 0xde,
-	0x28, 0x95, 0xf8, 0x7a, 0x3d, 0x1e, 0x38, 0x37, 0x6a, 0xa7, 0x50, 0xd9, 0x6b, 0x88, 0x7c, 0x3c,
-	0xe8, 0x8a, 0x2f, 0x16, 0xbf, 0x3a, 0x49, 0x71, 0x2f, 0x4e, 0x52, 0xdc, 0xcb, 0x93, 0x14, 0xf7,
-	0xc7, 0x49, 0x8a, 0x7b, 0xfa, 0x26, 0xb5, 0xf0, 0xf2, 0x4d, 0x6a, 0xe1, 0xb7, 0x37, 0xa9, 0x85,
-	0xf7, 0x6f, 0x9c, 0x35, 0xec, 0xc7, 0x63, 0xff, 0x8f, 0x36, 0x97, 0x9c, 0x99, 0xfb, 0xdf, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xc8, 0xb6, 0x98, 0xb6, 0x0e, 0x00, 0x00,
+	// 1514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x5b,
+	0x15, 0xcf, 0xd8, 0x8e, 0x63, 0x1f, 0x27, 0x8e, 0x7b, 0x1b, 0x52, 0xc7, 0xaf, 0xb5, 0x5d, 0xab,
+	0x7a, 0xca, 0x7b, 0x52, 0x6d, 0x12, 0xc4, 0x82, 0xb7, 0x41, 0xfe, 0x98, 0x34, 0x7e, 0x4a, 0x3d,
+	0xee, 0xf5, 0x38, 0xa1, 0x48, 0x68, 0x18, 0x7b, 0x6e, 0xec, 0x11, 0x33, 0x73, 0xcd, 0xdc, 0x6b,
+	0x27, 0x66, 0xc9, 0xaa, 0xea, 0x86, 0xee, 0x60, 0x53, 0x09, 0x89, 0x0d, 0x62, 0x8d, 0x04, 0xaa,
+	0xc4, 0xbe, 0xb0, 0x40, 0x85, 0x0d, 0x88, 0x45, 0x8b, 0xd2, 0xbf, 0x81, 0x3d, 0x9a, 0x2f, 0x7f,
+	0xc5, 0x09, 0x35, 0xd2, 0xdb, 0xe5, 0xdc, 0x73, 0x7e, 0xbf, 0xfb, 0x3b, 0xe7, 0x9e, 0x73, 0x3c,
+	0x81, 0x82, 0xd1, 0x31, 0x4b, 0xe7, 0x74, 0x68, 0x69, 0x2a, 0xd7, 0xa9, 0x55, 0x1a, 0x1d, 0xcc,
+	0x58, 0xc5, 0x81, 0x4d, 0x39, 0x45, 0x77, 0x8c, 0x8e, 0x59, 0x9c, 0x39, 0x1d, 0x1d, 0x64, 0x76,
+	0x7a, 0xb4, 0x47, 0x5d, 0x6f, 0xc9, 0xf9, 0xcb, 0x0b, 0xcc, 0x64, 0x7b, 0x94, 0xf6, 0x0c, 0x52,
+	0x72, 0xad, 0xce, 0xf0, 0xbc, 0xa4, 0x0d, 0xed, 0x19, 0xa2, 0x4c, 0x6e, 0xd1, 0xcf, 0x75, 0x93,
+	0x30, 0xae, 0x9a, 0x03, 0x3f, 0x60, 0x6f, 0x31, 0x40, 0xb5, 0xc6, 0x01, 0x77, 0x97, 0x32, 0x93,
+	0xb2, 0x52, 0x47, 0x65, 0xa4, 0x34, 0x3a, 0xe8, 0x10, 0xae, 0x1e, 0x94, 0xba, 0x54, 0x0f, 0xb8,
+	0xf7, 0x3c, 0xbf, 0xe2, 0x89, 0xf2, 0x0c, 0xcf, 0x55, 0xf8, 0x31, 0x44, 0x9b, 0xaa, 0xad, 0x9a,
+	0x0c, 0x35, 0x21, 0x39, 0xcd, 0x43, 0xe1, 0xea, 0x65, 0x5a, 0xc8, 0x0b, 0xfb, 0xf1, 0xca, 0x17,
+	0x6f, 0xdf, 0xe7, 0xd6, 0xfe, 0xf5, 0x3e, 0xf7, 0xb0, 0xa7, 0xf3, 0xfe, 0xb0, 0x53, 0xec, 0x52,
+	0xb3, 0x64, 0xe8, 0x16, 0x29, 0x19, 0x1d, 0xf3, 0x31, 0xd3, 0x7e, 0x52, 0xe2, 0xe3, 0x01, 0x61,
+	0xc5, 0x1a, 0xe9, 0xe2, 0xad, 0x29, 0x81, 0xac, 0x5e, 0x7e, 0x1d, 0x89, 0x85, 0x52, 0xe1, 0x02,
+	0x07, 0xa8, 0x12, 0x8b, 0x51, 0x9b, 0xf5, 0xf5, 0x01, 0xca, 0xc3, 0xa6, 0xc9, 0x7a, 0x8a, 0x83,
+	0x51, 0x86, 0xb6, 0xe1, 0xdd, 0x81, 0xc1, 0x64, 0x3d, 0x79, 0x3c, 0x20, 0x6d, 0xdb, 0x40, 0x35,
+	0x88, 0xab, 0x43, 0xde, 0xa7, 0xb6, 0xce, 0xc7, 0xe9, 0x50, 0x5e, 0xd8, 0x4f, 0x1e, 0x7e, 0x5e,
+	0xbc, 0x56, 0xe5, 0xe2, 0x94, 0xb3, 0x1c, 0x44, 0xe3, 0x29, 0xb0, 0xf0, 0x4b, 0x01, 0xa2, 0x4f,
+	0x89, 0xd9, 0x21, 0x36, 0x4a, 0xc3, 0x86, 0xaa, 0x69, 0x36, 0x61, 0xcc, 0xbf, 0x2d, 0x30, 0x51,
+	0x06, 0x62, 0x26, 0xe1, 0xaa, 0xa6, 0x72, 0xd5, 0xbd, 0x29, 0x8e, 0x27, 0x36, 0xfa, 0x3e, 0xc4,
+	0x54, 0x4d, 0x23, 0x9a, 0xa2, 0xf2, 0x74, 0x24, 0x2f, 0xec, 0x27, 0x0e, 0x33, 0x45, 0xef, 0x05,
+	0x8a, 0xc1, 0x0b, 0x14, 0xe5, 0xe0, 0x89, 0x2a, 0x31, 0xa7, 0x48, 0xaf, 0x3e, 0xe4, 0x04, 0x97,
+	0x9c, 0x68, 0x65, 0x8e, 0x76, 0x21, 0x7a, 0x6e, 0xd3, 0x9f, 0x11, 0x2b, 0xbd, 0x9e, 0x17, 0xf6,
+	0x63, 0xd8, 0xb7, 0x0a, 0x3f, 0x82, 0x2d, 0x4f, 0x18, 0x26, 0x3f, 0x1d, 0x12, 0xc6, 0x6f, 0xd1,
+	0xb7, 0x0b, 0x51, 0x9b, 0x98, 0x74, 0x44, 0x5c, 0x75, 0x31, 0xec, 0x5b, 0x73, 0xba, 0xc3, 0xf3,
+	0xba, 0x0b, 0x6f, 0x04, 0xb8, 0x27, 0xf7, 0x6d, 0xc2, 0xfa, 0xd4, 0xd0, 0x6a, 0xa4, 0xab, 0x33,
+	0x9d, 0x5a, 0x4d, 0x6a, 0xe8, 0xdd, 0x31, 0x7a, 0x02, 0x71, 0x1e, 0xb8, 0x56, 0x7f, 0xdd, 0x29,
+	0x16, 0x55, 0x60, 0xe3, 0x42, 0xb7, 0x34, 0x7a, 0xc1, 0x5c, 0x65, 0x89, 0xc3, 0xfd, 0x25, 0x2f,
+	0x34, 0x7f, 0xf9, 0x99, 0x17, 0x8f, 0x03, 0xe0, 0x57, 0xe8, 0xef, 0xbf, 0x7f, 0x9c, 0x9c, 0x8f,
+	0x29, 0xfc, 0x49, 0x80, 0x74, 0x93, 0xd8, 0x5d, 0x62, 0x71, 0xb5, 0x47, 0x16, 0xd4, 0xd7, 0x01,
+	0x06, 0x13, 0xdf, 0xea, 0xf2, 0x67, 0xc0, 0xdf, 0x98, 0xfe, 0x3f, 0x0a, 0xf0, 0xad, 0xa5, 0x30,
+	0x74, 0x0c, 0x5b, 0x23, 0xca, 0x75, 0xab, 0xa7, 0x0c, 0x88, 0xad, 0x53, 0xaf, 0xfc, 0x89, 0xc3,
+	0xbd, 0x6b, 0x3d, 0x55, 0xf3, 0xd7, 0x82, 0xd7, 0x52, 0xbf, 0x72, 0x5a, 0x6a, 0xd3, 0x43, 0x36,
+	0x5d, 0x20, 0x6a, 0xc3, 0x8e, 0xa9, 0x5b, 0x0a, 0xb9, 0x24, 0xdd, 0xa1, 0x3b, 0xaa, 0x3e, 0x61,
+	0xe8, 0xd3, 0x09, 0x91, 0xa9, 0x5b, 0x62, 0x80, 0xf7, 0x68, 0x0b, 0xcf, 0x60, 0x4f, 0x1a, 0x72,
+	0x46, 0x87, 0x76, 0x57, 0xb7, 0x7a, 0x0b, 0xa5, 0xcf, 0x43, 0x42, 0x23, 0xac, 0x6b, 0xeb, 0x03,
+	0x07, 0xe1, 0xb7, 0xe9, 0xec, 0xd1, 0xd2, 0x6a, 0xfc, 0x4d, 0x80, 0xe4, 0xd1, 0xa4, 0xa4, 0x75,
+	0xeb, 0x9c, 0x3a, 0xbd, 0x3e, 0x22, 0x36, 0x0b, 0x48, 0x22, 0x38, 0x30, 0xd1, 0x09, 0x6c, 0x72,
+	0xca, 0x55, 0x43, 0xb9, 0x20, 0x7a, 0xaf, 0xcf, 0xd3, 0xa1, 0x55, 0xdf, 0x37, 0xe1, 0xc2, 0xcf,
+	0x5c, 0x34, 0x7a, 0x06, 0xdb, 0x9a, 0x2f, 0x46, 0x19, 0xb8, 0x6a, 0xdc, 0x41, 0x49, 0x1c, 0xee,
+	0x5c, 0xab, 0x4f, 0xd9, 0x1a, 0x57, 0xd0, 0x5f, 0xae, 0xa9, 0xc7, 0x49, 0x6d, 0xce, 0xfe, 0x2a,
+	0xf2, 0xe2, 0xd7, 0xb9, 0xb5, 0xc2, 0x1f, 0x22, 0x10, 0x6b, 0xda, 0x74, 0x40, 0x99, 0x6a, 0xa0,
+	0x24, 0x84, 0x74, 0xcd, 0x4f, 0x24, 0xa4, 0x6b, 0xb7, 0xee, 0x93, 0xfb, 0x10, 0x1f, 0xb8, 0x38,
+	0x62, 0xb3, 0x74, 0x38, 0x1f, 0xde, 0x8f, 0xe3, 0xe9, 0x01, 0x12, 0x21, 0xc1, 0x86, 0x1d, 0x53,
+	0xe7, 0x8a, 0xb3, 0xf6, 0x57, 0x5a, 0x38, 0xe0, 0x01, 0x1d, 0x17, 0x7a, 0x0c, 0x68, 0x66, 0x87,
+	0x07, 0x95, 0x5e, 0x77, 0x05, 0xde, 0x99, 0x7a, 0x4e, 0xfd, 0x9a, 0x7f, 0x0f, 0xa2, 0x8c, 0xab,
+	0x7c, 0xc8, 0xd2, 0x51, 0x77, 0xcf, 0x3e, 0x5c, 0x32, 0x05, 0x41, 0xb2, 0x2d, 0x37, 0x10, 0xfb,
+	0x00, 0x84, 0x01, 0x9d, 0xeb, 0x96, 0x6a, 0x28, 0x5c, 0x35, 0x8c, 0xb1, 0x62, 0x13, 0x36, 0x34,
+	0x78, 0x7a, 0xc3, 0xd5, 0x9d, 0x5d, 0x42, 0x23, 0x3b, 0x61, 0xd8, 0x8d, 0xaa, 0x44, 0x1c, 0xed,
+	0x38, 0xe5, 0xe2, 0x67, 0xce, 0x51, 0x13, 0xee, 0xcc, 0xcd, 0x88, 0x42, 0x2c, 0x2d, 0x1d, 0x5b,
+	0xa1, 0x14, 0xdb, 0xb3, 0x83, 0x22, 0x5a, 0x1a, 0xc2, 0xb0, 0xed, 0xcd, 0x09, 0xb5, 0x03, 0x89,
+	0x71, 0x37, 0xd3, 0x2f, 0x6e, 0xc9, 0x54, 0xf4, 0x11, 0x9e, 0x2a, 0x9c, 0x24, 0x73, 0x36, 0xfa,
+	0xb6, 0xf3, 0xc8, 0x8c, 0xa9, 0x3d, 0xc2, 0xd2, 0x90, 0x0f, 0xdf, 0xd4, 0x53, 0x78, 0x12, 0xe5,
+	0x77, 0xce, 0x9f, 0x43, 0x90, 0x98, 0xcd, 0xf6, 0x08, 0xe2, 0x63, 0xc2, 0x94, 0x2e, 0x1d, 0x5a,
+	0x7c, 0xf5, 0x6d, 0x16, 0x1b, 0x13, 0x56, 0x75, 0xa0, 0xa8, 0x01, 0x5b, 0x6a, 0x87, 0x71, 0x55,
+	0xb7, 0x7c, 0xae, 0x95, 0x27, 0x67, 0xd3, 0xc7, 0x7b, 0x7c, 0x35, 0x88, 0x59, 0xd4, 0xa7, 0x0a,
+	0xaf, 0x4a, 0xb5, 0x61, 0x51, 0x8f, 0xe5, 0x14, 0x90, 0x45, 0x95, 0x0b, 0x9d, 0xf7, 0x95, 0x11,
+	0xe1, 0x01, 0x5f, 0x64, 0x55, 0xbe, 0x6d, 0x8b, 0x9e, 0xe9, 0xbc, 0x7f, 0x4a, 0xb8, 0xc7, 0xeb,
+	0xd7, 0xf2, 0x1f, 0x02, 0x44, 0x4e, 0x29, 0x27, 0x28, 0x07, 0x89, 0x81, 0xff, 0x6c, 0xca, 0x64,
+	0x14, 0x21, 0x38, 0xaa, 0x6b, 0x68, 0x07, 0xd6, 0x47, 0x94, 0x13, 0xdb, 0x9f, 0x47, 0xcf, 0x40,
+	0xdf, 0x85, 0x28, 0xf5, 0x56, 0x59, 0xd8, 0x6d, 0x87, 0x07, 0x4b, 0xda, 0xc1, 0xe1, 0x97, 0xdc,
+	0x20, 0xec, 0x07, 0xcf, 0xcd, 0x77, 0x64, 0x61, 0xbe, 0x17, 0x26, 0x78, 0xfd, 0xff, 0x9b, 0xe0,
+	0xc2, 0x00, 0x22, 0x4d, 0x4a, 0x0d, 0xd4, 0x87, 0x18, 0xb7, 0x89, 0xca, 0x86, 0xf6, 0x38, 0x2d,
+	0xb8, 0x5d, 0x76, 0xbf, 0xe8, 0x7f, 0xb8, 0x39, 0x5f, 0x79, 0x45, 0xff, 0x2b, 0xcf, 0x29, 0x52,
+	0x95, 0xea, 0x56, 0xa5, 0xe8, 0xb0, 0xfd, 0xee, 0x43, 0xee, 0xf3, 0xff, 0x59, 0x53, 0x27, 0x9c,
+	0xe1, 0x09, 0x7b, 0xe1, 0xe7, 0x02, 0xec, 0x4e, 0xb7, 0xb4, 0xd3, 0xfc, 0x93, 0xfd, 0xb6, 0x03,
+	0xeb, 0x5c, 0xe7, 0x86, 0xff, 0x63, 0x8b, 0x3d, 0x63, 0xf1, 0xc7, 0x20, 0x74, 0xed, 0xc7, 0x60,
+	0x6e, 0x44, 0xc2, 0x9f, 0x32, 0x22, 0x5f, 0xfe, 0x47, 0x80, 0xbb, 0x4b, 0xbe, 0xe8, 0xd0, 0x31,
+	0xe4, 0xab, 0x62, 0xa3, 0x25, 0xe1, 0xd6, 0x71, 0xbd, 0xa9, 0x94, 0xdb, 0xf2, 0xb1, 0x84, 0xeb,
+	0xf2, 0x73, 0xa5, 0xdd, 0x68, 0x35, 0xc5, 0x6a, 0xfd, 0xa8, 0x2e, 0xd6, 0x52, 0x6b, 0x99, 0xc2,
+	0xcb, 0xd7, 0xf9, 0xec, 0x12, 0x78, 0xdb, 0x62, 0x03, 0xd2, 0xd5, 0xcf, 0x75, 0xa2, 0xa1, 0x23,
+	0xc8, 0x2d, 0x65, 0x7a, 0x22, 0x9d, 0x8a, 0xb8, 0x51, 0x6e, 0x54, 0xc5, 0x94, 0x90, 0x79, 0xf8,
+	0xf2, 0x75, 0xfe, 0xc1, 0x12, 0xa2, 0x27, 0x74, 0x44, 0x6c, 0x4b, 0xb5, 0xba, 0xe4, 0x46, 0x9e,
+	0x23, 0xa9, 0xdd, 0xa8, 0x95, 0xe5, 0xba, 0xd4, 0x48, 0x85, 0x6e, 0xe4, 0x99, 0xd6, 0x39, 0x13,
+	0x79, 0xf1, 0x9b, 0xec, 0xda, 0x97, 0xbf, 0x10, 0x00, 0xa6, 0x8d, 0x86, 0x3e, 0x83, 0x7b, 0xa7,
+	0x92, 0x2c, 0x2a, 0x52, 0xd3, 0x21, 0x9a, 0xcf, 0x12, 0xdd, 0x85, 0xed, 0x59, 0xe7, 0x73, 0xb1,
+	0x95, 0x12, 0xd0, 0x3d, 0xb8, 0x3b, 0x7b, 0x58, 0xae, 0xb4, 0xe4, 0x72, 0xbd, 0x91, 0x0a, 0x21,
+	0x04, 0xc9, 0x59, 0x47, 0x43, 0x4a, 0x85, 0xd1, 0x7d, 0x48, 0xcf, 0x9f, 0x29, 0x67, 0x75, 0xf9,
+	0x58, 0x39, 0x15, 0x65, 0x29, 0x15, 0xf1, 0x15, 0xfd, 0x55, 0x80, 0xe4, 0xfc, 0xce, 0x47, 0x39,
+	0xf8, 0xac, 0x89, 0xa5, 0xa6, 0xd4, 0x2a, 0x9f, 0x28, 0x2d, 0xb9, 0x2c, 0xb7, 0x5b, 0x0b, 0xca,
+	0x1e, 0xc0, 0xde, 0x62, 0x40, 0xab, 0x5d, 0x79, 0x5a, 0x97, 0x65, 0xb1, 0x96, 0x12, 0x9c, 0x6b,
+	0x17, 0xdd, 0xe5, 0x6a, 0x55, 0x6c, 0x3a, 0xde, 0xd0, 0x32, 0x2f, 0x16, 0xbf, 0x16, 0xab, 0x8e,
+	0x37, 0xec, 0x54, 0xe4, 0x1a, 0xb6, 0x22, 0x61, 0xc7, 0x19, 0x59, 0x76, 0xaf, 0x93, 0x50, 0x0d,
+	0x97, 0xcf, 0x1a, 0xa9, 0x75, 0x3f, 0xa1, 0x37, 0x02, 0xec, 0x2e, 0x5f, 0xed, 0x68, 0x1f, 0x1e,
+	0x4d, 0xf0, 0xe2, 0x0f, 0xc4, 0x6a, 0x5b, 0x96, 0xb0, 0x82, 0xc5, 0x56, 0xfb, 0x44, 0x5e, 0xc8,
+	0xf0, 0x11, 0xe4, 0x6f, 0x8c, 0x6c, 0x48, 0xb2, 0x82, 0xdb, 0x8d, 0x94, 0x70, 0x6b, 0x54, 0xab,
+	0x5d, 0xad, 0x8a, 0xad, 0x56, 0x2a, 0x74, 0x6b, 0xd4, 0x51, 0xb9, 0x7e, 0xd2, 0xc6, 0x62, 0x2a,
+	0xec, 0x89, 0xaf, 0x54, 0x7e, 0x7b, 0x95, 0x15, 0xde, 0x5e, 0x65, 0x85, 0x77, 0x57, 0x59, 0xe1,
+	0xdf, 0x57, 0x59, 0xe1, 0xd5, 0xc7, 0xec, 0xda, 0xbb, 0x8f, 0xd9, 0xb5, 0x7f, 0x7e, 0xcc, 0xae,
+	0xfd, 0xf0, 0xd1, 0x4d, 0xc3, 0x7e, 0x39, 0xf3, 0x8f, 0x6a, 0x27, 0xea, 0xce, 0xdc, 0x77, 0xfe,
+	0x3b, 0x00, 0x0f, 0x0b, 0x02, 0xb9, 0xcf, 0x0e, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1176,6 +1187,9 @@ func (this *Member) Equal(that interface{}) bool {
 	if !this.AddedAt.Equal(that1.AddedAt) {
 		return false
 	}
+	if this.Frozen != that1.Frozen {
+		return false
+	}
 	return true
 }
 func (this *MemberRequest) Equal(that interface{}) bool {
@@ -1625,6 +1639,16 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt):])
 	if err1 != nil {
 		return 0, err1
@@ -2265,6 +2289,9 @@ func (m *Member) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt)
 	n += 1 + l + sovFoundation(uint64(l))
+	if m.Frozen {
+		n += 2
+	}
 	return n
 }
 
@@ -2802,6 +2829,26 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -12,6 +12,9 @@ import (
 type Keeper interface {
 	GetAuthority() string
 	Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) error
+	GetProposal(ctx sdk.Context, id uint64) (*foundation.Proposal, error)
+	GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (*foundation.Vote, error)
+	FreezeMember(ctx sdk.Context, address sdk.AccAddress) error
 
 	InitGenesis(ctx sdk.Context, gs *foundation.GenesisState) error
 	ExportGenesis(ctx sdk.Context) *foundation.GenesisState
@@ -54,6 +57,21 @@ func (k keeper) Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) err
 	return k.impl.Accept(ctx, grantee, msg)
 }
 
+// GetProposal returns the foundation proposal of the given id.
+func (k keeper) GetProposal(ctx sdk.Context, id uint64) (*foundation.Proposal, error) {
+	return k.impl.GetProposal(ctx, id)
+}
+
+// GetVote returns the vote of the voter on the foundation proposal.
+func (k keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (*foundation.Vote, error) {
+	return k.impl.GetVote(ctx, proposalID, voter)
+}
+
+// FreezeMember freezes the foundation member, e.g. on its misbehavior.
+func (k keeper) FreezeMember(ctx sdk.Context, address sdk.AccAddress) error {
+	return k.impl.FreezeMember(ctx, address)
+}
+
 func (k keeper) InitGenesis(ctx sdk.Context, gs *foundation.GenesisState) error {
 	return k.impl.InitGenesis(ctx, gs)
 }
@@ -123,6 +123,31 @@ func (k Keeper) SetMember(ctx sdk.Context, member foundation.Member) {
 	store.Set(key, bz)
 }
 
+// FreezeMember freezes the member, which disallows it to submit proposals, vote
+// on and execute them. Its votes are not counted on tally either, and its weight
+// is excluded from the total weight the decision policy is applied with.
+// The authority may unfreeze the member by updating it with Msg/UpdateMembers.
+func (k Keeper) FreezeMember(ctx sdk.Context, address sdk.AccAddress) error {
+	member, err := k.GetMember(ctx, address)
+	if err != nil {
+		return err
+	}
+	if member.Frozen {
+		return nil
+	}
+
+	member.Frozen = true
+	k.SetMember(ctx, *member)
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventFreezeMember{
+		Address: member.Address,
+	}); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
 func (k Keeper) deleteMember(ctx sdk.Context, address sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	key := memberKey(address)
@@ -189,3 +214,19 @@ func (k Keeper) validateMembers(ctx sdk.Context, members []string) error {
 
 	return nil
 }
+
+// validateActiveMembers is validateMembers which also rejects the frozen members.
+func (k Keeper) validateActiveMembers(ctx sdk.Context, members []string) error {
+	for _, member := range members {
+		addr := sdk.MustAccAddressFromBech32(member)
+		found, err := k.GetMember(ctx, addr)
+		if err != nil {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is not a member", member)
+		}
+		if found.Frozen {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is frozen", member)
+		}
+	}
+
+	return nil
+}
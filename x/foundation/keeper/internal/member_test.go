@@ -100,3 +100,110 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestFreezeMember() {
+	testCases := map[string]struct {
+		member sdk.AccAddress
+		frozen bool
+		valid  bool
+	}{
+		"valid request": {
+			member: s.members[0],
+			valid:  true,
+		},
+		"already frozen": {
+			member: s.members[0],
+			frozen: true,
+			valid:  true,
+		},
+		"not a member": {
+			member: s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.frozen {
+				err := s.impl.FreezeMember(ctx, tc.member)
+				s.Require().NoError(err)
+			}
+
+			err := s.impl.FreezeMember(ctx, tc.member)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			member, err := s.impl.GetMember(ctx, tc.member)
+			s.Require().NoError(err)
+			s.Require().True(member.Frozen)
+
+			// the votes of the frozen member are not counted
+			res, err := s.queryServer.TallyResult(sdk.WrapSDKContext(ctx), &foundation.QueryTallyResultRequest{
+				ProposalId: s.votedProposal,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewDec(int64(len(s.members)-1)), res.Tally.TotalCounts())
+
+			// the authority unfreezes the member by updating it
+			err = s.impl.UpdateMembers(ctx, []foundation.MemberRequest{{Address: tc.member.String()}})
+			s.Require().NoError(err)
+			member, err = s.impl.GetMember(ctx, tc.member)
+			s.Require().NoError(err)
+			s.Require().False(member.Frozen)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFreezeMemberTally() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// every member not abstaining must vote yes
+	err := s.impl.UpdateDecisionPolicy(ctx, &foundation.PercentageDecisionPolicy{
+		Percentage: sdk.OneDec(),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+		},
+	})
+	s.Require().NoError(err)
+
+	id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba")})
+	s.Require().NoError(err)
+
+	err = s.impl.Vote(ctx, foundation.Vote{
+		ProposalId: *id,
+		Voter:      s.members[0].String(),
+		Option:     foundation.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	// the frozen members do not count as abstaining
+	for _, member := range s.members[1:] {
+		err := s.impl.FreezeMember(ctx, member)
+		s.Require().NoError(err)
+	}
+
+	err = s.impl.Exec(ctx, *id)
+	s.Require().NoError(err)
+
+	// the proposal has been accepted and executed
+	_, err = s.impl.GetProposal(ctx, *id)
+	s.Require().Error(err)
+
+	// the proposal cannot pass if every member has been frozen
+	id, err = s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba")})
+	s.Require().NoError(err)
+
+	err = s.impl.FreezeMember(ctx, s.members[0])
+	s.Require().NoError(err)
+
+	err = s.impl.Exec(ctx, *id)
+	s.Require().NoError(err)
+
+	proposal, err := s.impl.GetProposal(ctx, *id)
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.PROPOSAL_STATUS_REJECTED, proposal.Status)
+}
@@ -135,7 +135,7 @@ func (s msgServer) UpdateDecisionPolicy(c context.Context, req *foundation.MsgUp
 func (s msgServer) SubmitProposal(c context.Context, req *foundation.MsgSubmitProposal) (*foundation.MsgSubmitProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := s.keeper.validateMembers(ctx, req.Proposers); err != nil {
+	if err := s.keeper.validateActiveMembers(ctx, req.Proposers); err != nil {
 		return nil, err
 	}
 
@@ -213,7 +213,7 @@ func (s msgServer) WithdrawProposal(c context.Context, req *foundation.MsgWithdr
 func (s msgServer) Vote(c context.Context, req *foundation.MsgVote) (*foundation.MsgVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := s.keeper.validateMembers(ctx, []string{req.Voter}); err != nil {
+	if err := s.keeper.validateActiveMembers(ctx, []string{req.Voter}); err != nil {
 		return nil, err
 	}
 
@@ -240,7 +240,7 @@ func (s msgServer) Vote(c context.Context, req *foundation.MsgVote) (*foundation
 func (s msgServer) Exec(c context.Context, req *foundation.MsgExec) (*foundation.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := s.keeper.validateMembers(ctx, []string{req.Signer}); err != nil {
+	if err := s.keeper.validateActiveMembers(ctx, []string{req.Signer}); err != nil {
 		return nil, err
 	}
 
@@ -399,6 +399,14 @@ func (s *KeeperTestSuite) TestMsgVote() {
 			proposalID: s.votedProposal,
 			voter:      s.members[0],
 		},
+		"frozen member": {
+			malleate: func(ctx sdk.Context) {
+				err := s.keeper.FreezeMember(ctx, s.members[0])
+				s.Require().NoError(err)
+			},
+			proposalID: s.activeProposal,
+			voter:      s.members[0],
+		},
 		"exec fails": {
 			malleate: func(ctx sdk.Context) {
 				// try exec will fail because of a non-zero MinExecutionPeriod.
@@ -10,7 +10,7 @@ import (
 
 // NewFoundationProposalsHandler creates a handler for the gov proposals.
 func NewFoundationProposalsHandler(k Keeper) govtypes.Handler {
-	return func(ctx sdk.Context, content govtypes.Content) error {
+	return func(ctx sdk.Context, _ uint64, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *foundation.FoundationExecProposal:
 			return handleFoundationExecProposal(ctx, k, *c)
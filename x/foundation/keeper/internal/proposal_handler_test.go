@@ -103,7 +103,7 @@ func (s *KeeperTestSuite) TestProposalHandler() {
 			proposal := &foundation.FoundationExecProposal{}
 			proposal.SetMessages([]sdk.Msg{tc.msg})
 
-			err := s.proposalHandler(ctx, proposal)
+			err := s.proposalHandler(ctx, 1, proposal)
 			if !tc.valid {
 				s.Require().Error(err)
 				return
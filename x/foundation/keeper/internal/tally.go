@@ -18,10 +18,17 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *foundation.Proposal) error
 	info := k.GetFoundationInfo(ctx)
 	policy := info.GetDecisionPolicy()
 	sinceSubmission := ctx.BlockTime().Sub(p.SubmitTime) // duration passed since proposal submission.
-	result, err := policy.Allow(tallyResult, info.TotalWeight, sinceSubmission)
-	if err != nil {
-		return err
+
+	// The frozen members cannot vote, so their weight is excluded from the
+	// total weight. Otherwise, each freeze would work as a permanent abstention.
+	result := &foundation.DecisionPolicyResult{Final: true}
+	if totalWeight := info.TotalWeight.Sub(k.frozenWeight(ctx)); totalWeight.IsPositive() {
+		result, err = policy.Allow(tallyResult, totalWeight, sinceSubmission)
+		if err != nil {
+			return err
+		}
 	}
+	// Otherwise, no member is able to vote and the proposal cannot pass.
 
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
@@ -55,7 +62,7 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 	k.iterateVotes(ctx, p.Id, func(vote foundation.Vote) (stop bool) {
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
 
-		_, err := k.GetMember(ctx, voter)
+		member, err := k.GetMember(ctx, voter)
 		switch {
 		case sdkerrors.ErrNotFound.Is(err):
 			// If the member left the foundation after voting, then we simply skip the
@@ -65,6 +72,9 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 			// For any other errors, we stop and return the error.
 			errIter = err
 			return true
+		case member.Frozen:
+			// The votes of the frozen members are not counted either.
+			return false
 		}
 
 		if err := tallyResult.Add(vote.Option); err != nil {
@@ -80,3 +90,16 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 
 	return tallyResult, nil
 }
+
+// frozenWeight returns the total weight of the frozen members.
+func (k Keeper) frozenWeight(ctx sdk.Context) sdk.Dec {
+	weight := sdk.ZeroDec()
+	k.iterateMembers(ctx, func(member foundation.Member) (stop bool) {
+		if member.Frozen {
+			weight = weight.Add(sdk.OneDec())
+		}
+		return false
+	})
+
+	return weight
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"sort"
 	"time"
 
@@ -14,16 +15,39 @@ import (
 	"github.com/line/lbm-sdk/client/flags"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/version"
+	"github.com/line/lbm-sdk/x/genutil/legacy/external"
+	v045 "github.com/line/lbm-sdk/x/genutil/legacy/v045"
 	"github.com/line/lbm-sdk/x/genutil/types"
 )
 
-const flagGenesisTime = "genesis-time"
+const (
+	flagGenesisTime    = "genesis-time"
+	flagExternalTokens = "external-tokens"
+)
 
 // Allow applications to extend and modify the migration process.
 //
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/5041
 var migrationMap = types.MigrationMap{}
 
+// migrationRegistry holds the migration steps keyed by the source version,
+// which the modules and the applications contribute to.
+var migrationRegistry = newMigrationRegistry()
+
+func newMigrationRegistry() *types.MigrationRegistry {
+	registry := types.NewMigrationRegistry()
+	v045.RegisterMigrations(registry)
+
+	return registry
+}
+
+// RegisterMigrationStep registers a migration step for the genesis of the
+// source version. The steps of a version are applied in the order of their
+// registration.
+func RegisterMigrationStep(version, name string, step types.MigrationStep) {
+	migrationRegistry.Register(version, name, step)
+}
+
 // GetMigrationCallback returns a MigrationCallback for a given version.
 func GetMigrationCallback(version string) types.MigrationCallback {
 	return migrationMap[version]
@@ -45,16 +69,48 @@ func GetMigrationVersions() []string {
 	return versions
 }
 
+// GetMigrationSourceVersions gets all the source versions of the registered
+// migration steps in a sorted slice.
+func GetMigrationSourceVersions() []string {
+	return migrationRegistry.Versions()
+}
+
+// migrate migrates the genesis state by the callback of the target version,
+// or by the registered steps of the source version.
+func migrate(version string, appState types.AppMap, clientCtx client.Context) (types.AppMap, error) {
+	if migrationFunc := GetMigrationCallback(version); migrationFunc != nil {
+		// TODO: handler error from migrationFunc call
+		return migrationFunc(appState, clientCtx), nil
+	}
+
+	if migrationRegistry.Has(version) {
+		return migrationRegistry.Migrate(version, appState, clientCtx)
+	}
+
+	return nil, fmt.Errorf("unknown migration function for version: %s", version)
+}
+
 // MigrateGenesisCmd returns a command to execute genesis state migration.
 func MigrateGenesisCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "migrate [target-version] [genesis-file]",
-		Short: "Migrate genesis to a specified target version",
+		Use:   "migrate [version] [genesis-file]",
+		Short: "Migrate genesis to a specified target version, or from a specified source version",
 		Long: fmt.Sprintf(`Migrate the source genesis into the target version and print to STDOUT.
 
+The version is either the target version of the legacy migrations, or the
+source version of the registered migration steps, which migrate the genesis
+into the current version.
+The tokens described in the file given by --%s are imported into x/token and
+x/collection after the migration.
+
+Target versions: %v
+Source versions: %v
+
 Example:
 $ %s migrate v0.43 /path/to/genesis.json --chain-id=test-chain-1 --genesis-time=2021-11-08T14:00:00Z
-`, version.AppName),
+$ %s migrate %s /path/to/genesis.json --%s=/path/to/tokens.json
+`, flagExternalTokens, GetMigrationVersions(), GetMigrationSourceVersions(),
+			version.AppName, version.AppName, v045.Version, flagExternalTokens),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -82,13 +138,27 @@ $ %s migrate v0.43 /path/to/genesis.json --chain-id=test-chain-1 --genesis-time=
 				return errors.Wrap(err, "failed to JSON unmarshal initial genesis state")
 			}
 
-			migrationFunc := GetMigrationCallback(target)
-			if migrationFunc == nil {
-				return fmt.Errorf("unknown migration function for version: %s", target)
+			newGenState, err := migrate(target, initialState, clientCtx)
+			if err != nil {
+				return err
 			}
 
-			// TODO: handler error from migrationFunc call
-			newGenState := migrationFunc(initialState, clientCtx)
+			tokensFile, _ := cmd.Flags().GetString(flagExternalTokens)
+			if tokensFile != "" {
+				bz, err := os.ReadFile(tokensFile)
+				if err != nil {
+					return errors.Wrap(err, "failed to read external tokens")
+				}
+
+				tokens, err := external.ParseTokens(bz)
+				if err != nil {
+					return errors.Wrap(err, "failed to parse external tokens")
+				}
+
+				if err := external.Migrate(newGenState, clientCtx, *tokens); err != nil {
+					return errors.Wrap(err, "failed to import external tokens")
+				}
+			}
 
 			genDoc.AppState, err = json.Marshal(newGenState)
 			if err != nil {
@@ -129,6 +199,7 @@ $ %s migrate v0.43 /path/to/genesis.json --chain-id=test-chain-1 --genesis-time=
 
 	cmd.Flags().String(flagGenesisTime, "", "override genesis_time with this flag")
 	cmd.Flags().String(flags.FlagChainID, "", "override chain_id with this flag")
+	cmd.Flags().String(flagExternalTokens, "", "import the tokens described in this JSON file into x/token and x/collection")
 
 	return cmd
 }
@@ -1,15 +1,68 @@
 package testutil
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/lbm-sdk/testutil"
 	clitestutil "github.com/line/lbm-sdk/testutil/cli"
+	"github.com/line/lbm-sdk/types/bech32"
 	"github.com/line/lbm-sdk/x/genutil/client/cli"
 )
 
+// An example exported genesis file of a cosmos-sdk v0.45 based chain, whose
+// addresses are to be filled.
+var v045CosmosExported = `{
+	"app_hash": "",
+	"app_state": {
+		"auth": {
+			"params": {
+				"max_memo_characters": "256",
+				"tx_sig_limit": "7",
+				"tx_size_cost_per_byte": "10",
+				"sig_verify_cost_ed25519": "590",
+				"sig_verify_cost_secp256k1": "1000"
+			},
+			"accounts": [
+				{
+					"@type": "/cosmos.auth.v1beta1.BaseAccount",
+					"address": "%[1]s",
+					"pub_key": null,
+					"account_number": "0",
+					"sequence": "0"
+				}
+			]
+		},
+		"bank": {
+			"params": { "send_enabled": [], "default_send_enabled": true },
+			"balances": [
+				{ "address": "%[1]s", "coins": [{ "denom": "stake", "amount": "1000" }] }
+			],
+			"supply": [{ "denom": "stake", "amount": "1000" }],
+			"denom_metadata": []
+		}
+	},
+	"chain_id": "test",
+	"consensus_params": {
+		"block": {
+		"max_bytes": "22020096",
+		"max_gas": "-1",
+		"time_iota_ms": "1000"
+		},
+		"evidence": {
+			"max_age_num_blocks": "100000",
+			"max_age_duration": "172800000000000",
+			"max_bytes": "1048576"
+		},
+		"validator": { "pub_key_types": ["ed25519"] }
+	},
+	"genesis_time": "2020-09-29T20:16:29.172362037Z",
+	"validators": []
+}`
+
 func TestGetMigrationCallback(t *testing.T) {
 	for _, version := range cli.GetMigrationVersions() {
 		require.NotNil(t, cli.GetMigrationCallback(version))
@@ -19,10 +72,22 @@ func TestGetMigrationCallback(t *testing.T) {
 func (s *IntegrationTestSuite) TestMigrateGenesis() {
 	val0 := s.network.Validators[0]
 
+	addr := val0.Address
+	cosmosAddr, err := bech32.ConvertAndEncode("cosmos", addr)
+	s.Require().NoError(err)
+	v045Genesis := fmt.Sprintf(v045CosmosExported, cosmosAddr)
+
+	tokensFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{
+	"fungible_tokens": [
+		{ "name": "Fox Coin", "symbol": "FOX", "balances": [{ "address": "%[1]s", "amount": "10" }] }
+	]
+}`, cosmosAddr))
+
 	testCases := []struct {
 		name      string
 		genesis   string
 		target    string
+		args      []string
 		expErr    bool
 		expErrMsg string
 		check     func(jsonOut string)
@@ -31,17 +96,40 @@ func (s *IntegrationTestSuite) TestMigrateGenesis() {
 			"migrate 0.42 to 0.43(result error)",
 			v040Valid,
 			"v0.43",
+			nil,
 			true, "",
 			nil,
 		},
+		{
+			"unknown version",
+			v040Valid,
+			"v0.00",
+			nil,
+			true, "unknown migration function",
+			nil,
+		},
+		{
+			"migrate from cosmos-sdk v0.45",
+			v045Genesis,
+			"cosmos-v0.45",
+			[]string{fmt.Sprintf("--external-tokens=%s", tokensFile.Name())},
+			false, "",
+			func(jsonOut string) {
+				s.Require().NotContains(jsonOut, cosmosAddr)
+				s.Require().Equal(3, strings.Count(jsonOut, addr.String()))
+				s.Require().Contains(jsonOut, `"symbol":"FOX"`)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		s.Run(tc.name, func() {
 			genesisFile := testutil.WriteToNewTempFile(s.T(), tc.genesis)
-			jsonOutput, err := clitestutil.ExecTestCLICmd(val0.ClientCtx, cli.MigrateGenesisCmd(), []string{tc.target, genesisFile.Name()})
+			args := append([]string{tc.target, genesisFile.Name()}, tc.args...)
+			jsonOutput, err := clitestutil.ExecTestCLICmd(val0.ClientCtx, cli.MigrateGenesisCmd(), args)
 			if tc.expErr {
+				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.expErrMsg)
 			} else {
 				s.Require().NoError(err)
@@ -0,0 +1,304 @@
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/store"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/bech32"
+	"github.com/line/lbm-sdk/x/collection"
+	collectionkeeper "github.com/line/lbm-sdk/x/collection/keeper"
+	"github.com/line/lbm-sdk/x/genutil/types"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/token/class"
+	classkeeper "github.com/line/lbm-sdk/x/token/class/keeper"
+)
+
+// Tokens defines the tokens issued outside of the chain, e.g. the CW20 and the
+// CW721 contracts of the source chain, to import into x/token and
+// x/collection.
+type Tokens struct {
+	FungibleTokens    []FungibleToken    `json:"fungible_tokens"`
+	NonFungibleTokens []NonFungibleToken `json:"non_fungible_tokens"`
+}
+
+// FungibleToken defines a CW20-like fungible token, which is imported as a
+// contract of x/token.
+type FungibleToken struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals int32  `json:"decimals"`
+	URI      string `json:"uri,omitempty"`
+	Meta     string `json:"meta,omitempty"`
+	// Minter is granted all the permissions on the contract. The contract is
+	// not mintable if it is empty.
+	Minter   string    `json:"minter,omitempty"`
+	Balances []Balance `json:"balances"`
+}
+
+// Balance defines the amount of a fungible token held by an address.
+type Balance struct {
+	Address string  `json:"address"`
+	Amount  sdk.Int `json:"amount"`
+}
+
+// NonFungibleToken defines a CW721-like collection of non-fungible tokens,
+// which is imported as a contract of x/collection with a single class.
+type NonFungibleToken struct {
+	Name string `json:"name"`
+	URI  string `json:"uri,omitempty"`
+	Meta string `json:"meta,omitempty"`
+	// Minter is granted all the permissions on the contract.
+	Minter string `json:"minter"`
+	Tokens []NFT  `json:"tokens"`
+}
+
+// NFT defines a non-fungible token. The token is assigned a new id on the
+// import, so its original id is used as its name if the name is empty.
+type NFT struct {
+	ID    string `json:"id"`
+	Owner string `json:"owner"`
+	Name  string `json:"name,omitempty"`
+	Meta  string `json:"meta,omitempty"`
+}
+
+// ParseTokens parses the JSON representation of Tokens.
+func ParseTokens(bz []byte) (*Tokens, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+
+	var tokens Tokens
+	if err := decoder.Decode(&tokens); err != nil {
+		return nil, err
+	}
+
+	return &tokens, nil
+}
+
+// MigrationStep returns a migration step importing the tokens.
+func MigrationStep(tokens Tokens) types.MigrationStep {
+	return func(appState types.AppMap, clientCtx client.Context) error {
+		return Migrate(appState, clientCtx, tokens)
+	}
+}
+
+// Migrate imports the tokens into the genesis states of x/token and
+// x/collection. The addresses of any bech32 prefix are accepted, and
+// re-encoded with the prefix of the current config.
+func Migrate(appState types.AppMap, clientCtx client.Context, tokens Tokens) error {
+	cdc := clientCtx.Codec
+
+	tokenState := token.DefaultGenesisState()
+	if bz, ok := appState[token.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(bz, tokenState); err != nil {
+			return err
+		}
+	}
+	if tokenState.ClassState == nil {
+		tokenState.ClassState = token.DefaultClassGenesisState()
+	}
+
+	collectionState := collection.DefaultGenesisState()
+	if bz, ok := appState[collection.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(bz, collectionState); err != nil {
+			return err
+		}
+	}
+
+	// the contract ids are shared by x/token and x/collection, so issue them
+	// through the keepers on the current states.
+	ctx, classKeeper, collectionKeeper, err := newContext(cdc)
+	if err != nil {
+		return err
+	}
+	classKeeper.InitGenesis(ctx, tokenState.ClassState)
+	collectionKeeper.InitGenesis(ctx, collectionState)
+
+	for i, ft := range tokens.FungibleTokens {
+		if err := importFungibleToken(ctx, classKeeper, tokenState, ft); err != nil {
+			return fmt.Errorf("fungible token %d (%s): %w", i, ft.Name, err)
+		}
+	}
+
+	for i, nft := range tokens.NonFungibleTokens {
+		if err := importNonFungibleToken(ctx, collectionKeeper, nft); err != nil {
+			return fmt.Errorf("non-fungible token %d (%s): %w", i, nft.Name, err)
+		}
+	}
+
+	tokenState.ClassState = classKeeper.ExportGenesis(ctx)
+	if err := token.ValidateGenesis(*tokenState); err != nil {
+		return err
+	}
+	collectionState = collectionKeeper.ExportGenesis(ctx)
+	if err := collection.ValidateGenesis(*collectionState); err != nil {
+		return err
+	}
+
+	if appState[token.ModuleName], err = cdc.MarshalJSON(tokenState); err != nil {
+		return err
+	}
+	if appState[collection.ModuleName], err = cdc.MarshalJSON(collectionState); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newContext(cdc codec.Codec) (sdk.Context, classkeeper.Keeper, collectionkeeper.Keeper, error) {
+	classKey := sdk.NewKVStoreKey(class.StoreKey)
+	collectionKey := sdk.NewKVStoreKey(collection.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(classKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(collectionKey, sdk.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		return sdk.Context{}, classkeeper.Keeper{}, collectionkeeper.Keeper{}, err
+	}
+
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	classKeeper := classkeeper.NewKeeper(cdc, classKey)
+	collectionKeeper := collectionkeeper.NewKeeper(cdc, collectionKey, classKeeper)
+
+	return ctx, classKeeper, collectionKeeper, nil
+}
+
+func importFungibleToken(ctx sdk.Context, classKeeper classkeeper.Keeper, state *token.GenesisState, ft FungibleToken) error {
+	contractID := classKeeper.NewID(ctx)
+	state.Classes = append(state.Classes, token.Contract{
+		Id:       contractID,
+		Name:     ft.Name,
+		Symbol:   ft.Symbol,
+		Uri:      ft.URI,
+		Meta:     ft.Meta,
+		Decimals: ft.Decimals,
+		Mintable: ft.Minter != "",
+	})
+
+	if ft.Minter != "" {
+		minter, err := convertAddress(ft.Minter)
+		if err != nil {
+			return err
+		}
+
+		var grants []token.Grant
+		for _, permission := range []token.Permission{
+			token.PermissionModify,
+			token.PermissionMint,
+			token.PermissionBurn,
+		} {
+			grants = append(grants, token.Grant{
+				Grantee:    minter.String(),
+				Permission: permission,
+			})
+		}
+		state.Grants = append(state.Grants, token.ContractGrants{
+			ContractId: contractID,
+			Grants:     grants,
+		})
+	}
+
+	supply := sdk.ZeroInt()
+	amounts := map[string]sdk.Int{}
+	var holders []string
+	for _, balance := range ft.Balances {
+		if balance.Amount.IsNil() || !balance.Amount.IsPositive() {
+			return fmt.Errorf("invalid amount of %s: %s", balance.Address, balance.Amount)
+		}
+
+		holder, err := convertAddress(balance.Address)
+		if err != nil {
+			return err
+		}
+
+		if amount, ok := amounts[holder.String()]; ok {
+			amounts[holder.String()] = amount.Add(balance.Amount)
+		} else {
+			amounts[holder.String()] = balance.Amount
+			holders = append(holders, holder.String())
+		}
+		supply = supply.Add(balance.Amount)
+	}
+
+	if len(holders) != 0 {
+		balances := make([]token.Balance, len(holders))
+		for i, holder := range holders {
+			balances[i] = token.Balance{
+				Address: holder,
+				Amount:  amounts[holder],
+			}
+		}
+		state.Balances = append(state.Balances, token.ContractBalances{
+			ContractId: contractID,
+			Balances:   balances,
+		})
+	}
+
+	state.Supplies = append(state.Supplies, token.ContractCoin{ContractId: contractID, Amount: supply})
+	state.Mints = append(state.Mints, token.ContractCoin{ContractId: contractID, Amount: supply})
+
+	return nil
+}
+
+func importNonFungibleToken(ctx sdk.Context, k collectionkeeper.Keeper, nft NonFungibleToken) error {
+	minter, err := convertAddress(nft.Minter)
+	if err != nil {
+		return err
+	}
+
+	contractID := k.CreateContract(ctx, minter, collection.Contract{
+		Name: nft.Name,
+		Uri:  nft.URI,
+		Meta: nft.Meta,
+	})
+
+	classID, err := k.CreateTokenClass(ctx, contractID, &collection.NFTClass{
+		Name: nft.Name,
+		Meta: nft.Meta,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, t := range nft.Tokens {
+		owner, err := convertAddress(t.Owner)
+		if err != nil {
+			return err
+		}
+
+		name := t.Name
+		if name == "" {
+			name = t.ID
+		}
+
+		if _, err := k.MintNFT(ctx, contractID, owner, []collection.MintNFTParam{{
+			TokenType: *classID,
+			Name:      name,
+			Meta:      t.Meta,
+		}}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertAddress decodes the bech32 address of any prefix.
+func convertAddress(address string) (sdk.AccAddress, error) {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s: %w", address, err)
+	}
+
+	return sdk.AccAddress(bz), nil
+}
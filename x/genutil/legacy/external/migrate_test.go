@@ -0,0 +1,145 @@
+package external_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/bech32"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/genutil/legacy/external"
+	"github.com/line/lbm-sdk/x/genutil/types"
+	"github.com/line/lbm-sdk/x/token"
+)
+
+func TestMigrate(t *testing.T) {
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithCodec(encodingConfig.Marshaler)
+
+	addrs := make([]sdk.AccAddress, 3)
+	externalAddrs := make([]string, len(addrs))
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress([]byte{byte(i), 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+		bz, err := bech32.ConvertAndEncode("wasm", addrs[i])
+		require.NoError(t, err)
+		externalAddrs[i] = bz
+	}
+
+	tokens, err := external.ParseTokens([]byte(`{
+  "fungible_tokens": [
+    {
+      "name": "Fox Coin",
+      "symbol": "FOX",
+      "decimals": 6,
+      "minter": "` + externalAddrs[0] + `",
+      "balances": [
+        {"address": "` + externalAddrs[1] + `", "amount": "100"},
+        {"address": "` + externalAddrs[2] + `", "amount": "20"},
+        {"address": "` + externalAddrs[1] + `", "amount": "3"}
+      ]
+    }
+  ],
+  "non_fungible_tokens": [
+    {
+      "name": "Foxes",
+      "minter": "` + externalAddrs[0] + `",
+      "tokens": [
+        {"id": "fennec", "owner": "` + externalAddrs[1] + `"},
+        {"id": "arctic", "owner": "` + externalAddrs[2] + `", "name": "Arctic Fox", "meta": "white"}
+      ]
+    }
+  ]
+}`))
+	require.NoError(t, err)
+
+	genesisState := simapp.NewDefaultGenesisState(encodingConfig.Marshaler)
+	appState := types.AppMap(genesisState)
+	require.NoError(t, external.Migrate(appState, clientCtx, *tokens))
+
+	var tokenState token.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appState[token.ModuleName], &tokenState)
+	require.NoError(t, token.ValidateGenesis(tokenState))
+
+	require.Len(t, tokenState.Classes, 1)
+	class := tokenState.Classes[0]
+	require.Equal(t, "FOX", class.Symbol)
+	require.True(t, class.Mintable)
+
+	require.Len(t, tokenState.Balances, 1)
+	require.Equal(t, []token.Balance{
+		{Address: addrs[1].String(), Amount: sdk.NewInt(103)},
+		{Address: addrs[2].String(), Amount: sdk.NewInt(20)},
+	}, tokenState.Balances[0].Balances)
+	require.Equal(t, []token.ContractCoin{{ContractId: class.Id, Amount: sdk.NewInt(123)}}, tokenState.Supplies)
+	require.Equal(t, tokenState.Supplies, tokenState.Mints)
+	require.Len(t, tokenState.Grants, 1)
+	require.Len(t, tokenState.Grants[0].Grants, 3)
+	for _, grant := range tokenState.Grants[0].Grants {
+		require.Equal(t, addrs[0].String(), grant.Grantee)
+	}
+
+	// the contract ids are shared by x/token and x/collection
+	require.Len(t, tokenState.ClassState.Ids, 2)
+
+	var collectionState collection.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appState[collection.ModuleName], &collectionState)
+	require.NoError(t, collection.ValidateGenesis(collectionState))
+
+	require.Len(t, collectionState.Contracts, 1)
+	contract := collectionState.Contracts[0]
+	require.Equal(t, "Foxes", contract.Name)
+	require.Contains(t, tokenState.ClassState.Ids, contract.Id)
+	require.NotEqual(t, class.Id, contract.Id)
+
+	require.Len(t, collectionState.Nfts, 1)
+	nfts := collectionState.Nfts[0].Nfts
+	require.Len(t, nfts, 2)
+	require.Equal(t, "fennec", nfts[0].Name)
+	require.Equal(t, "Arctic Fox", nfts[1].Name)
+	require.Equal(t, "white", nfts[1].Meta)
+
+	require.Len(t, collectionState.Balances, 1)
+	owners := map[string]string{}
+	for _, balance := range collectionState.Balances[0].Balances {
+		for _, coin := range balance.Amount {
+			owners[coin.TokenId] = balance.Address
+		}
+	}
+	require.Equal(t, addrs[1].String(), owners[nfts[0].TokenId])
+	require.Equal(t, addrs[2].String(), owners[nfts[1].TokenId])
+}
+
+func TestMigrateInvalid(t *testing.T) {
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithCodec(encodingConfig.Marshaler)
+
+	_, err := external.ParseTokens([]byte(`{"fungible_tokens": [{"name": "Fox Coin", "supply": "10"}]}`))
+	require.Error(t, err, "unknown field")
+
+	testCases := map[string]string{
+		"invalid address":     `{"fungible_tokens": [{"name": "Fox Coin", "symbol": "FOX", "balances": [{"address": "fox", "amount": "1"}]}]}`,
+		"non-positive amount": `{"fungible_tokens": [{"name": "Fox Coin", "symbol": "FOX", "balances": [{"address": "link1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3q4fdzl", "amount": "0"}]}]}`,
+		"invalid symbol":      `{"fungible_tokens": [{"name": "Fox Coin", "symbol": "fox"}]}`,
+		"no minter":           `{"non_fungible_tokens": [{"name": "Foxes"}]}`,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tokens, err := external.ParseTokens([]byte(tc))
+			require.NoError(t, err)
+
+			appState := types.AppMap(simapp.NewDefaultGenesisState(encodingConfig.Marshaler))
+			require.Error(t, external.Migrate(appState, clientCtx, *tokens))
+		})
+	}
+}
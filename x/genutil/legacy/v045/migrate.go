@@ -0,0 +1,139 @@
+package v045
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/line/lbm-sdk/client"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/bech32"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	"github.com/line/lbm-sdk/x/genutil/types"
+)
+
+// Version is the source version of the genesis exported by the chains based
+// on cosmos-sdk v0.45.
+const Version = "cosmos-v0.45"
+
+// RegisterMigrations registers the migration steps of the genesis exported by
+// the chains based on cosmos-sdk v0.45.
+func RegisterMigrations(registry *types.MigrationRegistry) {
+	registry.Register(Version, "bech32-prefix", MigrateAddresses)
+}
+
+// MigrateAddresses re-encodes all the bech32 addresses and public keys in the
+// genesis state with the prefixes of the current config. The prefix of the
+// source chain is detected from the accounts in x/auth.
+func MigrateAddresses(appState types.AppMap, _ client.Context) error {
+	authState, ok := appState[authtypes.ModuleName]
+	if !ok {
+		return nil
+	}
+
+	var auth interface{}
+	if err := unmarshalJSON(authState, &auth); err != nil {
+		return err
+	}
+	address := findAddress(auth)
+	if address == "" {
+		return nil
+	}
+	prefix, _, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return err
+	}
+
+	config := sdk.GetConfig()
+	if prefix == config.GetBech32AccountAddrPrefix() {
+		return nil
+	}
+	prefixes := map[string]string{
+		prefix:                    config.GetBech32AccountAddrPrefix(),
+		prefix + sdk.PrefixPublic: config.GetBech32AccountPubPrefix(),
+		prefix + sdk.PrefixValidator + sdk.PrefixOperator:                     config.GetBech32ValidatorAddrPrefix(),
+		prefix + sdk.PrefixValidator + sdk.PrefixOperator + sdk.PrefixPublic:  config.GetBech32ValidatorPubPrefix(),
+		prefix + sdk.PrefixValidator + sdk.PrefixConsensus:                    config.GetBech32ConsensusAddrPrefix(),
+		prefix + sdk.PrefixValidator + sdk.PrefixConsensus + sdk.PrefixPublic: config.GetBech32ConsensusPubPrefix(),
+	}
+
+	for module, bz := range appState {
+		var state interface{}
+		if err := unmarshalJSON(bz, &state); err != nil {
+			return fmt.Errorf("%s: %w", module, err)
+		}
+
+		if state, err = convertAddresses(state, prefixes); err != nil {
+			return fmt.Errorf("%s: %w", module, err)
+		}
+
+		if appState[module], err = json.Marshal(state); err != nil {
+			return fmt.Errorf("%s: %w", module, err)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalJSON unmarshals bz into v, keeping the numbers as they are.
+func unmarshalJSON(bz []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// findAddress returns the first value of the address fields found in v.
+func findAddress(v interface{}) string {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if address, ok := v["address"].(string); ok && address != "" {
+			return address
+		}
+		for _, value := range v {
+			if address := findAddress(value); address != "" {
+				return address
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if address := findAddress(value); address != "" {
+				return address
+			}
+		}
+	}
+
+	return ""
+}
+
+// convertAddresses replaces the prefixes of the bech32 strings in v.
+func convertAddresses(v interface{}, prefixes map[string]string) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			converted, err := convertAddresses(value, prefixes)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = converted
+		}
+	case []interface{}:
+		for i, value := range v {
+			converted, err := convertAddresses(value, prefixes)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = converted
+		}
+	case string:
+		hrp, data, err := bech32.DecodeAndConvert(v)
+		if err != nil {
+			// not a bech32 string
+			return v, nil
+		}
+		if prefix, ok := prefixes[hrp]; ok {
+			return bech32.ConvertAndEncode(prefix, data)
+		}
+	}
+
+	return v, nil
+}
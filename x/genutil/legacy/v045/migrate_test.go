@@ -0,0 +1,47 @@
+package v045_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/types/bech32"
+	v045 "github.com/line/lbm-sdk/x/genutil/legacy/v045"
+	"github.com/line/lbm-sdk/x/genutil/types"
+)
+
+func TestMigrateAddresses(t *testing.T) {
+	addr := []byte("addr________________")
+	encode := func(hrp string) string {
+		bz, err := bech32.ConvertAndEncode(hrp, addr)
+		require.NoError(t, err)
+		return bz
+	}
+
+	appState := types.AppMap{
+		"auth":    json.RawMessage(`{"accounts":[{"@type":"/cosmos.auth.v1beta1.BaseAccount","address":"` + encode("cosmos") + `","account_number":"0","sequence":"0"}]}`),
+		"bank":    json.RawMessage(`{"balances":[{"address":"` + encode("cosmos") + `","coins":[{"denom":"stake","amount":"100000000000000000000000000000"}]}]}`),
+		"staking": json.RawMessage(`{"validators":[{"operator_address":"` + encode("cosmosvaloper") + `","tokens":"1","memo":"not an address"}]}`),
+		"other":   json.RawMessage(`{"address":"` + encode("osmo") + `"}`),
+	}
+
+	require.NoError(t, v045.MigrateAddresses(appState, client.Context{}))
+
+	require.JSONEq(t, `{"accounts":[{"@type":"/cosmos.auth.v1beta1.BaseAccount","address":"`+encode("link")+`","account_number":"0","sequence":"0"}]}`, string(appState["auth"]))
+	// numbers are kept as they are
+	require.JSONEq(t, `{"balances":[{"address":"`+encode("link")+`","coins":[{"denom":"stake","amount":"100000000000000000000000000000"}]}]}`, string(appState["bank"]))
+	require.JSONEq(t, `{"validators":[{"operator_address":"`+encode("linkvaloper")+`","tokens":"1","memo":"not an address"}]}`, string(appState["staking"]))
+	// the addresses of the other chains are left as they are
+	require.JSONEq(t, `{"address":"`+encode("osmo")+`"}`, string(appState["other"]))
+}
+
+func TestMigrateAddressesWithoutAuth(t *testing.T) {
+	appState := types.AppMap{
+		"bank": json.RawMessage(`{"balances":[]}`),
+	}
+
+	require.NoError(t, v045.MigrateAddresses(appState, client.Context{}))
+	require.JSONEq(t, `{"balances":[]}`, string(appState["bank"]))
+}
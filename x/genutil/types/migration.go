@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/line/lbm-sdk/client"
+)
+
+// MigrationStep migrates a part of the genesis state in place.
+type MigrationStep func(AppMap, client.Context) error
+
+type namedMigrationStep struct {
+	name string
+	step MigrationStep
+}
+
+// MigrationRegistry holds the migration steps contributed by the modules,
+// keyed by the version of the source genesis. The steps of a version are
+// applied in the order of their registration.
+type MigrationRegistry struct {
+	steps map[string][]namedMigrationStep
+}
+
+// NewMigrationRegistry returns an empty MigrationRegistry.
+func NewMigrationRegistry() *MigrationRegistry {
+	return &MigrationRegistry{
+		steps: map[string][]namedMigrationStep{},
+	}
+}
+
+// Register adds a migration step of the given name for the source version.
+// It panics if the name has been already registered for the version.
+func (r *MigrationRegistry) Register(version, name string, step MigrationStep) {
+	for _, s := range r.steps[version] {
+		if s.name == name {
+			panic(fmt.Sprintf("migration step %s already registered for %s", name, version))
+		}
+	}
+
+	r.steps[version] = append(r.steps[version], namedMigrationStep{name: name, step: step})
+}
+
+// Has returns whether any migration step is registered for the version.
+func (r *MigrationRegistry) Has(version string) bool {
+	return len(r.steps[version]) != 0
+}
+
+// Versions returns the source versions in a sorted slice.
+func (r *MigrationRegistry) Versions() []string {
+	versions := make([]string, 0, len(r.steps))
+	for version := range r.steps {
+		versions = append(versions, version)
+	}
+
+	sort.Strings(versions)
+
+	return versions
+}
+
+// Steps returns the names of the migration steps of the version in order.
+func (r *MigrationRegistry) Steps(version string) []string {
+	names := make([]string, len(r.steps[version]))
+	for i, s := range r.steps[version] {
+		names[i] = s.name
+	}
+
+	return names
+}
+
+// Migrate applies all the migration steps of the source version on the
+// genesis state.
+func (r *MigrationRegistry) Migrate(version string, appState AppMap, clientCtx client.Context) (AppMap, error) {
+	steps, ok := r.steps[version]
+	if !ok {
+		return nil, fmt.Errorf("no migration registered for version: %s", version)
+	}
+
+	for _, s := range steps {
+		if err := s.step(appState, clientCtx); err != nil {
+			return nil, fmt.Errorf("migration step %s of %s failed: %w", s.name, version, err)
+		}
+	}
+
+	return appState, nil
+}
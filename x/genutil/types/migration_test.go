@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/x/genutil/types"
+)
+
+func TestMigrationRegistry(t *testing.T) {
+	registry := types.NewMigrationRegistry()
+
+	var applied []string
+	step := func(name string, err error) types.MigrationStep {
+		return func(appState types.AppMap, _ client.Context) error {
+			applied = append(applied, name)
+			appState[name] = []byte(`{}`)
+			return err
+		}
+	}
+	registry.Register("v2", "second", step("second", nil))
+	registry.Register("v2", "first", step("first", nil))
+	registry.Register("v1", "broken", step("broken", errors.New("whoops")))
+
+	require.Panics(t, func() { registry.Register("v2", "first", step("first", nil)) })
+
+	require.Equal(t, []string{"v1", "v2"}, registry.Versions())
+	require.Equal(t, []string{"second", "first"}, registry.Steps("v2"))
+	require.True(t, registry.Has("v1"))
+	require.False(t, registry.Has("v3"))
+
+	// steps are applied in the order of their registration
+	appState, err := registry.Migrate("v2", types.AppMap{}, client.Context{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"second", "first"}, applied)
+	require.Len(t, appState, 2)
+
+	_, err = registry.Migrate("v1", types.AppMap{}, client.Context{})
+	require.Error(t, err)
+
+	_, err = registry.Migrate("v3", types.AppMap{}, client.Context{})
+	require.Error(t, err)
+}
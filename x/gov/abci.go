@@ -56,13 +56,15 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			handler, err := keeper.GetProposalHandler(proposal.GetContent())
+			if err == nil {
+				err = handler(cacheCtx, proposal.ProposalId, proposal.GetContent())
+			}
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
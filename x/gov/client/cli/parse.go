@@ -7,6 +7,8 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
 	govutils "github.com/line/lbm-sdk/x/gov/client/utils"
 )
 
@@ -66,3 +68,27 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseMsgs reads the messages of an ExecMsgsProposal from the given JSON file.
+func parseMsgs(cdc codec.JSONCodec, msgsFile string) ([]sdk.Msg, error) {
+	contents, err := os.ReadFile(msgsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(contents, &rawMsgs); err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msg); err != nil {
+			return nil, fmt.Errorf("failed to parse message at position %d: %w", i, err)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
@@ -60,6 +60,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	}
 
 	cmdSubmitProp := NewCmdSubmitProposal()
+	cmdSubmitProp.AddCommand(NewCmdSubmitExecMsgsProposal())
 	for _, propCmd := range propCmds {
 		flags.AddTxFlagsToCmd(propCmd)
 		cmdSubmitProp.AddCommand(propCmd)
@@ -140,6 +141,90 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	return cmd
 }
 
+// NewCmdSubmitExecMsgsProposal implements submitting a proposal which executes
+// arbitrary messages on behalf of the gov module account.
+func NewCmdSubmitExecMsgsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec-msgs [messages-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal which executes messages on behalf of the gov module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal along with an initial deposit. The messages in the
+given file are executed on behalf of the gov module account if the proposal passes.
+The signer of every message must be the gov module account.
+
+Example:
+$ %s tx gov submit-proposal exec-msgs path/to/messages.json --title="Test Proposal" --description="My awesome proposal" --deposit="10test" --from mykey
+
+Where messages.json contains:
+
+[
+  {
+    "@type": "/cosmos.bank.v1beta1.MsgSend",
+    "from_address": "link10d07y265gmmuvt4z0w9aw880jnsr700jw7ycaz",
+    "to_address": "link1ghekyjucln7y67ntx7cf27m9dpuxxemnqk82wt",
+    "amount": [{"denom": "stake", "amount": "10"}]
+  }
+]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := parseMsgs(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			content, err := types.NewExecMsgsProposal(title, description, msgs)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTitle, "", "The proposal title")
+	cmd.Flags().String(FlagDescription, "", "The proposal description")
+	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdDeposit implements depositing tokens for an active proposal.
 func NewCmdDeposit() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/gov/types"
+)
+
+// GetProposalHandler returns the handler which executes the given proposal
+// content. ExecMsgsProposal is executed by the keeper itself through the
+// MsgServiceRouter, while the other contents are routed through the gov Router.
+func (keeper Keeper) GetProposalHandler(content types.Content) (types.Handler, error) {
+	if _, ok := content.(*types.ExecMsgsProposal); ok {
+		if keeper.msgRouter == nil {
+			return nil, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalType())
+		}
+		return keeper.handleExecMsgsProposal, nil
+	}
+
+	if !keeper.router.HasRoute(content.ProposalRoute()) {
+		return nil, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+	return keeper.router.GetRoute(content.ProposalRoute()), nil
+}
+
+// handleExecMsgsProposal routes the messages of the proposal to the registered
+// msg service handlers, on behalf of the gov module account.
+func (keeper Keeper) handleExecMsgsProposal(ctx sdk.Context, _ uint64, content types.Content) error {
+	proposal, ok := content.(*types.ExecMsgsProposal)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (*types.ExecMsgsProposal)(nil), content)
+	}
+
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	authority := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+	if err := ensureMsgAuthz(msgs, authority); err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := keeper.msgRouter.Handler(msg)
+		if handler == nil {
+			return sdkerrors.ErrUnknownRequest.Wrapf("no message handler found for %q", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "message %q at position %d", sdk.MsgTypeURL(msg), i)
+		}
+
+		// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+		if res != nil {
+			ctx.EventManager().EmitEvents(res.GetEvents())
+		}
+	}
+
+	return nil
+}
+
+// ensureMsgAuthz checks that all the signers of the messages are equal to the given authority.
+func ensureMsgAuthz(msgs []sdk.Msg, authority sdk.AccAddress) error {
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !authority.Equals(signer) {
+				return sdkerrors.ErrUnauthorized.Wrapf("bad signer; expected %s, got %s", authority, signer)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestExecMsgsProposal() {
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	suite.Require().NoError(simapp.FundModuleAccount(suite.app, suite.ctx, types.ModuleName, amount))
+
+	testCases := map[string]struct {
+		msgs  []sdk.Msg
+		valid bool
+	}{
+		"valid proposal": {
+			msgs:  []sdk.Msg{banktypes.NewMsgSend(govAddr, suite.addrs[0], amount)},
+			valid: true,
+		},
+		"bad signer": {
+			msgs: []sdk.Msg{banktypes.NewMsgSend(suite.addrs[1], suite.addrs[0], amount)},
+		},
+		"insufficient funds": {
+			msgs: []sdk.Msg{banktypes.NewMsgSend(govAddr, suite.addrs[0], amount.Add(amount...))},
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			content, err := types.NewExecMsgsProposal("title", "description", tc.msgs)
+			suite.Require().NoError(err)
+			suite.Require().NoError(content.ValidateBasic())
+
+			_, err = suite.app.GovKeeper.SubmitProposal(ctx, content)
+			if !tc.valid {
+				suite.Require().ErrorIs(err, types.ErrInvalidProposalContent)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the messages are executed in a branched context on submission
+			balance := suite.app.BankKeeper.GetBalance(ctx, suite.addrs[0], sdk.DefaultBondDenom)
+
+			handler, err := suite.app.GovKeeper.GetProposalHandler(content)
+			suite.Require().NoError(err)
+			suite.Require().NoError(handler(ctx, 1, content))
+
+			expected := balance.Add(amount[0])
+			suite.Require().Equal(expected, suite.app.BankKeeper.GetBalance(ctx, suite.addrs[0], sdk.DefaultBondDenom))
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, govAddr).IsZero())
+		})
+	}
+}
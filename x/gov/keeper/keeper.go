@@ -6,6 +6,7 @@ import (
 
 	"github.com/line/ostracon/libs/log"
 
+	"github.com/line/lbm-sdk/baseapp"
 	"github.com/line/lbm-sdk/codec"
 	sdk "github.com/line/lbm-sdk/types"
 	authtypes "github.com/line/lbm-sdk/x/auth/types"
@@ -34,6 +35,9 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Msg service router used to execute the messages of ExecMsgsProposal
+	msgRouter *baseapp.MsgServiceRouter
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -46,6 +50,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	msgRouter *baseapp.MsgServiceRouter,
 ) Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr.Empty() {
@@ -65,6 +70,7 @@ func NewKeeper(
 		sk:         sk,
 		cdc:        cdc,
 		router:     rtr,
+		msgRouter:  msgRouter,
 	}
 }
 
@@ -11,24 +11,24 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
-	if !keeper.router.HasRoute(content.ProposalRoute()) {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	handler, err := keeper.GetProposalHandler(content)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	proposalID, err := keeper.GetProposalID(ctx)
+	if err != nil {
+		return types.Proposal{}, err
 	}
 
 	// Execute the proposal content in a new context branch (with branched store)
 	// to validate the actual parameter changes before the proposal proceeds
 	// through the governance process. State is not persisted.
 	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
+	if err := handler(cacheCtx, proposalID, content); err != nil {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
 	}
 
-	proposalID, err := keeper.GetProposalID(ctx)
-	if err != nil {
-		return types.Proposal{}, err
-	}
-
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
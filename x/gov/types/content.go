@@ -29,8 +29,9 @@ type Content interface {
 }
 
 // Handler defines a function that handles a proposal after it has passed the
-// governance process.
-type Handler func(ctx sdk.Context, content Content) error
+// governance process. It is given the id of the proposal, which is also the id
+// the proposal will get when the handler validates the content on submission.
+type Handler func(ctx sdk.Context, proposalID uint64, content Content) error
 
 // ValidateAbstract validates a proposal's abstract contents returning an error
 // if invalid.
@@ -8,9 +8,9 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	types1 "github.com/line/lbm-sdk/codec/types"
+	types "github.com/line/lbm-sdk/codec/types"
 	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
-	types "github.com/line/lbm-sdk/types"
+	types1 "github.com/line/lbm-sdk/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
@@ -200,6 +200,48 @@ func (m *TextProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TextProposal proto.InternalMessageInfo
 
+// ExecMsgsProposal defines a proposal which executes arbitrary messages on
+// behalf of the gov module account once the proposal has passed.
+type ExecMsgsProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// messages to execute through the MsgServiceRouter.
+	// all the signers must be the gov module account.
+	Messages []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ExecMsgsProposal) Reset()      { *m = ExecMsgsProposal{} }
+func (*ExecMsgsProposal) ProtoMessage() {}
+func (*ExecMsgsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+}
+func (m *ExecMsgsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecMsgsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecMsgsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecMsgsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecMsgsProposal.Merge(m, src)
+}
+func (m *ExecMsgsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecMsgsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecMsgsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecMsgsProposal proto.InternalMessageInfo
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
@@ -211,7 +253,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +285,7 @@ var xxx_messageInfo_Deposit proto.InternalMessageInfo
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	ProposalId       uint64                              `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"id" yaml:"id"`
-	Content          *types1.Any                         `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Content          *types.Any                          `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Status           ProposalStatus                      `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1beta1.ProposalStatus" json:"status,omitempty" yaml:"proposal_status"`
 	FinalTallyResult TallyResult                         `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result" yaml:"final_tally_result"`
 	SubmitTime       time.Time                           `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
@@ -256,7 +298,7 @@ type Proposal struct {
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +338,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +383,7 @@ type Vote struct {
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +424,7 @@ type DepositParams struct {
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +462,7 @@ type VotingParams struct {
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +506,7 @@ type TallyParams struct {
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,6 +540,7 @@ func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
+	proto.RegisterType((*ExecMsgsProposal)(nil), "cosmos.gov.v1beta1.ExecMsgsProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1beta1.TallyResult")
@@ -510,99 +553,101 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x41, 0x6b, 0x1b, 0xd7,
-	0x16, 0xd6, 0x48, 0xb2, 0x6c, 0x5d, 0xc9, 0xf6, 0xe4, 0xda, 0xb1, 0x65, 0xbd, 0xbc, 0x19, 0x65,
-	0xf2, 0x78, 0xe4, 0xe5, 0x25, 0x52, 0xe3, 0x96, 0x96, 0xd8, 0x14, 0xaa, 0xb1, 0xc6, 0xad, 0x4a,
-	0xb0, 0xc4, 0x48, 0x91, 0x49, 0x0a, 0x19, 0x46, 0xd6, 0x8d, 0x3c, 0xed, 0xcc, 0x5c, 0x55, 0x73,
-	0xe5, 0xd8, 0x74, 0x53, 0x28, 0x85, 0xa0, 0x42, 0xc9, 0xaa, 0x64, 0x23, 0x30, 0x74, 0xd7, 0x75,
-	0x36, 0xfd, 0x07, 0x21, 0x64, 0x11, 0xba, 0x0a, 0x5d, 0x28, 0x8d, 0x0d, 0x25, 0x64, 0x57, 0xff,
-	0x82, 0x32, 0x73, 0xef, 0x58, 0x23, 0xc9, 0xad, 0x9d, 0xee, 0x66, 0xce, 0x3d, 0xdf, 0xf7, 0x9d,
-	0xfb, 0xf9, 0x9c, 0x33, 0x32, 0xb8, 0xb0, 0x85, 0x1d, 0x0b, 0x3b, 0xb9, 0x26, 0xde, 0xc9, 0xed,
-	0x5c, 0xaf, 0x23, 0xa2, 0x5f, 0x77, 0x9f, 0xb3, 0xad, 0x36, 0x26, 0x18, 0x42, 0x7a, 0x9a, 0x75,
-	0x23, 0xec, 0x34, 0x2d, 0x30, 0x44, 0x5d, 0x77, 0xd0, 0x31, 0x64, 0x0b, 0x1b, 0x36, 0xc5, 0xa4,
-	0xe7, 0x9b, 0xb8, 0x89, 0xbd, 0xc7, 0x9c, 0xfb, 0xc4, 0xa2, 0x4b, 0x14, 0xa5, 0xd1, 0x03, 0x46,
-	0x4b, 0x8f, 0xc4, 0x26, 0xc6, 0x4d, 0x13, 0xe5, 0xbc, 0xb7, 0x7a, 0xe7, 0x5e, 0x8e, 0x18, 0x16,
-	0x72, 0x88, 0x6e, 0xb5, 0x7c, 0xec, 0x68, 0x82, 0x6e, 0xef, 0xb1, 0x23, 0x61, 0xf4, 0xa8, 0xd1,
-	0x69, 0xeb, 0xc4, 0xc0, 0xac, 0x18, 0x69, 0x9f, 0x03, 0x70, 0x13, 0x19, 0xcd, 0x6d, 0x82, 0x1a,
-	0x35, 0x4c, 0x50, 0xa9, 0xe5, 0x1e, 0xc2, 0xf7, 0x41, 0x0c, 0x7b, 0x4f, 0x29, 0x2e, 0xc3, 0x5d,
-	0x9e, 0x59, 0x16, 0xb2, 0xe3, 0x17, 0xcd, 0x0e, 0xf2, 0x55, 0x96, 0x0d, 0x55, 0x10, 0xbb, 0xef,
-	0xb1, 0xa5, 0xc2, 0x19, 0xee, 0x72, 0x5c, 0x5e, 0x79, 0xd2, 0x17, 0x43, 0xbf, 0xf6, 0xc5, 0x8b,
-	0x4d, 0x83, 0x6c, 0x77, 0xea, 0xd9, 0x2d, 0x6c, 0xe5, 0x4c, 0xc3, 0x46, 0x39, 0xb3, 0x6e, 0x5d,
-	0x73, 0x1a, 0x5f, 0xe4, 0xc8, 0x5e, 0x0b, 0x39, 0xd9, 0x02, 0xda, 0x3a, 0xea, 0x8b, 0xd3, 0x7b,
-	0xba, 0x65, 0xae, 0x48, 0x94, 0x40, 0x52, 0x19, 0x93, 0xb4, 0x09, 0x92, 0x55, 0xb4, 0x4b, 0xca,
-	0x6d, 0xdc, 0xc2, 0x8e, 0x6e, 0xc2, 0x79, 0x30, 0x41, 0x0c, 0x62, 0x22, 0xaf, 0xb4, 0xb8, 0x4a,
-	0x5f, 0x60, 0x06, 0x24, 0x1a, 0xc8, 0xd9, 0x6a, 0x1b, 0xb4, 0x6c, 0x4f, 0x5e, 0x0d, 0x86, 0x56,
-	0x66, 0x5f, 0xef, 0x8b, 0xdc, 0x2f, 0x8f, 0xaf, 0x4d, 0xae, 0x61, 0x9b, 0x20, 0x9b, 0x48, 0x4f,
-	0x39, 0x30, 0x59, 0x40, 0x2d, 0xec, 0x18, 0x04, 0x7e, 0x00, 0x12, 0x2d, 0x26, 0xa0, 0x19, 0x0d,
-	0x8f, 0x3a, 0x2a, 0x2f, 0x1c, 0xf5, 0x45, 0x48, 0x8b, 0x0a, 0x1c, 0x4a, 0x2a, 0xf0, 0xdf, 0x8a,
-	0x0d, 0x78, 0x01, 0xc4, 0x1b, 0x94, 0x03, 0xb7, 0x99, 0xea, 0x20, 0x00, 0xef, 0x82, 0x98, 0x6e,
-	0xe1, 0x8e, 0x4d, 0x52, 0x91, 0x4c, 0xe4, 0x72, 0x62, 0x79, 0xc9, 0xf7, 0xd1, 0x6d, 0x8e, 0x63,
-	0x23, 0xd7, 0xb0, 0x61, 0xcb, 0xff, 0x77, 0xad, 0xfa, 0xe9, 0xa5, 0x78, 0xe9, 0xef, 0xad, 0x72,
-	0x73, 0x1d, 0x95, 0xb1, 0xae, 0x4c, 0x3d, 0xd8, 0x17, 0x43, 0xaf, 0xf7, 0xc5, 0x90, 0xf4, 0x47,
-	0x0c, 0x4c, 0x1d, 0x5b, 0xf4, 0xde, 0x49, 0xb7, 0x99, 0x7b, 0xd3, 0x17, 0xc3, 0x46, 0xe3, 0xa8,
-	0x2f, 0xc6, 0xe9, 0x9d, 0x46, 0xaf, 0xb2, 0x0a, 0x26, 0xb7, 0xa8, 0x35, 0xde, 0x45, 0x12, 0xcb,
-	0xf3, 0x59, 0xda, 0x3d, 0x59, 0xbf, 0x7b, 0xb2, 0x79, 0x7b, 0x4f, 0x4e, 0x3c, 0x1d, 0x78, 0xa8,
-	0xfa, 0x08, 0x58, 0x03, 0x31, 0x87, 0xe8, 0xa4, 0xe3, 0xa4, 0x22, 0x5e, 0xc7, 0x48, 0x27, 0x75,
-	0x8c, 0x5f, 0x60, 0xc5, 0xcb, 0x94, 0xd3, 0x47, 0x7d, 0x71, 0x61, 0xc4, 0x5f, 0x4a, 0x22, 0xa9,
-	0x8c, 0x0d, 0xb6, 0x00, 0xbc, 0x67, 0xd8, 0xba, 0xa9, 0x11, 0xdd, 0x34, 0xf7, 0xb4, 0x36, 0x72,
-	0x3a, 0x26, 0x49, 0x45, 0xbd, 0xfa, 0xc4, 0x93, 0x34, 0xaa, 0x6e, 0x9e, 0xea, 0xa5, 0xc9, 0x17,
-	0x5d, 0x4f, 0x8f, 0xfa, 0xe2, 0x12, 0x15, 0x19, 0x27, 0x92, 0x54, 0xde, 0x0b, 0x06, 0x40, 0xf0,
-	0x33, 0x90, 0x70, 0x3a, 0x75, 0xcb, 0x20, 0x9a, 0x3b, 0x67, 0xa9, 0x09, 0x4f, 0x2a, 0x3d, 0x66,
-	0x45, 0xd5, 0x1f, 0x42, 0x59, 0x60, 0x2a, 0xac, 0x55, 0x02, 0x60, 0xe9, 0xe1, 0x4b, 0x91, 0x53,
-	0x01, 0x8d, 0xb8, 0x00, 0x68, 0x00, 0x9e, 0x75, 0x87, 0x86, 0xec, 0x06, 0x55, 0x88, 0x9d, 0xaa,
-	0x70, 0x89, 0x29, 0x2c, 0x52, 0x85, 0x51, 0x06, 0x2a, 0x33, 0xc3, 0xc2, 0x8a, 0xdd, 0xf0, 0xa4,
-	0xbe, 0xe1, 0xc0, 0x34, 0xc1, 0x44, 0x37, 0x35, 0x76, 0x90, 0x9a, 0x3c, 0xad, 0x07, 0xd7, 0x98,
-	0xce, 0x3c, 0xd5, 0x19, 0x42, 0x4b, 0x67, 0xed, 0xcd, 0xa4, 0x07, 0xf3, 0x07, 0xcb, 0x04, 0xe7,
-	0x76, 0x30, 0x31, 0xec, 0xa6, 0xfb, 0x97, 0x6d, 0x33, 0x4f, 0xa7, 0x4e, 0xbd, 0xf1, 0x7f, 0x58,
-	0x25, 0x29, 0x5a, 0xc9, 0x18, 0x05, 0xbd, 0xf2, 0x2c, 0x8d, 0x57, 0xdc, 0xb0, 0x77, 0xe7, 0x7b,
-	0x80, 0x85, 0x06, 0xee, 0xc6, 0x4f, 0xd5, 0x92, 0x98, 0xd6, 0xc2, 0x90, 0xd6, 0xb0, 0xb9, 0xd3,
-	0x34, 0xca, 0xbc, 0x5d, 0x89, 0xba, 0xbb, 0x44, 0x7a, 0x1c, 0x06, 0x89, 0x60, 0xe7, 0xac, 0x82,
-	0xc8, 0x1e, 0x72, 0xe8, 0x5e, 0x92, 0xff, 0x77, 0xb6, 0xd5, 0x57, 0xb4, 0x89, 0xea, 0xa2, 0xe0,
-	0x1a, 0x98, 0xd4, 0xeb, 0x0e, 0xd1, 0x0d, 0xb6, 0xbc, 0xde, 0x86, 0xc0, 0x47, 0xc2, 0x1b, 0x20,
-	0x6c, 0x63, 0x6f, 0x02, 0xdf, 0x0a, 0x1f, 0xb6, 0x31, 0xac, 0x83, 0xa4, 0x8d, 0xb5, 0xfb, 0x06,
-	0xd9, 0xd6, 0x76, 0x10, 0xc1, 0xde, 0x88, 0xc5, 0xe5, 0x8f, 0xce, 0x4c, 0x72, 0xd4, 0x17, 0xe7,
-	0xa8, 0x81, 0x41, 0x1a, 0x49, 0x05, 0x36, 0xde, 0x34, 0xc8, 0x76, 0x0d, 0x11, 0xcc, 0x6c, 0x3b,
-	0xe4, 0x40, 0xd4, 0xfd, 0x76, 0xfc, 0xf3, 0xa5, 0x3b, 0x0f, 0x26, 0x76, 0x30, 0x41, 0xfe, 0xc2,
-	0xa5, 0x2f, 0x70, 0xe5, 0xf8, 0xa3, 0x15, 0x39, 0xcb, 0x47, 0x4b, 0x0e, 0xa7, 0xb8, 0xe3, 0x0f,
-	0xd7, 0x3a, 0x98, 0xa4, 0x4f, 0x4e, 0x2a, 0xea, 0x4d, 0xc9, 0x7f, 0x4f, 0x02, 0x8f, 0x7f, 0x29,
-	0xe5, 0xa8, 0x6b, 0x90, 0xea, 0x83, 0x57, 0xa6, 0x1e, 0xf9, 0x0b, 0xf9, 0xe7, 0x30, 0x98, 0x66,
-	0x43, 0x50, 0xd6, 0xdb, 0xba, 0xe5, 0xc0, 0x1f, 0x38, 0x90, 0xb0, 0x0c, 0xfb, 0x78, 0x1c, 0xb9,
-	0xd3, 0xc6, 0xf1, 0x8e, 0xcb, 0xfd, 0xa6, 0x2f, 0x9e, 0x0f, 0xa0, 0xae, 0x62, 0xcb, 0x20, 0xc8,
-	0x6a, 0x91, 0xbd, 0x81, 0x4f, 0x81, 0xe3, 0x33, 0x4f, 0x29, 0xb0, 0x0c, 0xdb, 0x9f, 0xd1, 0xef,
-	0x39, 0x00, 0x2d, 0x7d, 0xd7, 0xe7, 0xd0, 0x5a, 0xa8, 0x6d, 0xe0, 0x06, 0xfb, 0x08, 0x2c, 0x8d,
-	0x4d, 0x4e, 0x81, 0xfd, 0x84, 0x90, 0x15, 0x56, 0xdf, 0x85, 0x71, 0xf0, 0x50, 0x99, 0x6c, 0xfd,
-	0x8e, 0x67, 0x49, 0x8f, 0xdc, 0xd9, 0xe2, 0x2d, 0x7d, 0xd7, 0x77, 0x8a, 0x86, 0xbf, 0xe3, 0x40,
-	0xb2, 0xe6, 0x0d, 0x1c, 0xb3, 0xee, 0x2b, 0xc0, 0x06, 0xd0, 0xaf, 0x8d, 0x3b, 0xad, 0xb6, 0x55,
-	0x56, 0xdb, 0xe2, 0x10, 0x6e, 0xa8, 0xac, 0xf9, 0xa1, 0x79, 0x0f, 0x56, 0x94, 0xa4, 0x31, 0x56,
-	0xcd, 0x33, 0x7f, 0xcc, 0x59, 0x31, 0xb7, 0x40, 0xec, 0xcb, 0x0e, 0x6e, 0x77, 0x2c, 0xaf, 0x8a,
-	0xa4, 0xfc, 0xe1, 0x99, 0x7f, 0xe4, 0xbc, 0xe9, 0x8b, 0x3c, 0x85, 0x0e, 0x0a, 0x51, 0x19, 0x19,
-	0xbc, 0x0b, 0xe2, 0x64, 0xbb, 0x8d, 0x9c, 0x6d, 0x6c, 0x52, 0xef, 0x93, 0x67, 0x9d, 0x3e, 0xca,
-	0x3c, 0x77, 0x8c, 0x0e, 0x90, 0x0f, 0x28, 0xe1, 0xb7, 0x1c, 0x98, 0x71, 0x47, 0x52, 0x1b, 0xa8,
-	0x44, 0x3c, 0x95, 0xbb, 0x6f, 0xa3, 0x92, 0x1a, 0xa6, 0x18, 0x32, 0xf4, 0x3c, 0x33, 0x74, 0x28,
-	0x43, 0x52, 0xa7, 0xdd, 0x40, 0xd5, 0x7f, 0xbf, 0xf2, 0x3b, 0x07, 0x40, 0xe0, 0xa7, 0xe6, 0x55,
-	0xb0, 0x58, 0x2b, 0x55, 0x15, 0xad, 0x54, 0xae, 0x16, 0x4b, 0x1b, 0xda, 0xad, 0x8d, 0x4a, 0x59,
-	0x59, 0x2b, 0xae, 0x17, 0x95, 0x02, 0x1f, 0x4a, 0xcf, 0x76, 0x7b, 0x99, 0x04, 0x4d, 0x54, 0x5c,
-	0x11, 0x28, 0x81, 0xd9, 0x60, 0xf6, 0x6d, 0xa5, 0xc2, 0x73, 0xe9, 0xe9, 0x6e, 0x2f, 0x13, 0xa7,
-	0x59, 0xb7, 0x91, 0x03, 0xaf, 0x80, 0xb9, 0x60, 0x4e, 0x5e, 0xae, 0x54, 0xf3, 0xc5, 0x0d, 0x3e,
-	0x9c, 0x3e, 0xd7, 0xed, 0x65, 0xa6, 0x69, 0x5e, 0x9e, 0x2d, 0xcc, 0x0c, 0x98, 0x09, 0xe6, 0x6e,
-	0x94, 0xf8, 0x48, 0x3a, 0xd9, 0xed, 0x65, 0xa6, 0x68, 0xda, 0x06, 0x86, 0xcb, 0x20, 0x35, 0x9c,
-	0xa1, 0x6d, 0x16, 0xab, 0x9f, 0x68, 0x35, 0xa5, 0x5a, 0xe2, 0xa3, 0xe9, 0xf9, 0x6e, 0x2f, 0xc3,
-	0xfb, 0xb9, 0xfe, 0x9e, 0x4b, 0x47, 0x1f, 0xfc, 0x28, 0x84, 0xae, 0x3c, 0x0b, 0x83, 0x99, 0xe1,
-	0x5f, 0x3c, 0x30, 0x0b, 0xfe, 0x55, 0x56, 0x4b, 0xe5, 0x52, 0x25, 0x7f, 0x53, 0xab, 0x54, 0xf3,
-	0xd5, 0x5b, 0x95, 0x91, 0x0b, 0x7b, 0x57, 0xa1, 0xc9, 0x1b, 0x86, 0x09, 0x57, 0x81, 0x30, 0x9a,
-	0x5f, 0x50, 0xca, 0xa5, 0x4a, 0xb1, 0xaa, 0x95, 0x15, 0xb5, 0x58, 0x2a, 0xf0, 0x5c, 0x7a, 0xb1,
-	0xdb, 0xcb, 0xcc, 0x51, 0xc8, 0xd0, 0x14, 0xc1, 0x1b, 0xe0, 0xdf, 0xa3, 0xe0, 0x5a, 0xa9, 0x5a,
-	0xdc, 0xf8, 0xd8, 0xc7, 0x86, 0xd3, 0x0b, 0xdd, 0x5e, 0x06, 0x52, 0x6c, 0x2d, 0xd0, 0xf2, 0xf0,
-	0x2a, 0x58, 0x18, 0x85, 0x96, 0xf3, 0x95, 0x8a, 0x52, 0xe0, 0x23, 0x69, 0xbe, 0xdb, 0xcb, 0x24,
-	0x29, 0xa6, 0xac, 0x3b, 0x0e, 0x6a, 0xc0, 0x77, 0x40, 0x6a, 0x34, 0x5b, 0x55, 0x3e, 0x55, 0xd6,
-	0xaa, 0x4a, 0x81, 0x8f, 0xa6, 0x61, 0xb7, 0x97, 0x99, 0xa1, 0xf9, 0x2a, 0xfa, 0x1c, 0x6d, 0x11,
-	0x74, 0x22, 0xff, 0x7a, 0xbe, 0x78, 0x53, 0x29, 0xf0, 0x13, 0x41, 0xfe, 0x75, 0xdd, 0x30, 0x51,
-	0x83, 0xda, 0x29, 0x17, 0x9f, 0xbc, 0x12, 0x42, 0x2f, 0x5e, 0x09, 0xa1, 0xaf, 0x0f, 0x84, 0xd0,
-	0x93, 0x03, 0x81, 0x7b, 0x7e, 0x20, 0x70, 0xbf, 0x1d, 0x08, 0xdc, 0xc3, 0x43, 0x21, 0xf4, 0xfc,
-	0x50, 0x08, 0xbd, 0x38, 0x14, 0x42, 0x77, 0xfe, 0x72, 0xf9, 0xed, 0x7a, 0xff, 0xc2, 0x79, 0xad,
-	0x5c, 0x8f, 0x79, 0xfb, 0xe2, 0xdd, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xe3, 0xf6, 0x1d,
-	0xdd, 0x0d, 0x00, 0x00,
+	// 1491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xc1, 0x6f, 0x1a, 0xc7,
+	0x1a, 0x67, 0x01, 0x63, 0x33, 0x60, 0x7b, 0x33, 0x76, 0x6c, 0xcc, 0xcb, 0x63, 0xc9, 0xe6, 0xe9,
+	0x29, 0x2f, 0x2f, 0x81, 0x24, 0xef, 0xe9, 0x3d, 0x05, 0xab, 0x52, 0x58, 0xb3, 0x6e, 0xa9, 0x52,
+	0x83, 0x16, 0x82, 0x95, 0x54, 0xca, 0x6a, 0x81, 0x09, 0xde, 0x96, 0xdd, 0xa1, 0xec, 0xe0, 0xd8,
+	0xea, 0xa5, 0x52, 0x55, 0x29, 0xa5, 0x52, 0x95, 0x53, 0x95, 0x0b, 0x92, 0xa5, 0xde, 0x7a, 0xce,
+	0xa5, 0xff, 0x41, 0x14, 0xe5, 0x10, 0xf5, 0x14, 0xf5, 0x40, 0x1a, 0x5b, 0xaa, 0xa2, 0xdc, 0xea,
+	0xbf, 0xa0, 0xda, 0x9d, 0x59, 0x58, 0xc0, 0xa9, 0xed, 0xf6, 0xb6, 0xfb, 0xcd, 0xf7, 0xfb, 0x7d,
+	0xbf, 0xf9, 0xed, 0x7c, 0xdf, 0x00, 0x38, 0x57, 0xc3, 0x96, 0x81, 0xad, 0x74, 0x03, 0x6f, 0xa7,
+	0xb7, 0xaf, 0x55, 0x11, 0xd1, 0xae, 0xd9, 0xcf, 0xa9, 0x56, 0x1b, 0x13, 0x0c, 0x21, 0x5d, 0x4d,
+	0xd9, 0x11, 0xb6, 0x1a, 0x4f, 0x30, 0x44, 0x55, 0xb3, 0xd0, 0x00, 0x52, 0xc3, 0xba, 0x49, 0x31,
+	0xf1, 0xc5, 0x06, 0x6e, 0x60, 0xe7, 0x31, 0x6d, 0x3f, 0xb1, 0xe8, 0x0a, 0x45, 0xa9, 0x74, 0x81,
+	0xd1, 0xd2, 0x25, 0xa1, 0x81, 0x71, 0xa3, 0x89, 0xd2, 0xce, 0x5b, 0xb5, 0x73, 0x3f, 0x4d, 0x74,
+	0x03, 0x59, 0x44, 0x33, 0x5a, 0x2e, 0x76, 0x3c, 0x41, 0x33, 0x77, 0xd9, 0x52, 0x62, 0x7c, 0xa9,
+	0xde, 0x69, 0x6b, 0x44, 0xc7, 0x4c, 0x8c, 0xb8, 0xc7, 0x01, 0xb8, 0x89, 0xf4, 0xc6, 0x16, 0x41,
+	0xf5, 0x0a, 0x26, 0xa8, 0xd0, 0xb2, 0x17, 0xe1, 0xff, 0x40, 0x08, 0x3b, 0x4f, 0x31, 0x2e, 0xc9,
+	0x5d, 0x9c, 0xbb, 0x9e, 0x48, 0x4d, 0x6e, 0x34, 0x35, 0xcc, 0x57, 0x58, 0x36, 0x54, 0x40, 0xe8,
+	0x81, 0xc3, 0x16, 0xf3, 0x27, 0xb9, 0x8b, 0x61, 0x29, 0xf3, 0xb4, 0x2f, 0xf8, 0x7e, 0xee, 0x0b,
+	0xe7, 0x1b, 0x3a, 0xd9, 0xea, 0x54, 0x53, 0x35, 0x6c, 0xa4, 0x9b, 0xba, 0x89, 0xd2, 0xcd, 0xaa,
+	0x71, 0xc5, 0xaa, 0x7f, 0x9a, 0x26, 0xbb, 0x2d, 0x64, 0xa5, 0x72, 0xa8, 0x76, 0xd8, 0x17, 0x66,
+	0x77, 0x35, 0xa3, 0x99, 0x11, 0x29, 0x81, 0xa8, 0x30, 0x26, 0x71, 0x13, 0x44, 0xcb, 0x68, 0x87,
+	0x14, 0xdb, 0xb8, 0x85, 0x2d, 0xad, 0x09, 0x17, 0xc1, 0x14, 0xd1, 0x49, 0x13, 0x39, 0xd2, 0xc2,
+	0x0a, 0x7d, 0x81, 0x49, 0x10, 0xa9, 0x23, 0xab, 0xd6, 0xd6, 0xa9, 0x6c, 0xa7, 0xbc, 0xe2, 0x0d,
+	0x65, 0xe6, 0xdf, 0xec, 0x09, 0xdc, 0x4f, 0x4f, 0xae, 0x4c, 0xaf, 0x61, 0x93, 0x20, 0x93, 0x88,
+	0x5f, 0x73, 0x80, 0x97, 0x77, 0x50, 0xed, 0x23, 0xab, 0x61, 0xfd, 0x55, 0x76, 0x78, 0x15, 0xcc,
+	0x18, 0xc8, 0xb2, 0xb4, 0x06, 0xb2, 0x62, 0x81, 0x64, 0xe0, 0x62, 0xe4, 0xfa, 0x62, 0x8a, 0x7a,
+	0x9f, 0x72, 0xbd, 0x4f, 0x65, 0xcd, 0x5d, 0x65, 0x90, 0x95, 0x89, 0x78, 0xb5, 0x3c, 0xe3, 0xc0,
+	0x74, 0x0e, 0xb5, 0xb0, 0xa5, 0x13, 0xf8, 0x7f, 0x10, 0x69, 0x31, 0x39, 0xaa, 0x5e, 0x77, 0x84,
+	0x04, 0xa5, 0xa5, 0xc3, 0xbe, 0x00, 0xa9, 0x41, 0x9e, 0x45, 0x51, 0x01, 0xee, 0x5b, 0xbe, 0x0e,
+	0xcf, 0x81, 0x70, 0x9d, 0x72, 0xe0, 0x36, 0xd3, 0x38, 0x0c, 0xc0, 0x7b, 0x20, 0xa4, 0x19, 0xb8,
+	0x63, 0x12, 0xa6, 0x6f, 0xc5, 0xfd, 0xa6, 0xf6, 0x41, 0x1d, 0x7c, 0xd4, 0x35, 0xac, 0x9b, 0xd2,
+	0xbf, 0xed, 0xcf, 0xf6, 0xc3, 0x2b, 0xe1, 0xc2, 0x1f, 0x7f, 0x36, 0x3b, 0xd7, 0x52, 0x18, 0x6b,
+	0x66, 0xe6, 0xe1, 0x9e, 0xe0, 0x7b, 0xb3, 0x27, 0xf8, 0xc4, 0xdf, 0x42, 0x60, 0x66, 0x60, 0xe8,
+	0x7f, 0x8f, 0xda, 0xcd, 0xc2, 0xdb, 0xbe, 0xe0, 0xd7, 0xeb, 0x87, 0x7d, 0x21, 0x4c, 0xf7, 0x34,
+	0xbe, 0x95, 0x55, 0x30, 0x5d, 0xa3, 0xd6, 0x38, 0x1b, 0x79, 0x87, 0x9b, 0x52, 0xe4, 0xd9, 0xd0,
+	0x43, 0xc5, 0x45, 0xc0, 0x0a, 0x08, 0x59, 0x44, 0x23, 0x1d, 0xfb, 0x4b, 0xd8, 0xa7, 0x57, 0x3c,
+	0xea, 0xf4, 0xba, 0x02, 0x4b, 0x4e, 0xa6, 0x14, 0x3f, 0xec, 0x0b, 0x4b, 0x63, 0xfe, 0x52, 0x12,
+	0x51, 0x61, 0x6c, 0xb0, 0x05, 0xe0, 0x7d, 0xdd, 0xd4, 0x9a, 0x2a, 0xd1, 0x9a, 0xcd, 0x5d, 0xb5,
+	0x8d, 0xac, 0x4e, 0x93, 0xc4, 0x82, 0x8e, 0x3e, 0xe1, 0xa8, 0x1a, 0x65, 0x3b, 0x4f, 0x71, 0xd2,
+	0xa4, 0xf3, 0xb6, 0xa7, 0x87, 0x7d, 0x61, 0x85, 0x16, 0x99, 0x24, 0x12, 0x15, 0xde, 0x09, 0x7a,
+	0x40, 0xf0, 0x63, 0x10, 0xb1, 0x3a, 0x55, 0x43, 0x27, 0xaa, 0xdd, 0xf3, 0xb1, 0x29, 0xa7, 0x54,
+	0x7c, 0xc2, 0x8a, 0xb2, 0x3b, 0x10, 0xa4, 0x04, 0xab, 0xc2, 0x8e, 0x8a, 0x07, 0x2c, 0x3e, 0x7a,
+	0x25, 0x70, 0x0a, 0xa0, 0x11, 0x1b, 0x00, 0x75, 0xc0, 0xb3, 0xd3, 0xa1, 0x22, 0xb3, 0x4e, 0x2b,
+	0x84, 0x8e, 0xad, 0x70, 0x81, 0x55, 0x58, 0xa6, 0x15, 0xc6, 0x19, 0x68, 0x99, 0x39, 0x16, 0x96,
+	0xcd, 0xba, 0x53, 0xea, 0x4b, 0x0e, 0xcc, 0x12, 0x4c, 0xb4, 0xa6, 0xca, 0x16, 0x62, 0xd3, 0xc7,
+	0x9d, 0xc1, 0x35, 0x56, 0x67, 0x91, 0xd6, 0x19, 0x41, 0x8b, 0x27, 0x3d, 0x9b, 0x51, 0x07, 0xe6,
+	0x36, 0x56, 0x13, 0x9c, 0xd9, 0xc6, 0x44, 0x37, 0x1b, 0xf6, 0x97, 0x6d, 0x33, 0x4f, 0x67, 0x8e,
+	0xdd, 0xf1, 0x3f, 0x98, 0x92, 0x18, 0x55, 0x32, 0x41, 0x41, 0xb7, 0x3c, 0x4f, 0xe3, 0x25, 0x3b,
+	0xec, 0xec, 0xf9, 0x3e, 0x60, 0xa1, 0xa1, 0xbb, 0xe1, 0x63, 0x6b, 0x89, 0xac, 0xd6, 0xd2, 0x48,
+	0xad, 0x51, 0x73, 0x67, 0x69, 0x94, 0x79, 0x9b, 0x09, 0xda, 0x73, 0x4d, 0x7c, 0xe2, 0x07, 0x11,
+	0xef, 0xc9, 0x59, 0x05, 0x81, 0x5d, 0x64, 0xd1, 0x29, 0x26, 0xfd, 0xeb, 0x64, 0x63, 0x38, 0x6f,
+	0x12, 0xc5, 0x46, 0xc1, 0x35, 0x30, 0xad, 0x55, 0x2d, 0xa2, 0xe9, 0x6c, 0xd4, 0x9d, 0x86, 0xc0,
+	0x45, 0xc2, 0x1b, 0xc0, 0x6f, 0xe2, 0x58, 0xe0, 0xb4, 0x78, 0xbf, 0x89, 0x61, 0x15, 0x44, 0x4d,
+	0xac, 0x3e, 0xd0, 0xc9, 0x96, 0xba, 0x8d, 0x08, 0x76, 0x5a, 0x2c, 0x2c, 0xdd, 0x3c, 0x31, 0xc9,
+	0x61, 0x5f, 0x58, 0xa0, 0x06, 0x7a, 0x69, 0x44, 0x05, 0x98, 0x78, 0x53, 0x27, 0x5b, 0x15, 0x44,
+	0x30, 0xb3, 0xed, 0x80, 0x03, 0x41, 0xfb, 0x1e, 0xfb, 0xf3, 0x43, 0x77, 0x11, 0x4c, 0x6d, 0x63,
+	0x82, 0xdc, 0x81, 0x4b, 0x5f, 0x60, 0x66, 0x70, 0x81, 0x06, 0x4e, 0x72, 0x81, 0x4a, 0xfe, 0x18,
+	0x37, 0xb8, 0x44, 0xd7, 0xc1, 0x34, 0x7d, 0xb2, 0x62, 0x41, 0xa7, 0x4b, 0xfe, 0x79, 0x14, 0x78,
+	0xf2, 0xd6, 0x96, 0x82, 0xb6, 0x41, 0x8a, 0x0b, 0xce, 0xcc, 0x3c, 0x76, 0x07, 0xf2, 0x8f, 0x7e,
+	0x30, 0xcb, 0x9a, 0xa0, 0xa8, 0xb5, 0x35, 0xc3, 0x82, 0xdf, 0x71, 0x20, 0x62, 0xe8, 0xe6, 0xa0,
+	0x1d, 0xb9, 0xe3, 0xda, 0xf1, 0xae, 0xcd, 0xfd, 0xb6, 0x2f, 0x9c, 0xf5, 0xa0, 0x2e, 0x63, 0x43,
+	0x27, 0xc8, 0x68, 0x91, 0xdd, 0xa1, 0x4f, 0x9e, 0xe5, 0x13, 0x77, 0x29, 0x30, 0x74, 0xd3, 0xed,
+	0xd1, 0x6f, 0x39, 0x00, 0x0d, 0x6d, 0xc7, 0xe5, 0x50, 0x5b, 0xa8, 0xad, 0xe3, 0x3a, 0xbb, 0x04,
+	0x56, 0x26, 0x3a, 0x27, 0xc7, 0x7e, 0xce, 0x48, 0x32, 0xd3, 0x77, 0x6e, 0x12, 0x3c, 0x22, 0x93,
+	0x8d, 0xdf, 0xc9, 0x2c, 0xf1, 0xb1, 0xdd, 0x5b, 0xbc, 0xa1, 0xed, 0xb8, 0x4e, 0xd1, 0xf0, 0x37,
+	0x1c, 0x88, 0x56, 0x9c, 0x86, 0x63, 0xd6, 0x7d, 0x0e, 0x58, 0x03, 0xba, 0xda, 0xb8, 0xe3, 0xb4,
+	0xad, 0x32, 0x6d, 0xcb, 0x23, 0xb8, 0x11, 0x59, 0x8b, 0x23, 0xfd, 0xee, 0x55, 0x14, 0xa5, 0x31,
+	0xa6, 0xe6, 0xb9, 0xdb, 0xe6, 0x4c, 0xcc, 0x6d, 0x10, 0xfa, 0xac, 0x83, 0xdb, 0x1d, 0xc3, 0x51,
+	0x11, 0x95, 0xde, 0x3b, 0xf1, 0x0f, 0xae, 0xb7, 0x7d, 0x81, 0xa7, 0xd0, 0xa1, 0x10, 0x85, 0x91,
+	0xc1, 0x7b, 0x20, 0x4c, 0xb6, 0xda, 0xc8, 0xda, 0xc2, 0x4d, 0xea, 0x7d, 0x54, 0xba, 0x79, 0x1a,
+	0xe6, 0x85, 0x01, 0xda, 0x43, 0x3e, 0xa4, 0x84, 0x5f, 0x71, 0x60, 0xce, 0x6e, 0x49, 0x75, 0x58,
+	0x25, 0xe0, 0x54, 0xb9, 0x77, 0x9a, 0x2a, 0xb1, 0x51, 0x8a, 0x11, 0x43, 0xcf, 0x32, 0x43, 0x47,
+	0x32, 0x44, 0x65, 0xd6, 0x0e, 0x94, 0xdd, 0xf7, 0x4b, 0xbf, 0x72, 0x00, 0x78, 0x7e, 0xf6, 0x5e,
+	0x06, 0xcb, 0x95, 0x42, 0x59, 0x56, 0x0b, 0xc5, 0x72, 0xbe, 0xb0, 0xa1, 0xde, 0xde, 0x28, 0x15,
+	0xe5, 0xb5, 0xfc, 0x7a, 0x5e, 0xce, 0xf1, 0xbe, 0xf8, 0x7c, 0xb7, 0x97, 0x8c, 0xd0, 0x44, 0xd9,
+	0x2e, 0x02, 0x45, 0x30, 0xef, 0xcd, 0xbe, 0x23, 0x97, 0x78, 0x2e, 0x3e, 0xdb, 0xed, 0x25, 0xc3,
+	0x34, 0xeb, 0x0e, 0xb2, 0xe0, 0x25, 0xb0, 0xe0, 0xcd, 0xc9, 0x4a, 0xa5, 0x72, 0x36, 0xbf, 0xc1,
+	0xfb, 0xe3, 0x67, 0xba, 0xbd, 0xe4, 0x2c, 0xcd, 0xcb, 0xb2, 0x81, 0x99, 0x04, 0x73, 0xde, 0xdc,
+	0x8d, 0x02, 0x1f, 0x88, 0x47, 0xbb, 0xbd, 0xe4, 0x0c, 0x4d, 0xdb, 0xc0, 0xf0, 0x3a, 0x88, 0x8d,
+	0x66, 0xa8, 0x9b, 0xf9, 0xf2, 0x07, 0x6a, 0x45, 0x2e, 0x17, 0xf8, 0x60, 0x7c, 0xb1, 0xdb, 0x4b,
+	0xf2, 0x6e, 0xae, 0x3b, 0xe7, 0xe2, 0xc1, 0x87, 0xdf, 0x27, 0x7c, 0x97, 0x9e, 0xfb, 0xc1, 0xdc,
+	0xe8, 0x2f, 0x1e, 0x98, 0x02, 0x7f, 0x2b, 0x2a, 0x85, 0x62, 0xa1, 0x94, 0xbd, 0xa5, 0x96, 0xca,
+	0xd9, 0xf2, 0xed, 0xd2, 0xd8, 0x86, 0x9d, 0xad, 0xd0, 0xe4, 0x0d, 0xbd, 0x09, 0x57, 0x41, 0x62,
+	0x3c, 0x3f, 0x27, 0x17, 0x0b, 0xa5, 0x7c, 0x59, 0x2d, 0xca, 0x4a, 0xbe, 0x90, 0xe3, 0xb9, 0xf8,
+	0x72, 0xb7, 0x97, 0x5c, 0xa0, 0x90, 0x91, 0x2e, 0x82, 0x37, 0xc0, 0xdf, 0xc7, 0xc1, 0x95, 0x42,
+	0x39, 0xbf, 0xf1, 0xbe, 0x8b, 0xf5, 0xc7, 0x97, 0xba, 0xbd, 0x24, 0xa4, 0xd8, 0x8a, 0xe7, 0xc8,
+	0xc3, 0xcb, 0x60, 0x69, 0x1c, 0x5a, 0xcc, 0x96, 0x4a, 0x72, 0x8e, 0x0f, 0xc4, 0xf9, 0x6e, 0x2f,
+	0x19, 0xa5, 0x98, 0xa2, 0x66, 0x59, 0xa8, 0x0e, 0xaf, 0x82, 0xd8, 0x78, 0xb6, 0x22, 0x7f, 0x28,
+	0xaf, 0x95, 0xe5, 0x1c, 0x1f, 0x8c, 0xc3, 0x6e, 0x2f, 0x39, 0x47, 0xf3, 0x15, 0xf4, 0x09, 0xaa,
+	0x11, 0x74, 0x24, 0xff, 0x7a, 0x36, 0x7f, 0x4b, 0xce, 0xf1, 0x53, 0x5e, 0xfe, 0x75, 0x4d, 0x6f,
+	0xa2, 0x3a, 0xb5, 0x53, 0xca, 0x3f, 0x7d, 0x9d, 0xf0, 0xbd, 0x7c, 0x9d, 0xf0, 0x7d, 0xb1, 0x9f,
+	0xf0, 0x3d, 0xdd, 0x4f, 0x70, 0x2f, 0xf6, 0x13, 0xdc, 0x2f, 0xfb, 0x09, 0xee, 0xd1, 0x41, 0xc2,
+	0xf7, 0xe2, 0x20, 0xe1, 0x7b, 0x79, 0x90, 0xf0, 0xdd, 0x7d, 0xe7, 0xf0, 0xdb, 0x71, 0xfe, 0x4e,
+	0x3a, 0x47, 0xb9, 0x1a, 0x72, 0xe6, 0xc5, 0x7f, 0x7e, 0x1f, 0x00, 0xfb, 0x42, 0x88, 0x24, 0x69,
+	0x0e, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -793,6 +838,57 @@ func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecMsgsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecMsgsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecMsgsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1231,6 +1327,29 @@ func (m *TextProposal) Size() (n int) {
 	return n
 }
 
+func (m *ExecMsgsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Deposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1596,6 +1715,154 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExecMsgsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecMsgsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecMsgsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Deposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1705,7 +1972,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
+			m.Amount = append(m.Amount, types1.Coin{})
 			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1809,7 +2076,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Content == nil {
-				m.Content = &types1.Any{}
+				m.Content = &types.Any{}
 			}
 			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1962,7 +2229,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
 			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2452,7 +2719,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
 			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -180,7 +180,8 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText string = "Text"
+	ProposalTypeText     string = "Text"
+	ProposalTypeExecMsgs string = "ExecMsgs"
 )
 
 // Implements Content Interface
@@ -212,8 +213,104 @@ func (tp TextProposal) String() string {
 	return string(out)
 }
 
+// Implements Content Interface
+var _ Content = &ExecMsgsProposal{}
+
+// NewExecMsgsProposal creates a proposal Content which executes the given
+// messages on behalf of the gov module account.
+func NewExecMsgsProposal(title, description string, msgs []sdk.Msg) (Content, error) {
+	proposal := &ExecMsgsProposal{
+		Title:       title,
+		Description: description,
+	}
+	if err := proposal.SetMsgs(msgs); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// GetTitle returns the proposal title
+func (p *ExecMsgsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the proposal description
+func (p *ExecMsgsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the proposal router key
+func (p *ExecMsgsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "ExecMsgs"
+func (p *ExecMsgsProposal) ProposalType() string { return ProposalTypeExecMsgs }
+
+// ValidateBasic validates the abstract and the messages of the proposal
+func (p *ExecMsgsProposal) ValidateBasic() error {
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, err.Error())
+	}
+	if len(msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "no messages to execute")
+	}
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg: %d", i)
+		}
+	}
+
+	return ValidateAbstract(p)
+}
+
+// GetMsgs unpacks the messages of the proposal.
+func (p ExecMsgsProposal) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(p.Messages))
+	for i, any := range p.Messages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (sdk.Msg)(nil), any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// SetMsgs packs the given messages into the proposal.
+func (p *ExecMsgsProposal) SetMsgs(msgs []sdk.Msg) error {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+		anys[i] = any
+	}
+	p.Messages = anys
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p ExecMsgsProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range p.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (p ExecMsgsProposal) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:     {},
+	ProposalTypeExecMsgs: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -250,7 +347,7 @@ func IsValidProposalType(ty string) bool {
 // proposals (ie. TextProposal ). Since these are
 // merely signaling mechanisms at the moment and do not affect state, it
 // performs a no-op.
-func ProposalHandler(_ sdk.Context, c Content) error {
+func ProposalHandler(_ sdk.Context, _ uint64, c Content) error {
 	switch c.ProposalType() {
 	case ProposalTypeText:
 		// both proposal types do not change state so this performs a no-op
@@ -17,18 +17,26 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
+	// The params are validated against the registered schedules, but the binary
+	// may still lack the schedule, e.g. after an upgrade dropping it. Do not halt
+	// the chain, but skip minting until the params select a known schedule.
+	schedule, err := k.GetInflationSchedule(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("skipped minting", "err", err)
+		return
+	}
+
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	minter = schedule.NextMinter(minter, params, ctx.BlockHeight(), bondedRatio, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
 	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
+	mintedCoin := k.CapProvision(ctx, minter.BlockProvision(params))
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
-	err := k.MintCoins(ctx, mintedCoins)
+	err = k.MintCoins(ctx, mintedCoins)
 	if err != nil {
 		panic(err)
 	}
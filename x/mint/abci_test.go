@@ -0,0 +1,26 @@
+package mint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/x/mint"
+	"github.com/line/lbm-sdk/x/mint/types"
+)
+
+func TestBeginBlockerUnknownSchedule(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	params := app.MintKeeper.GetParams(ctx)
+	supply := app.BankKeeper.GetSupply(ctx, params.MintDenom)
+
+	// e.g. the schedule has been dropped from the binary
+	app.GetSubspace(types.ModuleName).Set(ctx, types.KeyInflationSchedule, "unknown")
+
+	require.NotPanics(t, func() { mint.BeginBlocker(ctx, app.MintKeeper) })
+	require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, params.MintDenom))
+}
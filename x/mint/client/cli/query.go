@@ -2,14 +2,22 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/version"
 	"github.com/line/lbm-sdk/x/mint/types"
 )
 
+// Query flags
+const (
+	FlagEpochLength = "epoch-length"
+	FlagEpochs      = "epochs"
+)
+
 // GetQueryCmd returns the cli query commands for the minting module.
 func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd := &cobra.Command{
@@ -24,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryProjectedEmissions(),
 	)
 
 	return mintingQueryCmd
@@ -115,3 +124,56 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProjectedEmissions implements a command to return the emissions
+// projected by the current inflation schedule.
+func GetCmdQueryProjectedEmissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-emissions",
+		Short: "Query the emissions projected by the current inflation schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of tokens expected to be minted per epoch, as projected by the
+current inflation schedule. The bonded ratio is assumed to remain the same.
+
+Example:
+$ %s query %s projected-emissions --%s=6311520 --%s=10
+`,
+				version.AppName, types.ModuleName, FlagEpochLength, FlagEpochs,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochLength, err := cmd.Flags().GetUint64(FlagEpochLength)
+			if err != nil {
+				return err
+			}
+			epochs, err := cmd.Flags().GetUint32(FlagEpochs)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryProjectedEmissionsRequest{
+				EpochLength: epochLength,
+				Epochs:      epochs,
+			}
+			res, err := queryClient.ProjectedEmissions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagEpochLength, 0, "Number of blocks in an epoch (defaults to blocks_per_year)")
+	cmd.Flags().Uint32(FlagEpochs, 1, "Number of epochs to project")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -67,16 +67,20 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", ostcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","inflation_schedule":"bonded_ratio","initial_annual_provisions":"0.000000000000000000","halving_interval":"25246080","max_supply":"0"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", ostcli.OutputFlag)},
 			`blocks_per_year: "6311520"
 goal_bonded: "0.670000000000000000"
+halving_interval: "25246080"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
 inflation_rate_change: "0.130000000000000000"
+inflation_schedule: bonded_ratio
+initial_annual_provisions: "0.000000000000000000"
+max_supply: "0"
 mint_denom: stake`,
 		},
 	}
@@ -162,3 +166,67 @@ func (s *IntegrationTestSuite) TestGetCmdQueryAnnualProvisions() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryProjectedEmissions() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		valid    bool
+		expected []minttypes.EpochEmission
+	}{
+		{
+			"default epochs",
+			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", ostcli.OutputFlag)},
+			true,
+			[]minttypes.EpochEmission{{StartHeight: 2, EndHeight: 6311521}},
+		},
+		{
+			"custom epochs",
+			[]string{
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+				fmt.Sprintf("--%s=10", cli.FlagEpochLength),
+				fmt.Sprintf("--%s=2", cli.FlagEpochs),
+				fmt.Sprintf("--%s=json", ostcli.OutputFlag),
+			},
+			true,
+			[]minttypes.EpochEmission{{StartHeight: 2, EndHeight: 11}, {StartHeight: 12, EndHeight: 21}},
+		},
+		{
+			"too many epochs",
+			[]string{
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+				fmt.Sprintf("--%s=1000", cli.FlagEpochs),
+				fmt.Sprintf("--%s=json", ostcli.OutputFlag),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryProjectedEmissions()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res minttypes.QueryProjectedEmissionsResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Len(res.Emissions, len(tc.expected))
+			for i, emission := range res.Emissions {
+				s.Require().Equal(tc.expected[i].StartHeight, emission.StartHeight)
+				s.Require().Equal(tc.expected[i].EndHeight, emission.EndHeight)
+				s.Require().True(emission.Provisions.IsPositive())
+			}
+		})
+	}
+}
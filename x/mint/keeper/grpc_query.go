@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/mint/types"
@@ -32,3 +36,44 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// Limits of a projected emissions query, which bound its computation.
+const (
+	// maxProjectedEpochs is the maximum number of epochs in a projected emissions query.
+	maxProjectedEpochs = 100
+
+	// maxProjectedEpochLength is the maximum length of an epoch in a projected
+	// emissions query, which keeps the projected heights within int64.
+	maxProjectedEpochLength = math.MaxUint32
+)
+
+// ProjectedEmissions returns the emissions projected by the inflation schedule of the mint module.
+func (k Keeper) ProjectedEmissions(c context.Context, req *types.QueryProjectedEmissionsRequest) (*types.QueryProjectedEmissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Epochs > maxProjectedEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "epochs cannot exceed %d", maxProjectedEpochs)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epochLength := req.EpochLength
+	if epochLength == 0 {
+		epochLength = k.GetParams(ctx).BlocksPerYear
+	}
+	if epochLength > maxProjectedEpochLength {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch length cannot exceed %d", uint64(maxProjectedEpochLength))
+	}
+	epochs := req.Epochs
+	if epochs == 0 {
+		epochs = 1
+	}
+
+	emissions, err := k.ProjectEmissions(ctx, epochLength, epochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProjectedEmissionsResponse{Emissions: emissions}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -51,6 +52,42 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCProjectedEmissions() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.InflationSchedule = types.InflationScheduleHalving
+	params.InitialAnnualProvisions = sdk.NewDec(int64(params.BlocksPerYear) * 1_000)
+	params.HalvingInterval = params.BlocksPerYear
+	supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	params.MaxSupply = supply.Add(sdk.NewInt(int64(params.BlocksPerYear) * 1_200))
+	app.MintKeeper.SetParams(ctx, params)
+
+	_, err := queryClient.ProjectedEmissions(gocontext.Background(), &types.QueryProjectedEmissionsRequest{Epochs: 1_000})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ProjectedEmissions(gocontext.Background(), &types.QueryProjectedEmissionsRequest{EpochLength: math.MaxUint64, Epochs: 1})
+	suite.Require().Error(err)
+
+	res, err := queryClient.ProjectedEmissions(gocontext.Background(), &types.QueryProjectedEmissionsRequest{Epochs: 3})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Emissions, 3)
+
+	// the halving happens at the end of the first epoch, then the max supply is reached
+	blocksPerYear := int64(params.BlocksPerYear)
+	first := sdk.NewInt((blocksPerYear-1)*1_000 + 500)
+	suite.Require().Equal(types.EpochEmission{
+		StartHeight: 1,
+		EndHeight:   blocksPerYear,
+		Provisions:  first,
+		TotalSupply: supply.Add(first),
+	}, res.Emissions[0])
+	suite.Require().Equal(params.MaxSupply.Sub(supply.Add(first)), res.Emissions[1].Provisions)
+	suite.Require().Equal(params.MaxSupply, res.Emissions[1].TotalSupply)
+	suite.Require().True(res.Emissions[2].Provisions.IsZero())
+	suite.Require().Equal(params.MaxSupply, res.Emissions[2].TotalSupply)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/line/lbm-sdk/codec"
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/mint/types"
 	paramtypes "github.com/line/lbm-sdk/x/params/types"
 )
@@ -80,6 +81,54 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// GetInflationSchedule returns the inflation schedule selected by the params.
+func (k Keeper) GetInflationSchedule(ctx sdk.Context) (types.InflationSchedule, error) {
+	name := k.GetParams(ctx).InflationSchedule
+	schedule, ok := types.GetInflationSchedule(name)
+	if !ok {
+		return nil, sdkerrors.ErrNotFound.Wrapf("inflation schedule %s", name)
+	}
+
+	return schedule, nil
+}
+
+// ProjectEmissions returns the emissions of the given number of epochs from the
+// next block, projected by the current inflation schedule and capped by the max supply.
+func (k Keeper) ProjectEmissions(ctx sdk.Context, epochLength uint64, epochs uint32) ([]types.EpochEmission, error) {
+	schedule, err := k.GetInflationSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight() + 1
+	provisions := schedule.ProjectEmissions(k.GetMinter(ctx), params, height, k.BondedRatio(ctx), k.StakingTokenSupply(ctx), epochLength, epochs)
+
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	emissions := make([]types.EpochEmission, len(provisions))
+	for i, provision := range provisions {
+		capped := types.CapProvision(sdk.NewCoin(params.MintDenom, provision), supply, params.MaxSupply)
+		supply = supply.Add(capped.Amount)
+
+		emissions[i] = types.EpochEmission{
+			StartHeight: height,
+			EndHeight:   height + int64(epochLength) - 1,
+			Provisions:  capped.Amount,
+			TotalSupply: supply,
+		}
+		height += int64(epochLength)
+	}
+
+	return emissions, nil
+}
+
+// CapProvision limits the given provision by the max supply in the params.
+func (k Keeper) CapProvision(ctx sdk.Context, provision sdk.Coin) sdk.Coin {
+	maxSupply := k.GetParams(ctx).MaxSupply
+	supply := k.bankKeeper.GetSupply(ctx, provision.Denom).Amount
+	return types.CapProvision(provision, supply, maxSupply)
+}
+
 // StakingTokenSupply implements an alias call to the underlying staking keeper's
 // StakingTokenSupply to be used in BeginBlocker.
 func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
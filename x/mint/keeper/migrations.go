@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/mint/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -15,6 +16,16 @@ func NewMigrator(keeper Keeper) Migrator {
 }
 
 // Migrate1to2 migrates from version 1 to 2.
+// It sets the params of the inflation schedules, keeping the bonded ratio
+// schedule in use.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var blocksPerYear uint64
+	m.keeper.paramSpace.Get(ctx, types.KeyBlocksPerYear, &blocksPerYear)
+
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationSchedule, types.InflationScheduleBondedRatio)
+	m.keeper.paramSpace.Set(ctx, types.KeyInitialProvisions, sdk.ZeroDec())
+	m.keeper.paramSpace.Set(ctx, types.KeyHalvingInterval, 4*blocksPerYear)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxSupply, sdk.ZeroInt())
+
 	return nil
 }
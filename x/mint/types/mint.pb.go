@@ -79,6 +79,14 @@ type Params struct {
 	GoalBonded github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// name of the inflation schedule which drives the emission
+	InflationSchedule string `protobuf:"bytes,7,opt,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule,omitempty" yaml:"inflation_schedule"`
+	// annual provisions before the first halving, used by the halving schedule
+	InitialAnnualProvisions github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,8,opt,name=initial_annual_provisions,json=initialAnnualProvisions,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"initial_annual_provisions" yaml:"initial_annual_provisions"`
+	// number of blocks between two halvings, used by the halving schedule
+	HalvingInterval uint64 `protobuf:"varint,9,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty" yaml:"halving_interval"`
+	// maximum total supply of the mint denom, zero means no cap
+	MaxSupply github_com_line_lbm_sdk_types.Int `protobuf:"bytes,10,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/line/lbm-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,43 +135,132 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationSchedule() string {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return ""
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+// EpochEmission represents the projected emission of an epoch.
+type EpochEmission struct {
+	// first block height of the epoch
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// last block height of the epoch
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// amount of tokens expected to be minted during the epoch
+	Provisions github_com_line_lbm_sdk_types.Int `protobuf:"bytes,3,opt,name=provisions,proto3,customtype=github.com/line/lbm-sdk/types.Int" json:"provisions"`
+	// expected total supply of the mint denom at the end of the epoch
+	TotalSupply github_com_line_lbm_sdk_types.Int `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3,customtype=github.com/line/lbm-sdk/types.Int" json:"total_supply" yaml:"total_supply"`
+}
+
+func (m *EpochEmission) Reset()         { *m = EpochEmission{} }
+func (m *EpochEmission) String() string { return proto.CompactTextString(m) }
+func (*EpochEmission) ProtoMessage()    {}
+func (*EpochEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *EpochEmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochEmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochEmission.Merge(m, src)
+}
+func (m *EpochEmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochEmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochEmission proto.InternalMessageInfo
+
+func (m *EpochEmission) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochEmission) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*EpochEmission)(nil), "cosmos.mint.v1beta1.EpochEmission")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xb1, 0x6e, 0xd4, 0x30,
-	0x1c, 0xc6, 0x13, 0x38, 0x4e, 0x3a, 0x43, 0x05, 0xb8, 0x05, 0x45, 0x15, 0x24, 0x25, 0x62, 0x28,
-	0x03, 0x17, 0x55, 0x6c, 0x1d, 0x40, 0x84, 0x22, 0xc4, 0x50, 0xe9, 0xe4, 0x0d, 0x96, 0xf0, 0x4f,
-	0xce, 0x4d, 0xad, 0xc6, 0x76, 0x64, 0xbb, 0x55, 0x6e, 0xe0, 0x1d, 0x18, 0x19, 0x11, 0xef, 0x82,
-	0xd4, 0xb1, 0x23, 0x62, 0x88, 0xd0, 0xdd, 0x1b, 0xf4, 0x09, 0x50, 0xec, 0xd3, 0x1d, 0x1c, 0x20,
-	0x71, 0x12, 0x9b, 0xbf, 0xef, 0xff, 0xf7, 0xf7, 0xfb, 0x3c, 0x18, 0x85, 0x85, 0xd4, 0x5c, 0xea,
-	0x84, 0x33, 0x61, 0x92, 0xb3, 0xbd, 0x9c, 0x1a, 0xd8, 0xb3, 0x62, 0x58, 0x2b, 0x69, 0x24, 0xde,
-	0x74, 0xf3, 0xa1, 0xb5, 0xe6, 0xf3, 0xed, 0xad, 0x52, 0x96, 0xd2, 0xce, 0x93, 0xee, 0xe4, 0x56,
-	0xe3, 0x2f, 0x3e, 0xea, 0x1f, 0x32, 0x61, 0xa8, 0xc2, 0xaf, 0xd0, 0x80, 0x89, 0xa3, 0x0a, 0x0c,
-	0x93, 0x22, 0xf0, 0x77, 0xfc, 0xdd, 0x41, 0xfa, 0xe8, 0xbc, 0x8d, 0xbc, 0x6f, 0x6d, 0xf4, 0xa0,
-	0x64, 0xe6, 0xf8, 0x34, 0x1f, 0x16, 0x92, 0x27, 0x15, 0x13, 0x34, 0xa9, 0x72, 0xfe, 0x58, 0x8f,
-	0x4f, 0x12, 0x33, 0xa9, 0xa9, 0x1e, 0x1e, 0xd0, 0x82, 0x2c, 0xef, 0x62, 0x85, 0x6e, 0x83, 0x10,
-	0xa7, 0x50, 0x65, 0xb5, 0x92, 0x67, 0x4c, 0x33, 0x29, 0x74, 0x70, 0xc5, 0x06, 0xbe, 0xfc, 0xe7,
-	0xc0, 0xcb, 0x36, 0x0a, 0x26, 0xc0, 0xab, 0xfd, 0xf8, 0xb7, 0xac, 0x98, 0xdc, 0x72, 0xde, 0x68,
-	0x69, 0x7d, 0xee, 0xa1, 0xfe, 0x08, 0x14, 0x70, 0x8d, 0xef, 0x23, 0xd4, 0x3d, 0x3c, 0x1b, 0x53,
-	0x21, 0xb9, 0x7b, 0x08, 0x19, 0x74, 0xce, 0x41, 0x67, 0xe0, 0xf7, 0xe8, 0xce, 0xa2, 0x6a, 0xa6,
-	0xc0, 0xd0, 0xac, 0x38, 0x06, 0x51, 0xd2, 0x79, 0xc3, 0xd7, 0xeb, 0x34, 0xbc, 0xe7, 0x1a, 0xfe,
-	0x31, 0x2f, 0x26, 0x9b, 0x0b, 0x9f, 0x80, 0xa1, 0x2f, 0xac, 0x8b, 0x8f, 0xd0, 0xc6, 0x72, 0x9d,
-	0x43, 0x13, 0x5c, 0xb5, 0xd8, 0xe7, 0xeb, 0x60, 0xb7, 0x56, 0xb1, 0x1c, 0x9a, 0x98, 0xdc, 0x58,
-	0xe8, 0x43, 0x68, 0x56, 0x38, 0x4c, 0x04, 0xbd, 0xff, 0xc1, 0x61, 0xe2, 0x17, 0x0e, 0x13, 0xf8,
-	0x1d, 0xba, 0x5e, 0x4a, 0xa8, 0xb2, 0x5c, 0x8a, 0x31, 0x1d, 0x07, 0xd7, 0x2c, 0xe5, 0xd9, 0x3a,
-	0x14, 0xec, 0x28, 0x3f, 0xa5, 0xc4, 0x04, 0x75, 0x2a, 0xb5, 0x02, 0xa7, 0xe8, 0x66, 0x5e, 0xc9,
-	0xe2, 0x44, 0x67, 0x35, 0x55, 0xd9, 0x84, 0x82, 0x0a, 0xfa, 0x3b, 0xfe, 0x6e, 0x2f, 0xdd, 0xbe,
-	0x6c, 0xa3, 0xbb, 0xee, 0xf2, 0xca, 0x42, 0x4c, 0x36, 0x9c, 0x33, 0xa2, 0xea, 0x0d, 0x05, 0xb5,
-	0xdf, 0xfb, 0xf8, 0x29, 0xf2, 0xd2, 0xa7, 0xe7, 0xd3, 0xd0, 0xbf, 0x98, 0x86, 0xfe, 0xf7, 0x69,
-	0xe8, 0x7f, 0x98, 0x85, 0xde, 0xc5, 0x2c, 0xf4, 0xbe, 0xce, 0x42, 0xef, 0xed, 0xc3, 0xbf, 0x15,
-	0x6d, 0xdc, 0x27, 0xb3, 0x7d, 0xf3, 0xbe, 0xfd, 0x33, 0x4f, 0x7e, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x9b, 0xde, 0x5b, 0xb9, 0x80, 0x03, 0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0x81, 0x17, 0x5e, 0x06, 0x10, 0x30, 0xc0, 0xc3, 0xf0, 0x1e, 0x36, 0x6f, 0xd4, 0x05,
+	0x5d, 0x34, 0x11, 0x6a, 0x57, 0x2c, 0x68, 0x49, 0xa1, 0x6d, 0xa4, 0x22, 0xa1, 0x61, 0xd5, 0x4a,
+	0x95, 0x3b, 0xb1, 0x07, 0x7b, 0x84, 0x3d, 0x63, 0xd9, 0x93, 0x28, 0x59, 0xf4, 0x03, 0xba, 0xeb,
+	0xb2, 0xcb, 0x4a, 0xfd, 0x82, 0x7e, 0x44, 0x25, 0x96, 0x2c, 0xab, 0x2e, 0xac, 0x0a, 0xfe, 0x20,
+	0x5f, 0x50, 0x65, 0xc6, 0x89, 0xd3, 0x50, 0x24, 0x22, 0x75, 0xe7, 0x7b, 0xee, 0xbd, 0xe7, 0xdc,
+	0x73, 0x33, 0x33, 0x01, 0x96, 0x2b, 0xd2, 0x48, 0xa4, 0xb5, 0x88, 0x71, 0x59, 0x6b, 0xef, 0x36,
+	0xa9, 0x24, 0xbb, 0x2a, 0xa8, 0xc6, 0x89, 0x90, 0x02, 0xae, 0xe8, 0x7c, 0x55, 0x41, 0x79, 0x7e,
+	0x73, 0xd5, 0x17, 0xbe, 0x50, 0xf9, 0x5a, 0xff, 0x4b, 0x97, 0xa2, 0xaf, 0x06, 0x28, 0x1f, 0x33,
+	0x2e, 0x69, 0x02, 0x9f, 0x83, 0x0a, 0xe3, 0x67, 0x21, 0x91, 0x4c, 0x70, 0xd3, 0xd8, 0x36, 0x76,
+	0x2a, 0xf5, 0xfb, 0x17, 0x99, 0x5d, 0xfa, 0x9e, 0xd9, 0xff, 0xfb, 0x4c, 0x06, 0xad, 0x66, 0xd5,
+	0x15, 0x51, 0x2d, 0x64, 0x9c, 0xd6, 0xc2, 0x66, 0xf4, 0x20, 0xf5, 0xce, 0x6b, 0xb2, 0x1b, 0xd3,
+	0xb4, 0x7a, 0x48, 0x5d, 0x5c, 0xf4, 0xc2, 0x04, 0x2c, 0x13, 0xce, 0x5b, 0x24, 0x74, 0xe2, 0x44,
+	0xb4, 0x59, 0xca, 0x04, 0x4f, 0xcd, 0x29, 0x45, 0x78, 0x74, 0x67, 0xc2, 0x5e, 0x66, 0x9b, 0x5d,
+	0x12, 0x85, 0x7b, 0xe8, 0x06, 0x17, 0xc2, 0x4b, 0x1a, 0x3b, 0x29, 0xa0, 0xcf, 0xb3, 0xa0, 0x7c,
+	0x42, 0x12, 0x12, 0xa5, 0x70, 0x0b, 0x80, 0xbe, 0x71, 0xc7, 0xa3, 0x5c, 0x44, 0xda, 0x08, 0xae,
+	0xf4, 0x91, 0xc3, 0x3e, 0x00, 0xdf, 0x81, 0xb5, 0xe1, 0xa8, 0x4e, 0x42, 0x24, 0x75, 0xdc, 0x80,
+	0x70, 0x9f, 0xe6, 0x13, 0x36, 0x26, 0x99, 0xf0, 0x3f, 0x3d, 0xe1, 0x6f, 0xf9, 0x10, 0x5e, 0x19,
+	0xe2, 0x98, 0x48, 0xfa, 0x54, 0xa1, 0xf0, 0x0c, 0x2c, 0x14, 0xe5, 0x11, 0xe9, 0x98, 0xd3, 0x4a,
+	0xf6, 0x60, 0x12, 0xd9, 0xd5, 0x71, 0xd9, 0x88, 0x74, 0x10, 0x9e, 0x1f, 0xc6, 0xc7, 0xa4, 0x33,
+	0xa6, 0xc3, 0xb8, 0x39, 0xf3, 0x27, 0x74, 0x18, 0xff, 0x45, 0x87, 0x71, 0xf8, 0x16, 0xcc, 0xf9,
+	0x82, 0x84, 0x4e, 0x53, 0x70, 0x8f, 0x7a, 0xe6, 0x5f, 0x4a, 0xe5, 0xf1, 0x24, 0x2a, 0x50, 0xab,
+	0x8c, 0xb0, 0x20, 0x0c, 0xfa, 0x51, 0x5d, 0x05, 0xb0, 0x0e, 0x16, 0x9b, 0xa1, 0x70, 0xcf, 0x53,
+	0x27, 0xa6, 0x89, 0xd3, 0xa5, 0x24, 0x31, 0xcb, 0xdb, 0xc6, 0xce, 0x4c, 0x7d, 0xb3, 0x97, 0xd9,
+	0xff, 0xe8, 0xe6, 0xb1, 0x02, 0x84, 0x17, 0x34, 0x72, 0x42, 0x93, 0x57, 0x94, 0x24, 0xf0, 0x25,
+	0x80, 0x85, 0x8b, 0xd4, 0x0d, 0xa8, 0xd7, 0x0a, 0xa9, 0x39, 0xab, 0x86, 0xdd, 0xea, 0x65, 0xf6,
+	0xc6, 0xb8, 0xd3, 0x41, 0x0d, 0xc2, 0xcb, 0x43, 0xf0, 0x34, 0xc7, 0xe0, 0x7b, 0x03, 0x6c, 0x30,
+	0xce, 0x24, 0x23, 0xa1, 0x73, 0xf3, 0xa4, 0xff, 0xad, 0x58, 0x8f, 0x27, 0x59, 0xc1, 0xf6, 0x40,
+	0xfe, 0x16, 0x4e, 0x84, 0xd7, 0xf3, 0xdc, 0xc1, 0xd8, 0xc1, 0x87, 0xcf, 0xc0, 0x52, 0x40, 0xc2,
+	0x36, 0xe3, 0xbe, 0xa3, 0xae, 0x71, 0x9b, 0x84, 0x66, 0x45, 0xad, 0xe7, 0xdf, 0x5e, 0x66, 0xaf,
+	0x6b, 0xe2, 0xf1, 0x0a, 0x84, 0x17, 0x73, 0xa8, 0x91, 0x23, 0xf0, 0x0d, 0x00, 0x11, 0xe9, 0x38,
+	0x69, 0x2b, 0x8e, 0xc3, 0xae, 0x09, 0x94, 0x87, 0xfd, 0xbb, 0x79, 0x68, 0x70, 0xd9, 0xcb, 0xec,
+	0x65, 0x2d, 0x55, 0x90, 0x20, 0x5c, 0x89, 0x48, 0xe7, 0x54, 0x7d, 0xef, 0xcd, 0x7c, 0xfc, 0x64,
+	0x97, 0xd0, 0x97, 0x29, 0xb0, 0x70, 0x14, 0x0b, 0x37, 0x38, 0x8a, 0x58, 0xda, 0x9f, 0x1f, 0xee,
+	0x81, 0xf9, 0x54, 0x92, 0x44, 0x3a, 0x01, 0x65, 0x7e, 0x20, 0xd5, 0x75, 0x9d, 0xae, 0xaf, 0xf7,
+	0x32, 0x7b, 0x45, 0xf3, 0x8d, 0x66, 0x11, 0x9e, 0x53, 0xe1, 0x0b, 0x15, 0xc1, 0x47, 0x00, 0x50,
+	0xee, 0x0d, 0x3a, 0xa7, 0x54, 0xe7, 0x5a, 0x31, 0x49, 0x91, 0x43, 0xb8, 0x42, 0xb9, 0x97, 0x77,
+	0x35, 0x00, 0x18, 0xf9, 0xb1, 0xa6, 0x27, 0x79, 0xe7, 0x1a, 0x5c, 0xe2, 0x91, 0x66, 0xe8, 0x82,
+	0x79, 0x29, 0x24, 0x09, 0x07, 0x5b, 0xd3, 0x57, 0xec, 0xc9, 0x24, 0x5b, 0xcb, 0x5d, 0x8e, 0xd2,
+	0x20, 0x3c, 0xa7, 0x42, 0xbd, 0xb9, 0xfa, 0xfe, 0xc5, 0x95, 0x65, 0x5c, 0x5e, 0x59, 0xc6, 0x8f,
+	0x2b, 0xcb, 0xf8, 0x70, 0x6d, 0x95, 0x2e, 0xaf, 0xad, 0xd2, 0xb7, 0x6b, 0xab, 0xf4, 0xfa, 0xde,
+	0x6d, 0x02, 0x1d, 0xfd, 0xcf, 0xa0, 0x74, 0x9a, 0x65, 0xf5, 0xd0, 0x3f, 0xfc, 0x39, 0x00, 0x5c,
+	0x8c, 0x21, 0x5b, 0x35, 0x06, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -229,6 +326,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.InitialAnnualProvisions.Size()
+		i -= size
+		if _, err := m.InitialAnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.InflationSchedule) > 0 {
+		i -= len(m.InflationSchedule)
+		copy(dAtA[i:], m.InflationSchedule)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.InflationSchedule)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -284,6 +413,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Provisions.Size()
+		i -= size
+		if _, err := m.Provisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -329,6 +511,36 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = len(m.InflationSchedule)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.InitialAnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *EpochEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	l = m.Provisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -672,6 +884,281 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochEmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochEmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
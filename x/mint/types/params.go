@@ -19,6 +19,10 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyInflationSchedule   = []byte("InflationSchedule")
+	KeyInitialProvisions   = []byte("InitialAnnualProvisions")
+	KeyHalvingInterval     = []byte("HalvingInterval")
+	KeyMaxSupply           = []byte("MaxSupply")
 )
 
 // ParamTable for minting module.
@@ -36,19 +40,24 @@ func NewParams(
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		InflationSchedule:   InflationScheduleBondedRatio,
+		// the parameters of the halving schedule are irrelevant for the default one
+		InitialAnnualProvisions: sdk.ZeroDec(),
+		HalvingInterval:         4 * blocksPerYear,
+		MaxSupply:               sdk.ZeroInt(),
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
-	return Params{
-		MintDenom:           sdk.DefaultBondDenom,
-		InflationRateChange: sdk.NewDecWithPrec(13, 2),
-		InflationMax:        sdk.NewDecWithPrec(20, 2),
-		InflationMin:        sdk.NewDecWithPrec(7, 2),
-		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
-	}
+	return NewParams(
+		sdk.DefaultBondDenom,
+		sdk.NewDecWithPrec(13, 2),
+		sdk.NewDecWithPrec(20, 2),
+		sdk.NewDecWithPrec(7, 2),
+		sdk.NewDecWithPrec(67, 2),
+		uint64(60*60*8766/5), // assuming 5 second block times
+	)
 }
 
 // validate params
@@ -71,6 +80,18 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateInflationSchedule(p.InflationSchedule); err != nil {
+		return err
+	}
+	if err := validateInitialAnnualProvisions(p.InitialAnnualProvisions); err != nil {
+		return err
+	}
+	if err := validateHalvingInterval(p.HalvingInterval); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -96,6 +117,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyInflationSchedule, &p.InflationSchedule, validateInflationSchedule),
+		paramtypes.NewParamSetPair(KeyInitialProvisions, &p.InitialAnnualProvisions, validateInitialAnnualProvisions),
+		paramtypes.NewParamSetPair(KeyHalvingInterval, &p.HalvingInterval, validateHalvingInterval),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
 	}
 }
 
@@ -191,3 +216,64 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateInflationSchedule(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("inflation schedule cannot be blank")
+	}
+	if _, ok := GetInflationSchedule(v); !ok {
+		return fmt.Errorf("inflation schedule not registered: %s", v)
+	}
+
+	return nil
+}
+
+func validateInitialAnnualProvisions(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("initial annual provisions cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("initial annual provisions cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateHalvingInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("halving interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("max supply cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -268,6 +268,109 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryProjectedEmissionsRequest is the request type for the
+// Query/ProjectedEmissions RPC method.
+type QueryProjectedEmissionsRequest struct {
+	// epoch_length is the number of blocks in an epoch, defaults to blocks_per_year.
+	EpochLength uint64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// epochs is the number of epochs to project, defaults to 1.
+	Epochs uint32 `protobuf:"varint,2,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *QueryProjectedEmissionsRequest) Reset()         { *m = QueryProjectedEmissionsRequest{} }
+func (m *QueryProjectedEmissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedEmissionsRequest) ProtoMessage()    {}
+func (*QueryProjectedEmissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryProjectedEmissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedEmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedEmissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedEmissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedEmissionsRequest.Merge(m, src)
+}
+func (m *QueryProjectedEmissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedEmissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedEmissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedEmissionsRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedEmissionsRequest) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *QueryProjectedEmissionsRequest) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+// QueryProjectedEmissionsResponse is the response type for the
+// Query/ProjectedEmissions RPC method.
+type QueryProjectedEmissionsResponse struct {
+	// emissions are the projected emissions of the epochs.
+	Emissions []EpochEmission `protobuf:"bytes,1,rep,name=emissions,proto3" json:"emissions"`
+}
+
+func (m *QueryProjectedEmissionsResponse) Reset()         { *m = QueryProjectedEmissionsResponse{} }
+func (m *QueryProjectedEmissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedEmissionsResponse) ProtoMessage()    {}
+func (*QueryProjectedEmissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryProjectedEmissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedEmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedEmissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedEmissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedEmissionsResponse.Merge(m, src)
+}
+func (m *QueryProjectedEmissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedEmissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedEmissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedEmissionsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedEmissionsResponse) GetEmissions() []EpochEmission {
+	if m != nil {
+		return m.Emissions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -275,40 +378,50 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryProjectedEmissionsRequest)(nil), "cosmos.mint.v1beta1.QueryProjectedEmissionsRequest")
+	proto.RegisterType((*QueryProjectedEmissionsResponse)(nil), "cosmos.mint.v1beta1.QueryProjectedEmissionsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x33, 0xfe, 0x29, 0xdc, 0xd1, 0xc5, 0x75, 0x6e, 0xfd, 0x43, 0xda, 0x4e, 0x6b, 0x94,
-	0x5a, 0x15, 0x67, 0x68, 0x5d, 0xb9, 0x11, 0x2c, 0x82, 0xb8, 0xab, 0x5d, 0xb8, 0x70, 0x23, 0x93,
-	0x3a, 0xc6, 0x60, 0x32, 0x93, 0x66, 0x26, 0xd5, 0xee, 0xc4, 0xb5, 0x0b, 0xc1, 0xa7, 0xf0, 0x41,
-	0x84, 0x2e, 0x0b, 0x6e, 0xc4, 0x45, 0x91, 0xd6, 0x07, 0x91, 0x4c, 0xd2, 0x8a, 0x69, 0x82, 0xb7,
-	0xbb, 0x30, 0xdf, 0x39, 0xe7, 0xfb, 0x71, 0xbe, 0x13, 0xd8, 0x9e, 0x48, 0x15, 0x4a, 0x45, 0x43,
-	0x5f, 0x68, 0x3a, 0xeb, 0xbb, 0x5c, 0xb3, 0x3e, 0x9d, 0x26, 0x3c, 0x9e, 0x93, 0x28, 0x96, 0x5a,
-	0xa2, 0x93, 0xac, 0x80, 0xa4, 0x05, 0x24, 0x2f, 0xb0, 0xeb, 0x9e, 0xf4, 0xa4, 0xd1, 0x69, 0xfa,
-	0x95, 0x95, 0xda, 0x4d, 0x4f, 0x4a, 0x2f, 0xe0, 0x94, 0x45, 0x3e, 0x65, 0x42, 0x48, 0xcd, 0xb4,
-	0x2f, 0x85, 0xca, 0x55, 0x5c, 0xe6, 0x64, 0xa6, 0x1a, 0xdd, 0xa9, 0x43, 0xf4, 0x2c, 0xf5, 0x1d,
-	0xb1, 0x98, 0x85, 0x6a, 0xcc, 0xa7, 0x09, 0x57, 0xda, 0x19, 0xc1, 0x93, 0x7f, 0x5e, 0x55, 0x24,
-	0x85, 0xe2, 0xe8, 0x01, 0xac, 0x45, 0xe6, 0xe5, 0x1a, 0xe8, 0x80, 0xde, 0x85, 0x41, 0x83, 0x94,
-	0x60, 0x92, 0xac, 0x69, 0x78, 0x6e, 0xb1, 0x6a, 0x5b, 0xe3, 0xbc, 0xc1, 0xb9, 0x0a, 0x2f, 0x9b,
-	0x89, 0x4f, 0xc5, 0xeb, 0xc0, 0x00, 0x6e, 0xad, 0x18, 0xbc, 0x52, 0x14, 0x72, 0xb7, 0x27, 0xf0,
-	0xc8, 0xdf, 0x3e, 0x1a, 0xc3, 0x8b, 0xc3, 0xdb, 0xe9, 0xcc, 0x9f, 0xab, 0xf6, 0x75, 0xcf, 0xd7,
-	0x6f, 0x12, 0x97, 0x4c, 0x64, 0x48, 0x03, 0x5f, 0x70, 0x1a, 0xb8, 0xe1, 0x3d, 0xf5, 0xea, 0x2d,
-	0xd5, 0xf3, 0x88, 0x2b, 0xf2, 0x98, 0x4f, 0xc6, 0x7f, 0x7b, 0x1d, 0x0c, 0x9b, 0xc6, 0xe2, 0x91,
-	0x10, 0x09, 0x0b, 0x46, 0xb1, 0x9c, 0xf9, 0x2a, 0x5d, 0xd1, 0x16, 0xe1, 0x1d, 0x6c, 0x55, 0xe8,
-	0x39, 0xc9, 0x73, 0x78, 0x89, 0x19, 0xed, 0x65, 0xb4, 0x13, 0x0f, 0x27, 0x3a, 0x66, 0x85, 0xf9,
-	0x83, 0x6f, 0x67, 0xe1, 0x79, 0xe3, 0x8c, 0x3e, 0x00, 0x58, 0xcb, 0xf6, 0x86, 0x6e, 0x95, 0x2e,
-	0x75, 0x3f, 0x24, 0xbb, 0xf7, 0xff, 0xc2, 0x8c, 0xdf, 0xb9, 0xf1, 0xf1, 0xfb, 0xef, 0x2f, 0x67,
-	0x5a, 0xa8, 0x41, 0xcb, 0xae, 0x21, 0x4b, 0x08, 0x7d, 0x02, 0xf0, 0x68, 0x17, 0x02, 0xba, 0x53,
-	0x3d, 0xbc, 0x18, 0xa1, 0x7d, 0xf7, 0x54, 0xb5, 0x39, 0x4b, 0xd7, 0xb0, 0x74, 0x10, 0x2e, 0x65,
-	0xd9, 0x85, 0x86, 0xbe, 0x02, 0x78, 0x5c, 0x0c, 0x04, 0xf5, 0xab, 0x9d, 0x2a, 0xc2, 0xb5, 0x07,
-	0x87, 0xb4, 0xe4, 0x8c, 0xc4, 0x30, 0xf6, 0x50, 0xb7, 0x94, 0x71, 0xef, 0x14, 0x86, 0x0f, 0x17,
-	0x6b, 0x0c, 0x96, 0x6b, 0x0c, 0x7e, 0xad, 0x31, 0xf8, 0xbc, 0xc1, 0xd6, 0x72, 0x83, 0xad, 0x1f,
-	0x1b, 0x6c, 0xbd, 0xb8, 0x59, 0x75, 0x16, 0xef, 0xb3, 0x99, 0xe6, 0x3a, 0xdc, 0x9a, 0xf9, 0x17,
-	0xef, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x43, 0x44, 0x6b, 0x37, 0x17, 0x04, 0x00, 0x00,
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x95, 0x12, 0x29, 0x2f, 0x45, 0x2a, 0xd7, 0x52, 0x22, 0xb7, 0x75, 0x52, 0x83, 0x8a,
+	0x29, 0xc2, 0x26, 0x29, 0x0b, 0x0b, 0x12, 0x11, 0x05, 0x21, 0x31, 0x84, 0x0c, 0x0c, 0x30, 0x44,
+	0x97, 0xf4, 0x70, 0x0c, 0xf6, 0x9d, 0x9b, 0xbb, 0x14, 0xba, 0x21, 0x66, 0x06, 0x24, 0x3e, 0x05,
+	0x23, 0xdf, 0xa2, 0x63, 0x25, 0x16, 0xc4, 0x50, 0xa1, 0x84, 0x2f, 0xc1, 0x86, 0x7c, 0x3e, 0xa7,
+	0x90, 0xd8, 0x40, 0xb6, 0xdc, 0x7b, 0xef, 0xf7, 0x27, 0xf7, 0x7e, 0x67, 0xa8, 0xf6, 0xb8, 0x08,
+	0xb9, 0x70, 0x43, 0x9f, 0x49, 0xf7, 0xb0, 0xde, 0xa5, 0x92, 0xd4, 0xdd, 0x83, 0x21, 0x1d, 0x1c,
+	0x39, 0xd1, 0x80, 0x4b, 0x8e, 0x57, 0x92, 0x01, 0x27, 0x1e, 0x70, 0xf4, 0x80, 0xb1, 0xea, 0x71,
+	0x8f, 0xab, 0xbe, 0x1b, 0xff, 0x4a, 0x46, 0x8d, 0x0d, 0x8f, 0x73, 0x2f, 0xa0, 0x2e, 0x89, 0x7c,
+	0x97, 0x30, 0xc6, 0x25, 0x91, 0x3e, 0x67, 0x42, 0x77, 0xcd, 0x2c, 0x25, 0xc5, 0xaa, 0xfa, 0xd6,
+	0x2a, 0xe0, 0x27, 0xb1, 0x6e, 0x8b, 0x0c, 0x48, 0x28, 0xda, 0xf4, 0x60, 0x48, 0x85, 0xb4, 0x5a,
+	0xb0, 0xf2, 0x47, 0x55, 0x44, 0x9c, 0x09, 0x8a, 0xef, 0x40, 0x31, 0x52, 0x95, 0x0a, 0xaa, 0x21,
+	0xbb, 0xdc, 0x58, 0x77, 0x32, 0x6c, 0x3a, 0x09, 0xa8, 0xb9, 0x78, 0x7c, 0x5a, 0x2d, 0xb4, 0x35,
+	0xc0, 0xba, 0x0c, 0x97, 0x14, 0xe3, 0x23, 0xf6, 0x22, 0x50, 0x06, 0x53, 0x29, 0x02, 0x6b, 0xd3,
+	0x0d, 0xad, 0xf6, 0x10, 0x4a, 0x7e, 0x5a, 0x54, 0x82, 0x4b, 0xcd, 0xeb, 0x31, 0xe7, 0xb7, 0xd3,
+	0xea, 0x96, 0xe7, 0xcb, 0xfe, 0xb0, 0xeb, 0xf4, 0x78, 0xe8, 0x06, 0x3e, 0xa3, 0x6e, 0xd0, 0x0d,
+	0x6f, 0x8a, 0xfd, 0x57, 0xae, 0x3c, 0x8a, 0xa8, 0x70, 0xee, 0xd3, 0x5e, 0xfb, 0x0c, 0x6b, 0x99,
+	0xb0, 0xa1, 0x24, 0xee, 0x31, 0x36, 0x24, 0x41, 0x6b, 0xc0, 0x0f, 0x7d, 0x11, 0x5f, 0x51, 0x6a,
+	0xe1, 0x35, 0x6c, 0xe6, 0xf4, 0xb5, 0x93, 0xa7, 0x70, 0x91, 0xa8, 0x5e, 0x27, 0x9a, 0x34, 0xe7,
+	0x77, 0xb4, 0x4c, 0xa6, 0xf8, 0xad, 0xe7, 0x60, 0x26, 0xd7, 0x3c, 0xe0, 0x2f, 0x69, 0x4f, 0xd2,
+	0xfd, 0xbd, 0xd0, 0x17, 0xbf, 0x5b, 0xc3, 0x5b, 0xb0, 0x44, 0x23, 0xde, 0xeb, 0x77, 0x02, 0xca,
+	0x3c, 0xd9, 0x57, 0xa2, 0x8b, 0xed, 0xb2, 0xaa, 0x3d, 0x56, 0x25, 0xbc, 0x06, 0x45, 0x75, 0x14,
+	0x95, 0x85, 0x1a, 0xb2, 0x2f, 0xb4, 0xf5, 0xc9, 0xf2, 0xa1, 0x9a, 0x4b, 0xae, 0xff, 0xd7, 0x03,
+	0x28, 0xd1, 0xb4, 0x58, 0x41, 0xb5, 0x73, 0x76, 0xb9, 0x61, 0x65, 0xae, 0x74, 0x2f, 0xa6, 0x4c,
+	0xf1, 0x7a, 0xb3, 0x67, 0xd0, 0xc6, 0xcf, 0x45, 0x38, 0xaf, 0xb4, 0xf0, 0x5b, 0x04, 0xc5, 0x64,
+	0xff, 0xf8, 0x5a, 0x26, 0xd3, 0x6c, 0xd8, 0x0c, 0xfb, 0xdf, 0x83, 0x89, 0x5f, 0xeb, 0xca, 0xbb,
+	0x2f, 0x3f, 0x3e, 0x2e, 0x6c, 0xe2, 0x75, 0x37, 0x2b, 0xd5, 0x49, 0xd2, 0xf0, 0x7b, 0x04, 0xa5,
+	0x49, 0x98, 0xf0, 0x4e, 0x3e, 0xf9, 0x74, 0x14, 0x8d, 0x1b, 0xff, 0x35, 0xab, 0xbd, 0x6c, 0x2b,
+	0x2f, 0x35, 0x6c, 0x66, 0x7a, 0x99, 0x84, 0x0f, 0x7f, 0x42, 0xb0, 0x3c, 0x1d, 0x2c, 0x5c, 0xcf,
+	0x57, 0xca, 0x09, 0xa9, 0xd1, 0x98, 0x07, 0xa2, 0x3d, 0x3a, 0xca, 0xa3, 0x8d, 0xb7, 0x33, 0x3d,
+	0xce, 0x44, 0x1a, 0x7f, 0x46, 0x80, 0x67, 0xe3, 0x82, 0x77, 0xff, 0xb2, 0xa0, 0xbc, 0xe4, 0x1a,
+	0xb7, 0xe7, 0x03, 0x69, 0xc7, 0xb7, 0x94, 0xe3, 0x1d, 0x6c, 0x67, 0x6f, 0x38, 0x05, 0x76, 0x26,
+	0xd9, 0x6b, 0xde, 0x3d, 0x1e, 0x99, 0xe8, 0x64, 0x64, 0xa2, 0xef, 0x23, 0x13, 0x7d, 0x18, 0x9b,
+	0x85, 0x93, 0xb1, 0x59, 0xf8, 0x3a, 0x36, 0x0b, 0xcf, 0xae, 0xe6, 0x3d, 0xc9, 0x37, 0x09, 0xab,
+	0x7a, 0x99, 0xdd, 0xa2, 0xfa, 0x0e, 0xee, 0xfe, 0x1a, 0x00, 0x86, 0x86, 0x1d, 0xac, 0x93, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +442,8 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedEmissions returns the emissions projected by the inflation schedule.
+	ProjectedEmissions(ctx context.Context, in *QueryProjectedEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectedEmissionsResponse, error)
 }
 
 type queryClient struct {
@@ -366,6 +481,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) ProjectedEmissions(ctx context.Context, in *QueryProjectedEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectedEmissionsResponse, error) {
+	out := new(QueryProjectedEmissionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/ProjectedEmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -374,6 +498,8 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedEmissions returns the emissions projected by the inflation schedule.
+	ProjectedEmissions(context.Context, *QueryProjectedEmissionsRequest) (*QueryProjectedEmissionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -389,6 +515,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedEmissions(ctx context.Context, req *QueryProjectedEmissionsRequest) (*QueryProjectedEmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedEmissions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -448,6 +577,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedEmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedEmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedEmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/ProjectedEmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedEmissions(ctx, req.(*QueryProjectedEmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -464,6 +611,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "ProjectedEmissions",
+			Handler:    _Query_ProjectedEmissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -637,6 +788,76 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectedEmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedEmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedEmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedEmissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedEmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedEmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Emissions) > 0 {
+		for iNdEx := len(m.Emissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Emissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -708,6 +929,36 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectedEmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovQuery(uint64(m.EpochLength))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *QueryProjectedEmissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Emissions) > 0 {
+		for _, e := range m.Emissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1364,178 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectedEmissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedEmissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedEmissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedEmissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emissions = append(m.Emissions, EpochEmission{})
+			if err := m.Emissions[len(m.Emissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,194 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// Names of the built-in inflation schedules.
+const (
+	InflationScheduleBondedRatio = "bonded_ratio"
+	InflationScheduleHalving     = "halving"
+)
+
+// maxHalvings is the number of halvings after which no more tokens are minted.
+const maxHalvings = 128
+
+// InflationSchedule defines the emission curve of the mint module. Params.InflationSchedule
+// selects the schedule in use by its name.
+type InflationSchedule interface {
+	// Name returns the name of the schedule.
+	Name() string
+
+	// NextMinter returns the minter for the block at the given height.
+	NextMinter(minter Minter, params Params, height int64, bondedRatio sdk.Dec, totalSupply sdk.Int) Minter
+
+	// ProjectEmissions returns the amount of tokens expected to be minted during each of
+	// the given number of epochs, starting from the block at the given height. The
+	// bonded ratio is assumed to remain the same.
+	ProjectEmissions(minter Minter, params Params, height int64, bondedRatio sdk.Dec, totalSupply sdk.Int, epochLength uint64, epochs uint32) []sdk.Int
+}
+
+var (
+	_ InflationSchedule = BondedRatioSchedule{}
+	_ InflationSchedule = HalvingSchedule{}
+)
+
+var (
+	schedulesMtx sync.RWMutex
+
+	// schedules holds the inflation schedules selectable by Params.InflationSchedule.
+	schedules = map[string]InflationSchedule{
+		InflationScheduleBondedRatio: BondedRatioSchedule{},
+		InflationScheduleHalving:     HalvingSchedule{},
+	}
+)
+
+// RegisterInflationSchedule makes the schedule selectable by Params.InflationSchedule.
+// Applications supplying their own schedules should register them on initialization,
+// so that the params are validated against them, e.g. on genesis validation and param
+// change proposals. Registering a schedule twice is a no-op, but it panics if another
+// schedule has been registered with the same name.
+func RegisterInflationSchedule(schedule InflationSchedule) {
+	schedulesMtx.Lock()
+	defer schedulesMtx.Unlock()
+
+	name := schedule.Name()
+	if registered, ok := schedules[name]; ok {
+		if reflect.TypeOf(registered) != reflect.TypeOf(schedule) {
+			panic(fmt.Sprintf("duplicate inflation schedule: %s", name))
+		}
+		return
+	}
+
+	schedules[name] = schedule
+}
+
+// GetInflationSchedule returns the registered inflation schedule of the given name.
+func GetInflationSchedule(name string) (InflationSchedule, bool) {
+	schedulesMtx.RLock()
+	defer schedulesMtx.RUnlock()
+
+	schedule, ok := schedules[name]
+	return schedule, ok
+}
+
+// BondedRatioSchedule adjusts the inflation rate according to the bonded ratio, towards
+// Params.GoalBonded.
+type BondedRatioSchedule struct{}
+
+// Name implements InflationSchedule.
+func (BondedRatioSchedule) Name() string {
+	return InflationScheduleBondedRatio
+}
+
+// NextMinter implements InflationSchedule.
+func (BondedRatioSchedule) NextMinter(minter Minter, params Params, _ int64, bondedRatio sdk.Dec, totalSupply sdk.Int) Minter {
+	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalSupply)
+	return minter
+}
+
+// ProjectEmissions implements InflationSchedule. The inflation rate is adjusted once per
+// epoch and the provisions of an epoch are based on the mean of the rates at its
+// boundaries, hence the result is an approximation.
+func (BondedRatioSchedule) ProjectEmissions(minter Minter, params Params, _ int64, bondedRatio sdk.Dec, totalSupply sdk.Int, epochLength uint64, epochs uint32) []sdk.Int {
+	// the ratio of an epoch to a year
+	epochRatio := sdk.NewDec(int64(epochLength)).QuoInt64(int64(params.BlocksPerYear))
+
+	yearly := params
+	yearly.BlocksPerYear = 1
+
+	emissions := make([]sdk.Int, epochs)
+	for i := range emissions {
+		// the rate change over an epoch is the per block change times the epoch length
+		next := minter
+		next.Inflation = minter.Inflation.Add(minter.NextInflationRate(yearly, bondedRatio).Sub(minter.Inflation).Mul(epochRatio))
+		next.Inflation = sdk.MinDec(sdk.MaxDec(next.Inflation, params.InflationMin), params.InflationMax)
+
+		meanInflation := minter.Inflation.Add(next.Inflation).QuoInt64(2)
+		emissions[i] = meanInflation.MulInt(totalSupply).Mul(epochRatio).TruncateInt()
+
+		totalSupply = totalSupply.Add(emissions[i])
+		minter = next
+	}
+
+	return emissions
+}
+
+// HalvingSchedule mints Params.InitialAnnualProvisions per year, halving the provisions
+// every Params.HalvingInterval blocks.
+type HalvingSchedule struct{}
+
+// Name implements InflationSchedule.
+func (HalvingSchedule) Name() string {
+	return InflationScheduleHalving
+}
+
+// NextMinter implements InflationSchedule.
+func (s HalvingSchedule) NextMinter(minter Minter, params Params, height int64, _ sdk.Dec, totalSupply sdk.Int) Minter {
+	minter.AnnualProvisions = s.annualProvisions(params, height)
+	minter.Inflation = sdk.ZeroDec()
+	if totalSupply.IsPositive() {
+		minter.Inflation = minter.AnnualProvisions.QuoInt(totalSupply)
+	}
+	return minter
+}
+
+// ProjectEmissions implements InflationSchedule.
+func (s HalvingSchedule) ProjectEmissions(_ Minter, params Params, height int64, _ sdk.Dec, _ sdk.Int, epochLength uint64, epochs uint32) []sdk.Int {
+	emissions := make([]sdk.Int, epochs)
+	for i := range emissions {
+		end := height + int64(epochLength)
+
+		// sum up the provisions of the halving periods overlapping the epoch, until
+		// no more tokens are minted
+		provisions := sdk.ZeroDec()
+		for start := height; start < end && uint64(start)/params.HalvingInterval < maxHalvings; {
+			next := (start/int64(params.HalvingInterval) + 1) * int64(params.HalvingInterval)
+			if next > end {
+				next = end
+			}
+
+			// truncated like Minter.BlockProvision
+			blockProvision := s.annualProvisions(params, start).QuoInt64(int64(params.BlocksPerYear)).TruncateDec()
+			provisions = provisions.Add(blockProvision.MulInt64(next - start))
+			start = next
+		}
+
+		emissions[i] = provisions.TruncateInt()
+		height = end
+	}
+
+	return emissions
+}
+
+func (HalvingSchedule) annualProvisions(params Params, height int64) sdk.Dec {
+	halvings := uint64(height) / params.HalvingInterval
+	if halvings >= maxHalvings {
+		return sdk.ZeroDec()
+	}
+
+	return params.InitialAnnualProvisions.Quo(sdk.NewDec(2).Power(halvings))
+}
+
+// CapProvision limits the provision so that the total supply does not exceed the max
+// supply. A zero max supply means no cap.
+func CapProvision(provision sdk.Coin, totalSupply, maxSupply sdk.Int) sdk.Coin {
+	if maxSupply.IsZero() {
+		return provision
+	}
+
+	remaining := maxSupply.Sub(totalSupply)
+	if !remaining.IsPositive() {
+		return sdk.NewCoin(provision.Denom, sdk.ZeroInt())
+	}
+	if provision.Amount.GT(remaining) {
+		return sdk.NewCoin(provision.Denom, remaining)
+	}
+
+	return provision
+}
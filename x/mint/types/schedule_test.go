@@ -0,0 +1,158 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+func TestBondedRatioScheduleNextMinter(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+	totalSupply := sdk.NewInt(1_000_000_000)
+
+	next := BondedRatioSchedule{}.NextMinter(minter, params, 1, bondedRatio, totalSupply)
+
+	inflation := minter.NextInflationRate(params, bondedRatio)
+	require.Equal(t, inflation, next.Inflation)
+	require.Equal(t, inflation.MulInt(totalSupply), next.AnnualProvisions)
+}
+
+func TestHalvingScheduleNextMinter(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = InflationScheduleHalving
+	params.InitialAnnualProvisions = sdk.NewDec(1_000_000)
+	params.HalvingInterval = 100
+	totalSupply := sdk.NewInt(10_000_000)
+
+	tests := []struct {
+		height     int64
+		provisions sdk.Dec
+	}{
+		{1, sdk.NewDec(1_000_000)},
+		{99, sdk.NewDec(1_000_000)},
+		{100, sdk.NewDec(500_000)},
+		{250, sdk.NewDec(250_000)},
+		{100 * maxHalvings, sdk.ZeroDec()},
+	}
+	for _, tc := range tests {
+		minter := HalvingSchedule{}.NextMinter(DefaultInitialMinter(), params, tc.height, sdk.ZeroDec(), totalSupply)
+		require.Equal(t, tc.provisions, minter.AnnualProvisions, "height: %d", tc.height)
+		require.Equal(t, tc.provisions.QuoInt(totalSupply), minter.Inflation, "height: %d", tc.height)
+	}
+}
+
+func TestHalvingScheduleProjectEmissions(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = InflationScheduleHalving
+	params.InitialAnnualProvisions = sdk.NewDec(1_000_000)
+	params.BlocksPerYear = 100
+	params.HalvingInterval = 100
+
+	// the block provision is 10_000 before the first halving
+	emissions := HalvingSchedule{}.ProjectEmissions(DefaultInitialMinter(), params, 50, sdk.ZeroDec(), sdk.ZeroInt(), 100, 3)
+	require.Equal(t, []sdk.Int{
+		sdk.NewInt(50*10_000 + 50*5_000),
+		sdk.NewInt(50*5_000 + 50*2_500),
+		sdk.NewInt(50*2_500 + 50*1_250),
+	}, emissions)
+
+	// the projection matches the minted amount block by block
+	minted := sdk.ZeroInt()
+	for height := int64(50); height < 150; height++ {
+		minter := HalvingSchedule{}.NextMinter(DefaultInitialMinter(), params, height, sdk.ZeroDec(), sdk.OneInt())
+		minted = minted.Add(minter.BlockProvision(params).Amount)
+	}
+	require.Equal(t, emissions[0], minted)
+
+	// the halving periods after the last halving are not iterated
+	params.HalvingInterval = 1
+	emissions = HalvingSchedule{}.ProjectEmissions(DefaultInitialMinter(), params, 1, sdk.ZeroDec(), sdk.ZeroInt(), math.MaxUint32, 2)
+	require.True(t, emissions[1].IsZero())
+}
+
+type testSchedule struct {
+	BondedRatioSchedule
+}
+
+func (testSchedule) Name() string {
+	return "test"
+}
+
+type anotherTestSchedule struct {
+	testSchedule
+}
+
+func TestRegisterInflationSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = testSchedule{}.Name()
+	require.Error(t, params.Validate())
+
+	RegisterInflationSchedule(testSchedule{})
+	require.NoError(t, params.Validate())
+
+	// registering the schedule again is a no-op
+	require.NotPanics(t, func() { RegisterInflationSchedule(testSchedule{}) })
+	require.Panics(t, func() { RegisterInflationSchedule(anotherTestSchedule{}) })
+}
+
+func TestBondedRatioScheduleProjectEmissions(t *testing.T) {
+	params := DefaultParams()
+	totalSupply := sdk.NewInt(1_000_000_000)
+
+	// the inflation stays at the min inflation with all the tokens bonded
+	minter := InitialMinter(params.InflationMin)
+	emissions := BondedRatioSchedule{}.ProjectEmissions(minter, params, 1, sdk.OneDec(), totalSupply, params.BlocksPerYear, 2)
+	first := params.InflationMin.MulInt(totalSupply).TruncateInt()
+	second := params.InflationMin.MulInt(totalSupply.Add(first)).TruncateInt()
+	require.Equal(t, []sdk.Int{first, second}, emissions)
+
+	// the inflation increases towards the max inflation with nothing bonded
+	minter = InitialMinter(params.InflationMax.Sub(params.InflationRateChange))
+	emissions = BondedRatioSchedule{}.ProjectEmissions(minter, params, 1, sdk.ZeroDec(), totalSupply, params.BlocksPerYear, 1)
+	mean := minter.Inflation.Add(params.InflationMax).QuoInt64(2)
+	require.Equal(t, []sdk.Int{mean.MulInt(totalSupply).TruncateInt()}, emissions)
+}
+
+func TestCapProvision(t *testing.T) {
+	provision := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	tests := map[string]struct {
+		totalSupply sdk.Int
+		maxSupply   sdk.Int
+		expected    sdk.Int
+	}{
+		"no cap": {
+			totalSupply: sdk.NewInt(1_000),
+			maxSupply:   sdk.ZeroInt(),
+			expected:    sdk.NewInt(100),
+		},
+		"below cap": {
+			totalSupply: sdk.NewInt(900),
+			maxSupply:   sdk.NewInt(1_000),
+			expected:    sdk.NewInt(100),
+		},
+		"reaching cap": {
+			totalSupply: sdk.NewInt(950),
+			maxSupply:   sdk.NewInt(1_000),
+			expected:    sdk.NewInt(50),
+		},
+		"exceeded cap": {
+			totalSupply: sdk.NewInt(1_100),
+			maxSupply:   sdk.NewInt(1_000),
+			expected:    sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			capped := CapProvision(provision, tc.totalSupply, tc.maxSupply)
+			require.Equal(t, provision.Denom, capped.Denom)
+			require.Equal(t, tc.expected, capped.Amount)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
+	paramscutils "github.com/line/lbm-sdk/x/params/client/utils"
 	"github.com/line/lbm-sdk/x/params/types"
 	"github.com/line/lbm-sdk/x/params/types/proposal"
 )
@@ -19,7 +20,11 @@ func NewQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(NewQuerySubspaceParamsCmd())
+	cmd.AddCommand(
+		NewQuerySubspaceParamsCmd(),
+		NewQueryValidateParamChangesCmd(),
+		NewQueryParamChangeHistoryCmd(),
+	)
 
 	return cmd
 }
@@ -52,3 +57,78 @@ func NewQuerySubspaceParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewQueryValidateParamChangesCmd returns a CLI command handler for validating
+// the parameter changes of a proposal before its submission.
+func NewQueryValidateParamChangesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-changes [proposal-file]",
+		Short: "Validate the parameter changes of a proposal without applying them",
+		Long: `Validate the parameter changes of a proposal against the validators of the
+subspaces, without applying them. The proposal file is the same as the one of
+'tx gov submit-proposal param-change'.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := proposal.NewQueryClient(clientCtx)
+
+			content, err := paramscutils.ParseParamChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			req := proposal.QueryValidateParamChangesRequest{Changes: content.Changes.ToParamChanges()}
+			res, err := queryClient.ValidateParamChanges(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryParamChangeHistoryCmd returns a CLI command handler for querying
+// the history of the parameter changes by subspace and optionally key.
+func NewQueryParamChangeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [subspace] [key]",
+		Short: "Query the history of the parameter changes by subspace and optionally key",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := proposal.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := proposal.QueryParamChangeHistoryRequest{Subspace: args[0], Pagination: pageReq}
+			if len(args) > 1 {
+				req.Key = args[1]
+			}
+			res, err := queryClient.ParamChangeHistory(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+
+	return cmd
+}
@@ -7,9 +7,11 @@ import (
 	ostcli "github.com/line/ostracon/libs/cli"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/line/lbm-sdk/testutil"
 	clitestutil "github.com/line/lbm-sdk/testutil/cli"
 	"github.com/line/lbm-sdk/testutil/network"
 	"github.com/line/lbm-sdk/x/params/client/cli"
+	"github.com/line/lbm-sdk/x/params/types/proposal"
 )
 
 type IntegrationTestSuite struct {
@@ -78,3 +80,85 @@ value: "100"`,
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewQueryValidateParamChangesCmd() {
+	val := s.network.Validators[0]
+
+	proposalFile := testutil.WriteToNewTempFile(s.T(), `{
+  "title": "Param Change",
+  "description": "Update max validators",
+  "changes": [
+    {"subspace": "staking", "key": "MaxValidators", "value": 1},
+    {"subspace": "staking", "key": "MaxValidators", "value": "fox"}
+  ],
+  "deposit": "1000stake"
+}`)
+
+	cmd := cli.NewQueryValidateParamChangesCmd()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{
+		proposalFile.Name(),
+		fmt.Sprintf("--%s=json", ostcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var res proposal.QueryValidateParamChangesResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Len(res.Results, 2)
+	s.Require().True(res.Results[0].Valid)
+	s.Require().False(res.Results[1].Valid)
+	s.Require().NotEmpty(res.Results[1].Error)
+
+	// nothing applied
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewQuerySubspaceParamsCmd(), []string{
+		"staking", "MaxValidators",
+		fmt.Sprintf("--%s=json", ostcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(`{"subspace":"staking","key":"MaxValidators","value":"100"}`, strings.TrimSpace(out.String()))
+}
+
+func (s *IntegrationTestSuite) TestNewQueryParamChangeHistoryCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{
+			"by subspace",
+			[]string{"staking"},
+			false,
+		},
+		{
+			"by key",
+			[]string{"staking", "MaxValidators"},
+			false,
+		},
+		{
+			"too many args",
+			[]string{"staking", "MaxValidators", "extra"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewQueryParamChangeHistoryCmd()
+			args := append(tc.args, fmt.Sprintf("--%s=json", ostcli.OutputFlag))
+
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res proposal.QueryParamChangeHistoryResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Empty(res.Records)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/params/types/proposal"
+)
+
+// InitGenesis sets the history of the parameter changes from the genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *proposal.GenesisState) {
+	for _, record := range data.ParamChangeHistory {
+		k.AddParamChangeRecord(ctx, record)
+	}
+}
+
+// ExportGenesis returns the params module's genesis state, which is the
+// history of the parameter changes.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *proposal.GenesisState {
+	history := []proposal.ParamChangeRecord{}
+	k.iterateAllParamChangeHistory(ctx, func(record proposal.ParamChangeRecord) (stop bool) {
+		history = append(history, record)
+		return false
+	})
+
+	return proposal.NewGenesisState(history)
+}
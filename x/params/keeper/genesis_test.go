@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"github.com/line/lbm-sdk/x/params/types/proposal"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	suite.Require().Equal(proposal.DefaultGenesisState(), app.ParamsKeeper.ExportGenesis(ctx))
+
+	records := []proposal.ParamChangeRecord{
+		{Subspace: "a", Key: "key2", Height: 3, ProposalId: 1, OldValue: "", NewValue: "1"},
+		{Subspace: "a", Key: "key2", Height: 5, ProposalId: 2, OldValue: "1", NewValue: "2"},
+		{Subspace: "b", Key: "key1", Height: 5, ProposalId: 2, OldValue: "x", NewValue: "y"},
+	}
+	genState := proposal.NewGenesisState(records)
+	suite.Require().NoError(proposal.ValidateGenesis(genState))
+
+	newApp, newCtx := createTestApp(false)
+	newApp.ParamsKeeper.InitGenesis(newCtx, genState)
+	suite.Require().Equal(genState, newApp.ParamsKeeper.ExportGenesis(newCtx))
+}
+
+func (suite *KeeperTestSuite) TestValidateGenesis() {
+	testCases := map[string]struct {
+		record proposal.ParamChangeRecord
+		valid  bool
+	}{
+		"valid": {
+			record: proposal.ParamChangeRecord{Subspace: "a", Key: "key", Height: 1},
+			valid:  true,
+		},
+		"empty subspace": {
+			record: proposal.ParamChangeRecord{Key: "key", Height: 1},
+		},
+		"empty key": {
+			record: proposal.ParamChangeRecord{Subspace: "a", Height: 1},
+		},
+		"negative height": {
+			record: proposal.ParamChangeRecord{Subspace: "a", Key: "key", Height: -1},
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			err := proposal.ValidateGenesis(proposal.NewGenesisState([]proposal.ParamChangeRecord{tc.record}))
+			if tc.valid {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/line/lbm-sdk/store/prefix"
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/address"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/types/query"
 	"github.com/line/lbm-sdk/x/params/types/proposal"
 )
 
@@ -34,3 +37,79 @@ func (k Keeper) Params(c context.Context, req *proposal.QueryParamsRequest) (*pr
 
 	return &proposal.QueryParamsResponse{Param: param}, nil
 }
+
+// ValidateParamChanges validates the parameter changes without applying them
+func (k Keeper) ValidateParamChanges(c context.Context, req *proposal.QueryValidateParamChangesRequest) (*proposal.QueryValidateParamChangesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Changes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	// cache, we don't want to write changes
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+
+	results := make([]proposal.ParamChangeValidation, len(req.Changes))
+	for i, change := range req.Changes {
+		results[i] = proposal.ParamChangeValidation{Change: change, Valid: true}
+		if err := k.validateParamChange(ctx, change); err != nil {
+			results[i].Valid = false
+			results[i].Error = err.Error()
+		}
+	}
+
+	return &proposal.QueryValidateParamChangesResponse{Results: results}, nil
+}
+
+// validateParamChange applies the change on ctx, as the proposal handler does.
+func (k Keeper) validateParamChange(ctx sdk.Context, change proposal.ParamChange) error {
+	if err := proposal.ValidateChanges([]proposal.ParamChange{change}); err != nil {
+		return err
+	}
+
+	ss, ok := k.GetSubspace(change.Subspace)
+	if !ok {
+		return sdkerrors.Wrap(proposal.ErrUnknownSubspace, change.Subspace)
+	}
+
+	if !ss.IsRegistered([]byte(change.Key)) {
+		return sdkerrors.Wrapf(proposal.ErrSettingParameter, "parameter %s not registered in %s", change.Key, change.Subspace)
+	}
+
+	if err := ss.Update(ctx, []byte(change.Key), []byte(change.Value)); err != nil {
+		return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", change.Key, change.Value, err.Error())
+	}
+
+	return nil
+}
+
+// ParamChangeHistory returns the history of the parameter changes
+func (k Keeper) ParamChangeHistory(c context.Context, req *proposal.QueryParamChangeHistoryRequest) (*proposal.QueryParamChangeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Subspace == "" || len(req.Subspace) > address.MaxAddrLen || len(req.Key) > address.MaxAddrLen {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.key), paramChangeHistoryPrefix(req.Subspace, req.Key))
+
+	var records []proposal.ParamChangeRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var record proposal.ParamChangeRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proposal.QueryParamChangeHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -84,3 +84,94 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidateParamChanges() {
+	suite.SetupTest()
+	key := []byte("key")
+	validatePositive := func(i interface{}) error {
+		if i.(int64) <= 0 {
+			return fmt.Errorf("not positive: %d", i)
+		}
+		return nil
+	}
+	space := suite.app.ParamsKeeper.Subspace("test").
+		WithKeyTable(types.NewKeyTable(types.NewParamSetPair(key, int64(0), validatePositive)))
+	space.Set(suite.ctx, key, int64(1))
+
+	_, err := suite.queryClient.ValidateParamChanges(sdk.WrapSDKContext(suite.ctx), &proposal.QueryValidateParamChangesRequest{})
+	suite.Require().Error(err)
+
+	changes := []proposal.ParamChange{
+		proposal.NewParamChange("test", "key", `"10"`),
+		proposal.NewParamChange("test", "key", `"-1"`),
+		proposal.NewParamChange("test", "key", `"fox"`),
+		proposal.NewParamChange("test", "nonexistent", `"10"`),
+		proposal.NewParamChange("nonexistent", "key", `"10"`),
+		proposal.NewParamChange("test", "key", ""),
+	}
+	res, err := suite.queryClient.ValidateParamChanges(sdk.WrapSDKContext(suite.ctx), &proposal.QueryValidateParamChangesRequest{Changes: changes})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, len(changes))
+
+	for i, expected := range []bool{true, false, false, false, false, false} {
+		result := res.Results[i]
+		suite.Require().Equal(changes[i], result.Change)
+		suite.Require().Equal(expected, result.Valid, result.Error)
+		suite.Require().Equal(expected, result.Error == "")
+	}
+
+	// the changes are never applied
+	var value int64
+	space.Get(suite.ctx, key, &value)
+	suite.Require().Equal(int64(1), value)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryParamChangeHistory() {
+	suite.SetupTest()
+	records := []proposal.ParamChangeRecord{
+		{Subspace: "test", Key: "key1", Height: 2, ProposalId: 2, OldValue: `"1"`, NewValue: `"2"`},
+		{Subspace: "test", Key: "key1", Height: 1, ProposalId: 1, NewValue: `"1"`},
+		{Subspace: "test", Key: "key2", Height: 1, ProposalId: 1, NewValue: `"3"`},
+		{Subspace: "other", Key: "key1", Height: 1, ProposalId: 1, NewValue: `"4"`},
+	}
+	for _, record := range records {
+		suite.app.ParamsKeeper.AddParamChangeRecord(suite.ctx, record)
+	}
+
+	testCases := map[string]struct {
+		req      *proposal.QueryParamChangeHistoryRequest
+		expected []proposal.ParamChangeRecord
+		expPass  bool
+	}{
+		"empty request": {
+			req: &proposal.QueryParamChangeHistoryRequest{},
+		},
+		"by subspace": {
+			req:      &proposal.QueryParamChangeHistoryRequest{Subspace: "test"},
+			expected: []proposal.ParamChangeRecord{records[1], records[0], records[2]},
+			expPass:  true,
+		},
+		"by key": {
+			req:      &proposal.QueryParamChangeHistoryRequest{Subspace: "test", Key: "key1"},
+			expected: []proposal.ParamChangeRecord{records[1], records[0]},
+			expPass:  true,
+		},
+		"no records": {
+			req:     &proposal.QueryParamChangeHistoryRequest{Subspace: "test", Key: "key3"},
+			expPass: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			res, err := suite.queryClient.ParamChangeHistory(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, res.Records)
+		})
+	}
+}
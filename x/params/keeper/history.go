@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/params/types"
+	"github.com/line/lbm-sdk/x/params/types/proposal"
+)
+
+// AddParamChangeRecord records a parameter change applied by a proposal.
+func (k Keeper) AddParamChangeRecord(ctx sdk.Context, record proposal.ParamChangeRecord) {
+	store := ctx.KVStore(k.key)
+	key := paramChangeHistoryKey(record.Subspace, record.Key, record.Height, record.ProposalId)
+	store.Set(key, k.cdc.MustMarshal(&record))
+}
+
+// IterateParamChangeHistory iterates over the parameter changes of the
+// subspace in the order of application per key. All the keys are iterated if
+// the key is empty.
+func (k Keeper) IterateParamChangeHistory(ctx sdk.Context, subspace, key string, cb func(record proposal.ParamChangeRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), paramChangeHistoryPrefix(subspace, key))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record proposal.ParamChangeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// iterateAllParamChangeHistory iterates over the parameter changes of all the
+// subspaces.
+func (k Keeper) iterateAllParamChangeHistory(ctx sdk.Context, cb func(record proposal.ParamChangeRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.ParamChangeHistoryKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record proposal.ParamChangeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+func paramChangeHistoryPrefix(subspace, key string) []byte {
+	if key == "" {
+		return paramChangeHistoryKeyPrefixBySubspace(subspace)
+	}
+	return paramChangeHistoryKeyPrefixByKey(subspace, key)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/line/lbm-sdk/types/address"
+	"github.com/line/lbm-sdk/x/params/types"
+)
+
+// paramChangeHistoryKey returns the key of a param change record:
+// 0x00<subspace_len (1 byte)><subspace><key_len (1 byte)><key><height (8 bytes)><proposal_id (8 bytes)>
+func paramChangeHistoryKey(subspace, key string, height int64, proposalID uint64) []byte {
+	prefix := paramChangeHistoryKeyPrefixByKey(subspace, key)
+	res := make([]byte, len(prefix)+16)
+	copy(res, prefix)
+	binary.BigEndian.PutUint64(res[len(prefix):], uint64(height))
+	binary.BigEndian.PutUint64(res[len(prefix)+8:], proposalID)
+
+	return res
+}
+
+func paramChangeHistoryKeyPrefixByKey(subspace, key string) []byte {
+	prefix := paramChangeHistoryKeyPrefixBySubspace(subspace)
+	return append(prefix, address.MustLengthPrefix([]byte(key))...)
+}
+
+func paramChangeHistoryKeyPrefixBySubspace(subspace string) []byte {
+	prefix := make([]byte, len(types.ParamChangeHistoryKeyPrefix))
+	copy(prefix, types.ParamChangeHistoryKeyPrefix)
+	return append(prefix, address.MustLengthPrefix([]byte(subspace))...)
+}
@@ -3,6 +3,7 @@ package params
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/rand"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -44,11 +45,32 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 // DefaultGenesis returns default genesis state as raw bytes for the params
 // module.
-func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage { return nil }
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(proposal.DefaultGenesisState())
+}
 
 // ValidateGenesis performs genesis state validation for the params module.
-func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, config client.TxEncodingConfig, _ json.RawMessage) error {
-	return nil
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	data, err := unmarshalGenesis(cdc, bz)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", proposal.ModuleName, err)
+	}
+
+	return proposal.ValidateGenesis(data)
+}
+
+// unmarshalGenesis unmarshals the genesis state, which is empty in the genesis
+// files made before the params module had one.
+func unmarshalGenesis(cdc codec.JSONCodec, bz json.RawMessage) (*proposal.GenesisState, error) {
+	var data proposal.GenesisState
+	if len(bz) == 0 || string(bz) == "null" {
+		return proposal.DefaultGenesisState(), nil
+	}
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the params module.
@@ -87,8 +109,15 @@ func NewAppModule(k keeper.Keeper) AppModule {
 
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
-// InitGenesis performs a no-op.
-func (am AppModule) InitGenesis(_ sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+// InitGenesis performs genesis initialization for the params module. It
+// returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) []abci.ValidatorUpdate {
+	data, err := unmarshalGenesis(cdc, bz)
+	if err != nil {
+		panic(err)
+	}
+	am.keeper.InitGenesis(ctx, data)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -135,9 +164,10 @@ func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.Weig
 	return nil
 }
 
-// ExportGenesis performs a no-op.
-func (am AppModule) ExportGenesis(_ sdk.Context, _ codec.JSONCodec) json.RawMessage {
-	return nil
+// ExportGenesis returns the exported genesis state as raw bytes for the params
+// module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
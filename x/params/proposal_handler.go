@@ -12,10 +12,10 @@ import (
 
 // NewParamChangeProposalHandler creates a new governance Handler for a ParamChangeProposal
 func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
-	return func(ctx sdk.Context, content govtypes.Content) error {
+	return func(ctx sdk.Context, proposalID uint64, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *proposal.ParameterChangeProposal:
-			return handleParameterChangeProposal(ctx, k, c)
+			return handleParameterChangeProposal(ctx, k, proposalID, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized param proposal content type: %T", c)
@@ -23,7 +23,7 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 	}
 }
 
-func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
+func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, proposalID uint64, p *proposal.ParameterChangeProposal) error {
 	for _, c := range p.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
@@ -34,9 +34,19 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
 
+		oldValue := ss.GetRaw(ctx, []byte(c.Key))
 		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
 			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
 		}
+
+		k.AddParamChangeRecord(ctx, proposal.ParamChangeRecord{
+			Subspace:   c.Subspace,
+			Key:        c.Key,
+			Height:     ctx.BlockHeight(),
+			ProposalId: proposalID,
+			OldValue:   string(oldValue),
+			NewValue:   string(ss.GetRaw(ctx, []byte(c.Key))),
+		})
 	}
 
 	return nil
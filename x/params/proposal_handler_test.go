@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -79,7 +80,7 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
-			err := suite.govHandler(suite.ctx, tc.proposal)
+			err := suite.govHandler(suite.ctx, 1, tc.proposal)
 			if tc.expErr {
 				suite.Require().Error(err)
 			} else {
@@ -89,3 +90,37 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 		})
 	}
 }
+
+func (suite *HandlerTestSuite) TestProposalHandlerRecordsHistory() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	key := string(stakingtypes.KeyMaxValidators)
+	oldValue := suite.app.StakingKeeper.MaxValidators(ctx)
+
+	err := suite.govHandler(ctx, 7, testProposal(proposal.NewParamChange(stakingtypes.ModuleName, key, "1")))
+	suite.Require().NoError(err)
+
+	var records []proposal.ParamChangeRecord
+	suite.app.ParamsKeeper.IterateParamChangeHistory(ctx, stakingtypes.ModuleName, key, func(record proposal.ParamChangeRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	suite.Require().Equal([]proposal.ParamChangeRecord{{
+		Subspace:   stakingtypes.ModuleName,
+		Key:        key,
+		Height:     10,
+		ProposalId: 7,
+		OldValue:   fmt.Sprintf("%d", oldValue),
+		NewValue:   "1",
+	}}, records)
+
+	// a failed change is not recorded
+	err = suite.govHandler(ctx.WithBlockHeight(11), 8, testProposal(proposal.NewParamChange(stakingtypes.ModuleName, key, "-")))
+	suite.Require().Error(err)
+
+	records = nil
+	suite.app.ParamsKeeper.IterateParamChangeHistory(ctx, stakingtypes.ModuleName, "", func(record proposal.ParamChangeRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	suite.Require().Len(records, 1)
+}
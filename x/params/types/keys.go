@@ -7,3 +7,8 @@ const (
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
+
+// ParamChangeHistoryKeyPrefix is the prefix of the history of the parameter
+// changes in the params store. It never collides with the prefixes of the
+// subspaces, which are their names.
+var ParamChangeHistoryKeyPrefix = []byte{0x00}
@@ -0,0 +1,36 @@
+package proposal
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(history []ParamChangeRecord) *GenesisState {
+	return &GenesisState{
+		ParamChangeHistory: history,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		ParamChangeHistory: []ParamChangeRecord{},
+	}
+}
+
+// ValidateGenesis validates the params genesis data
+func ValidateGenesis(data *GenesisState) error {
+	for i, record := range data.ParamChangeHistory {
+		if len(record.Subspace) == 0 {
+			return fmt.Errorf("empty subspace of the param change record at %d", i)
+		}
+		if len(record.Key) == 0 {
+			return fmt.Errorf("empty key of the param change record at %d", i)
+		}
+		if record.Height < 0 {
+			return fmt.Errorf("negative height of the param change record at %d: %d", i, record.Height)
+		}
+	}
+
+	return nil
+}
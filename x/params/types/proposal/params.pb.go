@@ -123,9 +123,100 @@ func (m *ParamChange) GetValue() string {
 	return ""
 }
 
+// ParamChangeRecord defines a parameter change applied by a proposal.
+type ParamChangeRecord struct {
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// height is the block height at which the change was applied.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// proposal_id is the id of the proposal which applied the change.
+	ProposalId uint64 `protobuf:"varint,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// old_value is the raw value before the change, which is empty if the
+	// parameter had not been set.
+	OldValue string `protobuf:"bytes,5,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// new_value is the raw value after the change.
+	NewValue string `protobuf:"bytes,6,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *ParamChangeRecord) Reset()         { *m = ParamChangeRecord{} }
+func (m *ParamChangeRecord) String() string { return proto.CompactTextString(m) }
+func (*ParamChangeRecord) ProtoMessage()    {}
+func (*ParamChangeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53a944ecb0483e4c, []int{2}
+}
+func (m *ParamChangeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChangeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeRecord.Merge(m, src)
+}
+func (m *ParamChangeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeRecord proto.InternalMessageInfo
+
+func (m *ParamChangeRecord) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *ParamChangeRecord) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamChangeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParamChangeRecord) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ParamChangeRecord) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *ParamChangeRecord) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ParameterChangeProposal)(nil), "cosmos.params.v1beta1.ParameterChangeProposal")
 	proto.RegisterType((*ParamChange)(nil), "cosmos.params.v1beta1.ParamChange")
+	proto.RegisterType((*ParamChangeRecord)(nil), "cosmos.params.v1beta1.ParamChangeRecord")
 }
 
 func init() {
@@ -133,27 +224,31 @@ func init() {
 }
 
 var fileDescriptor_53a944ecb0483e4c = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xed, 0x3f, 0xfd, 0xa1, 0xb8, 0x0b, 0xb2, 0x8a, 0x88, 0x3a, 0x38, 0x51, 0xa6, 0x2e,
-	0xc4, 0x2a, 0x6c, 0x1d, 0xc3, 0x8c, 0x54, 0x75, 0x41, 0x62, 0x73, 0x52, 0x2b, 0x8d, 0x9a, 0xc4,
-	0x56, 0xec, 0x54, 0xf4, 0x0d, 0x18, 0x19, 0xd9, 0xe8, 0xc8, 0xa3, 0x74, 0xec, 0xc8, 0x84, 0x50,
-	0xf2, 0x22, 0x28, 0x4e, 0x82, 0x3a, 0xb0, 0xdd, 0x73, 0xfd, 0xf9, 0xde, 0x73, 0x0f, 0xf2, 0x22,
-	0xa1, 0x32, 0xa1, 0xa8, 0x64, 0x05, 0xcb, 0x14, 0xdd, 0xce, 0x42, 0xae, 0xd9, 0xac, 0x93, 0xbe,
-	0x2c, 0x84, 0x16, 0xf8, 0xaa, 0x65, 0xfc, 0xae, 0xd9, 0x31, 0x93, 0x71, 0x2c, 0x62, 0x61, 0x08,
-	0xda, 0x54, 0x2d, 0xec, 0xbd, 0x43, 0x74, 0xbd, 0x68, 0x40, 0xae, 0x79, 0x71, 0xbf, 0x66, 0x79,
-	0xcc, 0x17, 0x85, 0x90, 0x42, 0xb1, 0x14, 0x8f, 0xd1, 0x7f, 0x9d, 0xe8, 0x94, 0xdb, 0xd0, 0x85,
-	0xd3, 0x8b, 0x65, 0x2b, 0xb0, 0x8b, 0x46, 0x2b, 0xae, 0xa2, 0x22, 0x91, 0x3a, 0x11, 0xb9, 0xfd,
-	0xcf, 0xbc, 0x9d, 0xb6, 0x70, 0x80, 0xce, 0x23, 0x33, 0x49, 0xd9, 0x96, 0x6b, 0x4d, 0x47, 0xb7,
-	0x9e, 0xff, 0xa7, 0x25, 0xdf, 0x2c, 0x6e, 0x97, 0x06, 0x83, 0xc3, 0x97, 0x03, 0x96, 0xfd, 0xc7,
-	0xf9, 0xf0, 0x65, 0xef, 0x80, 0xb7, 0xbd, 0x03, 0xbc, 0x47, 0x34, 0x3a, 0xe1, 0xf0, 0x04, 0x0d,
-	0x55, 0x19, 0x2a, 0xc9, 0xa2, 0xde, 0xd7, 0xaf, 0xc6, 0x97, 0xc8, 0xda, 0xf0, 0x5d, 0x67, 0xa9,
-	0x29, 0x9b, 0x13, 0xb6, 0x2c, 0x2d, 0xb9, 0x6d, 0xb5, 0x27, 0x18, 0x31, 0x1f, 0x34, 0x83, 0x83,
-	0x87, 0x8f, 0x8a, 0xc0, 0x43, 0x45, 0xe0, 0xb1, 0x22, 0xf0, 0xbb, 0x22, 0xf0, 0xb5, 0x26, 0xe0,
-	0x58, 0x13, 0xf0, 0x59, 0x13, 0xf0, 0x44, 0xe3, 0x44, 0xaf, 0xcb, 0xd0, 0x8f, 0x44, 0x46, 0xd3,
-	0x24, 0xe7, 0x34, 0x0d, 0xb3, 0x1b, 0xb5, 0xda, 0xd0, 0xe7, 0x3e, 0x7c, 0xbd, 0x93, 0x5c, 0x51,
-	0xd9, 0xa5, 0x15, 0x9e, 0x99, 0x40, 0xef, 0x7e, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xb6, 0x0c,
-	0xaa, 0xa3, 0x01, 0x00, 0x00,
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x6f, 0x9b, 0x40,
+	0x1c, 0xe5, 0x0a, 0x76, 0xed, 0x63, 0x69, 0x4f, 0x6e, 0x8b, 0x5c, 0x09, 0x10, 0x93, 0x97, 0x72,
+	0x72, 0xbb, 0x79, 0x74, 0xa7, 0x0e, 0x95, 0x2c, 0x86, 0x44, 0xca, 0x62, 0xf1, 0xe7, 0x04, 0xc8,
+	0xc0, 0x21, 0xee, 0x6c, 0xc7, 0xdf, 0x20, 0x63, 0xc6, 0x6c, 0xf1, 0x98, 0x0f, 0x90, 0x0f, 0xe1,
+	0xd1, 0x63, 0xa6, 0x28, 0xc2, 0x5f, 0x24, 0xe2, 0x0e, 0x22, 0x0f, 0x59, 0xb2, 0xdd, 0xfb, 0xbd,
+	0xc7, 0x7b, 0xef, 0x87, 0x7e, 0xd0, 0x09, 0x29, 0xcb, 0x29, 0xc3, 0xa5, 0x5f, 0xf9, 0x39, 0xc3,
+	0x9b, 0x69, 0x40, 0xb8, 0x3f, 0x6d, 0xa1, 0x5b, 0x56, 0x94, 0x53, 0xf4, 0x4d, 0x6a, 0xdc, 0x76,
+	0xd8, 0x6a, 0xc6, 0xa3, 0x98, 0xc6, 0x54, 0x28, 0x70, 0xf3, 0x92, 0x62, 0xe7, 0x1e, 0xc0, 0x1f,
+	0x8b, 0x46, 0x48, 0x38, 0xa9, 0xfe, 0x26, 0x7e, 0x11, 0x93, 0x45, 0x45, 0x4b, 0xca, 0xfc, 0x0c,
+	0x8d, 0x60, 0x8f, 0xa7, 0x3c, 0x23, 0x06, 0xb0, 0xc1, 0x64, 0xe8, 0x49, 0x80, 0x6c, 0xa8, 0x47,
+	0x84, 0x85, 0x55, 0x5a, 0xf2, 0x94, 0x16, 0xc6, 0x27, 0xc1, 0x9d, 0x8f, 0xd0, 0x1c, 0x7e, 0x0e,
+	0x85, 0x13, 0x33, 0x54, 0x5b, 0x9d, 0xe8, 0xbf, 0x1d, 0xf7, 0xdd, 0x4a, 0xae, 0x08, 0x96, 0xa1,
+	0x73, 0xed, 0xf0, 0x6c, 0x29, 0x5e, 0xf7, 0xe1, 0x6c, 0x70, 0xb3, 0xb7, 0x94, 0xbb, 0xbd, 0xa5,
+	0x38, 0x97, 0x50, 0x3f, 0xd3, 0xa1, 0x31, 0x1c, 0xb0, 0x75, 0xc0, 0x4a, 0x3f, 0xec, 0x7a, 0xbd,
+	0x61, 0xf4, 0x05, 0xaa, 0x2b, 0xb2, 0x6b, 0x2b, 0x35, 0xcf, 0x66, 0x85, 0x8d, 0x9f, 0xad, 0x89,
+	0xa1, 0xca, 0x15, 0x04, 0x98, 0x69, 0xc2, 0xf8, 0x11, 0xc0, 0xaf, 0x67, 0xce, 0x1e, 0x09, 0x69,
+	0x15, 0x7d, 0xd0, 0xff, 0x3b, 0xec, 0x27, 0x24, 0x8d, 0x13, 0x2e, 0x02, 0x54, 0xaf, 0x45, 0xc8,
+	0x82, 0x7a, 0xd9, 0xfe, 0xc6, 0x65, 0x1a, 0x19, 0x9a, 0x0d, 0x26, 0x9a, 0x07, 0xbb, 0xd1, 0xbf,
+	0x08, 0xfd, 0x84, 0x43, 0x9a, 0x45, 0x4b, 0x59, 0xae, 0x27, 0x73, 0x68, 0x16, 0x5d, 0x34, 0xb8,
+	0x21, 0x0b, 0xb2, 0x6d, 0xc9, 0xbe, 0x24, 0x0b, 0xb2, 0x15, 0xe4, 0xfc, 0xff, 0x43, 0x6d, 0x82,
+	0x43, 0x6d, 0x82, 0x63, 0x6d, 0x82, 0x97, 0xda, 0x04, 0xb7, 0x27, 0x53, 0x39, 0x9e, 0x4c, 0xe5,
+	0xe9, 0x64, 0x2a, 0x57, 0x38, 0x4e, 0x79, 0xb2, 0x0e, 0xdc, 0x90, 0xe6, 0x38, 0x4b, 0x0b, 0x82,
+	0xb3, 0x20, 0xff, 0xc5, 0xa2, 0x15, 0xbe, 0xee, 0x6e, 0x86, 0xef, 0x4a, 0xc2, 0x70, 0x57, 0x25,
+	0xe8, 0x8b, 0x3b, 0xf8, 0xf3, 0x3a, 0x00, 0x0f, 0x3d, 0x25, 0x28, 0x5a, 0x02, 0x00, 0x00,
 }
 
 func (this *ParameterChangeProposal) Equal(that interface{}) bool {
@@ -221,6 +316,45 @@ func (this *ParamChange) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ParamChangeRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParamChangeRecord)
+	if !ok {
+		that2, ok := that.(ParamChangeRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Subspace != that1.Subspace {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if this.OldValue != that1.OldValue {
+		return false
+	}
+	if this.NewValue != that1.NewValue {
+		return false
+	}
+	return true
+}
 func (m *ParameterChangeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -316,6 +450,67 @@ func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamChangeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -371,6 +566,37 @@ func (m *ParamChange) Size() (n int) {
 	return n
 }
 
+func (m *ParamChangeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovParams(uint64(m.ProposalId))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -671,6 +897,222 @@ func (m *ParamChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParamChangeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChangeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChangeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
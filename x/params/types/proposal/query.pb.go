@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	query "github.com/line/lbm-sdk/types/query"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -130,36 +131,337 @@ func (m *QueryParamsResponse) GetParam() ParamChange {
 	return ParamChange{}
 }
 
+// QueryValidateParamChangesRequest is request type for the
+// Query/ValidateParamChanges RPC method.
+type QueryValidateParamChangesRequest struct {
+	// changes defines the parameter changes to validate.
+	Changes []ParamChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *QueryValidateParamChangesRequest) Reset()         { *m = QueryValidateParamChangesRequest{} }
+func (m *QueryValidateParamChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateParamChangesRequest) ProtoMessage()    {}
+func (*QueryValidateParamChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{2}
+}
+func (m *QueryValidateParamChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateParamChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateParamChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateParamChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateParamChangesRequest.Merge(m, src)
+}
+func (m *QueryValidateParamChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateParamChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateParamChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateParamChangesRequest proto.InternalMessageInfo
+
+func (m *QueryValidateParamChangesRequest) GetChanges() []ParamChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// QueryValidateParamChangesResponse is response type for the
+// Query/ValidateParamChanges RPC method.
+type QueryValidateParamChangesResponse struct {
+	// results defines the validation results in the order of the changes.
+	Results []ParamChangeValidation `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryValidateParamChangesResponse) Reset()         { *m = QueryValidateParamChangesResponse{} }
+func (m *QueryValidateParamChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateParamChangesResponse) ProtoMessage()    {}
+func (*QueryValidateParamChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{3}
+}
+func (m *QueryValidateParamChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateParamChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateParamChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateParamChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateParamChangesResponse.Merge(m, src)
+}
+func (m *QueryValidateParamChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateParamChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateParamChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateParamChangesResponse proto.InternalMessageInfo
+
+func (m *QueryValidateParamChangesResponse) GetResults() []ParamChangeValidation {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// ParamChangeValidation defines the validation result of a parameter change.
+type ParamChangeValidation struct {
+	Change ParamChange `protobuf:"bytes,1,opt,name=change,proto3" json:"change"`
+	Valid  bool        `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// error describes why the change is invalid.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ParamChangeValidation) Reset()         { *m = ParamChangeValidation{} }
+func (m *ParamChangeValidation) String() string { return proto.CompactTextString(m) }
+func (*ParamChangeValidation) ProtoMessage()    {}
+func (*ParamChangeValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{4}
+}
+func (m *ParamChangeValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangeValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangeValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChangeValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeValidation.Merge(m, src)
+}
+func (m *ParamChangeValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangeValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeValidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeValidation proto.InternalMessageInfo
+
+func (m *ParamChangeValidation) GetChange() ParamChange {
+	if m != nil {
+		return m.Change
+	}
+	return ParamChange{}
+}
+
+func (m *ParamChangeValidation) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ParamChangeValidation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// QueryParamChangeHistoryRequest is request type for the
+// Query/ParamChangeHistory RPC method.
+type QueryParamChangeHistoryRequest struct {
+	// subspace defines the module to query the history for.
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	// key defines the key of the parameter in the subspace. All the keys are
+	// queried if it is empty.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParamChangeHistoryRequest) Reset()         { *m = QueryParamChangeHistoryRequest{} }
+func (m *QueryParamChangeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamChangeHistoryRequest) ProtoMessage()    {}
+func (*QueryParamChangeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{5}
+}
+func (m *QueryParamChangeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamChangeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamChangeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamChangeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamChangeHistoryRequest.Merge(m, src)
+}
+func (m *QueryParamChangeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamChangeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamChangeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamChangeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryParamChangeHistoryRequest) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *QueryParamChangeHistoryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *QueryParamChangeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamChangeHistoryResponse is response type for the
+// Query/ParamChangeHistory RPC method.
+type QueryParamChangeHistoryResponse struct {
+	// records defines the parameter changes in the order of application, per
+	// key.
+	Records []ParamChangeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParamChangeHistoryResponse) Reset()         { *m = QueryParamChangeHistoryResponse{} }
+func (m *QueryParamChangeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamChangeHistoryResponse) ProtoMessage()    {}
+func (*QueryParamChangeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{6}
+}
+func (m *QueryParamChangeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamChangeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamChangeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamChangeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamChangeHistoryResponse.Merge(m, src)
+}
+func (m *QueryParamChangeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamChangeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamChangeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamChangeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryParamChangeHistoryResponse) GetRecords() []ParamChangeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryParamChangeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.params.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.params.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidateParamChangesRequest)(nil), "cosmos.params.v1beta1.QueryValidateParamChangesRequest")
+	proto.RegisterType((*QueryValidateParamChangesResponse)(nil), "cosmos.params.v1beta1.QueryValidateParamChangesResponse")
+	proto.RegisterType((*ParamChangeValidation)(nil), "cosmos.params.v1beta1.ParamChangeValidation")
+	proto.RegisterType((*QueryParamChangeHistoryRequest)(nil), "cosmos.params.v1beta1.QueryParamChangeHistoryRequest")
+	proto.RegisterType((*QueryParamChangeHistoryResponse)(nil), "cosmos.params.v1beta1.QueryParamChangeHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/params/v1beta1/query.proto", fileDescriptor_2b32979c1792ccc4) }
 
 var fileDescriptor_2b32979c1792ccc4 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x41, 0x4b, 0xf3, 0x40,
-	0x10, 0x86, 0xb3, 0xfd, 0xbe, 0x16, 0x5d, 0x2f, 0xb2, 0x2a, 0x94, 0xa0, 0xa9, 0x06, 0x04, 0x15,
-	0xcc, 0xd0, 0x7a, 0xf7, 0x50, 0x4f, 0xde, 0xb4, 0xe0, 0xc5, 0xdb, 0xa6, 0x2e, 0x69, 0x68, 0x92,
-	0xd9, 0x66, 0x36, 0x62, 0xaf, 0x1e, 0x3c, 0x17, 0xfc, 0x53, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x69,
-	0xfd, 0x21, 0xd2, 0x4d, 0x15, 0xc4, 0x2a, 0xde, 0x26, 0x93, 0x67, 0x9e, 0x77, 0x67, 0xf8, 0x5e,
-	0x17, 0x29, 0x45, 0x02, 0x2d, 0x73, 0x99, 0x12, 0xdc, 0x36, 0x43, 0x65, 0x64, 0x13, 0x06, 0x85,
-	0xca, 0x87, 0x81, 0xce, 0xd1, 0xa0, 0xd8, 0x2a, 0x91, 0xa0, 0x44, 0x82, 0x05, 0xe2, 0x6e, 0x46,
-	0x18, 0xa1, 0x25, 0x60, 0x5e, 0x95, 0xb0, 0xbb, 0x1d, 0x21, 0x46, 0x89, 0x02, 0xa9, 0x63, 0x90,
-	0x59, 0x86, 0x46, 0x9a, 0x18, 0x33, 0x5a, 0xfc, 0xf5, 0x97, 0xa7, 0x2d, 0xcc, 0x96, 0xf1, 0xdb,
-	0x5c, 0x5c, 0xce, 0xd3, 0x2f, 0x6c, 0xb3, 0xa3, 0x06, 0x85, 0x22, 0x23, 0x5c, 0xbe, 0x42, 0x45,
-	0x48, 0x5a, 0x76, 0x55, 0x9d, 0xed, 0xb2, 0x83, 0xd5, 0xce, 0xe7, 0xb7, 0x58, 0xe7, 0xff, 0xfa,
-	0x6a, 0x58, 0xaf, 0xd8, 0xf6, 0xbc, 0xf4, 0xaf, 0xf8, 0xc6, 0x17, 0x07, 0x69, 0xcc, 0x48, 0x89,
-	0x53, 0x5e, 0xb5, 0x51, 0xd6, 0xb0, 0xd6, 0xf2, 0x83, 0xa5, 0x9b, 0x05, 0x76, 0xea, 0xac, 0x27,
-	0xb3, 0x48, 0xb5, 0xff, 0x8f, 0x5f, 0x1a, 0x4e, 0xa7, 0x1c, 0x6b, 0x8d, 0x18, 0xaf, 0x5a, 0xaf,
-	0x78, 0x60, 0xbc, 0x56, 0xca, 0xc5, 0xe1, 0x0f, 0x96, 0xef, 0x4b, 0xb8, 0x47, 0x7f, 0x41, 0xcb,
-	0xb7, 0xfa, 0xfb, 0xf7, 0x4f, 0x6f, 0x8f, 0x95, 0x86, 0xd8, 0x81, 0xdf, 0x6e, 0xd6, 0x3e, 0x1f,
-	0x4f, 0x3d, 0x36, 0x99, 0x7a, 0xec, 0x75, 0xea, 0xb1, 0xd1, 0xcc, 0x73, 0x26, 0x33, 0xcf, 0x79,
-	0x9e, 0x79, 0xce, 0x35, 0x44, 0xb1, 0xe9, 0x15, 0x61, 0xd0, 0xc5, 0x14, 0x92, 0x38, 0x53, 0x90,
-	0x84, 0xe9, 0x31, 0xdd, 0xf4, 0xe1, 0xee, 0x43, 0x65, 0x86, 0x5a, 0x11, 0xe8, 0x1c, 0x35, 0x92,
-	0x4c, 0xc2, 0x9a, 0xbd, 0xff, 0xc9, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xea, 0xc8, 0xd8,
-	0x13, 0x02, 0x00, 0x00,
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0x24, 0x4d, 0x5a, 0xa7, 0x17, 0x19, 0x53, 0x08, 0x8b, 0x6e, 0xd2, 0x01, 0xb5, 0x8d,
+	0x76, 0x87, 0x44, 0xd4, 0xe2, 0x41, 0x24, 0x82, 0x56, 0xf0, 0xa0, 0x0b, 0x7a, 0xf0, 0x52, 0x66,
+	0x93, 0x71, 0xb3, 0x74, 0xb3, 0xb3, 0xd9, 0xd9, 0x04, 0x83, 0x78, 0x11, 0xf4, 0xaa, 0x20, 0xf8,
+	0x49, 0xc4, 0x2f, 0xe0, 0xa5, 0xc7, 0x82, 0x17, 0x4f, 0x22, 0x89, 0x1f, 0x44, 0x76, 0x66, 0x36,
+	0x49, 0x69, 0xfe, 0x98, 0xde, 0x76, 0x5e, 0x7e, 0xff, 0x66, 0xde, 0x7b, 0x81, 0xdb, 0x4d, 0x2e,
+	0x3a, 0x5c, 0x90, 0x90, 0x46, 0xb4, 0x23, 0x48, 0xbf, 0xe6, 0xb0, 0x98, 0xd6, 0x48, 0xb7, 0xc7,
+	0xa2, 0x81, 0x15, 0x46, 0x3c, 0xe6, 0x68, 0x4b, 0x41, 0x2c, 0x05, 0xb1, 0x34, 0xc4, 0x28, 0xba,
+	0xdc, 0xe5, 0x12, 0x41, 0x92, 0x2f, 0x05, 0x36, 0x2e, 0xbb, 0x9c, 0xbb, 0x3e, 0x23, 0x34, 0xf4,
+	0x08, 0x0d, 0x02, 0x1e, 0xd3, 0xd8, 0xe3, 0x81, 0xd0, 0xbf, 0x56, 0xb5, 0x9b, 0x43, 0x05, 0x53,
+	0x1e, 0x63, 0xc7, 0x90, 0xba, 0x5e, 0x20, 0xc1, 0x1a, 0x8b, 0x67, 0x27, 0xd3, 0x29, 0x24, 0x06,
+	0x37, 0x20, 0x7a, 0x9e, 0xa8, 0x3c, 0x93, 0x45, 0x9b, 0x75, 0x7b, 0x4c, 0xc4, 0xc8, 0x80, 0x1b,
+	0xa2, 0xe7, 0x88, 0x90, 0x36, 0x59, 0x09, 0x54, 0xc0, 0xce, 0x05, 0x7b, 0x7c, 0x46, 0x17, 0x61,
+	0xee, 0x88, 0x0d, 0x4a, 0x59, 0x59, 0x4e, 0x3e, 0xf1, 0x0b, 0x78, 0xe9, 0x94, 0x86, 0x08, 0x79,
+	0x20, 0x18, 0xba, 0x0f, 0xf3, 0xd2, 0x4a, 0x2a, 0x6c, 0xd6, 0xb1, 0x35, 0xf3, 0x15, 0x2c, 0xc9,
+	0x7a, 0xd8, 0xa6, 0x81, 0xcb, 0x1a, 0x6b, 0xc7, 0xbf, 0xcb, 0x19, 0x5b, 0xd1, 0xf0, 0x6b, 0x58,
+	0x91, 0xb2, 0x2f, 0xa9, 0xef, 0xb5, 0x68, 0xcc, 0xa6, 0x80, 0xe3, 0xa0, 0x0d, 0xb8, 0xde, 0x54,
+	0x95, 0x12, 0xa8, 0xe4, 0x56, 0x72, 0x49, 0x89, 0xb8, 0x0b, 0xb7, 0x17, 0xf8, 0xe8, 0xcb, 0x3c,
+	0x85, 0xeb, 0x11, 0x13, 0x3d, 0x3f, 0x4e, 0x8d, 0x6e, 0x2e, 0x37, 0xd2, 0x82, 0x1e, 0x0f, 0x52,
+	0x4b, 0x2d, 0x81, 0x3f, 0x00, 0xb8, 0x35, 0x13, 0x88, 0x1e, 0xc0, 0x82, 0xca, 0xb5, 0xf2, 0xab,
+	0x69, 0x1e, 0x2a, 0xc2, 0x7c, 0x3f, 0xd1, 0x93, 0x1d, 0xda, 0xb0, 0xd5, 0x21, 0xa9, 0xb2, 0x28,
+	0xe2, 0x51, 0x29, 0x27, 0xfb, 0xa6, 0x0e, 0xf8, 0x2b, 0x80, 0xe6, 0xa4, 0x75, 0x4a, 0xee, 0xc0,
+	0x13, 0x31, 0x8f, 0x06, 0xe7, 0x1a, 0x05, 0xf4, 0x08, 0xc2, 0xc9, 0x18, 0x4a, 0xaf, 0xcd, 0xfa,
+	0xb5, 0xf4, 0x0a, 0xc9, 0xcc, 0x5a, 0x6a, 0x2f, 0x26, 0xd7, 0x70, 0x99, 0x76, 0xb2, 0xa7, 0x98,
+	0xf8, 0x1b, 0x80, 0xe5, 0xb9, 0xc1, 0x74, 0x4b, 0x0e, 0x92, 0x96, 0x34, 0x79, 0xd4, 0x4a, 0x5b,
+	0xb2, 0xb3, 0xfc, 0xad, 0x6c, 0x49, 0x98, 0xb4, 0x43, 0xd2, 0xd1, 0xe3, 0x53, 0xa9, 0xb3, 0x32,
+	0xf5, 0xf5, 0xa5, 0xa9, 0x55, 0x8c, 0xe9, 0xd8, 0xf5, 0x4f, 0x6b, 0x30, 0x2f, 0x63, 0xa3, 0x8f,
+	0x00, 0x16, 0xd4, 0x3e, 0xa0, 0xdd, 0x39, 0xb1, 0xce, 0xee, 0x9d, 0x51, 0xfd, 0x1f, 0xa8, 0xf2,
+	0xc5, 0x57, 0xdf, 0xff, 0xfc, 0xfb, 0x25, 0x5b, 0x46, 0x57, 0xc8, 0xa2, 0x35, 0x47, 0x3f, 0x00,
+	0x2c, 0xce, 0x9a, 0x6c, 0x74, 0x77, 0x91, 0xd7, 0x82, 0x9d, 0x33, 0xf6, 0x57, 0x27, 0xea, 0xc8,
+	0xfb, 0x32, 0x72, 0x1d, 0xef, 0xcd, 0x89, 0xdc, 0xd7, 0xe4, 0x43, 0x59, 0x3f, 0xd4, 0x0b, 0x7a,
+	0x0f, 0x54, 0xd1, 0x77, 0x00, 0xd1, 0xd9, 0x51, 0x40, 0xb7, 0x97, 0xbe, 0xd7, 0xac, 0x99, 0x36,
+	0xee, 0xac, 0x4a, 0xd3, 0xf9, 0x6b, 0x32, 0xff, 0x0d, 0xb4, 0x3b, 0x27, 0x7f, 0x5b, 0xe1, 0xc9,
+	0xdb, 0x74, 0x43, 0xde, 0x35, 0x9e, 0x1c, 0x0f, 0x4d, 0x70, 0x32, 0x34, 0xc1, 0x9f, 0xa1, 0x09,
+	0x3e, 0x8f, 0xcc, 0xcc, 0xc9, 0xc8, 0xcc, 0xfc, 0x1a, 0x99, 0x99, 0x57, 0xc4, 0xf5, 0xe2, 0x76,
+	0xcf, 0xb1, 0x9a, 0xbc, 0x43, 0x7c, 0x2f, 0x60, 0xc4, 0x77, 0x3a, 0x7b, 0xa2, 0x75, 0x44, 0xde,
+	0xa4, 0xb2, 0xf1, 0x20, 0x64, 0x82, 0x84, 0x11, 0x0f, 0xb9, 0xa0, 0xbe, 0x53, 0x90, 0xff, 0xd8,
+	0xb7, 0xfe, 0x0d, 0x00, 0x4f, 0xd2, 0xed, 0xb4, 0x71, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -177,6 +479,13 @@ type QueryClient interface {
 	// Params queries a specific parameter of a module, given its subspace and
 	// key.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidateParamChanges validates the parameter changes against the
+	// validators of the subspaces, without applying them. The changes are
+	// validated in order, as the proposal handler applies them.
+	ValidateParamChanges(ctx context.Context, in *QueryValidateParamChangesRequest, opts ...grpc.CallOption) (*QueryValidateParamChangesResponse, error)
+	// ParamChangeHistory queries the history of the parameter changes applied by
+	// the proposals, given a subspace and optionally a key.
+	ParamChangeHistory(ctx context.Context, in *QueryParamChangeHistoryRequest, opts ...grpc.CallOption) (*QueryParamChangeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -196,11 +505,36 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidateParamChanges(ctx context.Context, in *QueryValidateParamChangesRequest, opts ...grpc.CallOption) (*QueryValidateParamChangesResponse, error) {
+	out := new(QueryValidateParamChangesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Query/ValidateParamChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamChangeHistory(ctx context.Context, in *QueryParamChangeHistoryRequest, opts ...grpc.CallOption) (*QueryParamChangeHistoryResponse, error) {
+	out := new(QueryParamChangeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Query/ParamChangeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries a specific parameter of a module, given its subspace and
 	// key.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidateParamChanges validates the parameter changes against the
+	// validators of the subspaces, without applying them. The changes are
+	// validated in order, as the proposal handler applies them.
+	ValidateParamChanges(context.Context, *QueryValidateParamChangesRequest) (*QueryValidateParamChangesResponse, error)
+	// ParamChangeHistory queries the history of the parameter changes applied by
+	// the proposals, given a subspace and optionally a key.
+	ParamChangeHistory(context.Context, *QueryParamChangeHistoryRequest) (*QueryParamChangeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -210,6 +544,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ValidateParamChanges(ctx context.Context, req *QueryValidateParamChangesRequest) (*QueryValidateParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateParamChanges not implemented")
+}
+func (*UnimplementedQueryServer) ParamChangeHistory(ctx context.Context, req *QueryParamChangeHistoryRequest) (*QueryParamChangeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamChangeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -233,6 +573,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidateParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Query/ValidateParamChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateParamChanges(ctx, req.(*QueryValidateParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamChangeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamChangeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamChangeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Query/ParamChangeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamChangeHistory(ctx, req.(*QueryParamChangeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.params.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -241,6 +617,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidateParamChanges",
+			Handler:    _Query_ValidateParamChanges_Handler,
+		},
+		{
+			MethodName: "ParamChangeHistory",
+			Handler:    _Query_ParamChangeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/params/v1beta1/query.proto",
@@ -308,60 +692,870 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateParamChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateParamChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateParamChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateParamChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateParamChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateParamChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChangeValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangeValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangeValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamChangeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamChangeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamChangeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamChangeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamChangeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamChangeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Param.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidateParamChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidateParamChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamChangeValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Change.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamChangeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamChangeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Param.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateParamChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateParamChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateParamChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateParamChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateParamChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateParamChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ParamChangeValidation{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Subspace)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ParamChangeValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChangeValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChangeValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Param.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamChangeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -384,10 +1578,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamChangeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamChangeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -454,6 +1648,42 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -475,7 +1705,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamChangeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -498,15 +1728,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamChangeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamChangeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -533,7 +1763,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Param.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, ParamChangeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -43,6 +43,12 @@ func (s Subspace) HasKeyTable() bool {
 	return len(s.table.m) > 0
 }
 
+// IsRegistered returns whether the parameter key is registered in the KeyTable.
+func (s Subspace) IsRegistered(key []byte) bool {
+	_, ok := s.table.m[string(key)]
+	return ok
+}
+
 // WithKeyTable initializes KeyTable and returns modified Subspace
 func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 	if table.m == nil {
@@ -102,6 +102,49 @@ tombstoned: false`, sdk.ConsAddress(val.PubKey.Address())),
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryInfractions() {
+	val := s.network.Validators[0]
+	pubKeyBz, err := s.cfg.Codec.MarshalInterfaceJSON(val.PubKey)
+	s.Require().NoError(err)
+	pubKeyStr := string(pubKeyBz)
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{"invalid address", []string{"foo"}, true, ``},
+		{
+			"valid address (json output)",
+			[]string{
+				pubKeyStr,
+				fmt.Sprintf("--%s=json", ostcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			`{"infractions":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryInfractions()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 	val := s.network.Validators[0]
 
@@ -41,6 +41,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		}
 	}
 
+	for _, infraction := range data.Infractions {
+		address, err := sdk.ConsAddressFromBech32(infraction.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetInfraction(ctx, address, infraction)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -68,5 +76,11 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	infractions := make([]types.Infraction, 0)
+	keeper.IterateAllInfractions(ctx, func(infraction types.Infraction) (stop bool) {
+		infractions = append(infractions, infraction)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, infractions)
 }
@@ -28,11 +28,16 @@ func TestExportAndInitGenesis(t *testing.T) {
 
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[0]), info1)
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[1]), info2)
+	infraction := types.NewInfraction(sdk.ConsAddress(addrDels[0]), types.InfractionDowntime, int64(3),
+		time.Now().UTC(), sdk.NewDecWithPrec(1, 2), sdk.NewInt(10), info1.JailedUntil)
+	app.SlashingKeeper.SetInfraction(ctx, sdk.ConsAddress(addrDels[0]), infraction)
 	genesisState := slashing.ExportGenesis(ctx, app.SlashingKeeper)
 
 	require.Equal(t, genesisState.Params, testslashing.TestParams())
 	require.Len(t, genesisState.SigningInfos, 2)
 	require.Equal(t, genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal(t, []types.Infraction{infraction}, genesisState.Infractions)
+	require.NoError(t, infraction.Validate())
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	app.SlashingKeeper.Tombstone(ctx, sdk.ConsAddress(addrDels[0]))
@@ -55,4 +60,5 @@ func TestExportAndInitGenesis(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, info1, newInfo1)
 	require.Equal(t, info2, newInfo2)
+	require.Equal(t, genesisState, slashing.ExportGenesis(ctx, app.SlashingKeeper))
 }
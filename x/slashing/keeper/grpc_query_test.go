@@ -105,6 +105,42 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCInfractions() {
+	queryClient := suite.queryClient
+
+	infractionsResp, err := queryClient.Infractions(gocontext.Background(), &types.QueryInfractionsRequest{ConsAddress: ""})
+	suite.Error(err)
+	suite.Nil(infractionsResp)
+
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+	infractions := []types.Infraction{
+		types.NewInfraction(consAddr, types.InfractionDowntime, 5, time.Unix(5, 0).UTC(),
+			sdk.NewDecWithPrec(1, 2), sdk.NewInt(10), time.Unix(15, 0).UTC()),
+		types.NewInfraction(consAddr, types.InfractionDoubleSign, 10, time.Unix(10, 0).UTC(),
+			sdk.NewDecWithPrec(5, 2), sdk.NewInt(50), time.Unix(20, 0).UTC()),
+	}
+	// stored in reverse order to check the ordering by height
+	for i := len(infractions) - 1; i >= 0; i-- {
+		suite.app.SlashingKeeper.SetInfraction(suite.ctx, consAddr, infractions[i])
+	}
+	// an infraction of another validator
+	otherAddr := sdk.ConsAddress(suite.addrDels[1])
+	suite.app.SlashingKeeper.SetInfraction(suite.ctx, otherAddr, types.NewInfraction(otherAddr, types.InfractionDowntime, 7,
+		time.Unix(7, 0).UTC(), sdk.NewDecWithPrec(1, 2), sdk.NewInt(10), time.Unix(17, 0).UTC()))
+
+	infractionsResp, err = queryClient.Infractions(gocontext.Background(),
+		&types.QueryInfractionsRequest{ConsAddress: consAddr.String()})
+	suite.NoError(err)
+	suite.Equal(infractions, infractionsResp.Infractions)
+
+	// test pagination
+	infractionsResp, err = queryClient.Infractions(gocontext.Background(),
+		&types.QueryInfractionsRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.NoError(err)
+	suite.Equal(infractions[:1], infractionsResp.Infractions)
+	suite.Equal(uint64(2), infractionsResp.Pagination.Total)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/slashing/types"
+)
+
+// SetInfraction records an infraction in the infraction history of the validator
+func (k Keeper) SetInfraction(ctx sdk.Context, address sdk.ConsAddress, infraction types.Infraction) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&infraction)
+	store.Set(types.InfractionKey(address, infraction.Height, infraction.InfractionType), bz)
+}
+
+// RecordDoubleSign records a double sign infraction of the validator, which
+// has been slashed and jailed for it.
+func (k Keeper) RecordDoubleSign(ctx sdk.Context, consAddr sdk.ConsAddress, height int64, infractionTime time.Time, tokensBurned sdk.Int) {
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
+	}
+
+	infraction := types.NewInfraction(
+		consAddr, types.InfractionDoubleSign, height, infractionTime,
+		k.SlashFractionDoubleSign(ctx), tokensBurned, signInfo.JailedUntil,
+	)
+	k.SetInfraction(ctx, consAddr, infraction)
+}
+
+// IterateInfractions iterates over the infraction history of the validator, ordered by height
+func (k Keeper) IterateInfractions(ctx sdk.Context, address sdk.ConsAddress,
+	handler func(infraction types.Infraction) (stop bool),
+) {
+	k.iterateInfractions(ctx, types.InfractionsPrefixKey(address), handler)
+}
+
+// IterateAllInfractions iterates over the infraction history of all the validators
+func (k Keeper) IterateAllInfractions(ctx sdk.Context, handler func(infraction types.Infraction) (stop bool)) {
+	k.iterateInfractions(ctx, types.InfractionKeyPrefix, handler)
+}
+
+func (k Keeper) iterateInfractions(ctx sdk.Context, prefix []byte, handler func(infraction types.Infraction) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var infraction types.Infraction
+		k.cdc.MustUnmarshal(iter.Value(), &infraction)
+		if handler(infraction) {
+			break
+		}
+	}
+}
@@ -63,8 +63,9 @@ func (k Keeper) GetPubkey(ctx sdk.Context, a cryptotypes.Address) (cryptotypes.P
 }
 
 // Slash attempts to slash a validator. The slash is delegated to the staking
-// module to make the necessary validator changes.
-func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) {
+// module to make the necessary validator changes. It returns the amount of the
+// validator tokens burned.
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) sdk.Int {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
@@ -74,7 +75,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.De
 		),
 	)
 
-	k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
+	return k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
 }
 
 // Jail attempts to jail a validator. The slash is delegated to the staking module
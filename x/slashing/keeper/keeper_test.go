@@ -10,6 +10,7 @@ import (
 	"github.com/line/lbm-sdk/simapp"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/slashing/testslashing"
+	"github.com/line/lbm-sdk/x/slashing/types"
 	"github.com/line/lbm-sdk/x/staking"
 	"github.com/line/lbm-sdk/x/staking/teststaking"
 	stakingtypes "github.com/line/lbm-sdk/x/staking/types"
@@ -162,6 +163,23 @@ func TestHandleAlreadyJailed(t *testing.T) {
 	resultingTokens := amt.Sub(app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
 	require.Equal(t, resultingTokens, validator.GetTokens())
 
+	// the infraction should have been recorded
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
+	require.True(t, found)
+	expInfraction := types.NewInfraction(
+		sdk.GetConsAddress(val), types.InfractionDowntime, height-1, ctx.BlockHeader().Time,
+		app.SlashingKeeper.SlashFractionDowntime(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 1), info.JailedUntil,
+	)
+	requireInfractions := func() {
+		var infractions []types.Infraction
+		app.SlashingKeeper.IterateInfractions(ctx, sdk.GetConsAddress(val), func(infraction types.Infraction) bool {
+			infractions = append(infractions, infraction)
+			return false
+		})
+		require.Equal(t, []types.Infraction{expInfraction}, infractions)
+	}
+	requireInfractions()
+
 	// another block missed
 	ctx = ctx.WithBlockHeight(height)
 	app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
@@ -169,6 +187,7 @@ func TestHandleAlreadyJailed(t *testing.T) {
 	// validator should not have been slashed twice
 	validator, _ = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, resultingTokens, validator.GetTokens())
+	requireInfractions()
 }
 
 // Test a validator dipping in and out of the validator set
@@ -30,6 +30,7 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	missed := gogotypes.BoolValue{Value: true}
+	infraction := types.NewInfraction(consAddr1, types.InfractionDowntime, 10, time.Now().UTC(), sdk.NewDecWithPrec(1, 2), sdk.NewInt(100), time.Now().UTC())
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
 
@@ -38,6 +39,7 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshal(&missed)},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.InfractionKey(consAddr1, 10, types.InfractionDowntime), Value: cdc.MustMarshal(&infraction)},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -50,6 +52,7 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"Infraction", fmt.Sprintf("%v\n%v", infraction, infraction), false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
@@ -9,12 +9,13 @@ import (
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
-	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks, infractions []Infraction,
 ) *GenesisState {
 	return &GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+		Infractions:  infractions,
 	}
 }
 
@@ -32,6 +33,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+		Infractions:  []Infraction{},
 	}
 }
 
@@ -62,5 +64,11 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	for _, infraction := range data.Infractions {
+		if err := infraction.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -33,6 +33,8 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	// infractions represents the infraction history of the validators.
+	Infractions []Infraction `protobuf:"bytes,4,rep,name=infractions,proto3" json:"infractions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +91,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetInfractions() []Infraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -267,34 +276,36 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0x09, 0x04, 0x38, 0xa7, 0xcb, 0xc9, 0x14, 0xab, 0x02, 0xa7, 0xb2, 0x28, 0xca,
-	0x52, 0x5b, 0x2d, 0x1b, 0x88, 0xc5, 0x0b, 0x62, 0x40, 0x42, 0xae, 0xc4, 0xc0, 0x12, 0x9d, 0xe3,
-	0xeb, 0xf5, 0x54, 0xfb, 0x2e, 0xf8, 0x33, 0x51, 0xfb, 0x0a, 0x4c, 0xcc, 0xbc, 0x01, 0x3b, 0x0f,
-	0x91, 0x31, 0x23, 0x53, 0x84, 0x92, 0x37, 0xe0, 0x09, 0x50, 0xee, 0x1c, 0xe2, 0x44, 0x31, 0x51,
-	0x37, 0x7f, 0xd2, 0xef, 0xff, 0xff, 0x7f, 0xdf, 0xdf, 0x3a, 0x7c, 0x32, 0x54, 0x90, 0x2b, 0x08,
-	0x21, 0xa3, 0x70, 0x25, 0x24, 0x0f, 0xc7, 0x67, 0x09, 0x2b, 0xe9, 0x59, 0xc8, 0x99, 0x64, 0x20,
-	0x20, 0x18, 0x15, 0xaa, 0x54, 0xe4, 0x89, 0xc1, 0x82, 0x15, 0x16, 0x54, 0xd8, 0x91, 0xc3, 0x15,
-	0x57, 0x9a, 0x09, 0x97, 0x5f, 0x06, 0x3f, 0x7a, 0xd1, 0xe4, 0xfa, 0x4f, 0xaf, 0x39, 0xff, 0x47,
-	0x0b, 0x77, 0xdf, 0x9a, 0xa0, 0x8b, 0x92, 0x96, 0x8c, 0xbc, 0xc1, 0x9d, 0x11, 0x2d, 0x68, 0x0e,
-	0x2e, 0x3a, 0x46, 0x7d, 0xfb, 0xbc, 0x17, 0x34, 0x04, 0x07, 0x1f, 0x34, 0x16, 0xdd, 0x9b, 0xcc,
-	0x7a, 0x56, 0x5c, 0x89, 0x08, 0xc7, 0x07, 0x20, 0xb8, 0x14, 0x92, 0x0f, 0x84, 0xbc, 0x54, 0xe0,
-	0xb6, 0x8e, 0xdb, 0x7d, 0xfb, 0xfc, 0x79, 0xa3, 0xcb, 0x85, 0xa1, 0xdf, 0xc9, 0x4b, 0x15, 0x3d,
-	0x5d, 0x5a, 0xfd, 0x99, 0xf5, 0x9c, 0x5b, 0x9a, 0x67, 0xaf, 0xfc, 0x0d, 0x23, 0x3f, 0xee, 0xc2,
-	0x1a, 0x05, 0xf2, 0x19, 0x1f, 0xe4, 0x02, 0x80, 0xa5, 0x83, 0x24, 0x53, 0xc3, 0x6b, 0x70, 0xdb,
-	0x3a, 0x28, 0x68, 0x0c, 0xfa, 0x48, 0x33, 0x91, 0xd2, 0x52, 0x15, 0xef, 0xb5, 0x2c, 0xd2, 0xaa,
-	0xed, 0xc8, 0x0d, 0x4b, 0x3f, 0xee, 0xe6, 0x35, 0xd6, 0xff, 0x89, 0xb0, 0x5d, 0x5b, 0x97, 0xb8,
-	0xf8, 0x01, 0x4d, 0xd3, 0x82, 0x81, 0xe9, 0xea, 0x51, 0xbc, 0x1a, 0xc9, 0x57, 0x84, 0x0f, 0xc7,
-	0xab, 0xbc, 0x41, 0xfd, 0x0e, 0xb7, 0xa5, 0x5b, 0x3d, 0xdd, 0xbf, 0x66, 0xbd, 0x98, 0x93, 0x6a,
-	0xcb, 0x67, 0x66, 0xcb, 0xdd, 0xd6, 0x7e, 0xec, 0x8c, 0x77, 0x88, 0xfd, 0xef, 0x08, 0x3f, 0xde,
-	0x79, 0xfc, 0x7f, 0x0e, 0xe0, 0xdb, 0xed, 0xee, 0xfb, 0x8d, 0x35, 0xdf, 0x3b, 0x75, 0xfa, 0x1a,
-	0xdb, 0x35, 0x29, 0x71, 0xf0, 0x7d, 0x21, 0x53, 0x76, 0xa3, 0xf7, 0x69, 0xc7, 0x66, 0x20, 0x87,
-	0xb8, 0x63, 0x44, 0xba, 0xbd, 0x87, 0x71, 0x35, 0x45, 0xd1, 0x64, 0xee, 0xa1, 0xe9, 0xdc, 0x43,
-	0xbf, 0xe7, 0x1e, 0xfa, 0xb6, 0xf0, 0xac, 0xe9, 0xc2, 0xb3, 0x7e, 0x2d, 0x3c, 0xeb, 0x53, 0x9f,
-	0x8b, 0xf2, 0xea, 0x4b, 0x12, 0x0c, 0x55, 0x1e, 0x66, 0x42, 0xb2, 0x30, 0x4b, 0xf2, 0x53, 0x48,
-	0xaf, 0xc3, 0x9b, 0xf5, 0x8b, 0x28, 0x6f, 0x47, 0x0c, 0x92, 0x8e, 0x7e, 0x07, 0x2f, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x4c, 0xc9, 0x17, 0x15, 0x87, 0x03, 0x00, 0x00,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xeb, 0x76, 0x14, 0x70, 0xba, 0x8b, 0x55, 0x46, 0x34, 0x41, 0x3a, 0x19, 0x86, 0x7a,
+	0x59, 0xa2, 0x8d, 0x1b, 0x88, 0x4b, 0x2e, 0x68, 0x42, 0x48, 0x28, 0x93, 0x38, 0x70, 0xa9, 0x9c,
+	0xc6, 0xf5, 0xac, 0x25, 0x76, 0xc9, 0xdf, 0x54, 0xdb, 0x2b, 0x70, 0xe2, 0xcc, 0x73, 0xf0, 0x10,
+	0x3b, 0xee, 0xc8, 0x69, 0x42, 0xed, 0x1b, 0x70, 0xe0, 0x8c, 0x66, 0xa7, 0x5b, 0x36, 0x35, 0x54,
+	0xdc, 0x62, 0xe9, 0xf7, 0x7d, 0x9f, 0xff, 0xdf, 0x3f, 0xc6, 0xbb, 0x63, 0x0d, 0x85, 0x86, 0x08,
+	0x72, 0x06, 0xc7, 0x52, 0x89, 0x68, 0xb6, 0x9f, 0x72, 0xc3, 0xf6, 0x23, 0xc1, 0x15, 0x07, 0x09,
+	0xe1, 0xb4, 0xd4, 0x46, 0x93, 0xc7, 0x0e, 0x0b, 0x97, 0x58, 0x58, 0x61, 0xdb, 0x7d, 0xa1, 0x85,
+	0xb6, 0x4c, 0x74, 0xf5, 0xe5, 0xf0, 0xed, 0x17, 0x4d, 0xae, 0xd7, 0x7a, 0xcb, 0xd1, 0x3f, 0x6d,
+	0xdc, 0x7b, 0xeb, 0x82, 0x8e, 0x0c, 0x33, 0x9c, 0xbc, 0xc1, 0xdd, 0x29, 0x2b, 0x59, 0x01, 0x3e,
+	0xda, 0x41, 0x43, 0xef, 0x60, 0x10, 0x36, 0x04, 0x87, 0x1f, 0x2c, 0x16, 0x6f, 0x9c, 0x5f, 0x0e,
+	0x5a, 0x49, 0x25, 0x22, 0x02, 0x6f, 0x82, 0x14, 0x4a, 0x2a, 0x31, 0x92, 0x6a, 0xa2, 0xc1, 0x6f,
+	0xef, 0x74, 0x86, 0xde, 0xc1, 0xf3, 0x46, 0x97, 0x23, 0x47, 0x1f, 0xaa, 0x89, 0x8e, 0x9f, 0x5c,
+	0x59, 0xfd, 0xbe, 0x1c, 0xf4, 0xcf, 0x58, 0x91, 0xbf, 0xa2, 0xb7, 0x8c, 0x68, 0xd2, 0x83, 0x1b,
+	0x14, 0xc8, 0x67, 0xbc, 0x59, 0x48, 0x00, 0x9e, 0x8d, 0xd2, 0x5c, 0x8f, 0x4f, 0xc0, 0xef, 0xd8,
+	0xa0, 0xb0, 0x31, 0xe8, 0x23, 0xcb, 0x65, 0xc6, 0x8c, 0x2e, 0xdf, 0x5b, 0x59, 0x6c, 0x55, 0x77,
+	0x23, 0x6f, 0x59, 0xd2, 0xa4, 0x57, 0xd4, 0x58, 0xf2, 0x0e, 0x7b, 0x52, 0x4d, 0x4a, 0x36, 0x36,
+	0x52, 0x2b, 0xf0, 0x37, 0x6c, 0xe0, 0xb3, 0xc6, 0xc0, 0xc3, 0x6b, 0xb6, 0xea, 0xa8, 0xae, 0xa6,
+	0x3f, 0x10, 0xf6, 0x6a, 0xb3, 0x13, 0x1f, 0xdf, 0x67, 0x59, 0x56, 0x72, 0x70, 0xc5, 0x3f, 0x4c,
+	0x96, 0x47, 0xf2, 0x15, 0xe1, 0xad, 0xd9, 0xf2, 0xf2, 0xa3, 0x7a, 0x29, 0x7e, 0xdb, 0xae, 0x68,
+	0x6f, 0xfd, 0xcc, 0xf5, 0x96, 0x77, 0xab, 0x91, 0x9f, 0xba, 0x91, 0x57, 0x5b, 0xd3, 0xa4, 0x3f,
+	0x5b, 0x21, 0xa6, 0xdf, 0x11, 0x7e, 0xb4, 0xb2, 0xc9, 0x7f, 0x0c, 0x20, 0xee, 0xae, 0x6a, 0xdd,
+	0x3f, 0x51, 0xf3, 0xfd, 0x9f, 0x05, 0xd1, 0xd7, 0xd8, 0xab, 0x49, 0x49, 0x1f, 0xdf, 0x93, 0x2a,
+	0xe3, 0xa7, 0xf6, 0x3e, 0x9d, 0xc4, 0x1d, 0xc8, 0x16, 0xee, 0x3a, 0x91, 0x6d, 0xef, 0x41, 0x52,
+	0x9d, 0xe2, 0xf8, 0x7c, 0x1e, 0xa0, 0x8b, 0x79, 0x80, 0x7e, 0xcd, 0x03, 0xf4, 0x6d, 0x11, 0xb4,
+	0x2e, 0x16, 0x41, 0xeb, 0xe7, 0x22, 0x68, 0x7d, 0x1a, 0x0a, 0x69, 0x8e, 0xbf, 0xa4, 0xe1, 0x58,
+	0x17, 0x51, 0x2e, 0x15, 0x8f, 0xf2, 0xb4, 0xd8, 0x83, 0xec, 0x24, 0x3a, 0xbd, 0x79, 0x5e, 0xe6,
+	0x6c, 0xca, 0x21, 0xed, 0xda, 0x47, 0xf5, 0xf2, 0xef, 0x00, 0xe4, 0xbe, 0xfd, 0x6c, 0xd4, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -317,6 +328,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -511,6 +536,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -699,6 +730,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, Infraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
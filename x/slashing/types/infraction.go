@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// NewInfraction creates a new Infraction instance
+func NewInfraction(
+	consAddr sdk.ConsAddress, infractionType InfractionType, height int64, infractionTime time.Time,
+	slashFraction sdk.Dec, tokensBurned sdk.Int, jailedUntil time.Time,
+) Infraction {
+	return Infraction{
+		Address:        consAddr.String(),
+		InfractionType: infractionType,
+		Height:         height,
+		Time:           infractionTime,
+		SlashFraction:  slashFraction,
+		TokensBurned:   tokensBurned,
+		JailedUntil:    jailedUntil,
+	}
+}
+
+// Validate performs a stateless validation of the infraction
+func (i Infraction) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(i.Address); err != nil {
+		return fmt.Errorf("invalid infraction address %s: %w", i.Address, err)
+	}
+
+	if _, ok := InfractionType_name[int32(i.InfractionType)]; !ok || i.InfractionType == InfractionEmpty {
+		return fmt.Errorf("invalid infraction type %s", i.InfractionType)
+	}
+
+	if i.Height < 0 {
+		return fmt.Errorf("infraction height cannot be negative, is %d", i.Height)
+	}
+
+	if i.SlashFraction.IsNil() || i.SlashFraction.IsNegative() || i.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("infraction slash fraction should be less than or equal to one and greater than zero, is %s", i.SlashFraction)
+	}
+
+	if i.TokensBurned.IsNil() || i.TokensBurned.IsNegative() {
+		return fmt.Errorf("infraction tokens burned cannot be negative, is %s", i.TokensBurned)
+	}
+
+	return nil
+}
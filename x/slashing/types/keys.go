@@ -30,10 +30,13 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><height_Bytes><infractionType_Byte>: Infraction
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	InfractionKeyPrefix                   = []byte{0x04} // Prefix for infraction history
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -67,3 +70,13 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// InfractionsPrefixKey - stored by *Consensus* address (not operator address)
+func InfractionsPrefixKey(v sdk.ConsAddress) []byte {
+	return append(InfractionKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// InfractionKey - stored by *Consensus* address (not operator address), ordered by height
+func InfractionKey(v sdk.ConsAddress, height int64, infractionType InfractionType) []byte {
+	return append(append(InfractionsPrefixKey(v), sdk.Uint64ToBigEndian(uint64(height))...), byte(infractionType))
+}
@@ -307,6 +307,116 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryInfractionsRequest is the request type for the Query/Infractions RPC
+// method
+type QueryInfractionsRequest struct {
+	// cons_address is the address to query the infraction history of
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionsRequest) Reset()         { *m = QueryInfractionsRequest{} }
+func (m *QueryInfractionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionsRequest) ProtoMessage()    {}
+func (*QueryInfractionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryInfractionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionsRequest.Merge(m, src)
+}
+func (m *QueryInfractionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionsRequest proto.InternalMessageInfo
+
+func (m *QueryInfractionsRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryInfractionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInfractionsResponse is the response type for the Query/Infractions RPC
+// method
+type QueryInfractionsResponse struct {
+	// infractions is the infraction history of the validator, ordered by height
+	Infractions []Infraction        `protobuf:"bytes,1,rep,name=infractions,proto3" json:"infractions"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionsResponse) Reset()         { *m = QueryInfractionsResponse{} }
+func (m *QueryInfractionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionsResponse) ProtoMessage()    {}
+func (*QueryInfractionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryInfractionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionsResponse.Merge(m, src)
+}
+func (m *QueryInfractionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionsResponse proto.InternalMessageInfo
+
+func (m *QueryInfractionsResponse) GetInfractions() []Infraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+func (m *QueryInfractionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -314,6 +424,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryInfractionsRequest)(nil), "cosmos.slashing.v1beta1.QueryInfractionsRequest")
+	proto.RegisterType((*QueryInfractionsResponse)(nil), "cosmos.slashing.v1beta1.QueryInfractionsResponse")
 }
 
 func init() {
@@ -321,40 +433,45 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x6d, 0x0d, 0x38, 0x29, 0x22, 0x63, 0xa1, 0x35, 0xc8, 0xc6, 0xae, 0x90, 0x06,
-	0xb5, 0x3b, 0x26, 0x22, 0x5e, 0xec, 0xc1, 0x1c, 0x2c, 0xde, 0x34, 0x8a, 0x07, 0x41, 0xc2, 0x6c,
-	0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0xfb, 0x36, 0xc1, 0x20, 0x5e, 0x3c, 0x7b, 0x10, 0xfc, 0x0c,
-	0x1e, 0x3d, 0xf8, 0x2d, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x09, 0xfc, 0x04, 0x92, 0x99,
-	0x49, 0xb2, 0x25, 0xae, 0xa6, 0xbd, 0x2d, 0x6f, 0xde, 0xff, 0xfd, 0x7f, 0x6f, 0xe6, 0xcf, 0xe2,
-	0x1b, 0x1d, 0x0d, 0x3d, 0x0d, 0x14, 0x22, 0x06, 0x87, 0x52, 0x09, 0x3a, 0xa8, 0x87, 0x3c, 0x65,
-	0x75, 0x7a, 0xd4, 0xe7, 0xc9, 0x30, 0x88, 0x13, 0x9d, 0x6a, 0xb2, 0x69, 0x9b, 0x82, 0x69, 0x53,
-	0xe0, 0x9a, 0xca, 0x37, 0x9d, 0x3a, 0x64, 0xc0, 0xad, 0x62, 0xa6, 0x8f, 0x99, 0x90, 0x8a, 0xa5,
-	0x52, 0x2b, 0x3b, 0xa4, 0xbc, 0x21, 0xb4, 0xd0, 0xe6, 0x93, 0x4e, 0xbe, 0x5c, 0xf5, 0x9a, 0xd0,
-	0x5a, 0x44, 0x9c, 0xb2, 0x58, 0x52, 0xa6, 0x94, 0x4e, 0x8d, 0x04, 0xdc, 0x69, 0x35, 0x8f, 0x6e,
-	0x46, 0x62, 0xfa, 0xfc, 0x0d, 0x4c, 0x9e, 0x4e, 0xdc, 0x9f, 0xb0, 0x84, 0xf5, 0xa0, 0xc5, 0x8f,
-	0xfa, 0x1c, 0x52, 0xff, 0x39, 0xbe, 0x72, 0xaa, 0x0a, 0xb1, 0x56, 0xc0, 0xc9, 0x1e, 0x2e, 0xc6,
-	0xa6, 0xb2, 0x85, 0xae, 0xa3, 0x5a, 0xa9, 0x51, 0x09, 0x72, 0xd6, 0x0b, 0xac, 0xb0, 0xb9, 0x76,
-	0xfc, 0xa3, 0x52, 0x68, 0x39, 0x91, 0xff, 0x00, 0x6f, 0x9a, 0xa9, 0xcf, 0xa4, 0x50, 0x52, 0x89,
-	0xc7, 0xea, 0x40, 0x3b, 0x43, 0xb2, 0x8d, 0xd7, 0x3b, 0x5a, 0x41, 0x9b, 0x75, 0xbb, 0x09, 0x07,
-	0x3b, 0xff, 0x62, 0xab, 0x34, 0xa9, 0x3d, 0xb4, 0x25, 0x7f, 0x88, 0xb7, 0x16, 0xd5, 0x0e, 0xec,
-	0x15, 0xbe, 0x3c, 0x60, 0x51, 0x1b, 0xec, 0x51, 0x5b, 0xaa, 0x03, 0xed, 0x10, 0x77, 0x73, 0x11,
-	0x5f, 0xb0, 0x48, 0x76, 0x59, 0xaa, 0x93, 0xcc, 0x40, 0x07, 0x7c, 0x69, 0xc0, 0xa2, 0x4c, 0xd5,
-	0x0f, 0x17, 0xad, 0xa7, 0x57, 0x45, 0x1e, 0x61, 0x3c, 0x7f, 0x30, 0x67, 0x5a, 0x9d, 0x9a, 0x4e,
-	0x5e, 0x37, 0xb0, 0x79, 0x98, 0xdf, 0x8c, 0xe0, 0x4e, 0xdb, 0xca, 0x28, 0xfd, 0x2f, 0x08, 0x5f,
-	0xfd, 0x8b, 0x89, 0x5b, 0x70, 0x1f, 0xaf, 0xb9, 0xa5, 0x56, 0xcf, 0xbb, 0x94, 0x19, 0x40, 0xf6,
-	0x4f, 0xe1, 0xae, 0x18, 0xdc, 0x9d, 0xff, 0xe2, 0x5a, 0x8a, 0x2c, 0x6f, 0xe3, 0xf7, 0x2a, 0xbe,
-	0x60, 0x78, 0xc9, 0x07, 0x84, 0x8b, 0xf6, 0xbd, 0xc9, 0xad, 0x5c, 0xb0, 0xc5, 0x90, 0x95, 0x6f,
-	0x2f, 0xd7, 0x6c, 0xbd, 0xfd, 0x9d, 0xf7, 0xdf, 0x7e, 0x7d, 0x5a, 0xd9, 0x26, 0x15, 0x9a, 0x97,
-	0x6c, 0x9b, 0x32, 0xf2, 0x15, 0xe1, 0x52, 0x66, 0x7b, 0x72, 0xe7, 0xdf, 0x36, 0x8b, 0x61, 0x2c,
-	0xd7, 0xcf, 0xa0, 0x70, 0x74, 0x7b, 0x86, 0xee, 0x3e, 0xb9, 0x97, 0x4b, 0x97, 0xcd, 0x26, 0xd0,
-	0xb7, 0xd9, 0xb4, 0xbf, 0x23, 0x9f, 0x11, 0x5e, 0xcf, 0xbe, 0x3b, 0x59, 0x1e, 0x61, 0x76, 0x9d,
-	0x8d, 0xb3, 0x48, 0x1c, 0x76, 0x60, 0xb0, 0x6b, 0xa4, 0xba, 0x1c, 0x76, 0xb3, 0x79, 0x3c, 0xf2,
-	0xd0, 0xc9, 0xc8, 0x43, 0x3f, 0x47, 0x1e, 0xfa, 0x38, 0xf6, 0x0a, 0x27, 0x63, 0xaf, 0xf0, 0x7d,
-	0xec, 0x15, 0x5e, 0xd6, 0x84, 0x4c, 0x0f, 0xfb, 0x61, 0xd0, 0xd1, 0x3d, 0x1a, 0x49, 0xc5, 0x69,
-	0x14, 0xf6, 0x76, 0xa1, 0xfb, 0x9a, 0xbe, 0x99, 0xcf, 0x4c, 0x87, 0x31, 0x87, 0xb0, 0x68, 0x7e,
-	0x3c, 0x77, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8b, 0xab, 0xfc, 0xbc, 0x40, 0x05, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0xb5, 0x2d, 0x38, 0x29, 0x22, 0x63, 0xa1, 0x31, 0xc8, 0xc6, 0x6e, 0x21, 0x0d,
+	0x6a, 0x77, 0x4c, 0x4a, 0xf1, 0xa0, 0x3d, 0x98, 0x43, 0x4b, 0xf1, 0xa2, 0x51, 0x3c, 0x08, 0x12,
+	0x66, 0x93, 0xc9, 0x76, 0x70, 0x33, 0xb3, 0xdd, 0xd9, 0x04, 0x83, 0x78, 0x11, 0x8f, 0x1e, 0x04,
+	0xbf, 0x82, 0x1e, 0x45, 0xfd, 0x16, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x49, 0xfc, 0x20, 0x92, 0x99,
+	0x49, 0xb2, 0x71, 0xbb, 0x36, 0x29, 0xde, 0x96, 0x37, 0xef, 0xff, 0xde, 0x6f, 0xfe, 0xf3, 0x5e,
+	0x02, 0x37, 0x1a, 0x42, 0xb6, 0x85, 0xc4, 0xd2, 0x27, 0xf2, 0x90, 0x71, 0x0f, 0x77, 0xcb, 0x2e,
+	0x8d, 0x48, 0x19, 0x1f, 0x75, 0x68, 0xd8, 0x73, 0x82, 0x50, 0x44, 0x02, 0xad, 0xe9, 0x24, 0x67,
+	0x94, 0xe4, 0x98, 0xa4, 0xfc, 0x0d, 0xa3, 0x76, 0x89, 0xa4, 0x5a, 0x31, 0xd6, 0x07, 0xc4, 0x63,
+	0x9c, 0x44, 0x4c, 0x70, 0x5d, 0x24, 0xbf, 0xea, 0x09, 0x4f, 0xa8, 0x4f, 0x3c, 0xfc, 0x32, 0xd1,
+	0x6b, 0x9e, 0x10, 0x9e, 0x4f, 0x31, 0x09, 0x18, 0x26, 0x9c, 0x8b, 0x48, 0x49, 0xa4, 0x39, 0x2d,
+	0xa6, 0xd1, 0x8d, 0x49, 0x54, 0x9e, 0xbd, 0x0a, 0xd1, 0xa3, 0x61, 0xf7, 0x87, 0x24, 0x24, 0x6d,
+	0x59, 0xa3, 0x47, 0x1d, 0x2a, 0x23, 0xfb, 0x09, 0xbc, 0x32, 0x15, 0x95, 0x81, 0xe0, 0x92, 0xa2,
+	0x5d, 0xb8, 0x1c, 0xa8, 0x48, 0x0e, 0x5c, 0x07, 0xa5, 0x6c, 0xa5, 0xe0, 0xa4, 0x5c, 0xcf, 0xd1,
+	0xc2, 0xea, 0xe2, 0xf1, 0xcf, 0x42, 0xa6, 0x66, 0x44, 0xf6, 0x3d, 0xb8, 0xa6, 0xaa, 0x3e, 0x66,
+	0x1e, 0x67, 0xdc, 0x3b, 0xe0, 0x2d, 0x61, 0x1a, 0xa2, 0x75, 0xb8, 0xd2, 0x10, 0x5c, 0xd6, 0x49,
+	0xb3, 0x19, 0x52, 0xa9, 0xeb, 0x5f, 0xac, 0x65, 0x87, 0xb1, 0xfb, 0x3a, 0x64, 0xf7, 0x60, 0x2e,
+	0xa9, 0x36, 0x60, 0xcf, 0xe1, 0xe5, 0x2e, 0xf1, 0xeb, 0x52, 0x1f, 0xd5, 0x19, 0x6f, 0x09, 0x83,
+	0xb8, 0x95, 0x8a, 0xf8, 0x94, 0xf8, 0xac, 0x49, 0x22, 0x11, 0xc6, 0x0a, 0x1a, 0xe0, 0x4b, 0x5d,
+	0xe2, 0xc7, 0xa2, 0xb6, 0x9b, 0x6c, 0x3d, 0xb2, 0x0a, 0xed, 0x41, 0x38, 0x79, 0x30, 0xd3, 0xb4,
+	0x38, 0x6a, 0x3a, 0x7c, 0x5d, 0x47, 0xcf, 0xc3, 0xc4, 0x19, 0x8f, 0x1a, 0x6d, 0x2d, 0xa6, 0xb4,
+	0x3f, 0x03, 0x78, 0xf5, 0x94, 0x26, 0xe6, 0x82, 0xfb, 0x70, 0xd1, 0x5c, 0xea, 0xc2, 0x79, 0x2f,
+	0xa5, 0x0a, 0xa0, 0xfd, 0x29, 0xdc, 0x05, 0x85, 0xbb, 0x79, 0x26, 0xae, 0xa6, 0x98, 0xe2, 0x7d,
+	0x0b, 0xcc, 0x6b, 0x1e, 0xf0, 0x56, 0x48, 0x1a, 0xc3, 0x98, 0x9c, 0xfd, 0x35, 0xd1, 0xde, 0x29,
+	0x1c, 0xe7, 0xb1, 0xed, 0x2b, 0x80, 0xb9, 0x24, 0x86, 0x71, 0xed, 0x01, 0xcc, 0xb2, 0x49, 0xd8,
+	0x98, 0xb7, 0x91, 0x6a, 0xde, 0xa4, 0x84, 0xb1, 0x2c, 0xae, 0xfe, 0x6f, 0xce, 0x55, 0x3e, 0x2e,
+	0xc1, 0x25, 0x85, 0x8c, 0xde, 0x01, 0xb8, 0xac, 0x37, 0x05, 0xdd, 0x4c, 0xa5, 0x4a, 0xae, 0x67,
+	0xfe, 0xd6, 0x6c, 0xc9, 0xba, 0xb7, 0xbd, 0xf9, 0xe6, 0xfb, 0xef, 0x0f, 0x0b, 0xeb, 0xa8, 0x80,
+	0xd3, 0x7e, 0x13, 0xf4, 0x7e, 0xa2, 0x6f, 0x00, 0x66, 0x63, 0x73, 0x83, 0x6e, 0xff, 0xbb, 0x4d,
+	0x72, 0x8d, 0xf3, 0xe5, 0x39, 0x14, 0x86, 0x6e, 0x57, 0xd1, 0xdd, 0x41, 0x3b, 0xa9, 0x74, 0xf1,
+	0xad, 0x96, 0xf8, 0x55, 0x7c, 0xb2, 0x5e, 0xa3, 0x4f, 0x00, 0xae, 0xc4, 0xca, 0x4a, 0x34, 0x3b,
+	0xc2, 0xd8, 0xce, 0xca, 0x3c, 0x12, 0x83, 0xed, 0x28, 0xec, 0x12, 0x2a, 0xce, 0x86, 0x8d, 0xbe,
+	0x00, 0x98, 0x8d, 0x8d, 0xe8, 0x59, 0xde, 0x26, 0x97, 0x2a, 0x5f, 0x9e, 0x43, 0x61, 0x20, 0xef,
+	0x2a, 0xc8, 0x1d, 0xb4, 0x9d, 0x0a, 0x19, 0x1b, 0xf0, 0xbf, 0x9c, 0xad, 0x56, 0x8f, 0xfb, 0x16,
+	0x38, 0xe9, 0x5b, 0xe0, 0x57, 0xdf, 0x02, 0xef, 0x07, 0x56, 0xe6, 0x64, 0x60, 0x65, 0x7e, 0x0c,
+	0xac, 0xcc, 0xb3, 0x92, 0xc7, 0xa2, 0xc3, 0x8e, 0xeb, 0x34, 0x44, 0x1b, 0xfb, 0x8c, 0x53, 0xec,
+	0xbb, 0xed, 0x2d, 0xd9, 0x7c, 0x81, 0x5f, 0x4e, 0x1a, 0x44, 0xbd, 0x80, 0x4a, 0x77, 0x59, 0xfd,
+	0xc9, 0x6c, 0xff, 0x19, 0x00, 0x2d, 0xe7, 0xc4, 0x84, 0x2c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -375,6 +492,8 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// Infractions queries the infraction history of given cons address
+	Infractions(ctx context.Context, in *QueryInfractionsRequest, opts ...grpc.CallOption) (*QueryInfractionsResponse, error)
 }
 
 type queryClient struct {
@@ -412,6 +531,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) Infractions(ctx context.Context, in *QueryInfractionsRequest, opts ...grpc.CallOption) (*QueryInfractionsResponse, error) {
+	out := new(QueryInfractionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/Infractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -420,6 +548,8 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// Infractions queries the infraction history of given cons address
+	Infractions(context.Context, *QueryInfractionsRequest) (*QueryInfractionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -435,6 +565,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) Infractions(ctx context.Context, req *QueryInfractionsRequest) (*QueryInfractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Infractions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -494,6 +627,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Infractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInfractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Infractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/Infractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Infractions(ctx, req.(*QueryInfractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -510,6 +661,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "Infractions",
+			Handler:    _Query_Infractions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -718,6 +873,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInfractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInfractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInfractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInfractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInfractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInfractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -805,6 +1051,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInfractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInfractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1315,6 +1597,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInfractionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfractionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInfractionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfractionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, Infraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
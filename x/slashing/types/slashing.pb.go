@@ -29,6 +29,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InfractionType enumerates the types of infractions a validator is punished for.
+type InfractionType int32
+
+const (
+	// INFRACTION_TYPE_UNSPECIFIED defines a no-op infraction type.
+	InfractionEmpty InfractionType = 0
+	// INFRACTION_TYPE_DOUBLE_SIGN defines a validator signing two blocks at the same height.
+	InfractionDoubleSign InfractionType = 1
+	// INFRACTION_TYPE_DOWNTIME defines a validator missing too many blocks in the signed blocks window.
+	InfractionDowntime InfractionType = 2
+)
+
+var InfractionType_name = map[int32]string{
+	0: "INFRACTION_TYPE_UNSPECIFIED",
+	1: "INFRACTION_TYPE_DOUBLE_SIGN",
+	2: "INFRACTION_TYPE_DOWNTIME",
+}
+
+var InfractionType_value = map[string]int32{
+	"INFRACTION_TYPE_UNSPECIFIED": 0,
+	"INFRACTION_TYPE_DOUBLE_SIGN": 1,
+	"INFRACTION_TYPE_DOWNTIME":    2,
+}
+
+func (x InfractionType) String() string {
+	return proto.EnumName(InfractionType_name, int32(x))
+}
+
+func (InfractionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -123,6 +155,92 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+// Infraction records an infraction of a validator and the punishment for it.
+type Infraction struct {
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// infraction_type is the type of the infraction.
+	InfractionType InfractionType `protobuf:"varint,2,opt,name=infraction_type,json=infractionType,proto3,enum=cosmos.slashing.v1beta1.InfractionType" json:"infraction_type,omitempty" yaml:"infraction_type"`
+	// height is the height at which the infraction was committed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time at which the infraction was committed.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// slash_fraction is the fraction of the stake slashed for the infraction.
+	SlashFraction github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	// tokens_burned is the amount of the validator tokens burned for the infraction.
+	TokensBurned github_com_line_lbm_sdk_types.Int `protobuf:"bytes,6,opt,name=tokens_burned,json=tokensBurned,proto3,customtype=github.com/line/lbm-sdk/types.Int" json:"tokens_burned" yaml:"tokens_burned"`
+	// jailed_until is the time until which the validator is jailed for the infraction.
+	JailedUntil time.Time `protobuf:"bytes,7,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until" yaml:"jailed_until"`
+}
+
+func (m *Infraction) Reset()         { *m = Infraction{} }
+func (m *Infraction) String() string { return proto.CompactTextString(m) }
+func (*Infraction) ProtoMessage()    {}
+func (*Infraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *Infraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Infraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Infraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Infraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Infraction.Merge(m, src)
+}
+func (m *Infraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Infraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Infraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Infraction proto.InternalMessageInfo
+
+func (m *Infraction) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Infraction) GetInfractionType() InfractionType {
+	if m != nil {
+		return m.InfractionType
+	}
+	return InfractionEmpty
+}
+
+func (m *Infraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Infraction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Infraction) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                             `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
@@ -136,7 +254,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +298,9 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.InfractionType", InfractionType_name, InfractionType_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*Infraction)(nil), "cosmos.slashing.v1beta1.Infraction")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -189,47 +309,63 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0xcd, 0x91, 0x52, 0xca, 0x25, 0x93, 0x9b, 0x12, 0x13, 0xc0, 0x4e, 0x3d, 0xa0, 0x30, 0x60,
-	0xab, 0x65, 0xeb, 0x68, 0xaa, 0x8a, 0x82, 0x04, 0xc5, 0x2d, 0x20, 0x31, 0x60, 0x9d, 0x73, 0x17,
-	0xe7, 0xa8, 0x7d, 0x17, 0xf9, 0x2e, 0xb4, 0x45, 0x6c, 0x2c, 0x48, 0x2c, 0x1d, 0x3b, 0x76, 0xe4,
-	0xa3, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0x58, 0xfb, 0x09, 0x90, 0xef, 0xec, 0x36, 0x6a,
-	0xd3, 0xa1, 0x5b, 0x7e, 0xef, 0xbd, 0x7b, 0xbf, 0xbf, 0x31, 0x7c, 0xd8, 0xe5, 0x22, 0xe5, 0xc2,
-	0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xd3, 0x52, 0x44, 0x24, 0x5a, 0x3a, 0x03, 0xdc, 0x41,
-	0xc6, 0x25, 0x37, 0x9a, 0x5a, 0xe7, 0x9e, 0xc1, 0x85, 0xae, 0xd5, 0x88, 0x79, 0xcc, 0x95, 0xc6,
-	0xcb, 0x7f, 0x69, 0x79, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6, 0x3c, 0x3c,
-	0xcc, 0x90, 0xa4, 0x9c, 0x15, 0xbc, 0x7d, 0x91, 0x97, 0x34, 0x25, 0x42, 0xa2, 0x74, 0xa0, 0x05,
-	0xce, 0xb7, 0x2a, 0x6c, 0xbc, 0x45, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x93, 0xc6, 0x8c, 0xb2, 0x78,
-	0x9d, 0xf5, 0xb8, 0x61, 0xc2, 0x5b, 0x08, 0xe3, 0x8c, 0x08, 0x61, 0x82, 0x36, 0xe8, 0xdc, 0x0e,
-	0xca, 0xd0, 0x58, 0x81, 0x75, 0x21, 0x51, 0x26, 0xc3, 0x3e, 0xa1, 0x71, 0x5f, 0x9a, 0x37, 0xda,
-	0xa0, 0x53, 0xf5, 0x9b, 0xa7, 0x23, 0x7b, 0x7e, 0x0f, 0xa5, 0xc9, 0x8a, 0x33, 0xc9, 0x3a, 0x41,
-	0x4d, 0x85, 0xcf, 0x54, 0x94, 0xbf, 0xa5, 0x0c, 0x93, 0xdd, 0x90, 0xf7, 0x7a, 0x82, 0x48, 0xb3,
-	0x7a, 0xf1, 0xed, 0x24, 0xeb, 0x04, 0x35, 0x15, 0xbe, 0x52, 0x91, 0xf1, 0x01, 0xd6, 0x3f, 0x22,
-	0x9a, 0x10, 0x1c, 0x0e, 0x99, 0xa4, 0x89, 0x39, 0xd3, 0x06, 0x9d, 0xda, 0x72, 0xcb, 0xd5, 0x2d,
-	0xba, 0x65, 0x8b, 0xee, 0x56, 0xd9, 0xa2, 0x6f, 0x1f, 0x8d, 0xec, 0xca, 0xb9, 0xf7, 0xe4, 0x6b,
-	0x67, 0xff, 0xb7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe4, 0x88, 0x61, 0x41, 0x28, 0x79, 0x1a, 0x09,
-	0xc9, 0x19, 0xc1, 0xe6, 0xcd, 0x36, 0xe8, 0xcc, 0x05, 0x13, 0x88, 0xb1, 0x05, 0x17, 0x52, 0x2a,
-	0x04, 0xc1, 0x61, 0x94, 0xf0, 0xee, 0xb6, 0x08, 0xbb, 0x7c, 0xc8, 0x24, 0xc9, 0xcc, 0x59, 0xd5,
-	0x44, 0xfb, 0x74, 0x64, 0xdf, 0xd7, 0x89, 0xa6, 0xca, 0x9c, 0x60, 0x5e, 0xe3, 0xbe, 0x82, 0x9f,
-	0x6a, 0x74, 0x65, 0xee, 0xe0, 0xd0, 0xae, 0xfc, 0x3d, 0xb4, 0x81, 0xf3, 0x6f, 0x06, 0xce, 0x6e,
-	0xa0, 0x0c, 0xa5, 0xc2, 0x78, 0x0d, 0x1b, 0x82, 0xc6, 0xec, 0xdc, 0x63, 0x87, 0x32, 0xcc, 0x77,
-	0xd4, 0x26, 0xaa, 0xbe, 0x7d, 0x3a, 0xb2, 0xef, 0x15, 0xa3, 0x9e, 0xa2, 0x72, 0x02, 0x43, 0xc3,
-	0x3a, 0xd1, 0x3b, 0x05, 0x1a, 0x5f, 0xf2, 0xea, 0x59, 0x58, 0x3c, 0x18, 0x90, 0xac, 0xf4, 0xcc,
-	0xd7, 0x57, 0xf7, 0xd7, 0xf3, 0x51, 0xfd, 0x1a, 0xd9, 0x8b, 0x31, 0x95, 0xfd, 0x61, 0xe4, 0x76,
-	0x79, 0xea, 0x25, 0x94, 0x11, 0x2f, 0x89, 0xd2, 0xc7, 0x02, 0x6f, 0x7b, 0x72, 0x6f, 0x40, 0x84,
-	0xbb, 0x4a, 0xba, 0x93, 0x6d, 0x4e, 0xf1, 0x73, 0x02, 0x23, 0xa5, 0x6c, 0x53, 0xc1, 0x1b, 0x24,
-	0x2b, 0xb2, 0x7f, 0x86, 0x77, 0x30, 0xdf, 0x61, 0xf9, 0xf5, 0x85, 0xf9, 0xcc, 0xc3, 0xf2, 0x4e,
-	0xd5, 0x05, 0xd4, 0x96, 0xef, 0x5e, 0xda, 0xe2, 0x6a, 0x21, 0xf0, 0x1f, 0x15, 0x4b, 0x7c, 0xa0,
-	0x93, 0x4e, 0xb7, 0x71, 0x0e, 0xf2, 0x75, 0x36, 0x4a, 0xf2, 0x39, 0xa2, 0x49, 0x69, 0x60, 0x7c,
-	0x07, 0xb0, 0xa5, 0xfe, 0x4e, 0x61, 0x2f, 0x43, 0xdd, 0x1c, 0x0a, 0x31, 0x1f, 0x46, 0x09, 0x51,
-	0xc5, 0xab, 0x33, 0xaa, 0xfb, 0x2f, 0xaf, 0xd3, 0xff, 0x62, 0x31, 0xfc, 0x2b, 0x4d, 0x9d, 0xa0,
-	0xa9, 0xc8, 0xb5, 0x82, 0x5b, 0x55, 0x54, 0x3e, 0x14, 0xe3, 0x2b, 0x80, 0xcd, 0x4b, 0x0f, 0x75,
-	0xd5, 0xea, 0xe6, 0xea, 0xfe, 0x8b, 0xeb, 0x94, 0x62, 0x5d, 0x51, 0x8a, 0x76, 0x74, 0x82, 0x85,
-	0x0b, 0x75, 0x68, 0xdc, 0x5f, 0xfb, 0x31, 0xb6, 0xc0, 0xd1, 0xd8, 0x02, 0xc7, 0x63, 0x0b, 0xfc,
-	0x19, 0x5b, 0x60, 0xff, 0xc4, 0xaa, 0x1c, 0x9f, 0x58, 0x95, 0x9f, 0x27, 0x56, 0xe5, 0x7d, 0xe7,
-	0xaa, 0xcc, 0xbb, 0xe7, 0xdf, 0x2f, 0x55, 0x44, 0x34, 0xab, 0xf6, 0xf5, 0xe4, 0x7f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x43, 0xaf, 0x8f, 0x07, 0xdf, 0x04, 0x00, 0x00,
+	// 896 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x73, 0xdb, 0x44,
+	0x18, 0xb6, 0x1a, 0xe3, 0xa6, 0x6b, 0x37, 0xcd, 0x6c, 0x9c, 0x44, 0xa8, 0x20, 0xb9, 0x3a, 0x80,
+	0x61, 0x06, 0x79, 0x1a, 0x7a, 0x80, 0xdc, 0xaa, 0xd8, 0x01, 0xf1, 0xe1, 0x04, 0xc5, 0xa1, 0x03,
+	0x07, 0x34, 0x92, 0xb5, 0x56, 0x96, 0x48, 0xbb, 0x1e, 0xed, 0x9a, 0x34, 0x0c, 0x37, 0x2e, 0x9d,
+	0x70, 0xe9, 0xb1, 0x1c, 0x32, 0xd3, 0x19, 0x2e, 0xfc, 0x09, 0xee, 0x3d, 0xf6, 0xc8, 0x70, 0x30,
+	0x4c, 0x72, 0x81, 0xab, 0x7f, 0x01, 0xa3, 0x95, 0x14, 0xdb, 0xb1, 0xc3, 0x34, 0x33, 0xdc, 0xfc,
+	0x3e, 0xef, 0xf7, 0xf3, 0x3e, 0x5a, 0x83, 0xb7, 0xba, 0x94, 0x45, 0x94, 0x35, 0x58, 0xe8, 0xb2,
+	0x03, 0x4c, 0x82, 0xc6, 0x77, 0xf7, 0x3d, 0xc4, 0xdd, 0xfb, 0x17, 0x80, 0xd1, 0x8f, 0x29, 0xa7,
+	0x70, 0x3d, 0x8d, 0x33, 0x2e, 0xe0, 0x2c, 0x4e, 0xa9, 0x06, 0x34, 0xa0, 0x22, 0xa6, 0x91, 0xfc,
+	0x4a, 0xc3, 0x15, 0x35, 0xa0, 0x34, 0x08, 0x51, 0x43, 0x58, 0xde, 0xa0, 0xd7, 0xf0, 0x07, 0xb1,
+	0xcb, 0x31, 0x25, 0x99, 0x5f, 0xbb, 0xec, 0xe7, 0x38, 0x42, 0x8c, 0xbb, 0x51, 0x3f, 0x0d, 0xd0,
+	0x9f, 0x2c, 0x80, 0xea, 0x97, 0x6e, 0x88, 0x7d, 0x97, 0xd3, 0x78, 0x0f, 0x07, 0x04, 0x93, 0xc0,
+	0x22, 0x3d, 0x0a, 0x65, 0x70, 0xd3, 0xf5, 0xfd, 0x18, 0x31, 0x26, 0x4b, 0x35, 0xa9, 0x7e, 0xcb,
+	0xce, 0x4d, 0xb8, 0x09, 0x2a, 0x8c, 0xbb, 0x31, 0x77, 0x0e, 0x10, 0x0e, 0x0e, 0xb8, 0x7c, 0xa3,
+	0x26, 0xd5, 0x17, 0xcc, 0xf5, 0xd1, 0x50, 0x5b, 0x39, 0x76, 0xa3, 0x70, 0x53, 0x9f, 0xf4, 0xea,
+	0x76, 0x59, 0x98, 0x1f, 0x0b, 0x2b, 0xc9, 0xc5, 0xc4, 0x47, 0x8f, 0x1d, 0xda, 0xeb, 0x31, 0xc4,
+	0xe5, 0x85, 0xcb, 0xb9, 0x93, 0x5e, 0xdd, 0x2e, 0x0b, 0x73, 0x47, 0x58, 0xf0, 0x1b, 0x50, 0xf9,
+	0xd6, 0xc5, 0x21, 0xf2, 0x9d, 0x01, 0xe1, 0x38, 0x94, 0x8b, 0x35, 0xa9, 0x5e, 0xde, 0x50, 0x8c,
+	0x74, 0x45, 0x23, 0x5f, 0xd1, 0xe8, 0xe4, 0x2b, 0x9a, 0xda, 0x8b, 0xa1, 0x56, 0x18, 0xd7, 0x9e,
+	0xcc, 0xd6, 0x9f, 0xfe, 0xa9, 0x49, 0x76, 0x39, 0x85, 0xf6, 0x13, 0x04, 0xaa, 0x00, 0x70, 0x1a,
+	0x79, 0x8c, 0x53, 0x82, 0x7c, 0xf9, 0xb5, 0x9a, 0x54, 0x5f, 0xb4, 0x27, 0x10, 0xd8, 0x01, 0xab,
+	0x11, 0x66, 0x0c, 0xf9, 0x8e, 0x17, 0xd2, 0xee, 0x21, 0x73, 0xba, 0x74, 0x40, 0x38, 0x8a, 0xe5,
+	0x92, 0x58, 0xa2, 0x36, 0x1a, 0x6a, 0x6f, 0xa4, 0x8d, 0xe6, 0x86, 0xe9, 0xf6, 0x4a, 0x8a, 0x9b,
+	0x02, 0xde, 0x4a, 0xd1, 0xcd, 0xc5, 0x67, 0xcf, 0xb5, 0xc2, 0xdf, 0xcf, 0x35, 0x49, 0xff, 0xb9,
+	0x08, 0x80, 0x45, 0x7a, 0xb1, 0xdb, 0x4d, 0x0e, 0xf8, 0x1f, 0x07, 0x08, 0xc1, 0x1d, 0x7c, 0x11,
+	0xe7, 0xf0, 0xe3, 0x3e, 0x12, 0x37, 0x58, 0xda, 0x78, 0xdb, 0xb8, 0x42, 0x3d, 0xc6, 0xb8, 0x6e,
+	0xe7, 0xb8, 0x8f, 0x4c, 0x65, 0x34, 0xd4, 0xd6, 0x72, 0xc2, 0xa7, 0x2a, 0xe9, 0xf6, 0x12, 0x9e,
+	0x8a, 0x85, 0x6b, 0xa0, 0x94, 0x1d, 0x5a, 0x1c, 0xcb, 0xce, 0x2c, 0xf8, 0x01, 0x28, 0x26, 0x62,
+	0x7a, 0x85, 0x33, 0x2c, 0x26, 0x67, 0x10, 0x7c, 0x8b, 0x0c, 0x88, 0xc1, 0x92, 0x18, 0xd0, 0xc9,
+	0xfb, 0x08, 0xb2, 0x6f, 0x99, 0x66, 0x12, 0xf7, 0xc7, 0x50, 0xbb, 0x17, 0x60, 0x7e, 0x30, 0xf0,
+	0x8c, 0x2e, 0x8d, 0x1a, 0x21, 0x26, 0xa8, 0x11, 0x7a, 0xd1, 0x7b, 0xcc, 0x3f, 0x6c, 0x24, 0x03,
+	0x32, 0xa3, 0x89, 0xba, 0xa3, 0xa1, 0xb6, 0x9a, 0x69, 0x6d, 0xaa, 0x90, 0x6e, 0xdf, 0x16, 0xc0,
+	0x76, 0x4e, 0x62, 0x0f, 0xdc, 0xe6, 0xf4, 0x10, 0x11, 0xe6, 0x78, 0x83, 0x38, 0x39, 0x6b, 0x49,
+	0x74, 0x7a, 0xf8, 0x6a, 0x9d, 0x2c, 0xc2, 0x47, 0x43, 0xad, 0x9a, 0x76, 0x9a, 0xaa, 0xa3, 0xdb,
+	0x95, 0xd4, 0x36, 0x85, 0x39, 0xa3, 0xcd, 0x9b, 0xff, 0xaf, 0x36, 0xf5, 0x7f, 0x8a, 0xa0, 0xb4,
+	0xeb, 0xc6, 0x6e, 0xc4, 0xe0, 0x17, 0xa0, 0xca, 0x70, 0x40, 0xc6, 0xfa, 0x3a, 0xc2, 0xc4, 0xa7,
+	0x47, 0x42, 0x24, 0x0b, 0xa6, 0x36, 0x1a, 0x6a, 0x77, 0x33, 0x6a, 0xe6, 0x44, 0xe9, 0x36, 0x4c,
+	0xe1, 0x54, 0x84, 0x8f, 0x04, 0x08, 0x7f, 0x48, 0x94, 0x4d, 0x9c, 0x2c, 0xa1, 0x8f, 0xe2, 0xbc,
+	0x66, 0x22, 0xab, 0x8a, 0x69, 0x5d, 0xe7, 0x2e, 0x17, 0x9f, 0xc0, 0x9c, 0x7a, 0xba, 0x0d, 0x23,
+	0x4c, 0xf6, 0x04, 0xbc, 0x8b, 0xe2, 0xac, 0xfb, 0xf7, 0x60, 0xcd, 0xa7, 0x47, 0x24, 0x91, 0x86,
+	0x93, 0xec, 0xec, 0xe4, 0x6f, 0x98, 0x10, 0x5c, 0x79, 0xe3, 0xf5, 0x19, 0x16, 0x9b, 0x59, 0x80,
+	0xf9, 0x4e, 0x46, 0xe2, 0x9b, 0x69, 0xd3, 0xf9, 0x65, 0xf4, 0x67, 0x09, 0x9d, 0xd5, 0xdc, 0xf9,
+	0x89, 0x8b, 0xc3, 0xbc, 0x00, 0xfc, 0x49, 0x02, 0xca, 0xb4, 0x84, 0x1c, 0x9f, 0x0e, 0xbc, 0x10,
+	0x89, 0xe1, 0x85, 0xb6, 0x2b, 0x66, 0xfb, 0x3a, 0xfb, 0xdf, 0x9b, 0xa7, 0xcb, 0xc9, 0xa2, 0xba,
+	0xbd, 0x3e, 0xa5, 0xd1, 0xa6, 0x70, 0x25, 0xa4, 0xc0, 0x1f, 0x25, 0xb0, 0x3e, 0x93, 0x98, 0x4e,
+	0x2d, 0x3e, 0x91, 0x8a, 0xf9, 0xe9, 0x75, 0x46, 0x51, 0xaf, 0x18, 0x25, 0xad, 0xa8, 0xdb, 0xab,
+	0x97, 0xe6, 0x48, 0xf1, 0x77, 0x7f, 0x93, 0xc0, 0xd2, 0xf4, 0x7b, 0x01, 0x1f, 0x80, 0xbb, 0x56,
+	0x7b, 0xdb, 0x7e, 0xb8, 0xd5, 0xb1, 0x76, 0xda, 0x4e, 0xe7, 0xab, 0xdd, 0x96, 0xb3, 0xdf, 0xde,
+	0xdb, 0x6d, 0x6d, 0x59, 0xdb, 0x56, 0xab, 0xb9, 0x5c, 0x50, 0x56, 0x4e, 0x4e, 0x6b, 0x77, 0xc6,
+	0x49, 0xad, 0xa8, 0xcf, 0x8f, 0xe1, 0x87, 0xb3, 0x59, 0xcd, 0x9d, 0x7d, 0xf3, 0xb3, 0x96, 0xb3,
+	0x67, 0x7d, 0xd4, 0x5e, 0x96, 0x14, 0xf9, 0xe4, 0xb4, 0x56, 0x1d, 0x67, 0x4d, 0x30, 0xf1, 0x00,
+	0xc8, 0xb3, 0xa9, 0x8f, 0xda, 0x1d, 0xeb, 0xf3, 0xd6, 0xf2, 0x0d, 0x65, 0xed, 0xe4, 0xb4, 0x06,
+	0x27, 0xf3, 0xd2, 0xc9, 0x95, 0xe2, 0x93, 0x5f, 0xd4, 0x82, 0xb9, 0xfd, 0xeb, 0x99, 0x2a, 0xbd,
+	0x38, 0x53, 0xa5, 0x97, 0x67, 0xaa, 0xf4, 0xd7, 0x99, 0x2a, 0x3d, 0x3d, 0x57, 0x0b, 0x2f, 0xcf,
+	0xd5, 0xc2, 0xef, 0xe7, 0x6a, 0xe1, 0xeb, 0xfa, 0x55, 0xcc, 0x3d, 0x1e, 0xff, 0x37, 0x0b, 0x12,
+	0xbd, 0x92, 0xd0, 0xdb, 0xfb, 0xff, 0x0e, 0x00, 0x6d, 0xcb, 0xae, 0xcf, 0xbb, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -271,6 +407,48 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Infraction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Infraction)
+	if !ok {
+		that2, ok := that.(Infraction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.InfractionType != that1.InfractionType {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if !this.TokensBurned.Equal(that1.TokensBurned) {
+		return false
+	}
+	if !this.JailedUntil.Equal(that1.JailedUntil) {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -370,6 +548,82 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Infraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Infraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Infraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TokensBurned.Size()
+		i -= size
+		if _, err := m.TokensBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InfractionType != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -410,12 +664,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -474,6 +728,33 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *Infraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.InfractionType != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionType))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.TokensBurned.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -692,6 +973,260 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Infraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Infraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Infraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionType", wireType)
+			}
+			m.InfractionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionType |= InfractionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package token
 
 import (
 	sdk "github.com/line/lbm-sdk/types"
+	auth "github.com/line/lbm-sdk/x/auth/types"
 )
 
 type (
@@ -13,4 +14,15 @@ type (
 		InitGenesis(ctx sdk.Context, data *ClassGenesisState)
 		ExportGenesis(ctx sdk.Context) *ClassGenesisState
 	}
+
+	// AccountKeeper defines the contract needed for AccountKeeper related APIs.
+	// Interface provides support to use non-sdk AccountKeeper for the simulation.
+	AccountKeeper interface {
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.AccountI
+	}
+
+	// BankKeeper defines the expected interface needed to pay the fees in the simulation.
+	BankKeeper interface {
+		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	}
 )
@@ -6,7 +6,7 @@ import (
 )
 
 // iterate through the balances of a contract and perform the provided function
-func (k Keeper) iterateContractBalances(ctx sdk.Context, contractID string, fn func(balance token.Balance) (stop bool)) {
+func (k Keeper) IterateContractBalances(ctx sdk.Context, contractID string, fn func(balance token.Balance) (stop bool)) {
 	k.iterateBalancesImpl(ctx, balanceKeyPrefixByContractID(contractID), func(_ string, balance token.Balance) (stop bool) {
 		return fn(balance)
 	})
@@ -38,10 +38,10 @@ func (k Keeper) iterateBalancesImpl(ctx sdk.Context, prefix []byte, fn func(cont
 }
 
 // iterate through the classes and perform the provided function
-func (k Keeper) iterateClasses(ctx sdk.Context, fn func(class token.Contract) (stop bool)) {
+func (k Keeper) IterateClasses(ctx sdk.Context, fn func(class token.Contract) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, classKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, ClassKeyPrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -55,7 +55,7 @@ func (k Keeper) iterateClasses(ctx sdk.Context, fn func(class token.Contract) (s
 	}
 }
 
-func (k Keeper) iterateContractGrants(ctx sdk.Context, contractID string, fn func(grant token.Grant) (stop bool)) {
+func (k Keeper) IterateContractGrants(ctx sdk.Context, contractID string, fn func(grant token.Grant) (stop bool)) {
 	k.iterateGrantsImpl(ctx, grantKeyPrefixByContractID(contractID), func(_ string, grant token.Grant) (stop bool) {
 		return fn(grant)
 	})
@@ -81,7 +81,7 @@ func (k Keeper) iterateGrantsImpl(ctx sdk.Context, prefix []byte, fn func(contra
 	}
 }
 
-func (k Keeper) iterateContractAuthorizations(ctx sdk.Context, contractID string, fn func(authorization token.Authorization) (stop bool)) {
+func (k Keeper) IterateContractAuthorizations(ctx sdk.Context, contractID string, fn func(authorization token.Authorization) (stop bool)) {
 	k.iterateAuthorizationsImpl(ctx, authorizationKeyPrefixByContractID(contractID), func(_ string, authorization token.Authorization) (stop bool) {
 		return fn(authorization)
 	})
@@ -129,13 +129,13 @@ func (k Keeper) iterateStatistics(ctx sdk.Context, prefix []byte, fn func(contra
 }
 
 func (k Keeper) iterateSupplies(ctx sdk.Context, fn func(contractID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatistics(ctx, supplyKeyPrefix, fn)
+	k.iterateStatistics(ctx, SupplyKeyPrefix, fn)
 }
 
 func (k Keeper) iterateMinteds(ctx sdk.Context, fn func(contractID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatistics(ctx, mintKeyPrefix, fn)
+	k.iterateStatistics(ctx, MintKeyPrefix, fn)
 }
 
 func (k Keeper) iterateBurnts(ctx sdk.Context, fn func(contractID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatistics(ctx, burnKeyPrefix, fn)
+	k.iterateStatistics(ctx, BurnKeyPrefix, fn)
 }
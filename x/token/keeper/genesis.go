@@ -67,7 +67,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *token.GenesisState) {
 // ExportGenesis returns a GenesisState for a given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 	var classes []token.Contract
-	k.iterateClasses(ctx, func(class token.Contract) (stop bool) {
+	k.IterateClasses(ctx, func(class token.Contract) (stop bool) {
 		classes = append(classes, class)
 		return false
 	})
@@ -79,7 +79,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 			ContractId: id,
 		}
 
-		k.iterateContractBalances(ctx, id, func(balance token.Balance) (stop bool) {
+		k.IterateContractBalances(ctx, id, func(balance token.Balance) (stop bool) {
 			contractBalances.Balances = append(contractBalances.Balances, balance)
 			return false
 		})
@@ -125,7 +125,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 			ContractId: id,
 		}
 
-		k.iterateContractGrants(ctx, id, func(grant token.Grant) (stop bool) {
+		k.IterateContractGrants(ctx, id, func(grant token.Grant) (stop bool) {
 			contractGrants.Grants = append(contractGrants.Grants, grant)
 			return false
 		})
@@ -141,7 +141,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 			ContractId: id,
 		}
 
-		k.iterateContractAuthorizations(ctx, id, func(authorization token.Authorization) (stop bool) {
+		k.IterateContractAuthorizations(ctx, id, func(authorization token.Authorization) (stop bool) {
 			contractAuthorizations.Authorizations = append(contractAuthorizations.Authorizations, authorization)
 			return false
 		})
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/token"
+)
+
+const (
+	totalSupplyInvariant   = "total-supply"
+	authorizationInvariant = "authorizations"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for name, invariant := range map[string]func(k Keeper) sdk.Invariant{
+		totalSupplyInvariant:   TotalSupplyInvariant,
+		authorizationInvariant: AuthorizationInvariant,
+	} {
+		ir.RegisterRoute(token.ModuleName, name, invariant(k))
+	}
+}
+
+// TotalSupplyInvariant checks that the supply of each contract equals to
+// the amount of minted tokens minus burnt ones, and to the sum of the balances.
+func TotalSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// cache, we don't want to write changes
+		ctx, _ = ctx.CacheContext()
+
+		msg := ""
+		broken := false
+
+		k.IterateClasses(ctx, func(class token.Contract) (stop bool) {
+			supply := k.GetSupply(ctx, class.Id)
+			minted := k.GetMinted(ctx, class.Id)
+			burnt := k.GetBurnt(ctx, class.Id)
+			if expected := minted.Sub(burnt); !supply.Equal(expected) {
+				msg += fmt.Sprintf("supply of %s; expected %s (minted %s - burnt %s), got %s\n", class.Id, expected, minted, burnt, supply)
+				broken = true
+			}
+
+			sum := sdk.ZeroInt()
+			k.IterateContractBalances(ctx, class.Id, func(balance token.Balance) (stop bool) {
+				sum = sum.Add(balance.Amount)
+				return false
+			})
+			if !supply.Equal(sum) {
+				msg += fmt.Sprintf("sum of the balances of %s; expected %s, got %s\n", class.Id, supply, sum)
+				broken = true
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(token.ModuleName, totalSupplyInvariant, msg), broken
+	}
+}
+
+// AuthorizationInvariant checks that all the operator authorizations
+// reference existing contracts.
+func AuthorizationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// cache, we don't want to write changes
+		ctx, _ = ctx.CacheContext()
+
+		msg := ""
+		broken := false
+
+		k.iterateAuthorizationsImpl(ctx, AuthorizationKeyPrefix, func(contractID string, authorization token.Authorization) (stop bool) {
+			if _, err := k.GetClass(ctx, contractID); err != nil {
+				msg += fmt.Sprintf("authorization of %s by %s on non-existent contract %s\n", authorization.Operator, authorization.Holder, contractID)
+				broken = true
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(token.ModuleName, authorizationInvariant, msg), broken
+	}
+}
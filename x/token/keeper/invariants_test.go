@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/token/keeper"
+)
+
+func (s *KeeperTestSuite) TestTotalSupplyInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"minted differs from the supply plus burnt": {
+			malleate: func(ctx sdk.Context) {
+				minted := s.keeper.GetMinted(ctx, s.contractID)
+				s.keeper.InitGenesis(ctx, &token.GenesisState{
+					Mints: []token.ContractCoin{{
+						ContractId: s.contractID,
+						Amount:     minted.Add(sdk.OneInt()),
+					}},
+				})
+			},
+		},
+		"sum of the balances differs from the supply": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &token.GenesisState{
+					Balances: []token.ContractBalances{{
+						ContractId: s.contractID,
+						Balances: []token.Balance{{
+							Address: s.stranger.String(),
+							Amount:  sdk.OneInt(),
+						}},
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.TotalSupplyInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAuthorizationInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"authorization on a non-existent contract": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &token.GenesisState{
+					Authorizations: []token.ContractAuthorizations{{
+						ContractId: "deadbeef",
+						Authorizations: []token.Authorization{{
+							Holder:   s.customer.String(),
+							Operator: s.operator.String(),
+						}},
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.AuthorizationInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
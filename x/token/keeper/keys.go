@@ -6,21 +6,21 @@ import (
 )
 
 var (
-	balanceKeyPrefix       = []byte{0x00}
-	classKeyPrefix         = []byte{0x01}
-	grantKeyPrefix         = []byte{0x02}
-	authorizationKeyPrefix = []byte{0x03}
+	BalanceKeyPrefix       = []byte{0x00}
+	ClassKeyPrefix         = []byte{0x01}
+	GrantKeyPrefix         = []byte{0x02}
+	AuthorizationKeyPrefix = []byte{0x03}
 
 	// statistics keys
-	supplyKeyPrefix = []byte{0x04}
-	mintKeyPrefix   = []byte{0x05}
-	burnKeyPrefix   = []byte{0x06}
+	SupplyKeyPrefix = []byte{0x04}
+	MintKeyPrefix   = []byte{0x05}
+	BurnKeyPrefix   = []byte{0x06}
 )
 
 func classKey(id string) []byte {
-	key := make([]byte, len(classKeyPrefix)+len(id))
-	copy(key, classKeyPrefix)
-	copy(key[len(classKeyPrefix):], id)
+	key := make([]byte, len(ClassKeyPrefix)+len(id))
+	copy(key, ClassKeyPrefix)
+	copy(key[len(ClassKeyPrefix):], id)
 	return key
 }
 
@@ -35,12 +35,12 @@ func balanceKey(contractID string, address sdk.AccAddress) []byte {
 }
 
 func balanceKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(balanceKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(BalanceKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, balanceKeyPrefix)
+	copy(key, BalanceKeyPrefix)
 
-	begin += len(balanceKeyPrefix)
+	begin += len(BalanceKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -50,7 +50,7 @@ func balanceKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitBalanceKey(key []byte) (contractID string, address sdk.AccAddress) {
-	begin := len(balanceKeyPrefix) + 1
+	begin := len(BalanceKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -68,15 +68,15 @@ func statisticsKey(keyPrefix []byte, contractID string) []byte {
 }
 
 // func supplyKey(contractID string) []byte {
-// 	return statisticsKey(supplyKeyPrefix, contractID)
+// 	return statisticsKey(SupplyKeyPrefix, contractID)
 // }
 
 // func mintKey(contractID string) []byte {
-// 	return statisticsKey(mintKeyPrefix, contractID)
+// 	return statisticsKey(MintKeyPrefix, contractID)
 // }
 
 // func burnKey(contractID string) []byte {
-// 	return statisticsKey(burnKeyPrefix, contractID)
+// 	return statisticsKey(BurnKeyPrefix, contractID)
 // }
 
 func splitStatisticsKey(key, keyPrefix []byte) (contractID string) {
@@ -84,15 +84,15 @@ func splitStatisticsKey(key, keyPrefix []byte) (contractID string) {
 }
 
 // func splitSupplyKey(key []byte) (contractID string) {
-// 	return splitStatisticsKey(key, supplyKeyPrefix)
+// 	return splitStatisticsKey(key, SupplyKeyPrefix)
 // }
 
 // func splitMintKey(key []byte) (contractID string) {
-// 	return splitStatisticsKey(key, mintKeyPrefix)
+// 	return splitStatisticsKey(key, MintKeyPrefix)
 // }
 
 // func splitBurnKey(key []byte) (contractID string) {
-// 	return splitStatisticsKey(key, burnKeyPrefix)
+// 	return splitStatisticsKey(key, BurnKeyPrefix)
 // }
 
 func grantKey(contractID string, grantee sdk.AccAddress, permission token.Permission) []byte {
@@ -122,12 +122,12 @@ func grantKeyPrefixByGrantee(contractID string, grantee sdk.AccAddress) []byte {
 }
 
 func grantKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(grantKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(GrantKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, grantKeyPrefix)
+	copy(key, GrantKeyPrefix)
 
-	begin += len(grantKeyPrefix)
+	begin += len(GrantKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -137,7 +137,7 @@ func grantKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitGrantKey(key []byte) (contractID string, grantee sdk.AccAddress, permission token.Permission) {
-	begin := len(grantKeyPrefix) + 1
+	begin := len(GrantKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -178,12 +178,12 @@ func authorizationKeyPrefixByOperator(contractID string, operator sdk.AccAddress
 }
 
 func authorizationKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(authorizationKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(AuthorizationKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, authorizationKeyPrefix)
+	copy(key, AuthorizationKeyPrefix)
 
-	begin += len(authorizationKeyPrefix)
+	begin += len(AuthorizationKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -193,7 +193,7 @@ func authorizationKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitAuthorizationKey(key []byte) (contractID string, operator, holder sdk.AccAddress) {
-	begin := len(authorizationKeyPrefix) + 1
+	begin := len(AuthorizationKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
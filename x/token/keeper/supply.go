@@ -242,27 +242,27 @@ func (k Keeper) setStatistics(ctx sdk.Context, contractID string, amount sdk.Int
 }
 
 func (k Keeper) GetSupply(ctx sdk.Context, contractID string) sdk.Int {
-	return k.getStatistics(ctx, contractID, supplyKeyPrefix)
+	return k.getStatistics(ctx, contractID, SupplyKeyPrefix)
 }
 
 func (k Keeper) GetMinted(ctx sdk.Context, contractID string) sdk.Int {
-	return k.getStatistics(ctx, contractID, mintKeyPrefix)
+	return k.getStatistics(ctx, contractID, MintKeyPrefix)
 }
 
 func (k Keeper) GetBurnt(ctx sdk.Context, contractID string) sdk.Int {
-	return k.getStatistics(ctx, contractID, burnKeyPrefix)
+	return k.getStatistics(ctx, contractID, BurnKeyPrefix)
 }
 
 func (k Keeper) setSupply(ctx sdk.Context, contractID string, amount sdk.Int) {
-	k.setStatistics(ctx, contractID, amount, supplyKeyPrefix)
+	k.setStatistics(ctx, contractID, amount, SupplyKeyPrefix)
 }
 
 func (k Keeper) setMinted(ctx sdk.Context, contractID string, amount sdk.Int) {
-	k.setStatistics(ctx, contractID, amount, mintKeyPrefix)
+	k.setStatistics(ctx, contractID, amount, MintKeyPrefix)
 }
 
 func (k Keeper) setBurnt(ctx sdk.Context, contractID string, amount sdk.Int) {
-	k.setStatistics(ctx, contractID, amount, burnKeyPrefix)
+	k.setStatistics(ctx, contractID, amount, BurnKeyPrefix)
 }
 
 func (k Keeper) Modify(ctx sdk.Context, contractID string, grantee sdk.AccAddress, changes []token.Attribute) error {
@@ -0,0 +1,44 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/kv"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/token/keeper"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding token type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], keeper.ClassKeyPrefix):
+			var classA, classB token.Contract
+			cdc.MustUnmarshal(kvA.Value, &classA)
+			cdc.MustUnmarshal(kvB.Value, &classB)
+			return fmt.Sprintf("%v\n%v", classA, classB)
+		case bytes.Equal(kvA.Key[:1], keeper.BalanceKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.SupplyKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.MintKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.BurnKeyPrefix):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+		case bytes.Equal(kvA.Key[:1], keeper.GrantKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.AuthorizationKeyPrefix):
+			// the keys carry all the information
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		default:
+			panic(fmt.Sprintf("invalid token key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/kv"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/token/keeper"
+	"github.com/line/lbm-sdk/x/token/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	class := token.Contract{
+		Id:       "deadbeef",
+		Name:     "test",
+		Symbol:   "TT",
+		Decimals: 8,
+		Mintable: true,
+	}
+	classBz, err := cdc.Marshal(&class)
+	require.NoError(t, err)
+
+	amount := sdk.NewInt(100)
+	amountBz, err := amount.Marshal()
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: keeper.ClassKeyPrefix, Value: classBz},
+			{Key: keeper.BalanceKeyPrefix, Value: amountBz},
+			{Key: keeper.SupplyKeyPrefix, Value: amountBz},
+			{Key: keeper.GrantKeyPrefix, Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Class", fmt.Sprintf("%v\n%v", class, class)},
+		{"Balance", fmt.Sprintf("%v\n%v", amount, amount)},
+		{"Supply", fmt.Sprintf("%v\n%v", amount, amount)},
+		{"Grant", fmt.Sprintf("%v\n%v", []byte{}, []byte{})},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/module"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
+	"github.com/line/lbm-sdk/x/token"
+)
+
+// Simulation parameter constants
+const (
+	numContracts = "num_contracts"
+)
+
+// genNumContracts returns a random number of the contracts in genesis.
+func genNumContracts(r *rand.Rand) int {
+	return r.Intn(5)
+}
+
+// genSymbol returns a random symbol which matches `[A-Z][A-Z0-9]{1,4}`.
+func genSymbol(r *rand.Rand) string {
+	const (
+		letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		digits  = "0123456789"
+	)
+
+	symbol := []byte{letters[r.Intn(len(letters))]}
+	for i := 0; i < 1+r.Intn(4); i++ {
+		charset := letters + digits
+		symbol = append(symbol, charset[r.Intn(len(charset))])
+	}
+	return string(symbol)
+}
+
+// genContract returns a contract of random values.
+func genContract(r *rand.Rand, id string) token.Contract {
+	return token.Contract{
+		Id:       id,
+		Name:     simtypes.RandStringOfLength(r, 1+r.Intn(20)),
+		Symbol:   genSymbol(r),
+		Uri:      simtypes.RandStringOfLength(r, r.Intn(100)),
+		Meta:     simtypes.RandStringOfLength(r, r.Intn(100)),
+		Decimals: int32(r.Intn(19)),
+		Mintable: r.Intn(2) == 0,
+	}
+}
+
+// genTokenGenesis returns a consistent genesis of the given number of the
+// contracts, held by the accounts.
+func genTokenGenesis(r *rand.Rand, accounts []simtypes.Account, n int) token.GenesisState {
+	genesis := token.GenesisState{
+		ClassState: token.DefaultClassGenesisState(),
+	}
+
+	seen := map[string]bool{}
+	for len(genesis.Classes) < n {
+		id := fmt.Sprintf("%08x", r.Uint32())
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		contract := genContract(r, id)
+		genesis.ClassState.Ids = append(genesis.ClassState.Ids, id)
+		genesis.Classes = append(genesis.Classes, contract)
+
+		// the owner of the contract
+		owner := accounts[r.Intn(len(accounts))]
+		permissions := []token.Permission{token.PermissionModify}
+		if contract.Mintable {
+			permissions = append(permissions, token.PermissionMint, token.PermissionBurn)
+		}
+		contractGrants := token.ContractGrants{ContractId: id}
+		for _, permission := range permissions {
+			contractGrants.Grants = append(contractGrants.Grants, token.Grant{
+				Grantee:    owner.Address.String(),
+				Permission: permission,
+			})
+		}
+		genesis.Grants = append(genesis.Grants, contractGrants)
+
+		// the holders of the tokens
+		supply := sdk.ZeroInt()
+		contractBalances := token.ContractBalances{ContractId: id}
+		holders := r.Perm(len(accounts))[:1+r.Intn(len(accounts))]
+		for _, i := range holders {
+			amount := sdk.NewInt(1 + r.Int63n(1000000))
+			contractBalances.Balances = append(contractBalances.Balances, token.Balance{
+				Address: accounts[i].Address.String(),
+				Amount:  amount,
+			})
+			supply = supply.Add(amount)
+		}
+		genesis.Balances = append(genesis.Balances, contractBalances)
+		genesis.Supplies = append(genesis.Supplies, token.ContractCoin{ContractId: id, Amount: supply})
+		genesis.Mints = append(genesis.Mints, token.ContractCoin{ContractId: id, Amount: supply})
+
+		// the operators of the holders
+		contractAuthorizations := token.ContractAuthorizations{ContractId: id}
+		for _, i := range holders {
+			operator := accounts[r.Intn(len(accounts))]
+			if operator.Address.Equals(accounts[i].Address) || r.Intn(2) == 0 {
+				continue
+			}
+			contractAuthorizations.Authorizations = append(contractAuthorizations.Authorizations, token.Authorization{
+				Holder:   accounts[i].Address.String(),
+				Operator: operator.Address.String(),
+			})
+		}
+		if len(contractAuthorizations.Authorizations) != 0 {
+			genesis.Authorizations = append(genesis.Authorizations, contractAuthorizations)
+		}
+	}
+
+	return genesis
+}
+
+// RandomizedGenState generates a random GenesisState for token
+func RandomizedGenState(simState *module.SimulationState) {
+	var n int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, numContracts, &n, simState.Rand,
+		func(r *rand.Rand) { n = genNumContracts(r) },
+	)
+
+	tokenGenesis := genTokenGenesis(simState.Rand, simState.Accounts, n)
+	bz, err := simState.Cdc.MarshalJSON(&tokenGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	simState.GenState[token.ModuleName] = bz
+}
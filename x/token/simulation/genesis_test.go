@@ -0,0 +1,45 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/types/module"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/token/simulation"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	app := simapp.Setup(false)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := simtypes.RandomAccounts(r, 3)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          app.AppCodec(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     accounts,
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+	simState.AppParams["num_contracts"] = json.RawMessage("3")
+
+	simulation.RandomizedGenState(&simState)
+	var tokenGenesis token.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[token.ModuleName], &tokenGenesis)
+
+	require.NoError(t, token.ValidateGenesis(tokenGenesis))
+	require.Len(t, tokenGenesis.Classes, 3)
+	require.Len(t, tokenGenesis.ClassState.Ids, 3)
+	require.Len(t, tokenGenesis.Balances, 3)
+	require.Len(t, tokenGenesis.Supplies, 3)
+}
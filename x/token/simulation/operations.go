@@ -0,0 +1,617 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/codec"
+	simappparams "github.com/line/lbm-sdk/simapp/params"
+	sdk "github.com/line/lbm-sdk/types"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
+	"github.com/line/lbm-sdk/x/simulation"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/token/keeper"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgIssue             = "op_weight_msg_token_issue"
+	OpWeightMsgSend              = "op_weight_msg_token_send"
+	OpWeightMsgOperatorSend      = "op_weight_msg_token_operator_send"
+	OpWeightMsgAuthorizeOperator = "op_weight_msg_token_authorize_operator"
+	OpWeightMsgRevokeOperator    = "op_weight_msg_token_revoke_operator"
+	OpWeightMsgGrantPermission   = "op_weight_msg_token_grant_permission"
+	OpWeightMsgRevokePermission  = "op_weight_msg_token_revoke_permission"
+	OpWeightMsgMint              = "op_weight_msg_token_mint"
+	OpWeightMsgBurn              = "op_weight_msg_token_burn"
+	OpWeightMsgOperatorBurn      = "op_weight_msg_token_operator_burn"
+	OpWeightMsgModify            = "op_weight_msg_token_modify"
+)
+
+var (
+	TypeMsgIssue             = sdk.MsgTypeURL(&token.MsgIssue{})
+	TypeMsgSend              = sdk.MsgTypeURL(&token.MsgSend{})
+	TypeMsgOperatorSend      = sdk.MsgTypeURL(&token.MsgOperatorSend{})
+	TypeMsgAuthorizeOperator = sdk.MsgTypeURL(&token.MsgAuthorizeOperator{})
+	TypeMsgRevokeOperator    = sdk.MsgTypeURL(&token.MsgRevokeOperator{})
+	TypeMsgGrantPermission   = sdk.MsgTypeURL(&token.MsgGrantPermission{})
+	TypeMsgRevokePermission  = sdk.MsgTypeURL(&token.MsgRevokePermission{})
+	TypeMsgMint              = sdk.MsgTypeURL(&token.MsgMint{})
+	TypeMsgBurn              = sdk.MsgTypeURL(&token.MsgBurn{})
+	TypeMsgOperatorBurn      = sdk.MsgTypeURL(&token.MsgOperatorBurn{})
+	TypeMsgModify            = sdk.MsgTypeURL(&token.MsgModify{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgIssue             int
+		weightMsgSend              int
+		weightMsgOperatorSend      int
+		weightMsgAuthorizeOperator int
+		weightMsgRevokeOperator    int
+		weightMsgGrantPermission   int
+		weightMsgRevokePermission  int
+		weightMsgMint              int
+		weightMsgBurn              int
+		weightMsgOperatorBurn      int
+		weightMsgModify            int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgIssue, &weightMsgIssue, nil,
+		func(_ *rand.Rand) {
+			weightMsgIssue = simappparams.DefaultWeightMsgTokenIssue
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgSend = simappparams.DefaultWeightMsgTokenSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorSend, &weightMsgOperatorSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorSend = simappparams.DefaultWeightMsgTokenOperatorSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAuthorizeOperator, &weightMsgAuthorizeOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgAuthorizeOperator = simappparams.DefaultWeightMsgTokenAuthorizeOperator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeOperator, &weightMsgRevokeOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeOperator = simappparams.DefaultWeightMsgTokenRevokeOperator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantPermission, &weightMsgGrantPermission, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantPermission = simappparams.DefaultWeightMsgTokenGrantPermission
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokePermission, &weightMsgRevokePermission, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokePermission = simappparams.DefaultWeightMsgTokenRevokePermission
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = simappparams.DefaultWeightMsgTokenMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = simappparams.DefaultWeightMsgTokenBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorBurn, &weightMsgOperatorBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorBurn = simappparams.DefaultWeightMsgTokenOperatorBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgModify, &weightMsgModify, nil,
+		func(_ *rand.Rand) {
+			weightMsgModify = simappparams.DefaultWeightMsgTokenModify
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgIssue,
+			SimulateMsgIssue(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSend,
+			SimulateMsgSend(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorSend,
+			SimulateMsgOperatorSend(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAuthorizeOperator,
+			SimulateMsgAuthorizeOperator(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeOperator,
+			SimulateMsgRevokeOperator(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgGrantPermission,
+			SimulateMsgGrantPermission(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokePermission,
+			SimulateMsgRevokePermission(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorBurn,
+			SimulateMsgOperatorBurn(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgModify,
+			SimulateMsgModify(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgIssue generates a MsgIssue with random values.
+func SimulateMsgIssue(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		contract := genContract(r, "")
+
+		msg := &token.MsgIssue{
+			Name:     contract.Name,
+			Symbol:   contract.Symbol,
+			Uri:      contract.Uri,
+			Meta:     contract.Meta,
+			Decimals: contract.Decimals,
+			Mintable: contract.Mintable,
+			Owner:    owner.Address.String(),
+			To:       to.Address.String(),
+			Amount:   sdk.NewInt(1 + r.Int63n(1000000)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg, TypeMsgIssue)
+	}
+}
+
+// SimulateMsgSend generates a MsgSend with random values.
+func SimulateMsgSend(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSend, "no contracts"), nil, nil
+		}
+
+		from, balance, ok := randomHolder(r, ctx, k, contract.Id, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSend, "no holders"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &token.MsgSend{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randomAmount(r, balance),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgSend)
+	}
+}
+
+// SimulateMsgOperatorSend generates a MsgOperatorSend with random values.
+func SimulateMsgOperatorSend(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorSend, "no contracts"), nil, nil
+		}
+
+		holder, operator, ok := randomAuthorization(r, ctx, k, contract.Id, accs, func(holder sdk.AccAddress) bool {
+			return k.GetBalance(ctx, contract.Id, holder).IsPositive()
+		})
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorSend, "no authorizations"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &token.MsgOperatorSend{
+			ContractId: contract.Id,
+			Operator:   operator.Address.String(),
+			From:       holder.String(),
+			To:         to.Address.String(),
+			Amount:     randomAmount(r, k.GetBalance(ctx, contract.Id, holder)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, TypeMsgOperatorSend)
+	}
+}
+
+// SimulateMsgAuthorizeOperator generates a MsgAuthorizeOperator with random values.
+func SimulateMsgAuthorizeOperator(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgAuthorizeOperator, "no contracts"), nil, nil
+		}
+
+		holder, _ := simtypes.RandomAcc(r, accs)
+		operator, _ := simtypes.RandomAcc(r, accs)
+		if holder.Address.Equals(operator.Address) {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgAuthorizeOperator, "holder and operator cannot be same"), nil, nil
+		}
+		if _, err := k.GetAuthorization(ctx, contract.Id, holder.Address, operator.Address); err == nil {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgAuthorizeOperator, "authorization exists"), nil, nil
+		}
+
+		msg := &token.MsgAuthorizeOperator{
+			ContractId: contract.Id,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, holder, msg, TypeMsgAuthorizeOperator)
+	}
+}
+
+// SimulateMsgRevokeOperator generates a MsgRevokeOperator with random values.
+func SimulateMsgRevokeOperator(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokeOperator, "no contracts"), nil, nil
+		}
+
+		holderAddr, operator, ok := randomAuthorization(r, ctx, k, contract.Id, accs, func(holder sdk.AccAddress) bool {
+			_, found := simtypes.FindAccount(accs, holder)
+			return found
+		})
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokeOperator, "no authorizations"), nil, nil
+		}
+		holder, _ := simtypes.FindAccount(accs, holderAddr)
+
+		msg := &token.MsgRevokeOperator{
+			ContractId: contract.Id,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, holder, msg, TypeMsgRevokeOperator)
+	}
+}
+
+// SimulateMsgGrantPermission generates a MsgGrantPermission with random values.
+func SimulateMsgGrantPermission(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgGrantPermission, "no contracts"), nil, nil
+		}
+
+		permission := randomPermission(r)
+		granter, ok := randomGrantee(r, ctx, k, contract.Id, permission, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgGrantPermission, "no grants"), nil, nil
+		}
+		grantee, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &token.MsgGrantPermission{
+			ContractId: contract.Id,
+			From:       granter.Address.String(),
+			To:         grantee.Address.String(),
+			Permission: token.LegacyPermission(permission).String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, granter, msg, TypeMsgGrantPermission)
+	}
+}
+
+// SimulateMsgRevokePermission generates a MsgRevokePermission with random values.
+func SimulateMsgRevokePermission(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokePermission, "no contracts"), nil, nil
+		}
+
+		permission := randomPermission(r)
+		grantee, ok := randomGrantee(r, ctx, k, contract.Id, permission, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokePermission, "no grants"), nil, nil
+		}
+
+		msg := &token.MsgRevokePermission{
+			ContractId: contract.Id,
+			From:       grantee.Address.String(),
+			Permission: token.LegacyPermission(permission).String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, TypeMsgRevokePermission)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMint, "no contracts"), nil, nil
+		}
+
+		grantee, ok := randomGrantee(r, ctx, k, contract.Id, token.PermissionMint, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMint, "no grants"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &token.MsgMint{
+			ContractId: contract.Id,
+			From:       grantee.Address.String(),
+			To:         to.Address.String(),
+			Amount:     sdk.NewInt(1 + r.Int63n(1000000)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, TypeMsgMint)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgBurn, "no contracts"), nil, nil
+		}
+
+		from, ok := randomGrantee(r, ctx, k, contract.Id, token.PermissionBurn, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgBurn, "no grants"), nil, nil
+		}
+		balance := k.GetBalance(ctx, contract.Id, from.Address)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgBurn, "no balance"), nil, nil
+		}
+
+		msg := &token.MsgBurn{
+			ContractId: contract.Id,
+			From:       from.Address.String(),
+			Amount:     randomAmount(r, balance),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, TypeMsgBurn)
+	}
+}
+
+// SimulateMsgOperatorBurn generates a MsgOperatorBurn with random values.
+func SimulateMsgOperatorBurn(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorBurn, "no contracts"), nil, nil
+		}
+
+		holder, operator, ok := randomAuthorization(r, ctx, k, contract.Id, accs, func(holder sdk.AccAddress) bool {
+			return k.GetBalance(ctx, contract.Id, holder).IsPositive()
+		})
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorBurn, "no authorizations"), nil, nil
+		}
+		if _, err := k.GetGrant(ctx, contract.Id, operator.Address, token.PermissionBurn); err != nil {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorBurn, "no grants"), nil, nil
+		}
+
+		msg := &token.MsgOperatorBurn{
+			ContractId: contract.Id,
+			Operator:   operator.Address.String(),
+			From:       holder.String(),
+			Amount:     randomAmount(r, k.GetBalance(ctx, contract.Id, holder)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, TypeMsgOperatorBurn)
+	}
+}
+
+// SimulateMsgModify generates a MsgModify with random values.
+func SimulateMsgModify(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, ok := randomContract(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgModify, "no contracts"), nil, nil
+		}
+
+		grantee, ok := randomGrantee(r, ctx, k, contract.Id, token.PermissionModify, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgModify, "no grants"), nil, nil
+		}
+
+		modified := genContract(r, contract.Id)
+		candidates := []token.Attribute{
+			{Key: token.AttributeKeyName.String(), Value: modified.Name},
+			{Key: token.AttributeKeyURI.String(), Value: modified.Uri},
+			{Key: token.AttributeKeyMeta.String(), Value: modified.Meta},
+		}
+		var changes []token.Attribute
+		for _, i := range r.Perm(len(candidates))[:1+r.Intn(len(candidates))] {
+			changes = append(changes, candidates[i])
+		}
+
+		msg := &token.MsgModify{
+			ContractId: contract.Id,
+			Owner:      grantee.Address.String(),
+			Changes:    changes,
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, TypeMsgModify)
+	}
+}
+
+// deliver generates and delivers a tx of the msg. The msg is run on a cached
+// context first, so the operation would be a no-op if the msg fails.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak token.AccountKeeper, bk token.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return simtypes.NoOpMsg(token.ModuleName, msgType, err.Error()), nil, nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if _, err := app.MsgServiceRouter().Handler(msg)(cacheCtx, msg); err != nil {
+		return simtypes.NoOpMsg(token.ModuleName, msgType, err.Error()), nil, nil
+	}
+
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      token.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+func randomContract(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (*token.Contract, bool) {
+	var contracts []token.Contract
+	k.IterateClasses(ctx, func(class token.Contract) (stop bool) {
+		contracts = append(contracts, class)
+		return false
+	})
+	if len(contracts) == 0 {
+		return nil, false
+	}
+
+	return &contracts[r.Intn(len(contracts))], true
+}
+
+// randomHolder returns a random simulation account which holds the tokens of the contract.
+func randomHolder(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, accs []simtypes.Account) (simtypes.Account, sdk.Int, bool) {
+	var holders []simtypes.Account
+	var balances []sdk.Int
+	k.IterateContractBalances(ctx, contractID, func(balance token.Balance) (stop bool) {
+		if acc, ok := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(balance.Address)); ok {
+			holders = append(holders, acc)
+			balances = append(balances, balance.Amount)
+		}
+		return false
+	})
+	if len(holders) == 0 {
+		return simtypes.Account{}, sdk.ZeroInt(), false
+	}
+
+	i := r.Intn(len(holders))
+	return holders[i], balances[i], true
+}
+
+// randomGrantee returns a random simulation account which has the permission on the contract.
+func randomGrantee(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, permission token.Permission, accs []simtypes.Account) (simtypes.Account, bool) {
+	var grantees []simtypes.Account
+	k.IterateContractGrants(ctx, contractID, func(grant token.Grant) (stop bool) {
+		if grant.Permission != permission {
+			return false
+		}
+		if acc, ok := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(grant.Grantee)); ok {
+			grantees = append(grantees, acc)
+		}
+		return false
+	})
+	if len(grantees) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	return grantees[r.Intn(len(grantees))], true
+}
+
+// randomAuthorization returns a random authorization on the contract, of which
+// the operator is a simulation account and the holder satisfies the filter.
+func randomAuthorization(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, accs []simtypes.Account, filter func(holder sdk.AccAddress) bool) (sdk.AccAddress, simtypes.Account, bool) {
+	var holders []sdk.AccAddress
+	var operators []simtypes.Account
+	k.IterateContractAuthorizations(ctx, contractID, func(authorization token.Authorization) (stop bool) {
+		holder := sdk.MustAccAddressFromBech32(authorization.Holder)
+		if !filter(holder) {
+			return false
+		}
+		if acc, ok := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authorization.Operator)); ok {
+			holders = append(holders, holder)
+			operators = append(operators, acc)
+		}
+		return false
+	})
+	if len(operators) == 0 {
+		return nil, simtypes.Account{}, false
+	}
+
+	i := r.Intn(len(operators))
+	return holders[i], operators[i], true
+}
+
+func randomPermission(r *rand.Rand) token.Permission {
+	permissions := []token.Permission{
+		token.PermissionModify,
+		token.PermissionMint,
+		token.PermissionBurn,
+	}
+	return permissions[r.Intn(len(permissions))]
+}
+
+// randomAmount returns a random amount in [1, max].
+func randomAmount(r *rand.Rand, max sdk.Int) sdk.Int {
+	return sdk.OneInt().Add(simtypes.RandomAmount(r, max.Sub(sdk.OneInt())))
+}
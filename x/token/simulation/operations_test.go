@@ -0,0 +1,202 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	ocabci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lbm-sdk/simapp"
+	simappparams "github.com/line/lbm-sdk/simapp/params"
+	sdk "github.com/line/lbm-sdk/types"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/token/simulation"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{
+		Time: time.Now(),
+	})
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		err := simapp.FundAccount(suite.app, suite.ctx, account.Address, initCoins)
+		suite.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(
+		appParams, cdc, app.AccountKeeper,
+		app.BankKeeper, app.TokenKeeper,
+	)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{
+			simappparams.DefaultWeightMsgTokenIssue,
+			token.ModuleName,
+			simulation.TypeMsgIssue,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenSend,
+			token.ModuleName,
+			simulation.TypeMsgSend,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenOperatorSend,
+			token.ModuleName,
+			simulation.TypeMsgOperatorSend,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenAuthorizeOperator,
+			token.ModuleName,
+			simulation.TypeMsgAuthorizeOperator,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenRevokeOperator,
+			token.ModuleName,
+			simulation.TypeMsgRevokeOperator,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenGrantPermission,
+			token.ModuleName,
+			simulation.TypeMsgGrantPermission,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenRevokePermission,
+			token.ModuleName,
+			simulation.TypeMsgRevokePermission,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenMint,
+			token.ModuleName,
+			simulation.TypeMsgMint,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenBurn,
+			token.ModuleName,
+			simulation.TypeMsgBurn,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenOperatorBurn,
+			token.ModuleName,
+			simulation.TypeMsgOperatorBurn,
+		},
+		{
+			simappparams.DefaultWeightMsgTokenModify,
+			token.ModuleName,
+			simulation.TypeMsgModify,
+		},
+	}
+
+	require.Len(weightedOps, len(expected))
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		require.Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) TestSimulateMsgIssue() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgIssue(app.AccountKeeper, app.BankKeeper, app.TokenKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	var msg token.MsgIssue
+	require.NoError(token.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg))
+
+	require.True(operationMsg.OK, operationMsg.Comment)
+	require.NoError(msg.ValidateBasic())
+	require.Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgSend() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	holder := accounts[0]
+	contractID := app.TokenKeeper.Issue(ctx, token.Contract{
+		Name:     "test",
+		Symbol:   "TT",
+		Decimals: 8,
+	}, holder.Address, holder.Address, sdk.NewInt(1000))
+
+	// execute operation
+	op := simulation.SimulateMsgSend(app.AccountKeeper, app.BankKeeper, app.TokenKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	var msg token.MsgSend
+	require.NoError(token.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg))
+
+	require.True(operationMsg.OK, operationMsg.Comment)
+	require.Equal(contractID, msg.ContractId)
+	require.Equal(holder.Address.String(), msg.From)
+	require.True(msg.Amount.IsPositive())
+	require.True(msg.Amount.LTE(sdk.NewInt(1000)))
+	require.Len(futureOperations, 0)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/x/upgrade/plan"
+	"github.com/line/lbm-sdk/x/upgrade/types"
+)
+
+const (
+	FlagDaemonName = "daemon-name"
+	FlagDaemonHome = "daemon-home"
+)
+
+// NewCmdPreUpgrade returns a command handler for preparing the binary of the
+// scheduled upgrade.
+func NewCmdPreUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pre-upgrade",
+		Short: "Download and verify the binary of the scheduled upgrade",
+		Long: "Download the binary of the currently scheduled upgrade for this platform, verify its checksum,\n" +
+			"and install it into <daemon-home>/cosmovisor/upgrades/<name>/bin along with <home>/data/upgrade-info.json,\n" +
+			"so that cosmovisor can switch binaries when the node halts at the upgrade height.\n" +
+			"The daemon home defaults to $DAEMON_HOME, or the home directory if it is not set.\n" +
+			"The plan must have the structured upgrade info, and only a local file path or file:// url is supported.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentPlan(cmd.Context(), &types.QueryCurrentPlanRequest{})
+			if err != nil {
+				return err
+			}
+			if res.Plan == nil {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			daemonName, err := cmd.Flags().GetString(FlagDaemonName)
+			if err != nil {
+				return err
+			}
+
+			daemonHome, err := cmd.Flags().GetString(FlagDaemonHome)
+			if err != nil {
+				return err
+			}
+			if len(daemonHome) == 0 {
+				daemonHome = os.Getenv("DAEMON_HOME")
+			}
+			if len(daemonHome) == 0 {
+				daemonHome = clientCtx.HomeDir
+			}
+
+			upgradeInfo := plan.UpgradeInfo{
+				Name:   res.Plan.Name,
+				Height: res.Plan.Height,
+				Info:   res.Plan.Info,
+			}
+			dir, err := plan.UpgradeDir(daemonHome, upgradeInfo.Name)
+			if err != nil {
+				return err
+			}
+			if err := plan.PrepareUpgrade(daemonHome, clientCtx.HomeDir, daemonName, upgradeInfo); err != nil {
+				return err
+			}
+
+			cmd.Printf("upgrade %s at height %d is prepared in %s\n", upgradeInfo.Name, upgradeInfo.Height, dir)
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDaemonName, filepath.Base(os.Args[0]), "The name of the binary to install")
+	cmd.Flags().String(FlagDaemonHome, "", "The home directory of cosmovisor; defaults to $DAEMON_HOME or the home directory")
+
+	return cmd
+}
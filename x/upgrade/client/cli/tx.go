@@ -34,7 +34,9 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 		Short: "Submit a software upgrade proposal",
 		Long: "Submit a software upgrade along with an initial deposit.\n" +
 			"Please specify a unique name and height for the upgrade to take effect.\n" +
-			"You may include info to reference a binary download link, in a format compatible with: https://github.com/line/lbm-sdk/tree/master/cosmovisor",
+			"You may include info to reference a binary download link, in a format compatible with: https://github.com/line/lbm-sdk/tree/master/cosmovisor\n" +
+			"If the info is a JSON object, it must be the structured upgrade info, e.g.\n" +
+			`{"binaries":{"linux/amd64":"file:///path/to/binary?checksum=sha256:<hex>"}}`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
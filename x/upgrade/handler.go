@@ -12,7 +12,7 @@ import (
 // It enables SoftwareUpgradeProposal to propose an Upgrade, and CancelSoftwareUpgradeProposal
 // to abort a previously voted upgrade.
 func NewSoftwareUpgradeProposalHandler(k keeper.Keeper) govtypes.Handler {
-	return func(ctx sdk.Context, content govtypes.Content) error {
+	return func(ctx sdk.Context, _ uint64, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.SoftwareUpgradeProposal:
 			return handleSoftwareUpgradeProposal(ctx, k, c)
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"path"
 	"path/filepath"
 	"sort"
 
@@ -19,11 +18,12 @@ import (
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/types/module"
 	xp "github.com/line/lbm-sdk/x/upgrade/exported"
+	"github.com/line/lbm-sdk/x/upgrade/plan"
 	"github.com/line/lbm-sdk/x/upgrade/types"
 )
 
 // UpgradeInfoFileName file to store upgrade information
-const UpgradeInfoFileName string = "upgrade-info.json"
+const UpgradeInfoFileName string = plan.UpgradeInfoFileName
 
 // upgrade defines a comparable structure for sorting upgrades.
 type upgrade struct {
@@ -382,7 +382,7 @@ func (k Keeper) DumpUpgradeInfoWithInfoToDisk(height int64, name string, info st
 		return err
 	}
 
-	upgradeInfo := upgradeInfo{
+	upgradeInfo := plan.UpgradeInfo{
 		Name:   name,
 		Height: height,
 		Info:   info,
@@ -397,13 +397,13 @@ func (k Keeper) DumpUpgradeInfoWithInfoToDisk(height int64, name string, info st
 
 // GetUpgradeInfoPath returns the upgrade info file path
 func (k Keeper) GetUpgradeInfoPath() (string, error) {
-	upgradeInfoFileDir := path.Join(k.getHomeDir(), "data")
-	err := ostos.EnsureDir(upgradeInfoFileDir, os.ModePerm)
+	upgradeInfoFilePath := plan.UpgradeInfoPath(k.getHomeDir())
+	err := ostos.EnsureDir(filepath.Dir(upgradeInfoFilePath), os.ModePerm)
 	if err != nil {
 		return "", err
 	}
 
-	return filepath.Join(upgradeInfoFileDir, UpgradeInfoFileName), nil
+	return upgradeInfoFilePath, nil
 }
 
 // getHomeDir returns the height at which the given upgrade was executed
@@ -440,16 +440,6 @@ func (k Keeper) ReadUpgradeInfoFromDisk() (store.UpgradeInfo, error) {
 	return upgradeInfo, nil
 }
 
-// upgradeInfo is stripped types.Plan structure used to dump upgrade plan data.
-type upgradeInfo struct {
-	// Name has types.Plan.Name value
-	Name string `json:"name,omitempty"`
-	// Height has types.Plan.Height value
-	Height int64 `json:"height,omitempty"`
-	// Height has types.Plan.Height value
-	Info string `json:"info,omitempty"`
-}
-
 // SetDowngradeVerified updates downgradeVerified.
 func (k *Keeper) SetDowngradeVerified(v bool) {
 	k.downgradeVerified = v
@@ -0,0 +1,197 @@
+package plan
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ValidateIsURLWithChecksum checks that url is a valid url with the checksum
+// of the binary, e.g. "file:///path/to/binary?checksum=sha256:<hex>".
+func ValidateIsURLWithChecksum(url string) error {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return err
+	}
+	if len(u.Path) == 0 && len(u.Opaque) == 0 {
+		return errors.New("no path")
+	}
+
+	_, _, err = parseChecksum(u.Query().Get("checksum"))
+	return err
+}
+
+// parseChecksum parses the checksum in the form of "<algorithm>:<hex>".
+func parseChecksum(checksum string) (hash.Hash, []byte, error) {
+	if len(checksum) == 0 {
+		return nil, nil, errors.New("missing checksum query parameter")
+	}
+
+	algo, sum, found := strings.Cut(checksum, ":")
+	if !found {
+		return nil, nil, fmt.Errorf("invalid checksum %s; must be <algorithm>:<hex>", checksum)
+	}
+
+	var h hash.Hash
+	switch algo {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, nil, fmt.Errorf("unsupported checksum algorithm: %s", algo)
+	}
+
+	bz, err := hex.DecodeString(sum)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid checksum %s: %w", checksum, err)
+	}
+	if len(bz) != h.Size() {
+		return nil, nil, fmt.Errorf("invalid %s checksum length: %d", algo, len(bz))
+	}
+
+	return h, bz, nil
+}
+
+// DownloadUpgrade fetches the binary from the url, verifies its checksum, and
+// installs it as an executable at dstRoot/bin/daemonName. Only a local file
+// path or a file:// url is supported as the source.
+func DownloadUpgrade(dstRoot, url, daemonName string) error {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return err
+	}
+	h, checksum, err := parseChecksum(u.Query().Get("checksum"))
+	if err != nil {
+		return err
+	}
+
+	var src string
+	switch u.Scheme {
+	case "":
+		src = u.Path
+	case "file":
+		src = u.Path
+		if len(u.Opaque) != 0 {
+			// e.g. file:relative/path
+			src = u.Opaque
+		}
+	default:
+		return fmt.Errorf("unsupported url scheme: %s", u.Scheme)
+	}
+
+	binDir := filepath.Join(dstRoot, "bin")
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return err
+	}
+
+	// verify on a temporary file, so a corrupted binary is never installed
+	tmp, err := os.CreateTemp(binDir, daemonName+".download-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := copyWithHash(tmp, src, h); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if sum := h.Sum(nil); !bytes.Equal(sum, checksum) {
+		return fmt.Errorf("checksum mismatch; expected %X, got %X", checksum, sum)
+	}
+
+	if err := os.Chmod(tmp.Name(), 0o755); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filepath.Join(binDir, daemonName))
+}
+
+func copyWithHash(dst io.Writer, src string, h hash.Hash) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(io.MultiWriter(dst, h), f)
+	return err
+}
+
+// ValidateName checks that the upgrade name is safe to be used as the name of
+// its directory.
+func ValidateName(name string) error {
+	if len(name) == 0 {
+		return errors.New("empty upgrade name")
+	}
+	if strings.ContainsAny(name, `/\`) || strings.Contains(name, "..") {
+		return fmt.Errorf("invalid upgrade name %s; must not contain path separators or ..", name)
+	}
+	return nil
+}
+
+// UpgradeDir returns the directory of the upgrade in the layout of cosmovisor,
+// daemonHome/cosmovisor/upgrades/name.
+func UpgradeDir(daemonHome, name string) (string, error) {
+	if err := ValidateName(name); err != nil {
+		return "", err
+	}
+	return filepath.Join(daemonHome, "cosmovisor", "upgrades", name), nil
+}
+
+// UpgradeInfoPath returns the path of the upgrade information in the home
+// directory of the node, homeDir/data/upgrade-info.json, which the upgrade
+// keeper writes when the node halts at the upgrade height.
+func UpgradeInfoPath(homeDir string) string {
+	return filepath.Join(homeDir, "data", UpgradeInfoFileName)
+}
+
+// PrepareUpgrade installs the binary of the upgrade for the running platform
+// at daemonHome/cosmovisor/upgrades/<name>/bin/daemonName, and writes the
+// upgrade information at homeDir/data/upgrade-info.json, so that cosmovisor can
+// switch to the binary when the node halts at the upgrade height.
+func PrepareUpgrade(daemonHome, homeDir, daemonName string, upgradeInfo UpgradeInfo) error {
+	dir, err := UpgradeDir(daemonHome, upgradeInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	info, err := ParseInfo(upgradeInfo.Info)
+	if err != nil {
+		return err
+	}
+
+	url, err := info.Binaries.URLFor(OSArch())
+	if err != nil {
+		return err
+	}
+
+	if err := DownloadUpgrade(dir, url, daemonName); err != nil {
+		return fmt.Errorf("failed to download the binary of upgrade %s: %w", upgradeInfo.Name, err)
+	}
+
+	bz, err := json.Marshal(upgradeInfo)
+	if err != nil {
+		return err
+	}
+
+	upgradeInfoPath := UpgradeInfoPath(homeDir)
+	if err := os.MkdirAll(filepath.Dir(upgradeInfoPath), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(upgradeInfoPath, bz, 0o600)
+}
@@ -0,0 +1,194 @@
+package plan_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/x/upgrade/plan"
+)
+
+func TestValidateIsURLWithChecksum(t *testing.T) {
+	testCases := map[string]struct {
+		url   string
+		valid bool
+	}{
+		"local path": {
+			url:   "/foo/bar?checksum=sha256:" + emptySHA256,
+			valid: true,
+		},
+		"file url": {
+			url:   "file:///foo/bar?checksum=sha256:" + emptySHA256,
+			valid: true,
+		},
+		"no path": {
+			url: "?checksum=sha256:" + emptySHA256,
+		},
+		"no checksum": {
+			url: "file:///foo/bar",
+		},
+		"no algorithm": {
+			url: "file:///foo/bar?checksum=" + emptySHA256,
+		},
+		"unsupported algorithm": {
+			url: "file:///foo/bar?checksum=md5:d41d8cd98f00b204e9800998ecf8427e",
+		},
+		"invalid length": {
+			url: "file:///foo/bar?checksum=sha512:" + emptySHA256,
+		},
+		"not hex": {
+			url: "file:///foo/bar?checksum=sha256:xyz",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := plan.ValidateIsURLWithChecksum(tc.url)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDownloadUpgrade(t *testing.T) {
+	srcDir := t.TempDir()
+	src := filepath.Join(srcDir, "simd")
+	content := []byte("#!/bin/sh\necho upgraded\n")
+	require.NoError(t, os.WriteFile(src, content, 0o600))
+	sum := sha256.Sum256(content)
+	checksum := "sha256:" + hex.EncodeToString(sum[:])
+
+	testCases := map[string]struct {
+		url   string
+		valid bool
+	}{
+		"local path": {
+			url:   fmt.Sprintf("%s?checksum=%s", src, checksum),
+			valid: true,
+		},
+		"file url": {
+			url:   fmt.Sprintf("file://%s?checksum=%s", src, checksum),
+			valid: true,
+		},
+		"checksum mismatch": {
+			url: fmt.Sprintf("file://%s?checksum=sha256:%s", src, emptySHA256),
+		},
+		"not found": {
+			url: fmt.Sprintf("file://%s?checksum=%s", filepath.Join(srcDir, "nope"), checksum),
+		},
+		"unsupported scheme": {
+			url: fmt.Sprintf("https://foo.bar/simd?checksum=%s", checksum),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dstRoot := t.TempDir()
+			err := plan.DownloadUpgrade(dstRoot, tc.url, "simd")
+
+			binDir := filepath.Join(dstRoot, "bin")
+			if !tc.valid {
+				require.Error(t, err)
+
+				// nothing left
+				entries, _ := os.ReadDir(binDir)
+				require.Empty(t, entries)
+				return
+			}
+			require.NoError(t, err)
+
+			installed := filepath.Join(binDir, "simd")
+			bz, err := os.ReadFile(installed)
+			require.NoError(t, err)
+			require.Equal(t, content, bz)
+
+			stat, err := os.Stat(installed)
+			require.NoError(t, err)
+			require.NotZero(t, stat.Mode()&0o100)
+		})
+	}
+}
+
+func TestPrepareUpgrade(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "simd")
+	content := []byte("upgraded")
+	require.NoError(t, os.WriteFile(src, content, 0o600))
+	sum := sha256.Sum256(content)
+
+	info := fmt.Sprintf(`{"binaries":{%q:"file://%s?checksum=sha256:%x"}}`, plan.OSArch(), src, sum)
+	upgradeInfo := plan.UpgradeInfo{
+		Name:   "v2",
+		Height: 123,
+		Info:   info,
+	}
+
+	daemonHome, home := t.TempDir(), t.TempDir()
+	require.NoError(t, plan.PrepareUpgrade(daemonHome, home, "simd", upgradeInfo))
+
+	bz, err := os.ReadFile(filepath.Join(daemonHome, "cosmovisor", "upgrades", "v2", "bin", "simd"))
+	require.NoError(t, err)
+	require.Equal(t, content, bz)
+
+	bz, err = os.ReadFile(filepath.Join(home, "data", plan.UpgradeInfoFileName))
+	require.NoError(t, err)
+	var written plan.UpgradeInfo
+	require.NoError(t, json.Unmarshal(bz, &written))
+	require.Equal(t, upgradeInfo, written)
+
+	// free-form info
+	upgradeInfo.Info = "https://foo.bar/baz"
+	require.Error(t, plan.PrepareUpgrade(t.TempDir(), t.TempDir(), "simd", upgradeInfo))
+
+	// name escaping the upgrades directory
+	upgradeInfo.Info = info
+	upgradeInfo.Name = "../../bin"
+	daemonHome = t.TempDir()
+	require.Error(t, plan.PrepareUpgrade(daemonHome, t.TempDir(), "simd", upgradeInfo))
+	_, err = os.Stat(filepath.Join(daemonHome, "bin"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestValidateName(t *testing.T) {
+	testCases := map[string]struct {
+		name  string
+		valid bool
+	}{
+		"valid": {
+			name:  "v2.0.1",
+			valid: true,
+		},
+		"empty": {},
+		"slash": {
+			name: "v2/bin",
+		},
+		"backslash": {
+			name: `v2\bin`,
+		},
+		"parent": {
+			name: "..",
+		},
+		"dots": {
+			name: "v2..1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := plan.ValidateName(tc.name)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package plan
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+const (
+	// UpgradeInfoFileName is the name of the file to store upgrade information
+	UpgradeInfoFileName = "upgrade-info.json"
+
+	// osArchAny is the platform key of the binary which can run on any platform.
+	osArchAny = "any"
+)
+
+var osArchRegex = regexp.MustCompile(`^[a-z0-9]+/[a-z0-9]+$`)
+
+// Info is the structured upgrade information which may be stored in Plan.Info.
+type Info struct {
+	Binaries BinaryDownloadURLMap `json:"binaries"`
+}
+
+// BinaryDownloadURLMap maps the platforms to the urls of the binaries. A
+// platform is either "os/arch" (e.g. "linux/amd64") or "any". A url must have
+// the checksum of the binary as its query, e.g. "?checksum=sha256:<hex>".
+type BinaryDownloadURLMap map[string]string
+
+// IsStructured returns whether infoStr is meant to be a structured upgrade
+// information, rather than a free-form one.
+func IsStructured(infoStr string) bool {
+	return strings.HasPrefix(strings.TrimSpace(infoStr), "{")
+}
+
+// ParseInfo parses the structured upgrade information, and validates it.
+func ParseInfo(infoStr string) (*Info, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(infoStr))
+	decoder.DisallowUnknownFields()
+
+	var info Info
+	if err := decoder.Decode(&info); err != nil {
+		return nil, fmt.Errorf("can't unmarshal upgrade info: %w", err)
+	}
+
+	if err := info.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// ValidateBasic performs basic validation of the upgrade information.
+func (m Info) ValidateBasic() error {
+	return m.Binaries.ValidateBasic()
+}
+
+// ValidateBasic performs basic validation of the binaries, without accessing
+// them.
+func (m BinaryDownloadURLMap) ValidateBasic() error {
+	if len(m) == 0 {
+		return fmt.Errorf("no binaries defined")
+	}
+
+	for osArch, url := range m {
+		if osArch != osArchAny && !osArchRegex.MatchString(osArch) {
+			return fmt.Errorf("invalid platform %q; must be os/arch or %s", osArch, osArchAny)
+		}
+		if err := ValidateIsURLWithChecksum(url); err != nil {
+			return fmt.Errorf("invalid url for %s: %w", osArch, err)
+		}
+	}
+
+	return nil
+}
+
+// URLFor returns the url of the binary for the platform, falling back to the
+// one for any platform.
+func (m BinaryDownloadURLMap) URLFor(osArch string) (string, error) {
+	if url, ok := m[osArch]; ok {
+		return url, nil
+	}
+	if url, ok := m[osArchAny]; ok {
+		return url, nil
+	}
+
+	return "", fmt.Errorf("no binary for %s", osArch)
+}
+
+// OSArch returns the platform of the running binary.
+func OSArch() string {
+	return fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+// UpgradeInfo is the upgrade information written to disk for the supervisor of
+// the node, e.g. on the halt at the upgrade height.
+type UpgradeInfo struct {
+	// Name has types.Plan.Name value
+	Name string `json:"name,omitempty"`
+	// Height has types.Plan.Height value
+	Height int64 `json:"height,omitempty"`
+	// Info has types.Plan.Info value
+	Info string `json:"info,omitempty"`
+}
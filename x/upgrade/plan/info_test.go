@@ -0,0 +1,79 @@
+package plan_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/x/upgrade/plan"
+)
+
+const emptySHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+func TestParseInfo(t *testing.T) {
+	testCases := map[string]struct {
+		info  string
+		valid bool
+	}{
+		"valid": {
+			info:  `{"binaries":{"linux/amd64":"file:///foo/bar?checksum=sha256:` + emptySHA256 + `"}}`,
+			valid: true,
+		},
+		"valid for any platform": {
+			info:  `{"binaries":{"any":"/foo/bar?checksum=sha256:` + emptySHA256 + `"}}`,
+			valid: true,
+		},
+		"malformed": {
+			info: `{"binaries":`,
+		},
+		"unknown field": {
+			info: `{"binaries":{"any":"/foo/bar?checksum=sha256:` + emptySHA256 + `"},"foo":"bar"}`,
+		},
+		"no binaries": {
+			info: `{"binaries":{}}`,
+		},
+		"invalid platform": {
+			info: `{"binaries":{"linux":"/foo/bar?checksum=sha256:` + emptySHA256 + `"}}`,
+		},
+		"no checksum": {
+			info: `{"binaries":{"any":"/foo/bar"}}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			info, err := plan.ParseInfo(tc.info)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, info.Binaries)
+		})
+	}
+}
+
+func TestIsStructured(t *testing.T) {
+	require.True(t, plan.IsStructured(` {"binaries":{}}`))
+	require.False(t, plan.IsStructured("https://foo.bar/baz"))
+	require.False(t, plan.IsStructured(""))
+}
+
+func TestURLFor(t *testing.T) {
+	binaries := plan.BinaryDownloadURLMap{
+		"linux/amd64": "linux",
+		"any":         "any",
+	}
+
+	url, err := binaries.URLFor("linux/amd64")
+	require.NoError(t, err)
+	require.Equal(t, "linux", url)
+
+	url, err = binaries.URLFor("darwin/arm64")
+	require.NoError(t, err)
+	require.Equal(t, "any", url)
+
+	delete(binaries, "any")
+	_, err = binaries.URLFor("darwin/arm64")
+	require.Error(t, err)
+}
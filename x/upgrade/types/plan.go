@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/upgrade/plan"
 )
 
 func (p Plan) String() string {
@@ -26,6 +27,14 @@ func (p Plan) ValidateBasic() error {
 	if p.Height <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
+	if plan.IsStructured(p.Info) {
+		if err := plan.ValidateName(p.Name); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if _, err := plan.ParseInfo(p.Info); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 
 	return nil
 }
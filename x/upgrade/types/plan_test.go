@@ -88,6 +88,43 @@ func TestPlanValid(t *testing.T) {
 				Height: -12345,
 			},
 		},
+		"free-form info": {
+			p: types.Plan{
+				Name:   "free-form",
+				Height: 123450000,
+				Info:   "https://foo.bar/baz",
+			},
+			valid: true,
+		},
+		"structured info": {
+			p: types.Plan{
+				Name:   "structured",
+				Height: 123450000,
+				Info:   `{"binaries":{"linux/amd64":"file:///foo/bar?checksum=sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}}`,
+			},
+			valid: true,
+		},
+		"structured info without checksum": {
+			p: types.Plan{
+				Name:   "no-checksum",
+				Height: 123450000,
+				Info:   `{"binaries":{"linux/amd64":"file:///foo/bar"}}`,
+			},
+		},
+		"structured info with a path in the name": {
+			p: types.Plan{
+				Name:   "../structured",
+				Height: 123450000,
+				Info:   `{"binaries":{"linux/amd64":"file:///foo/bar?checksum=sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}}`,
+			},
+		},
+		"malformed structured info": {
+			p: types.Plan{
+				Name:   "malformed",
+				Height: 123450000,
+				Info:   `{"binaries":`,
+			},
+		},
 	}
 
 	for name, tc := range cases {